@@ -0,0 +1,40 @@
+package cli
+
+import (
+	"context"
+	"log"
+
+	"volunteer-system/config"
+	"volunteer-system/internal/service"
+	"volunteer-system/pkg/database/mysql"
+	"volunteer-system/pkg/logger"
+)
+
+// CreateAdmin 创建平台管理员账号（用于初始化第一个管理员）
+func CreateAdmin(username, email, phone, password string) error {
+	cfg := config.LoadConfig()
+
+	if cfg.Logging != nil {
+		if err := logger.Init(cfg.Logging.Level, cfg.Logging.Console, cfg.Logging.File); err != nil {
+			log.Fatalf("日志器初始化失败: %v", err)
+		}
+	}
+	if cfg.MySQL == nil {
+		log.Fatalf("未配置MySQL，无法创建平台管理员")
+	}
+	if _, err := mysql.InitMySQL(cfg.MySQL); err != nil {
+		log.Fatalf("MySQL初始化失败: %v", err)
+	}
+	defer func() {
+		if err := mysql.CloseMySQL(); err != nil {
+			log.Printf("关闭MySQL连接失败: %v", err)
+		}
+	}()
+
+	account, err := service.NewAdminService(context.Background(), nil).CreateAdmin(username, email, phone, password)
+	if err != nil {
+		return err
+	}
+	log.Printf("平台管理员创建成功: 账号ID=%d, 用户名=%s", account.ID, account.Username)
+	return nil
+}
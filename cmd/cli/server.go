@@ -7,6 +7,8 @@ import (
 
 	"volunteer-system/config"
 	"volunteer-system/internal/router"
+	"volunteer-system/internal/scheduler"
+	"volunteer-system/internal/worker"
 	"volunteer-system/pkg/database/mysql"
 	"volunteer-system/pkg/database/redis"
 	"volunteer-system/pkg/logger"
@@ -40,6 +42,16 @@ func StartServer() {
 	}
 	defer closeDatabases()
 
+	// 启动后台调度（多实例通过Redis选主，仅主节点执行任务）
+	sched := scheduler.New(&cfg)
+	sched.Start()
+	defer sched.Stop()
+
+	// 启动异步任务执行器（导入、导出等耗时操作）
+	jobWorker := worker.New(&cfg)
+	jobWorker.Start()
+	defer jobWorker.Stop()
+
 	// 启动HTTP服务器
 	initHttpServer(&cfg)
 }
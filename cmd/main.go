@@ -10,13 +10,30 @@ import (
 
 func main() {
 	// 定义命令行参数
-	command := flag.String("c", "server", "Command to execute: server (default), version, help")
+	command := flag.String("c", "server", "Command to execute: server (default), create-admin, version, help")
+	username := flag.String("username", "", "Admin username (create-admin)")
+	email := flag.String("email", "", "Admin email (create-admin)")
+	phone := flag.String("phone", "", "Admin mobile phone (create-admin)")
+	password := flag.String("password", "", "Admin password (create-admin), defaults to $ADMIN_PASSWORD")
 	flag.Parse()
 
 	switch *command {
 	case "server":
 		// 启动服务器
 		cli.StartServer()
+	case "create-admin":
+		// 创建平台管理员
+		if *password == "" {
+			*password = os.Getenv("ADMIN_PASSWORD")
+		}
+		if *username == "" || *email == "" || *phone == "" || *password == "" {
+			log.Println("create-admin requires -username, -email, -phone and -password (or ADMIN_PASSWORD)")
+			os.Exit(1)
+		}
+		if err := cli.CreateAdmin(*username, *email, *phone, *password); err != nil {
+			log.Printf("Create admin failed: %v", err)
+			os.Exit(1)
+		}
 	case "version":
 		log.Println("Volunteer System v1.0.0")
 	case "help":
@@ -31,10 +48,15 @@ func main() {
 func printHelp() {
 	log.Println("Usage: volunteer-system [options]")
 	log.Println("Options:")
-	log.Println("  -c command    Command to execute: server, version, help")
+	log.Println("  -c command    Command to execute: server, create-admin, version, help")
+	log.Println("  -username     Admin username (create-admin)")
+	log.Println("  -email        Admin email (create-admin)")
+	log.Println("  -phone        Admin mobile phone (create-admin)")
+	log.Println("  -password     Admin password (create-admin), or set ADMIN_PASSWORD")
 	log.Println("")
 	log.Println("Commands:")
 	log.Println("  server        Start the HTTP server (default)")
+	log.Println("  create-admin  Create a platform admin account")
 	log.Println("  version       Show version information")
 	log.Println("  help          Show this help message")
 }
@@ -73,15 +73,70 @@ type AuthConfig struct {
 	} `mapstructure:"jwt"`
 }
 
+// CreditConfig 信用分策略配置（未配置的项使用默认值）
+type CreditConfig struct {
+	InitialScore      int `mapstructure:"initial_score"`       // 信用分上限（即初始分）
+	NoShowPenalty     int `mapstructure:"no_show_penalty"`     // 每次爽约扣分（须为正数）
+	StreakLength      int `mapstructure:"streak_length"`       // 连续出勤多少次奖励一次（须为正数）
+	StreakBonus       int `mapstructure:"streak_bonus"`        // 连续出勤奖励分（须为正数）
+	SignupRejectBelow int `mapstructure:"signup_reject_below"` // 低于该分数拒绝报名（-1表示不限制）
+}
+
+// SchedulerConfig 后台调度配置
+type SchedulerConfig struct {
+	Enabled                  bool `mapstructure:"enabled"`
+	IntervalSeconds          int  `mapstructure:"interval_seconds"`            // 调度间隔（秒）
+	LockTTLSeconds           int  `mapstructure:"lock_ttl_seconds"`            // 主节点锁有效期（秒）
+	BatchSize                int  `mapstructure:"batch_size"`                  // 单次任务最多处理的记录数
+	SignupCloseBeforeMinutes int  `mapstructure:"signup_close_before_minutes"` // 活动开始前多少分钟截止报名
+	FinishGraceMinutes       int  `mapstructure:"finish_grace_minutes"`        // 活动结束后多少分钟自动完结（未配置或为0时默认30分钟）
+}
+
+// CertificateConfig 证书配置
+type CertificateConfig struct {
+	VerifyURL string `mapstructure:"verify_url"` // 证书核验页地址，证书二维码内容为 verify_url?code=核验码；为空时二维码仅包含核验码
+}
+
+// JobConfig 异步任务配置
+type JobConfig struct {
+	Enabled             bool `mapstructure:"enabled"`
+	Workers             int  `mapstructure:"workers"`               // 每个实例的 worker 协程数
+	PollIntervalSeconds int  `mapstructure:"poll_interval_seconds"` // 延迟重试转入队列、补偿扫描数据库的间隔（秒）
+	MaxAttempts         int  `mapstructure:"max_attempts"`          // 任务类型未指定时的最大执行次数（含首次）
+	RetryBackoffSeconds int  `mapstructure:"retry_backoff_seconds"` // 首次重试间隔（秒），之后按 2 的幂递增
+	MaxBackoffSeconds   int  `mapstructure:"max_backoff_seconds"`   // 重试间隔上限（秒）
+	StaleSeconds        int  `mapstructure:"stale_seconds"`         // 执行中任务超过该时长无心跳视为中断（秒）
+}
+
+// AuditSLAConfig 审核时限配置：按审核类型设置处理时限，超时后由后台调度提醒审核人，可选超时自动通过或驳回
+type AuditSLAConfig struct {
+	Enabled             bool           `mapstructure:"enabled"`
+	RemindIntervalHours int            `mapstructure:"remind_interval_hours"` // 超时未处理时重复提醒的间隔（小时）
+	Rules               []AuditSLARule `mapstructure:"rules"`
+}
+
+// AuditSLARule 单个审核类型的处理时限，未配置的审核类型不计算超时
+type AuditSLARule struct {
+	TargetType     int32  `mapstructure:"target_type"`      // 审核类型: 1-志愿者实名, 2-组织资质, 3-加入/退出组织, 4-活动报名
+	DeadlineHours  int    `mapstructure:"deadline_hours"`   // 提交后多少小时内应处理
+	AutoAction     string `mapstructure:"auto_action"`      // 超时自动处理: none-不处理, approve-自动通过, reject-自动驳回
+	AutoAfterHours int    `mapstructure:"auto_after_hours"` // 提交后多少小时自动处理（不早于处理时限，0 表示到达处理时限即处理）
+}
+
 // Config 完整的配置结构
 type Config struct {
-	App     AppConfig          `mapstructure:"app"`
-	MySQL   *mysql.MySQLConfig `mapstructure:"mysql"`
-	Redis   *redis.RedisConfig `mapstructure:"redis"`
-	Email   *EmailConfig       `mapstructure:"email"`
-	Upload  *UploadConfig      `mapstructure:"upload"`
-	Logging *LoggingConfig     `mapstructure:"logging"`
-	Auth    *AuthConfig        `mapstructure:"auth"`
+	App         AppConfig          `mapstructure:"app"`
+	MySQL       *mysql.MySQLConfig `mapstructure:"mysql"`
+	Redis       *redis.RedisConfig `mapstructure:"redis"`
+	Email       *EmailConfig       `mapstructure:"email"`
+	Upload      *UploadConfig      `mapstructure:"upload"`
+	Logging     *LoggingConfig     `mapstructure:"logging"`
+	Auth        *AuthConfig        `mapstructure:"auth"`
+	Credit      *CreditConfig      `mapstructure:"credit"`
+	Scheduler   *SchedulerConfig   `mapstructure:"scheduler"`
+	Certificate *CertificateConfig `mapstructure:"certificate"`
+	Job         *JobConfig         `mapstructure:"job"`
+	AuditSLA    *AuditSLAConfig    `mapstructure:"audit_sla"`
 }
 
 var conf Config
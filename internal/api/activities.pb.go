@@ -163,7 +163,9 @@ type ActivityItem struct {
 	// 是否已报名 (当前用户)
 	IsRegistered bool `protobuf:"varint,12,opt,name=isRegistered,proto3" json:"isRegistered"`
 	// 是否已满员
-	IsFull        bool `protobuf:"varint,13,opt,name=isFull,proto3" json:"isFull"`
+	IsFull bool `protobuf:"varint,13,opt,name=isFull,proto3" json:"isFull"`
+	// 所属活动系列ID（0表示单次活动）
+	SeriesId      int64 `protobuf:"varint,14,opt,name=seriesId,proto3" json:"seriesId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -289,10 +291,19 @@ func (x *ActivityItem) GetIsFull() bool {
 	return false
 }
 
+func (x *ActivityItem) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
 type ActivitySignupRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 活动ID 必填 @gotags: json:"activityId,required"
-	ActivityId    int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
+	// 班次岗位ID 可选，活动设置了班次岗位时必填 @gotags: json:"slotId"
+	SlotId        int64 `protobuf:"varint,2,opt,name=slotId,proto3" json:"slotId"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -334,12 +345,23 @@ func (x *ActivitySignupRequest) GetActivityId() int64 {
 	return 0
 }
 
+func (x *ActivitySignupRequest) GetSlotId() int64 {
+	if x != nil {
+		return x.SlotId
+	}
+	return 0
+}
+
 type ActivitySignupResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 报名成功
-	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	// 是否进入候补队列（名额已满时自动排队）
+	Waitlisted bool `protobuf:"varint,2,opt,name=waitlisted,proto3" json:"waitlisted"`
+	// 候补排位（从1开始，仅 waitlisted=true 时有效）
+	WaitlistPosition int32 `protobuf:"varint,3,opt,name=waitlistPosition,proto3" json:"waitlistPosition"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *ActivitySignupResponse) Reset() {
@@ -379,6 +401,20 @@ func (x *ActivitySignupResponse) GetSuccess() bool {
 	return false
 }
 
+func (x *ActivitySignupResponse) GetWaitlisted() bool {
+	if x != nil {
+		return x.Waitlisted
+	}
+	return false
+}
+
+func (x *ActivitySignupResponse) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
 type ActivityCancelRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 活动ID 必填 @gotags: json:"activityId,required"
@@ -469,30 +505,28 @@ func (x *ActivityCancelResponse) GetSuccess() bool {
 	return false
 }
 
-type ActivityCheckInRequest struct {
+type ActivityWaitlistStatusRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 活动ID 必填 @gotags: json:"activityId,required"
-	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
-	// 签到码 必填 @gotags: json:"checkInCode,required"
-	CheckInCode   string `protobuf:"bytes,2,opt,name=checkInCode,proto3" json:"checkInCode,required"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivityCheckInRequest) Reset() {
-	*x = ActivityCheckInRequest{}
+func (x *ActivityWaitlistStatusRequest) Reset() {
+	*x = ActivityWaitlistStatusRequest{}
 	mi := &file_internal_api_activities_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityCheckInRequest) String() string {
+func (x *ActivityWaitlistStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityCheckInRequest) ProtoMessage() {}
+func (*ActivityWaitlistStatusRequest) ProtoMessage() {}
 
-func (x *ActivityCheckInRequest) ProtoReflect() protoreflect.Message {
+func (x *ActivityWaitlistStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_activities_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -504,49 +538,48 @@ func (x *ActivityCheckInRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityCheckInRequest.ProtoReflect.Descriptor instead.
-func (*ActivityCheckInRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityWaitlistStatusRequest.ProtoReflect.Descriptor instead.
+func (*ActivityWaitlistStatusRequest) Descriptor() ([]byte, []int) {
 	return file_internal_api_activities_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *ActivityCheckInRequest) GetActivityId() int64 {
+func (x *ActivityWaitlistStatusRequest) GetId() int64 {
 	if x != nil {
-		return x.ActivityId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ActivityCheckInRequest) GetCheckInCode() string {
-	if x != nil {
-		return x.CheckInCode
-	}
-	return ""
-}
-
-type ActivityCheckInResponse struct {
+type ActivityWaitlistStatusResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否成功
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	// 签到时间
-	CheckInTime   string `protobuf:"bytes,2,opt,name=checkInTime,proto3" json:"checkInTime"`
+	// 候补状态: 0-未排队, 1-排队中, 2-已递补, 3-已退出
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// 当前排位（从1开始，仅排队中有效）
+	Position int32 `protobuf:"varint,2,opt,name=position,proto3" json:"position"`
+	// 活动当前排队总人数
+	WaitingCount int32 `protobuf:"varint,3,opt,name=waitingCount,proto3" json:"waitingCount"`
+	// 入队时间
+	JoinedAt string `protobuf:"bytes,4,opt,name=joinedAt,proto3" json:"joinedAt"`
+	// 递补时间
+	PromotedAt    string `protobuf:"bytes,5,opt,name=promotedAt,proto3" json:"promotedAt"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivityCheckInResponse) Reset() {
-	*x = ActivityCheckInResponse{}
+func (x *ActivityWaitlistStatusResponse) Reset() {
+	*x = ActivityWaitlistStatusResponse{}
 	mi := &file_internal_api_activities_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityCheckInResponse) String() string {
+func (x *ActivityWaitlistStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityCheckInResponse) ProtoMessage() {}
+func (*ActivityWaitlistStatusResponse) ProtoMessage() {}
 
-func (x *ActivityCheckInResponse) ProtoReflect() protoreflect.Message {
+func (x *ActivityWaitlistStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_activities_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -558,49 +591,68 @@ func (x *ActivityCheckInResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityCheckInResponse.ProtoReflect.Descriptor instead.
-func (*ActivityCheckInResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityWaitlistStatusResponse.ProtoReflect.Descriptor instead.
+func (*ActivityWaitlistStatusResponse) Descriptor() ([]byte, []int) {
 	return file_internal_api_activities_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *ActivityCheckInResponse) GetSuccess() bool {
+func (x *ActivityWaitlistStatusResponse) GetStatus() int32 {
 	if x != nil {
-		return x.Success
+		return x.Status
 	}
-	return false
+	return 0
 }
 
-func (x *ActivityCheckInResponse) GetCheckInTime() string {
+func (x *ActivityWaitlistStatusResponse) GetPosition() int32 {
 	if x != nil {
-		return x.CheckInTime
+		return x.Position
+	}
+	return 0
+}
+
+func (x *ActivityWaitlistStatusResponse) GetWaitingCount() int32 {
+	if x != nil {
+		return x.WaitingCount
+	}
+	return 0
+}
+
+func (x *ActivityWaitlistStatusResponse) GetJoinedAt() string {
+	if x != nil {
+		return x.JoinedAt
 	}
 	return ""
 }
 
-type ActivityCheckOutRequest struct {
+func (x *ActivityWaitlistStatusResponse) GetPromotedAt() string {
+	if x != nil {
+		return x.PromotedAt
+	}
+	return ""
+}
+
+type ActivityWaitlistLeaveRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 活动ID 必填 @gotags: json:"activityId,required"
-	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
-	// 签退码 必填 @gotags: json:"checkOutCode,required"
-	CheckOutCode  string `protobuf:"bytes,2,opt,name=checkOutCode,proto3" json:"checkOutCode,required"`
+	ActivityId    int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivityCheckOutRequest) Reset() {
-	*x = ActivityCheckOutRequest{}
+func (x *ActivityWaitlistLeaveRequest) Reset() {
+	*x = ActivityWaitlistLeaveRequest{}
 	mi := &file_internal_api_activities_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityCheckOutRequest) String() string {
+func (x *ActivityWaitlistLeaveRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityCheckOutRequest) ProtoMessage() {}
+func (*ActivityWaitlistLeaveRequest) ProtoMessage() {}
 
-func (x *ActivityCheckOutRequest) ProtoReflect() protoreflect.Message {
+func (x *ActivityWaitlistLeaveRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_activities_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -612,51 +664,40 @@ func (x *ActivityCheckOutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityCheckOutRequest.ProtoReflect.Descriptor instead.
-func (*ActivityCheckOutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityWaitlistLeaveRequest.ProtoReflect.Descriptor instead.
+func (*ActivityWaitlistLeaveRequest) Descriptor() ([]byte, []int) {
 	return file_internal_api_activities_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *ActivityCheckOutRequest) GetActivityId() int64 {
+func (x *ActivityWaitlistLeaveRequest) GetActivityId() int64 {
 	if x != nil {
 		return x.ActivityId
 	}
 	return 0
 }
 
-func (x *ActivityCheckOutRequest) GetCheckOutCode() string {
-	if x != nil {
-		return x.CheckOutCode
-	}
-	return ""
-}
-
-type ActivityCheckOutResponse struct {
+type ActivityWaitlistLeaveResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 是否成功
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	// 签退时间
-	CheckOutTime string `protobuf:"bytes,2,opt,name=checkOutTime,proto3" json:"checkOutTime"`
-	// 本次发放工时
-	GrantedHours  float64 `protobuf:"fixed64,3,opt,name=grantedHours,proto3" json:"grantedHours"`
+	Success       bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivityCheckOutResponse) Reset() {
-	*x = ActivityCheckOutResponse{}
+func (x *ActivityWaitlistLeaveResponse) Reset() {
+	*x = ActivityWaitlistLeaveResponse{}
 	mi := &file_internal_api_activities_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityCheckOutResponse) String() string {
+func (x *ActivityWaitlistLeaveResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityCheckOutResponse) ProtoMessage() {}
+func (*ActivityWaitlistLeaveResponse) ProtoMessage() {}
 
-func (x *ActivityCheckOutResponse) ProtoReflect() protoreflect.Message {
+func (x *ActivityWaitlistLeaveResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_activities_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -668,62 +709,48 @@ func (x *ActivityCheckOutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityCheckOutResponse.ProtoReflect.Descriptor instead.
-func (*ActivityCheckOutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityWaitlistLeaveResponse.ProtoReflect.Descriptor instead.
+func (*ActivityWaitlistLeaveResponse) Descriptor() ([]byte, []int) {
 	return file_internal_api_activities_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *ActivityCheckOutResponse) GetSuccess() bool {
+func (x *ActivityWaitlistLeaveResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ActivityCheckOutResponse) GetCheckOutTime() string {
-	if x != nil {
-		return x.CheckOutTime
-	}
-	return ""
-}
-
-func (x *ActivityCheckOutResponse) GetGrantedHours() float64 {
-	if x != nil {
-		return x.GrantedHours
-	}
-	return 0
-}
-
-type ActivitySupplementAttendanceRequest struct {
+type ActivityCheckInRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 活动ID 必填 @gotags: json:"activityId,required"
 	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
-	// 志愿者ID（volunteers.id）必填 @gotags: json:"volunteerId,required"
-	VolunteerId int64 `protobuf:"varint,2,opt,name=volunteerId,proto3" json:"volunteerId,required"`
-	// 补录签到时间（当原记录未签到时必填）格式: 2006-01-02 15:04:05 @gotags: json:"checkInTime"
-	CheckInTime string `protobuf:"bytes,3,opt,name=checkInTime,proto3" json:"checkInTime"`
-	// 补录签退时间 必填 格式: 2006-01-02 15:04:05 @gotags: json:"checkOutTime,required"
-	CheckOutTime string `protobuf:"bytes,4,opt,name=checkOutTime,proto3" json:"checkOutTime,required"`
-	// 补录原因 可选 @gotags: json:"reason"
-	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason"`
+	// 签到码 与 qrToken 二选一 @gotags: json:"checkInCode"
+	CheckInCode string `protobuf:"bytes,2,opt,name=checkInCode,proto3" json:"checkInCode"`
+	// 扫码得到的签到二维码令牌 与 checkInCode 二选一 @gotags: json:"qrToken"
+	QrToken string `protobuf:"bytes,3,opt,name=qrToken,proto3" json:"qrToken"`
+	// 设备定位纬度 活动启用签到围栏时必填 @gotags: json:"latitude"
+	Latitude float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude"`
+	// 设备定位经度 活动启用签到围栏时必填 @gotags: json:"longitude"
+	Longitude     float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivitySupplementAttendanceRequest) Reset() {
-	*x = ActivitySupplementAttendanceRequest{}
+func (x *ActivityCheckInRequest) Reset() {
+	*x = ActivityCheckInRequest{}
 	mi := &file_internal_api_activities_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivitySupplementAttendanceRequest) String() string {
+func (x *ActivityCheckInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivitySupplementAttendanceRequest) ProtoMessage() {}
+func (*ActivityCheckInRequest) ProtoMessage() {}
 
-func (x *ActivitySupplementAttendanceRequest) ProtoReflect() protoreflect.Message {
+func (x *ActivityCheckInRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_activities_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -735,74 +762,74 @@ func (x *ActivitySupplementAttendanceRequest) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivitySupplementAttendanceRequest.ProtoReflect.Descriptor instead.
-func (*ActivitySupplementAttendanceRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityCheckInRequest.ProtoReflect.Descriptor instead.
+func (*ActivityCheckInRequest) Descriptor() ([]byte, []int) {
 	return file_internal_api_activities_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ActivitySupplementAttendanceRequest) GetActivityId() int64 {
+func (x *ActivityCheckInRequest) GetActivityId() int64 {
 	if x != nil {
 		return x.ActivityId
 	}
 	return 0
 }
 
-func (x *ActivitySupplementAttendanceRequest) GetVolunteerId() int64 {
+func (x *ActivityCheckInRequest) GetCheckInCode() string {
 	if x != nil {
-		return x.VolunteerId
+		return x.CheckInCode
 	}
-	return 0
+	return ""
 }
 
-func (x *ActivitySupplementAttendanceRequest) GetCheckInTime() string {
+func (x *ActivityCheckInRequest) GetQrToken() string {
 	if x != nil {
-		return x.CheckInTime
+		return x.QrToken
 	}
 	return ""
 }
 
-func (x *ActivitySupplementAttendanceRequest) GetCheckOutTime() string {
+func (x *ActivityCheckInRequest) GetLatitude() float64 {
 	if x != nil {
-		return x.CheckOutTime
+		return x.Latitude
 	}
-	return ""
+	return 0
 }
 
-func (x *ActivitySupplementAttendanceRequest) GetReason() string {
+func (x *ActivityCheckInRequest) GetLongitude() float64 {
 	if x != nil {
-		return x.Reason
+		return x.Longitude
 	}
-	return ""
+	return 0
 }
 
-type ActivitySupplementAttendanceResponse struct {
+type ActivityCheckInResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 是否成功
 	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	// 补录后的签到时间
+	// 签到时间
 	CheckInTime string `protobuf:"bytes,2,opt,name=checkInTime,proto3" json:"checkInTime"`
-	// 补录后的签退时间
-	CheckOutTime string `protobuf:"bytes,3,opt,name=checkOutTime,proto3" json:"checkOutTime"`
-	// 本次发放工时
-	GrantedHours  float64 `protobuf:"fixed64,4,opt,name=grantedHours,proto3" json:"grantedHours"`
+	// 是否在签到围栏外（围栏外允许签到时标记）
+	OutOfFence bool `protobuf:"varint,3,opt,name=outOfFence,proto3" json:"outOfFence"`
+	// 距活动地点距离（米，-1表示未计算）
+	Distance      int32 `protobuf:"varint,4,opt,name=distance,proto3" json:"distance"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivitySupplementAttendanceResponse) Reset() {
-	*x = ActivitySupplementAttendanceResponse{}
+func (x *ActivityCheckInResponse) Reset() {
+	*x = ActivityCheckInResponse{}
 	mi := &file_internal_api_activities_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivitySupplementAttendanceResponse) String() string {
+func (x *ActivityCheckInResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivitySupplementAttendanceResponse) ProtoMessage() {}
+func (*ActivityCheckInResponse) ProtoMessage() {}
 
-func (x *ActivitySupplementAttendanceResponse) ProtoReflect() protoreflect.Message {
+func (x *ActivityCheckInResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_activities_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -814,61 +841,69 @@ func (x *ActivitySupplementAttendanceResponse) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivitySupplementAttendanceResponse.ProtoReflect.Descriptor instead.
-func (*ActivitySupplementAttendanceResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityCheckInResponse.ProtoReflect.Descriptor instead.
+func (*ActivityCheckInResponse) Descriptor() ([]byte, []int) {
 	return file_internal_api_activities_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ActivitySupplementAttendanceResponse) GetSuccess() bool {
+func (x *ActivityCheckInResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ActivitySupplementAttendanceResponse) GetCheckInTime() string {
+func (x *ActivityCheckInResponse) GetCheckInTime() string {
 	if x != nil {
 		return x.CheckInTime
 	}
 	return ""
 }
 
-func (x *ActivitySupplementAttendanceResponse) GetCheckOutTime() string {
+func (x *ActivityCheckInResponse) GetOutOfFence() bool {
 	if x != nil {
-		return x.CheckOutTime
+		return x.OutOfFence
 	}
-	return ""
+	return false
 }
 
-func (x *ActivitySupplementAttendanceResponse) GetGrantedHours() float64 {
+func (x *ActivityCheckInResponse) GetDistance() int32 {
 	if x != nil {
-		return x.GrantedHours
+		return x.Distance
 	}
 	return 0
 }
 
-type ActivityDetailRequest struct {
+type ActivityCheckOutRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 活动ID 必填 @gotags: path:"id,required"
-	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	// 活动ID 必填 @gotags: json:"activityId,required"
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
+	// 签退码 与 qrToken 二选一 @gotags: json:"checkOutCode"
+	CheckOutCode string `protobuf:"bytes,2,opt,name=checkOutCode,proto3" json:"checkOutCode"`
+	// 扫码得到的签退二维码令牌 与 checkOutCode 二选一 @gotags: json:"qrToken"
+	QrToken string `protobuf:"bytes,3,opt,name=qrToken,proto3" json:"qrToken"`
+	// 设备定位纬度 活动启用签到围栏时必填 @gotags: json:"latitude"
+	Latitude float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude"`
+	// 设备定位经度 活动启用签到围栏时必填 @gotags: json:"longitude"
+	Longitude     float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivityDetailRequest) Reset() {
-	*x = ActivityDetailRequest{}
+func (x *ActivityCheckOutRequest) Reset() {
+	*x = ActivityCheckOutRequest{}
 	mi := &file_internal_api_activities_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityDetailRequest) String() string {
+func (x *ActivityCheckOutRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityDetailRequest) ProtoMessage() {}
+func (*ActivityCheckOutRequest) ProtoMessage() {}
 
-func (x *ActivityDetailRequest) ProtoReflect() protoreflect.Message {
+func (x *ActivityCheckOutRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_activities_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -880,42 +915,79 @@ func (x *ActivityDetailRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityDetailRequest.ProtoReflect.Descriptor instead.
-func (*ActivityDetailRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityCheckOutRequest.ProtoReflect.Descriptor instead.
+func (*ActivityCheckOutRequest) Descriptor() ([]byte, []int) {
 	return file_internal_api_activities_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ActivityDetailRequest) GetId() int64 {
+func (x *ActivityCheckOutRequest) GetActivityId() int64 {
 	if x != nil {
-		return x.Id
+		return x.ActivityId
 	}
 	return 0
 }
 
-type ActivityDetailResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Activity      *ActivityInfo          `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ActivityCheckOutRequest) GetCheckOutCode() string {
+	if x != nil {
+		return x.CheckOutCode
+	}
+	return ""
 }
 
-func (x *ActivityDetailResponse) Reset() {
-	*x = ActivityDetailResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[14]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *ActivityCheckOutRequest) GetQrToken() string {
+	if x != nil {
+		return x.QrToken
+	}
+	return ""
 }
 
-func (x *ActivityDetailResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActivityCheckOutRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
 }
 
-func (*ActivityDetailResponse) ProtoMessage() {}
-
-func (x *ActivityDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[14]
+func (x *ActivityCheckOutRequest) GetLongitude() float64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		return x.Longitude
+	}
+	return 0
+}
+
+type ActivityCheckOutResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	// 签退时间
+	CheckOutTime string `protobuf:"bytes,2,opt,name=checkOutTime,proto3" json:"checkOutTime"`
+	// 本次发放工时
+	GrantedHours float64 `protobuf:"fixed64,3,opt,name=grantedHours,proto3" json:"grantedHours"`
+	// 是否在签到围栏外（围栏外允许签退时标记）
+	OutOfFence bool `protobuf:"varint,4,opt,name=outOfFence,proto3" json:"outOfFence"`
+	// 距活动地点距离（米，-1表示未计算）
+	Distance      int32 `protobuf:"varint,5,opt,name=distance,proto3" json:"distance"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityCheckOutResponse) Reset() {
+	*x = ActivityCheckOutResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityCheckOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityCheckOutResponse) ProtoMessage() {}
+
+func (x *ActivityCheckOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
 			ms.StoreMessageInfo(mi)
 		}
@@ -924,82 +996,2561 @@ func (x *ActivityDetailResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityDetailResponse.ProtoReflect.Descriptor instead.
-func (*ActivityDetailResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ActivityCheckOutResponse.ProtoReflect.Descriptor instead.
+func (*ActivityCheckOutResponse) Descriptor() ([]byte, []int) {
 	return file_internal_api_activities_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ActivityDetailResponse) GetActivity() *ActivityInfo {
+func (x *ActivityCheckOutResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Activity
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type ActivityInfo struct {
+func (x *ActivityCheckOutResponse) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+func (x *ActivityCheckOutResponse) GetGrantedHours() float64 {
+	if x != nil {
+		return x.GrantedHours
+	}
+	return 0
+}
+
+func (x *ActivityCheckOutResponse) GetOutOfFence() bool {
+	if x != nil {
+		return x.OutOfFence
+	}
+	return false
+}
+
+func (x *ActivityCheckOutResponse) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+type ActivitySupplementAttendanceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	// 组织ID
-	OrgId int64 `protobuf:"varint,2,opt,name=orgId,proto3" json:"orgId"`
-	// 组织名称
-	OrgName string `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName"`
-	// 活动标题
-	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title"`
-	// 活动描述
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`
-	// 封面图URL
-	CoverUrl string `protobuf:"bytes,6,opt,name=coverUrl,proto3" json:"coverUrl"`
-	// 开始时间
-	StartTime string `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime"`
-	// 结束时间
-	EndTime string `protobuf:"bytes,8,opt,name=endTime,proto3" json:"endTime"`
-	// 地点名称
-	Location string `protobuf:"bytes,9,opt,name=location,proto3" json:"location"`
-	// 详细地址
-	Address string `protobuf:"bytes,10,opt,name=address,proto3" json:"address"`
-	// 预估工时
-	Duration float64 `protobuf:"fixed64,11,opt,name=duration,proto3" json:"duration"`
-	// 最大招募人数
-	MaxPeople int32 `protobuf:"varint,12,opt,name=maxPeople,proto3" json:"maxPeople"`
-	// 当前已报名人数
-	CurrentPeople int32 `protobuf:"varint,13,opt,name=currentPeople,proto3" json:"currentPeople"`
-	// 状态: 1-报名中, 2-已结束, 3-已取消
-	Status int32 `protobuf:"varint,14,opt,name=status,proto3" json:"status"`
-	// 是否已报名 (当前用户)
-	IsRegistered bool `protobuf:"varint,15,opt,name=isRegistered,proto3" json:"isRegistered"`
-	// 创建时间
-	CreatedAt string `protobuf:"bytes,16,opt,name=createdAt,proto3" json:"createdAt"`
-	// 签到状态: 0-未签到, 1-已签到
-	CheckInStatus int32 `protobuf:"varint,17,opt,name=checkInStatus,proto3" json:"checkInStatus"`
-	// 签到时间
-	CheckInTime string `protobuf:"bytes,18,opt,name=checkInTime,proto3" json:"checkInTime"`
-	// 签退状态: 0-未签退, 1-已签退
-	CheckOutStatus int32 `protobuf:"varint,19,opt,name=checkOutStatus,proto3" json:"checkOutStatus"`
-	// 签退时间
-	CheckOutTime string `protobuf:"bytes,20,opt,name=checkOutTime,proto3" json:"checkOutTime"`
-	// 工时结算状态: 0-未结算, 1-已发放, 2-已作废
-	WorkHourStatus int32 `protobuf:"varint,21,opt,name=workHourStatus,proto3" json:"workHourStatus"`
-	// 本次发放工时
-	GrantedHours  float64 `protobuf:"fixed64,22,opt,name=grantedHours,proto3" json:"grantedHours"`
+	// 活动ID 必填 @gotags: json:"activityId,required"
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
+	// 志愿者ID（volunteers.id）必填 @gotags: json:"volunteerId,required"
+	VolunteerId int64 `protobuf:"varint,2,opt,name=volunteerId,proto3" json:"volunteerId,required"`
+	// 补录签到时间（当原记录未签到时必填）格式: 2006-01-02 15:04:05 @gotags: json:"checkInTime"
+	CheckInTime string `protobuf:"bytes,3,opt,name=checkInTime,proto3" json:"checkInTime"`
+	// 补录签退时间 必填 格式: 2006-01-02 15:04:05 @gotags: json:"checkOutTime,required"
+	CheckOutTime string `protobuf:"bytes,4,opt,name=checkOutTime,proto3" json:"checkOutTime,required"`
+	// 补录原因 可选 @gotags: json:"reason"
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivityInfo) Reset() {
-	*x = ActivityInfo{}
+func (x *ActivitySupplementAttendanceRequest) Reset() {
+	*x = ActivitySupplementAttendanceRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySupplementAttendanceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySupplementAttendanceRequest) ProtoMessage() {}
+
+func (x *ActivitySupplementAttendanceRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_internal_api_activities_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySupplementAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*ActivitySupplementAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ActivitySupplementAttendanceRequest) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ActivitySupplementAttendanceRequest) GetVolunteerId() int64 {
+	if x != nil {
+		return x.VolunteerId
+	}
+	return 0
+}
+
+func (x *ActivitySupplementAttendanceRequest) GetCheckInTime() string {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return ""
+}
+
+func (x *ActivitySupplementAttendanceRequest) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+func (x *ActivitySupplementAttendanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type ActivitySupplementAttendanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	// 补录后的签到时间
+	CheckInTime string `protobuf:"bytes,2,opt,name=checkInTime,proto3" json:"checkInTime"`
+	// 补录后的签退时间
+	CheckOutTime string `protobuf:"bytes,3,opt,name=checkOutTime,proto3" json:"checkOutTime"`
+	// 本次发放工时
+	GrantedHours float64 `protobuf:"fixed64,4,opt,name=grantedHours,proto3" json:"grantedHours"`
+	// 志愿者自助签到时采集的位置（未采集为空），供争议复核
+	CheckInPosition *AttendancePosition `protobuf:"bytes,5,opt,name=checkInPosition,proto3" json:"checkInPosition"`
+	// 志愿者自助签退时采集的位置（未采集为空），供争议复核
+	CheckOutPosition *AttendancePosition `protobuf:"bytes,6,opt,name=checkOutPosition,proto3" json:"checkOutPosition"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ActivitySupplementAttendanceResponse) Reset() {
+	*x = ActivitySupplementAttendanceResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySupplementAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySupplementAttendanceResponse) ProtoMessage() {}
+
+func (x *ActivitySupplementAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySupplementAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*ActivitySupplementAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ActivitySupplementAttendanceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ActivitySupplementAttendanceResponse) GetCheckInTime() string {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return ""
+}
+
+func (x *ActivitySupplementAttendanceResponse) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+func (x *ActivitySupplementAttendanceResponse) GetGrantedHours() float64 {
+	if x != nil {
+		return x.GrantedHours
+	}
+	return 0
+}
+
+func (x *ActivitySupplementAttendanceResponse) GetCheckInPosition() *AttendancePosition {
+	if x != nil {
+		return x.CheckInPosition
+	}
+	return nil
+}
+
+func (x *ActivitySupplementAttendanceResponse) GetCheckOutPosition() *AttendancePosition {
+	if x != nil {
+		return x.CheckOutPosition
+	}
+	return nil
+}
+
+// AttendancePosition 签到/签退时采集的位置
+type AttendancePosition struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 纬度
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude"`
+	// 经度
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude"`
+	// 距活动地点距离（米，-1表示未计算）
+	Distance int32 `protobuf:"varint,3,opt,name=distance,proto3" json:"distance"`
+	// 是否在签到围栏外
+	OutOfFence    bool `protobuf:"varint,4,opt,name=outOfFence,proto3" json:"outOfFence"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AttendancePosition) Reset() {
+	*x = AttendancePosition{}
+	mi := &file_internal_api_activities_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AttendancePosition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AttendancePosition) ProtoMessage() {}
+
+func (x *AttendancePosition) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AttendancePosition.ProtoReflect.Descriptor instead.
+func (*AttendancePosition) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AttendancePosition) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *AttendancePosition) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *AttendancePosition) GetDistance() int32 {
+	if x != nil {
+		return x.Distance
+	}
+	return 0
+}
+
+func (x *AttendancePosition) GetOutOfFence() bool {
+	if x != nil {
+		return x.OutOfFence
+	}
+	return false
+}
+
+type ActivityDetailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityDetailRequest) Reset() {
+	*x = ActivityDetailRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityDetailRequest) ProtoMessage() {}
+
+func (x *ActivityDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityDetailRequest.ProtoReflect.Descriptor instead.
+func (*ActivityDetailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ActivityDetailRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type ActivityDetailResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Activity      *ActivityInfo          `protobuf:"bytes,1,opt,name=activity,proto3" json:"activity"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityDetailResponse) Reset() {
+	*x = ActivityDetailResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityDetailResponse) ProtoMessage() {}
+
+func (x *ActivityDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityDetailResponse.ProtoReflect.Descriptor instead.
+func (*ActivityDetailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ActivityDetailResponse) GetActivity() *ActivityInfo {
+	if x != nil {
+		return x.Activity
+	}
+	return nil
+}
+
+type ActivityInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 组织ID
+	OrgId int64 `protobuf:"varint,2,opt,name=orgId,proto3" json:"orgId"`
+	// 组织名称
+	OrgName string `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName"`
+	// 活动标题
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title"`
+	// 活动描述
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`
+	// 封面图URL
+	CoverUrl string `protobuf:"bytes,6,opt,name=coverUrl,proto3" json:"coverUrl"`
+	// 开始时间
+	StartTime string `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime"`
+	// 结束时间
+	EndTime string `protobuf:"bytes,8,opt,name=endTime,proto3" json:"endTime"`
+	// 地点名称
+	Location string `protobuf:"bytes,9,opt,name=location,proto3" json:"location"`
+	// 详细地址
+	Address string `protobuf:"bytes,10,opt,name=address,proto3" json:"address"`
+	// 预估工时
+	Duration float64 `protobuf:"fixed64,11,opt,name=duration,proto3" json:"duration"`
+	// 最大招募人数
+	MaxPeople int32 `protobuf:"varint,12,opt,name=maxPeople,proto3" json:"maxPeople"`
+	// 当前已报名人数
+	CurrentPeople int32 `protobuf:"varint,13,opt,name=currentPeople,proto3" json:"currentPeople"`
+	// 状态: 1-报名中, 2-已结束, 3-已取消
+	Status int32 `protobuf:"varint,14,opt,name=status,proto3" json:"status"`
+	// 是否已报名 (当前用户)
+	IsRegistered bool `protobuf:"varint,15,opt,name=isRegistered,proto3" json:"isRegistered"`
+	// 创建时间
+	CreatedAt string `protobuf:"bytes,16,opt,name=createdAt,proto3" json:"createdAt"`
+	// 签到状态: 0-未签到, 1-已签到
+	CheckInStatus int32 `protobuf:"varint,17,opt,name=checkInStatus,proto3" json:"checkInStatus"`
+	// 签到时间
+	CheckInTime string `protobuf:"bytes,18,opt,name=checkInTime,proto3" json:"checkInTime"`
+	// 签退状态: 0-未签退, 1-已签退
+	CheckOutStatus int32 `protobuf:"varint,19,opt,name=checkOutStatus,proto3" json:"checkOutStatus"`
+	// 签退时间
+	CheckOutTime string `protobuf:"bytes,20,opt,name=checkOutTime,proto3" json:"checkOutTime"`
+	// 工时结算状态: 0-未结算, 1-已发放, 2-已作废
+	WorkHourStatus int32 `protobuf:"varint,21,opt,name=workHourStatus,proto3" json:"workHourStatus"`
+	// 本次发放工时
+	GrantedHours float64 `protobuf:"fixed64,22,opt,name=grantedHours,proto3" json:"grantedHours"`
+	// 所属活动系列ID（0表示单次活动）
+	SeriesId int64 `protobuf:"varint,23,opt,name=seriesId,proto3" json:"seriesId"`
+	// 活动地点纬度
+	Latitude float64 `protobuf:"fixed64,24,opt,name=latitude,proto3" json:"latitude"`
+	// 活动地点经度
+	Longitude float64 `protobuf:"fixed64,25,opt,name=longitude,proto3" json:"longitude"`
+	// 签到围栏半径（米，0表示不启用）
+	GeofenceRadius int32 `protobuf:"varint,26,opt,name=geofenceRadius,proto3" json:"geofenceRadius"`
+	// 未签退自动结算策略（1-按计划时长发放，2-按结束时间发放，3-不发放并标记待确认）
+	AutoSettlePolicy int32 `protobuf:"varint,27,opt,name=autoSettlePolicy,proto3" json:"autoSettlePolicy"`
+	// 是否仅限实名认证志愿者报名
+	RequireVerified bool `protobuf:"varint,28,opt,name=requireVerified,proto3" json:"requireVerified"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ActivityInfo) Reset() {
+	*x = ActivityInfo{}
+	mi := &file_internal_api_activities_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityInfo) ProtoMessage() {}
+
+func (x *ActivityInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivityInfo.ProtoReflect.Descriptor instead.
+func (*ActivityInfo) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ActivityInfo) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetMaxPeople() int32 {
+	if x != nil {
+		return x.MaxPeople
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetCurrentPeople() int32 {
+	if x != nil {
+		return x.CurrentPeople
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetIsRegistered() bool {
+	if x != nil {
+		return x.IsRegistered
+	}
+	return false
+}
+
+func (x *ActivityInfo) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetCheckInStatus() int32 {
+	if x != nil {
+		return x.CheckInStatus
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetCheckInTime() string {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetCheckOutStatus() int32 {
+	if x != nil {
+		return x.CheckOutStatus
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+func (x *ActivityInfo) GetWorkHourStatus() int32 {
+	if x != nil {
+		return x.WorkHourStatus
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetGrantedHours() float64 {
+	if x != nil {
+		return x.GrantedHours
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetGeofenceRadius() int32 {
+	if x != nil {
+		return x.GeofenceRadius
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetAutoSettlePolicy() int32 {
+	if x != nil {
+		return x.AutoSettlePolicy
+	}
+	return 0
+}
+
+func (x *ActivityInfo) GetRequireVerified() bool {
+	if x != nil {
+		return x.RequireVerified
+	}
+	return false
+}
+
+type MyActivitiesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 页码 可选 @gotags: query:"page"
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page" query:"page"`
+	// 页大小 可选 @gotags: query:"pageSize"
+	PageSize int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" query:"pageSize"`
+	// 状态筛选 可选 @gotags: query:"status"
+	// 1-报名中, 2-已结束, 3-已取消
+	Status        int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status" query:"status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyActivitiesRequest) Reset() {
+	*x = MyActivitiesRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyActivitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyActivitiesRequest) ProtoMessage() {}
+
+func (x *MyActivitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyActivitiesRequest.ProtoReflect.Descriptor instead.
+func (*MyActivitiesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MyActivitiesRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *MyActivitiesRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *MyActivitiesRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type MyActivitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	List          []*MyActivityItem      `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyActivitiesResponse) Reset() {
+	*x = MyActivitiesResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyActivitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyActivitiesResponse) ProtoMessage() {}
+
+func (x *MyActivitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyActivitiesResponse.ProtoReflect.Descriptor instead.
+func (*MyActivitiesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MyActivitiesResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MyActivitiesResponse) GetList() []*MyActivityItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type MyActivityItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 组织ID
+	OrgId int64 `protobuf:"varint,2,opt,name=orgId,proto3" json:"orgId"`
+	// 组织名称
+	OrgName string `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName"`
+	// 活动标题
+	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title"`
+	// 活动描述
+	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`
+	// 封面图URL
+	CoverUrl string `protobuf:"bytes,6,opt,name=coverUrl,proto3" json:"coverUrl"`
+	// 开始时间
+	StartTime string `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime"`
+	// 结束时间
+	EndTime string `protobuf:"bytes,8,opt,name=endTime,proto3" json:"endTime"`
+	// 地点名称
+	Location string `protobuf:"bytes,9,opt,name=location,proto3" json:"location"`
+	// 预估工时
+	Duration float64 `protobuf:"fixed64,10,opt,name=duration,proto3" json:"duration"`
+	// 最大招募人数
+	MaxPeople int32 `protobuf:"varint,11,opt,name=maxPeople,proto3" json:"maxPeople"`
+	// 当前已报名人数
+	CurrentPeople int32 `protobuf:"varint,12,opt,name=currentPeople,proto3" json:"currentPeople"`
+	// 状态: 1-报名中, 2-已结束, 3-已取消
+	Status int32 `protobuf:"varint,13,opt,name=status,proto3" json:"status"`
+	// 报名时间
+	SignupTime string `protobuf:"bytes,14,opt,name=signupTime,proto3" json:"signupTime"`
+	// 签到状态: 0-未签到, 1-已签到
+	CheckInStatus int32 `protobuf:"varint,15,opt,name=checkInStatus,proto3" json:"checkInStatus"`
+	// 签到时间
+	CheckInTime string `protobuf:"bytes,16,opt,name=checkInTime,proto3" json:"checkInTime"`
+	// 签退状态: 0-未签退, 1-已签退
+	CheckOutStatus int32 `protobuf:"varint,17,opt,name=checkOutStatus,proto3" json:"checkOutStatus"`
+	// 签退时间
+	CheckOutTime string `protobuf:"bytes,18,opt,name=checkOutTime,proto3" json:"checkOutTime"`
+	// 工时结算状态: 0-未结算, 1-已发放, 2-已作废
+	WorkHourStatus int32 `protobuf:"varint,19,opt,name=workHourStatus,proto3" json:"workHourStatus"`
+	// 本次发放工时
+	GrantedHours float64 `protobuf:"fixed64,20,opt,name=grantedHours,proto3" json:"grantedHours"`
+	// 报名的班次岗位ID（0表示不区分班次）
+	SlotId        int64 `protobuf:"varint,21,opt,name=slotId,proto3" json:"slotId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyActivityItem) Reset() {
+	*x = MyActivityItem{}
+	mi := &file_internal_api_activities_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyActivityItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyActivityItem) ProtoMessage() {}
+
+func (x *MyActivityItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyActivityItem.ProtoReflect.Descriptor instead.
+func (*MyActivityItem) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *MyActivityItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetOrgName() string {
+	if x != nil {
+		return x.OrgName
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetMaxPeople() int32 {
+	if x != nil {
+		return x.MaxPeople
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetCurrentPeople() int32 {
+	if x != nil {
+		return x.CurrentPeople
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetSignupTime() string {
+	if x != nil {
+		return x.SignupTime
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetCheckInStatus() int32 {
+	if x != nil {
+		return x.CheckInStatus
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetCheckInTime() string {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetCheckOutStatus() int32 {
+	if x != nil {
+		return x.CheckOutStatus
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+func (x *MyActivityItem) GetWorkHourStatus() int32 {
+	if x != nil {
+		return x.WorkHourStatus
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetGrantedHours() float64 {
+	if x != nil {
+		return x.GrantedHours
+	}
+	return 0
+}
+
+func (x *MyActivityItem) GetSlotId() int64 {
+	if x != nil {
+		return x.SlotId
+	}
+	return 0
+}
+
+// CreateActivityRequest 创建活动请求
+type CreateActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 组织ID 必填 @gotags: json:"orgId,required"
+	OrgId int64 `protobuf:"varint,1,opt,name=orgId,proto3" json:"orgId,required"`
+	// 活动标题 必填 @gotags: json:"title,required"
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,required"`
+	// 活动描述 可选 @gotags: json:"description"
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	// 封面图URL 可选 @gotags: json:"coverUrl"
+	CoverUrl string `protobuf:"bytes,4,opt,name=coverUrl,proto3" json:"coverUrl"`
+	// 开始时间 必填 @gotags: json:"startTime,required"
+	StartTime string `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime,required"`
+	// 结束时间 必填 @gotags: json:"endTime,required"
+	EndTime string `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime,required"`
+	// 地点名称 必填 @gotags: json:"location,required"
+	Location string `protobuf:"bytes,7,opt,name=location,proto3" json:"location,required"`
+	// 详细地址 可选 @gotags: json:"address"
+	Address string `protobuf:"bytes,8,opt,name=address,proto3" json:"address"`
+	// 预估工时（小时） 必填 @gotags: json:"duration,required"
+	Duration float64 `protobuf:"fixed64,9,opt,name=duration,proto3" json:"duration,required"`
+	// 最大招募人数（0表示不限） 必填 @gotags: json:"maxPeople,required"
+	MaxPeople int32 `protobuf:"varint,10,opt,name=maxPeople,proto3" json:"maxPeople,required"`
+	// 活动地点纬度 可选 @gotags: json:"latitude"
+	Latitude float64 `protobuf:"fixed64,11,opt,name=latitude,proto3" json:"latitude"`
+	// 活动地点经度 可选 @gotags: json:"longitude"
+	Longitude float64 `protobuf:"fixed64,12,opt,name=longitude,proto3" json:"longitude"`
+	// 签到围栏半径（米）可选，0表示不启用，启用时须同时填写经纬度 @gotags: json:"geofenceRadius"
+	GeofenceRadius int32 `protobuf:"varint,13,opt,name=geofenceRadius,proto3" json:"geofenceRadius"`
+	// 围栏外处理方式（1-拒绝签到，2-允许并标记）可选，默认拒绝 @gotags: json:"geofenceMode"
+	GeofenceMode int32 `protobuf:"varint,14,opt,name=geofenceMode,proto3" json:"geofenceMode"`
+	// 未签退自动结算策略（1-按计划时长发放，2-按结束时间发放，3-不发放并标记待确认）可选，默认按结束时间 @gotags: json:"autoSettlePolicy"
+	AutoSettlePolicy int32 `protobuf:"varint,15,opt,name=autoSettlePolicy,proto3" json:"autoSettlePolicy"`
+	// 是否仅限实名认证志愿者报名 可选 @gotags: json:"requireVerified"
+	RequireVerified bool `protobuf:"varint,16,opt,name=requireVerified,proto3" json:"requireVerified"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateActivityRequest) Reset() {
+	*x = CreateActivityRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityRequest) ProtoMessage() {}
+
+func (x *CreateActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityRequest.ProtoReflect.Descriptor instead.
+func (*CreateActivityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *CreateActivityRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *CreateActivityRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateActivityRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateActivityRequest) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *CreateActivityRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateActivityRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateActivityRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateActivityRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateActivityRequest) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateActivityRequest) GetMaxPeople() int32 {
+	if x != nil {
+		return x.MaxPeople
+	}
+	return 0
+}
+
+func (x *CreateActivityRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateActivityRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *CreateActivityRequest) GetGeofenceRadius() int32 {
+	if x != nil {
+		return x.GeofenceRadius
+	}
+	return 0
+}
+
+func (x *CreateActivityRequest) GetGeofenceMode() int32 {
+	if x != nil {
+		return x.GeofenceMode
+	}
+	return 0
+}
+
+func (x *CreateActivityRequest) GetAutoSettlePolicy() int32 {
+	if x != nil {
+		return x.AutoSettlePolicy
+	}
+	return 0
+}
+
+func (x *CreateActivityRequest) GetRequireVerified() bool {
+	if x != nil {
+		return x.RequireVerified
+	}
+	return false
+}
+
+// CreateActivityResponse 创建活动响应
+type CreateActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 消息
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateActivityResponse) Reset() {
+	*x = CreateActivityResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivityResponse) ProtoMessage() {}
+
+func (x *CreateActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivityResponse.ProtoReflect.Descriptor instead.
+func (*CreateActivityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *CreateActivityResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreateActivityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// UpdateActivityRequest 更新活动请求
+type UpdateActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	// 活动标题 可选 @gotags: json:"title"
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
+	// 活动描述 可选 @gotags: json:"description"
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	// 封面图URL 可选 @gotags: json:"coverUrl"
+	CoverUrl string `protobuf:"bytes,4,opt,name=coverUrl,proto3" json:"coverUrl"`
+	// 开始时间 可选 @gotags: json:"startTime"
+	StartTime string `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime"`
+	// 结束时间 可选 @gotags: json:"endTime"
+	EndTime string `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime"`
+	// 地点名称 可选 @gotags: json:"location"
+	Location string `protobuf:"bytes,7,opt,name=location,proto3" json:"location"`
+	// 详细地址 可选 @gotags: json:"address"
+	Address string `protobuf:"bytes,8,opt,name=address,proto3" json:"address"`
+	// 预估工时（小时） 可选 @gotags: json:"duration"
+	Duration float64 `protobuf:"fixed64,9,opt,name=duration,proto3" json:"duration"`
+	// 最大招募人数（0表示不限） 可选 @gotags: json:"maxPeople"
+	MaxPeople int32 `protobuf:"varint,10,opt,name=maxPeople,proto3" json:"maxPeople"`
+	// 系列活动修改范围（1-仅本场，2-本场及之后）可选，默认仅本场 @gotags: json:"scope"
+	Scope int32 `protobuf:"varint,11,opt,name=scope,proto3" json:"scope"`
+	// 活动地点纬度 可选 @gotags: json:"latitude"
+	Latitude float64 `protobuf:"fixed64,12,opt,name=latitude,proto3" json:"latitude"`
+	// 活动地点经度 可选 @gotags: json:"longitude"
+	Longitude float64 `protobuf:"fixed64,13,opt,name=longitude,proto3" json:"longitude"`
+	// 签到围栏半径（米）可选，大于0时更新，小于0表示关闭围栏 @gotags: json:"geofenceRadius"
+	GeofenceRadius int32 `protobuf:"varint,14,opt,name=geofenceRadius,proto3" json:"geofenceRadius"`
+	// 围栏外处理方式（1-拒绝签到，2-允许并标记）可选 @gotags: json:"geofenceMode"
+	GeofenceMode int32 `protobuf:"varint,15,opt,name=geofenceMode,proto3" json:"geofenceMode"`
+	// 未签退自动结算策略（1-按计划时长发放，2-按结束时间发放，3-不发放并标记待确认）可选 @gotags: json:"autoSettlePolicy"
+	AutoSettlePolicy int32 `protobuf:"varint,16,opt,name=autoSettlePolicy,proto3" json:"autoSettlePolicy"`
+	// 实名认证报名限制（1-仅限实名认证志愿者，-1-取消限制）可选，0表示不修改 @gotags: json:"requireVerified"
+	RequireVerified int32 `protobuf:"varint,17,opt,name=requireVerified,proto3" json:"requireVerified"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateActivityRequest) Reset() {
+	*x = UpdateActivityRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityRequest) ProtoMessage() {}
+
+func (x *UpdateActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityRequest.ProtoReflect.Descriptor instead.
+func (*UpdateActivityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *UpdateActivityRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateActivityRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *UpdateActivityRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateActivityRequest) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *UpdateActivityRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateActivityRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *UpdateActivityRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *UpdateActivityRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateActivityRequest) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *UpdateActivityRequest) GetMaxPeople() int32 {
+	if x != nil {
+		return x.MaxPeople
+	}
+	return 0
+}
+
+func (x *UpdateActivityRequest) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+func (x *UpdateActivityRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *UpdateActivityRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *UpdateActivityRequest) GetGeofenceRadius() int32 {
+	if x != nil {
+		return x.GeofenceRadius
+	}
+	return 0
+}
+
+func (x *UpdateActivityRequest) GetGeofenceMode() int32 {
+	if x != nil {
+		return x.GeofenceMode
+	}
+	return 0
+}
+
+func (x *UpdateActivityRequest) GetAutoSettlePolicy() int32 {
+	if x != nil {
+		return x.AutoSettlePolicy
+	}
+	return 0
+}
+
+func (x *UpdateActivityRequest) GetRequireVerified() int32 {
+	if x != nil {
+		return x.RequireVerified
+	}
+	return 0
+}
+
+// UpdateActivityResponse 更新活动响应
+type UpdateActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateActivityResponse) Reset() {
+	*x = UpdateActivityResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateActivityResponse) ProtoMessage() {}
+
+func (x *UpdateActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateActivityResponse.ProtoReflect.Descriptor instead.
+func (*UpdateActivityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *UpdateActivityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// DeleteActivityRequest 删除活动请求
+type DeleteActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityRequest) Reset() {
+	*x = DeleteActivityRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityRequest) ProtoMessage() {}
+
+func (x *DeleteActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityRequest.ProtoReflect.Descriptor instead.
+func (*DeleteActivityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *DeleteActivityRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DeleteActivityResponse 删除活动响应
+type DeleteActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteActivityResponse) Reset() {
+	*x = DeleteActivityResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteActivityResponse) ProtoMessage() {}
+
+func (x *DeleteActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteActivityResponse.ProtoReflect.Descriptor instead.
+func (*DeleteActivityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *DeleteActivityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// CancelActivityRequest 取消活动请求
+type CancelActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	// 取消原因 可选 @gotags: json:"reason"
+	Reason string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	// 系列活动取消范围（1-仅本场，2-本场及之后）可选，默认仅本场 @gotags: json:"scope"
+	Scope         int32 `protobuf:"varint,3,opt,name=scope,proto3" json:"scope"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelActivityRequest) Reset() {
+	*x = CancelActivityRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelActivityRequest) ProtoMessage() {}
+
+func (x *CancelActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelActivityRequest.ProtoReflect.Descriptor instead.
+func (*CancelActivityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CancelActivityRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CancelActivityRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CancelActivityRequest) GetScope() int32 {
+	if x != nil {
+		return x.Scope
+	}
+	return 0
+}
+
+// CancelActivityResponse 取消活动响应
+type CancelActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelActivityResponse) Reset() {
+	*x = CancelActivityResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelActivityResponse) ProtoMessage() {}
+
+func (x *CancelActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelActivityResponse.ProtoReflect.Descriptor instead.
+func (*CancelActivityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CancelActivityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// FinishActivityRequest 完结活动请求
+type FinishActivityRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishActivityRequest) Reset() {
+	*x = FinishActivityRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishActivityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishActivityRequest) ProtoMessage() {}
+
+func (x *FinishActivityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishActivityRequest.ProtoReflect.Descriptor instead.
+func (*FinishActivityRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *FinishActivityRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// FinishActivityResponse 完结活动响应
+type FinishActivityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息
+	Message string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+	// 本次判定为已出勤的报名数
+	AttendedCount int32 `protobuf:"varint,2,opt,name=attendedCount,proto3" json:"attendedCount"`
+	// 本次判定为爽约的报名数（已按信用分策略扣分）
+	NoShowCount int32 `protobuf:"varint,3,opt,name=noShowCount,proto3" json:"noShowCount"`
+	// 本次按策略自动签退结算的报名数
+	AutoSettledCount int32 `protobuf:"varint,4,opt,name=autoSettledCount,proto3" json:"autoSettledCount"`
+	// 本次标记为待确认结算的报名数
+	FlaggedCount  int32 `protobuf:"varint,5,opt,name=flaggedCount,proto3" json:"flaggedCount"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FinishActivityResponse) Reset() {
+	*x = FinishActivityResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FinishActivityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FinishActivityResponse) ProtoMessage() {}
+
+func (x *FinishActivityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FinishActivityResponse.ProtoReflect.Descriptor instead.
+func (*FinishActivityResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *FinishActivityResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *FinishActivityResponse) GetAttendedCount() int32 {
+	if x != nil {
+		return x.AttendedCount
+	}
+	return 0
+}
+
+func (x *FinishActivityResponse) GetNoShowCount() int32 {
+	if x != nil {
+		return x.NoShowCount
+	}
+	return 0
+}
+
+func (x *FinishActivityResponse) GetAutoSettledCount() int32 {
+	if x != nil {
+		return x.AutoSettledCount
+	}
+	return 0
+}
+
+func (x *FinishActivityResponse) GetFlaggedCount() int32 {
+	if x != nil {
+		return x.FlaggedCount
+	}
+	return 0
+}
+
+// CreateActivitySeriesRequest 创建系列活动请求
+type CreateActivitySeriesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 组织ID 必填 @gotags: json:"orgId,required"
+	OrgId int64 `protobuf:"varint,1,opt,name=orgId,proto3" json:"orgId,required"`
+	// 活动标题 必填 @gotags: json:"title,required"
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,required"`
+	// 活动描述 可选 @gotags: json:"description"
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
+	// 封面图URL 可选 @gotags: json:"coverUrl"
+	CoverUrl string `protobuf:"bytes,4,opt,name=coverUrl,proto3" json:"coverUrl"`
+	// 首场开始时间 必填 格式: 2006-01-02 15:04:05 @gotags: json:"startTime,required"
+	StartTime string `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime,required"`
+	// 首场结束时间 必填 格式: 2006-01-02 15:04:05 @gotags: json:"endTime,required"
+	EndTime string `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime,required"`
+	// 地点名称 必填 @gotags: json:"location,required"
+	Location string `protobuf:"bytes,7,opt,name=location,proto3" json:"location,required"`
+	// 详细地址 可选 @gotags: json:"address"
+	Address string `protobuf:"bytes,8,opt,name=address,proto3" json:"address"`
+	// 每场预估工时（小时） 必填 @gotags: json:"duration,required"
+	Duration float64 `protobuf:"fixed64,9,opt,name=duration,proto3" json:"duration,required"`
+	// 每场最大招募人数（0表示不限） 必填 @gotags: json:"maxPeople,required"
+	MaxPeople int32 `protobuf:"varint,10,opt,name=maxPeople,proto3" json:"maxPeople,required"`
+	// 重复规则 必填，例如 FREQ=WEEKLY;INTERVAL=1;BYDAY=SA 或 FREQ=MONTHLY;BYMONTHDAY=1;COUNT=6 @gotags: json:"rrule,required"
+	Rrule string `protobuf:"bytes,11,opt,name=rrule,proto3" json:"rrule,required"`
+	// 系列截止日期（含当天）可选，格式: 2006-01-02；规则未指定 COUNT/UNTIL 时必填 @gotags: json:"untilDate"
+	UntilDate string `protobuf:"bytes,12,opt,name=untilDate,proto3" json:"untilDate"`
+	// 例外日期（不生成场次）可选，格式: 2006-01-02 @gotags: json:"exdates"
+	Exdates       []string `protobuf:"bytes,13,rep,name=exdates,proto3" json:"exdates"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateActivitySeriesRequest) Reset() {
+	*x = CreateActivitySeriesRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivitySeriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivitySeriesRequest) ProtoMessage() {}
+
+func (x *CreateActivitySeriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivitySeriesRequest.ProtoReflect.Descriptor instead.
+func (*CreateActivitySeriesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *CreateActivitySeriesRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *CreateActivitySeriesRequest) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesRequest) GetCoverUrl() string {
+	if x != nil {
+		return x.CoverUrl
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesRequest) GetLocation() string {
+	if x != nil {
+		return x.Location
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesRequest) GetDuration() float64 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *CreateActivitySeriesRequest) GetMaxPeople() int32 {
+	if x != nil {
+		return x.MaxPeople
+	}
+	return 0
+}
+
+func (x *CreateActivitySeriesRequest) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesRequest) GetUntilDate() string {
+	if x != nil {
+		return x.UntilDate
+	}
+	return ""
+}
+
+func (x *CreateActivitySeriesRequest) GetExdates() []string {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+// CreateActivitySeriesResponse 创建系列活动响应
+type CreateActivitySeriesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 系列ID
+	SeriesId int64 `protobuf:"varint,1,opt,name=seriesId,proto3" json:"seriesId"`
+	// 生成的场次活动ID（按开始时间排序）
+	ActivityIds []int64 `protobuf:"varint,2,rep,packed,name=activityIds,proto3" json:"activityIds"`
+	// 消息
+	Message       string `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateActivitySeriesResponse) Reset() {
+	*x = CreateActivitySeriesResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateActivitySeriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateActivitySeriesResponse) ProtoMessage() {}
+
+func (x *CreateActivitySeriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateActivitySeriesResponse.ProtoReflect.Descriptor instead.
+func (*CreateActivitySeriesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *CreateActivitySeriesResponse) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+func (x *CreateActivitySeriesResponse) GetActivityIds() []int64 {
+	if x != nil {
+		return x.ActivityIds
+	}
+	return nil
+}
+
+func (x *CreateActivitySeriesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ActivitySeriesDetailRequest 查询系列活动详情请求
+type ActivitySeriesDetailRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 系列ID 必填 @gotags: path:"id,required"
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySeriesDetailRequest) Reset() {
+	*x = ActivitySeriesDetailRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySeriesDetailRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySeriesDetailRequest) ProtoMessage() {}
+
+func (x *ActivitySeriesDetailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySeriesDetailRequest.ProtoReflect.Descriptor instead.
+func (*ActivitySeriesDetailRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ActivitySeriesDetailRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ActivitySeriesDetailResponse 查询系列活动详情响应
+type ActivitySeriesDetailResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 系列ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 组织ID
+	OrgId int64 `protobuf:"varint,2,opt,name=orgId,proto3" json:"orgId"`
+	// 系列标题
+	Title string `protobuf:"bytes,3,opt,name=title,proto3" json:"title"`
+	// 重复规则
+	Rrule string `protobuf:"bytes,4,opt,name=rrule,proto3" json:"rrule"`
+	// 系列截止日期
+	UntilDate string `protobuf:"bytes,5,opt,name=untilDate,proto3" json:"untilDate"`
+	// 例外日期
+	Exdates []string `protobuf:"bytes,6,rep,name=exdates,proto3" json:"exdates"`
+	// 系列状态: 1-正常, 2-已取消
+	Status int32 `protobuf:"varint,7,opt,name=status,proto3" json:"status"`
+	// 场次列表（按开始时间排序）
+	Occurrences   []*ActivityItem `protobuf:"bytes,8,rep,name=occurrences,proto3" json:"occurrences"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySeriesDetailResponse) Reset() {
+	*x = ActivitySeriesDetailResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySeriesDetailResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySeriesDetailResponse) ProtoMessage() {}
+
+func (x *ActivitySeriesDetailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySeriesDetailResponse.ProtoReflect.Descriptor instead.
+func (*ActivitySeriesDetailResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ActivitySeriesDetailResponse) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivitySeriesDetailResponse) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *ActivitySeriesDetailResponse) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *ActivitySeriesDetailResponse) GetRrule() string {
+	if x != nil {
+		return x.Rrule
+	}
+	return ""
+}
+
+func (x *ActivitySeriesDetailResponse) GetUntilDate() string {
+	if x != nil {
+		return x.UntilDate
+	}
+	return ""
+}
+
+func (x *ActivitySeriesDetailResponse) GetExdates() []string {
+	if x != nil {
+		return x.Exdates
+	}
+	return nil
+}
+
+func (x *ActivitySeriesDetailResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ActivitySeriesDetailResponse) GetOccurrences() []*ActivityItem {
+	if x != nil {
+		return x.Occurrences
+	}
+	return nil
+}
+
+// ActivitySeriesSignupRequest 报名系列活动请求
+type ActivitySeriesSignupRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 系列ID 必填 @gotags: json:"seriesId,required"
+	SeriesId      int64 `protobuf:"varint,1,opt,name=seriesId,proto3" json:"seriesId,required"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySeriesSignupRequest) Reset() {
+	*x = ActivitySeriesSignupRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySeriesSignupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySeriesSignupRequest) ProtoMessage() {}
+
+func (x *ActivitySeriesSignupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySeriesSignupRequest.ProtoReflect.Descriptor instead.
+func (*ActivitySeriesSignupRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ActivitySeriesSignupRequest) GetSeriesId() int64 {
+	if x != nil {
+		return x.SeriesId
+	}
+	return 0
+}
+
+// ActivitySeriesSignupResponse 报名系列活动响应
+type ActivitySeriesSignupResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功提交（含进入候补）的场次数
+	SuccessCount int32 `protobuf:"varint,1,opt,name=successCount,proto3" json:"successCount"`
+	// 各场次报名结果
+	Results       []*ActivitySeriesSignupResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySeriesSignupResponse) Reset() {
+	*x = ActivitySeriesSignupResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySeriesSignupResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySeriesSignupResponse) ProtoMessage() {}
+
+func (x *ActivitySeriesSignupResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySeriesSignupResponse.ProtoReflect.Descriptor instead.
+func (*ActivitySeriesSignupResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ActivitySeriesSignupResponse) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *ActivitySeriesSignupResponse) GetResults() []*ActivitySeriesSignupResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// ActivitySeriesSignupResult 单个场次的报名结果
+type ActivitySeriesSignupResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId"`
+	// 场次开始时间
+	StartTime string `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime"`
+	// 是否提交成功
+	Success bool `protobuf:"varint,3,opt,name=success,proto3" json:"success"`
+	// 是否进入候补队列
+	Waitlisted bool `protobuf:"varint,4,opt,name=waitlisted,proto3" json:"waitlisted"`
+	// 候补排位
+	WaitlistPosition int32 `protobuf:"varint,5,opt,name=waitlistPosition,proto3" json:"waitlistPosition"`
+	// 失败原因
+	Message       string `protobuf:"bytes,6,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySeriesSignupResult) Reset() {
+	*x = ActivitySeriesSignupResult{}
+	mi := &file_internal_api_activities_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySeriesSignupResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySeriesSignupResult) ProtoMessage() {}
+
+func (x *ActivitySeriesSignupResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySeriesSignupResult.ProtoReflect.Descriptor instead.
+func (*ActivitySeriesSignupResult) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ActivitySeriesSignupResult) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ActivitySeriesSignupResult) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ActivitySeriesSignupResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ActivitySeriesSignupResult) GetWaitlisted() bool {
+	if x != nil {
+		return x.Waitlisted
+	}
+	return false
+}
+
+func (x *ActivitySeriesSignupResult) GetWaitlistPosition() int32 {
+	if x != nil {
+		return x.WaitlistPosition
+	}
+	return 0
+}
+
+func (x *ActivitySeriesSignupResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ActivitySlotItem 班次岗位信息
+type ActivitySlotItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 班次岗位ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 活动ID
+	ActivityId int64 `protobuf:"varint,2,opt,name=activityId,proto3" json:"activityId"`
+	// 班次/岗位名称
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name"`
+	// 岗位角色
+	RoleName string `protobuf:"bytes,4,opt,name=roleName,proto3" json:"roleName"`
+	// 班次开始时间
+	StartTime string `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime"`
+	// 班次结束时间
+	EndTime string `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime"`
+	// 班次最大人数（0表示不限）
+	MaxPeople int32 `protobuf:"varint,7,opt,name=maxPeople,proto3" json:"maxPeople"`
+	// 班次当前已报名人数
+	CurrentPeople int32 `protobuf:"varint,8,opt,name=currentPeople,proto3" json:"currentPeople"`
+	// 所需技能
+	RequiredSkills []string `protobuf:"bytes,9,rep,name=requiredSkills,proto3" json:"requiredSkills"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ActivitySlotItem) Reset() {
+	*x = ActivitySlotItem{}
+	mi := &file_internal_api_activities_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySlotItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySlotItem) ProtoMessage() {}
+
+func (x *ActivitySlotItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySlotItem.ProtoReflect.Descriptor instead.
+func (*ActivitySlotItem) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ActivitySlotItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *ActivitySlotItem) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ActivitySlotItem) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ActivitySlotItem) GetRoleName() string {
+	if x != nil {
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *ActivitySlotItem) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ActivitySlotItem) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ActivitySlotItem) GetMaxPeople() int32 {
+	if x != nil {
+		return x.MaxPeople
+	}
+	return 0
+}
+
+func (x *ActivitySlotItem) GetCurrentPeople() int32 {
+	if x != nil {
+		return x.CurrentPeople
+	}
+	return 0
+}
+
+func (x *ActivitySlotItem) GetRequiredSkills() []string {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+// ActivitySlotListRequest 查询活动班次岗位列表请求
+type ActivitySlotListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySlotListRequest) Reset() {
+	*x = ActivitySlotListRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivitySlotListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivitySlotListRequest) ProtoMessage() {}
+
+func (x *ActivitySlotListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ActivitySlotListRequest.ProtoReflect.Descriptor instead.
+func (*ActivitySlotListRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ActivitySlotListRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// ActivitySlotListResponse 查询活动班次岗位列表响应
+type ActivitySlotListResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 班次岗位列表（按开始时间排序）
+	List          []*ActivitySlotItem `protobuf:"bytes,1,rep,name=list,proto3" json:"list"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivitySlotListResponse) Reset() {
+	*x = ActivitySlotListResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityInfo) String() string {
+func (x *ActivitySlotListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ActivityInfo) ProtoMessage() {}
+func (*ActivitySlotListResponse) ProtoMessage() {}
 
-func (x *ActivityInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[15]
+func (x *ActivitySlotListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1010,193 +3561,209 @@ func (x *ActivityInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ActivityInfo.ProtoReflect.Descriptor instead.
-func (*ActivityInfo) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use ActivitySlotListResponse.ProtoReflect.Descriptor instead.
+func (*ActivitySlotListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *ActivityInfo) GetId() int64 {
+func (x *ActivitySlotListResponse) GetList() []*ActivitySlotItem {
 	if x != nil {
-		return x.Id
+		return x.List
 	}
-	return 0
+	return nil
 }
 
-func (x *ActivityInfo) GetOrgId() int64 {
-	if x != nil {
-		return x.OrgId
-	}
-	return 0
+// CreateActivitySlotRequest 创建活动班次岗位请求
+type CreateActivitySlotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID 必填 @gotags: json:"activityId,required"
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
+	// 班次/岗位名称 必填 @gotags: json:"name,required"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,required"`
+	// 岗位角色 可选 @gotags: json:"roleName"
+	RoleName string `protobuf:"bytes,3,opt,name=roleName,proto3" json:"roleName"`
+	// 班次开始时间 必填 格式: 2006-01-02 15:04:05，需在活动时间范围内 @gotags: json:"startTime,required"
+	StartTime string `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime,required"`
+	// 班次结束时间 必填 格式: 2006-01-02 15:04:05，需在活动时间范围内 @gotags: json:"endTime,required"
+	EndTime string `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime,required"`
+	// 班次最大人数（0表示不限） 必填 @gotags: json:"maxPeople,required"
+	MaxPeople int32 `protobuf:"varint,6,opt,name=maxPeople,proto3" json:"maxPeople,required"`
+	// 所需技能 可选 @gotags: json:"requiredSkills"
+	RequiredSkills []string `protobuf:"bytes,7,rep,name=requiredSkills,proto3" json:"requiredSkills"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CreateActivitySlotRequest) Reset() {
+	*x = CreateActivitySlotRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityInfo) GetOrgName() string {
-	if x != nil {
-		return x.OrgName
-	}
-	return ""
+func (x *CreateActivitySlotRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ActivityInfo) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
-}
+func (*CreateActivitySlotRequest) ProtoMessage() {}
 
-func (x *ActivityInfo) GetDescription() string {
+func (x *CreateActivitySlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[44]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ActivityInfo) GetCoverUrl() string {
-	if x != nil {
-		return x.CoverUrl
-	}
-	return ""
+// Deprecated: Use CreateActivitySlotRequest.ProtoReflect.Descriptor instead.
+func (*CreateActivitySlotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *ActivityInfo) GetStartTime() string {
+func (x *CreateActivitySlotRequest) GetActivityId() int64 {
 	if x != nil {
-		return x.StartTime
+		return x.ActivityId
 	}
-	return ""
+	return 0
 }
 
-func (x *ActivityInfo) GetEndTime() string {
+func (x *CreateActivitySlotRequest) GetName() string {
 	if x != nil {
-		return x.EndTime
+		return x.Name
 	}
 	return ""
 }
 
-func (x *ActivityInfo) GetLocation() string {
+func (x *CreateActivitySlotRequest) GetRoleName() string {
 	if x != nil {
-		return x.Location
+		return x.RoleName
 	}
 	return ""
 }
 
-func (x *ActivityInfo) GetAddress() string {
+func (x *CreateActivitySlotRequest) GetStartTime() string {
 	if x != nil {
-		return x.Address
+		return x.StartTime
 	}
 	return ""
 }
 
-func (x *ActivityInfo) GetDuration() float64 {
+func (x *CreateActivitySlotRequest) GetEndTime() string {
 	if x != nil {
-		return x.Duration
+		return x.EndTime
 	}
-	return 0
+	return ""
 }
 
-func (x *ActivityInfo) GetMaxPeople() int32 {
+func (x *CreateActivitySlotRequest) GetMaxPeople() int32 {
 	if x != nil {
 		return x.MaxPeople
 	}
 	return 0
 }
 
-func (x *ActivityInfo) GetCurrentPeople() int32 {
-	if x != nil {
-		return x.CurrentPeople
-	}
-	return 0
-}
-
-func (x *ActivityInfo) GetStatus() int32 {
+func (x *CreateActivitySlotRequest) GetRequiredSkills() []string {
 	if x != nil {
-		return x.Status
+		return x.RequiredSkills
 	}
-	return 0
+	return nil
 }
 
-func (x *ActivityInfo) GetIsRegistered() bool {
-	if x != nil {
-		return x.IsRegistered
-	}
-	return false
+// CreateActivitySlotResponse 创建活动班次岗位响应
+type CreateActivitySlotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 班次岗位ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 消息
+	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ActivityInfo) GetCreatedAt() string {
-	if x != nil {
-		return x.CreatedAt
-	}
-	return ""
+func (x *CreateActivitySlotResponse) Reset() {
+	*x = CreateActivitySlotResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ActivityInfo) GetCheckInStatus() int32 {
-	if x != nil {
-		return x.CheckInStatus
-	}
-	return 0
+func (x *CreateActivitySlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ActivityInfo) GetCheckInTime() string {
-	if x != nil {
-		return x.CheckInTime
-	}
-	return ""
-}
+func (*CreateActivitySlotResponse) ProtoMessage() {}
 
-func (x *ActivityInfo) GetCheckOutStatus() int32 {
+func (x *CreateActivitySlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[45]
 	if x != nil {
-		return x.CheckOutStatus
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *ActivityInfo) GetCheckOutTime() string {
-	if x != nil {
-		return x.CheckOutTime
-	}
-	return ""
+// Deprecated: Use CreateActivitySlotResponse.ProtoReflect.Descriptor instead.
+func (*CreateActivitySlotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *ActivityInfo) GetWorkHourStatus() int32 {
+func (x *CreateActivitySlotResponse) GetId() int64 {
 	if x != nil {
-		return x.WorkHourStatus
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ActivityInfo) GetGrantedHours() float64 {
+func (x *CreateActivitySlotResponse) GetMessage() string {
 	if x != nil {
-		return x.GrantedHours
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type MyActivitiesRequest struct {
+// UpdateActivitySlotRequest 更新活动班次岗位请求
+type UpdateActivitySlotRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 页码 可选 @gotags: query:"page"
-	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page" query:"page"`
-	// 页大小 可选 @gotags: query:"pageSize"
-	PageSize int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" query:"pageSize"`
-	// 状态筛选 可选 @gotags: query:"status"
-	// 1-报名中, 2-已结束, 3-已取消
-	Status        int32 `protobuf:"varint,3,opt,name=status,proto3" json:"status" query:"status"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MyActivitiesRequest) Reset() {
-	*x = MyActivitiesRequest{}
-	mi := &file_internal_api_activities_proto_msgTypes[16]
+	// 班次岗位ID 必填 @gotags: path:"id,required"
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	// 班次/岗位名称 可选 @gotags: json:"name"
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name"`
+	// 岗位角色 可选 @gotags: json:"roleName"
+	RoleName string `protobuf:"bytes,3,opt,name=roleName,proto3" json:"roleName"`
+	// 班次开始时间 可选 格式: 2006-01-02 15:04:05 @gotags: json:"startTime"
+	StartTime string `protobuf:"bytes,4,opt,name=startTime,proto3" json:"startTime"`
+	// 班次结束时间 可选 格式: 2006-01-02 15:04:05 @gotags: json:"endTime"
+	EndTime string `protobuf:"bytes,5,opt,name=endTime,proto3" json:"endTime"`
+	// 班次最大人数（0表示不限，不能小于当前已报名人数） 必填 @gotags: json:"maxPeople,required"
+	MaxPeople int32 `protobuf:"varint,6,opt,name=maxPeople,proto3" json:"maxPeople,required"`
+	// 所需技能（整体覆盖） 可选 @gotags: json:"requiredSkills"
+	RequiredSkills []string `protobuf:"bytes,7,rep,name=requiredSkills,proto3" json:"requiredSkills"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UpdateActivitySlotRequest) Reset() {
+	*x = UpdateActivitySlotRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MyActivitiesRequest) String() string {
+func (x *UpdateActivitySlotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MyActivitiesRequest) ProtoMessage() {}
+func (*UpdateActivitySlotRequest) ProtoMessage() {}
 
-func (x *MyActivitiesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[16]
+func (x *UpdateActivitySlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,55 +3774,84 @@ func (x *MyActivitiesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MyActivitiesRequest.ProtoReflect.Descriptor instead.
-func (*MyActivitiesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use UpdateActivitySlotRequest.ProtoReflect.Descriptor instead.
+func (*UpdateActivitySlotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *MyActivitiesRequest) GetPage() int32 {
+func (x *UpdateActivitySlotRequest) GetId() int64 {
 	if x != nil {
-		return x.Page
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MyActivitiesRequest) GetPageSize() int32 {
+func (x *UpdateActivitySlotRequest) GetName() string {
 	if x != nil {
-		return x.PageSize
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *MyActivitiesRequest) GetStatus() int32 {
+func (x *UpdateActivitySlotRequest) GetRoleName() string {
 	if x != nil {
-		return x.Status
+		return x.RoleName
+	}
+	return ""
+}
+
+func (x *UpdateActivitySlotRequest) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateActivitySlotRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *UpdateActivitySlotRequest) GetMaxPeople() int32 {
+	if x != nil {
+		return x.MaxPeople
 	}
 	return 0
 }
 
-type MyActivitiesResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
-	List          []*MyActivityItem      `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
+func (x *UpdateActivitySlotRequest) GetRequiredSkills() []string {
+	if x != nil {
+		return x.RequiredSkills
+	}
+	return nil
+}
+
+// UpdateActivitySlotResponse 更新活动班次岗位响应
+type UpdateActivitySlotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MyActivitiesResponse) Reset() {
-	*x = MyActivitiesResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[17]
+func (x *UpdateActivitySlotResponse) Reset() {
+	*x = UpdateActivitySlotResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MyActivitiesResponse) String() string {
+func (x *UpdateActivitySlotResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MyActivitiesResponse) ProtoMessage() {}
+func (*UpdateActivitySlotResponse) ProtoMessage() {}
 
-func (x *MyActivitiesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[17]
+func (x *UpdateActivitySlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1266,85 +3862,42 @@ func (x *MyActivitiesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MyActivitiesResponse.ProtoReflect.Descriptor instead.
-func (*MyActivitiesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{17}
-}
-
-func (x *MyActivitiesResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use UpdateActivitySlotResponse.ProtoReflect.Descriptor instead.
+func (*UpdateActivitySlotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *MyActivitiesResponse) GetList() []*MyActivityItem {
+func (x *UpdateActivitySlotResponse) GetMessage() string {
 	if x != nil {
-		return x.List
-	}
-	return nil
-}
-
-type MyActivityItem struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	// 组织ID
-	OrgId int64 `protobuf:"varint,2,opt,name=orgId,proto3" json:"orgId"`
-	// 组织名称
-	OrgName string `protobuf:"bytes,3,opt,name=orgName,proto3" json:"orgName"`
-	// 活动标题
-	Title string `protobuf:"bytes,4,opt,name=title,proto3" json:"title"`
-	// 活动描述
-	Description string `protobuf:"bytes,5,opt,name=description,proto3" json:"description"`
-	// 封面图URL
-	CoverUrl string `protobuf:"bytes,6,opt,name=coverUrl,proto3" json:"coverUrl"`
-	// 开始时间
-	StartTime string `protobuf:"bytes,7,opt,name=startTime,proto3" json:"startTime"`
-	// 结束时间
-	EndTime string `protobuf:"bytes,8,opt,name=endTime,proto3" json:"endTime"`
-	// 地点名称
-	Location string `protobuf:"bytes,9,opt,name=location,proto3" json:"location"`
-	// 预估工时
-	Duration float64 `protobuf:"fixed64,10,opt,name=duration,proto3" json:"duration"`
-	// 最大招募人数
-	MaxPeople int32 `protobuf:"varint,11,opt,name=maxPeople,proto3" json:"maxPeople"`
-	// 当前已报名人数
-	CurrentPeople int32 `protobuf:"varint,12,opt,name=currentPeople,proto3" json:"currentPeople"`
-	// 状态: 1-报名中, 2-已结束, 3-已取消
-	Status int32 `protobuf:"varint,13,opt,name=status,proto3" json:"status"`
-	// 报名时间
-	SignupTime string `protobuf:"bytes,14,opt,name=signupTime,proto3" json:"signupTime"`
-	// 签到状态: 0-未签到, 1-已签到
-	CheckInStatus int32 `protobuf:"varint,15,opt,name=checkInStatus,proto3" json:"checkInStatus"`
-	// 签到时间
-	CheckInTime string `protobuf:"bytes,16,opt,name=checkInTime,proto3" json:"checkInTime"`
-	// 签退状态: 0-未签退, 1-已签退
-	CheckOutStatus int32 `protobuf:"varint,17,opt,name=checkOutStatus,proto3" json:"checkOutStatus"`
-	// 签退时间
-	CheckOutTime string `protobuf:"bytes,18,opt,name=checkOutTime,proto3" json:"checkOutTime"`
-	// 工时结算状态: 0-未结算, 1-已发放, 2-已作废
-	WorkHourStatus int32 `protobuf:"varint,19,opt,name=workHourStatus,proto3" json:"workHourStatus"`
-	// 本次发放工时
-	GrantedHours  float64 `protobuf:"fixed64,20,opt,name=grantedHours,proto3" json:"grantedHours"`
+		return x.Message
+	}
+	return ""
+}
+
+// DeleteActivitySlotRequest 删除活动班次岗位请求
+type DeleteActivitySlotRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 班次岗位ID 必填 @gotags: path:"id,required"
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MyActivityItem) Reset() {
-	*x = MyActivityItem{}
-	mi := &file_internal_api_activities_proto_msgTypes[18]
+func (x *DeleteActivitySlotRequest) Reset() {
+	*x = DeleteActivitySlotRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MyActivityItem) String() string {
+func (x *DeleteActivitySlotRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MyActivityItem) ProtoMessage() {}
+func (*DeleteActivitySlotRequest) ProtoMessage() {}
 
-func (x *MyActivityItem) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[18]
+func (x *DeleteActivitySlotRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1355,193 +3908,188 @@ func (x *MyActivityItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MyActivityItem.ProtoReflect.Descriptor instead.
-func (*MyActivityItem) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DeleteActivitySlotRequest.ProtoReflect.Descriptor instead.
+func (*DeleteActivitySlotRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *MyActivityItem) GetId() int64 {
+func (x *DeleteActivitySlotRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *MyActivityItem) GetOrgId() int64 {
-	if x != nil {
-		return x.OrgId
-	}
-	return 0
+// DeleteActivitySlotResponse 删除活动班次岗位响应
+type DeleteActivitySlotResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 消息
+	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MyActivityItem) GetOrgName() string {
-	if x != nil {
-		return x.OrgName
-	}
-	return ""
+func (x *DeleteActivitySlotResponse) Reset() {
+	*x = DeleteActivitySlotResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MyActivityItem) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+func (x *DeleteActivitySlotResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MyActivityItem) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
+func (*DeleteActivitySlotResponse) ProtoMessage() {}
 
-func (x *MyActivityItem) GetCoverUrl() string {
+func (x *DeleteActivitySlotResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[49]
 	if x != nil {
-		return x.CoverUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *MyActivityItem) GetStartTime() string {
-	if x != nil {
-		return x.StartTime
-	}
-	return ""
+// Deprecated: Use DeleteActivitySlotResponse.ProtoReflect.Descriptor instead.
+func (*DeleteActivitySlotResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *MyActivityItem) GetEndTime() string {
+func (x *DeleteActivitySlotResponse) GetMessage() string {
 	if x != nil {
-		return x.EndTime
+		return x.Message
 	}
 	return ""
 }
 
-func (x *MyActivityItem) GetLocation() string {
-	if x != nil {
-		return x.Location
-	}
-	return ""
+// GenerateAttendanceCodesRequest 生成签到码/签退码请求
+type GenerateAttendanceCodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	// 签到码有效时长（分钟）可选，<=0 表示不过期 @gotags: json:"checkInValidMinutes"
+	CheckInValidMinutes int32 `protobuf:"varint,2,opt,name=checkInValidMinutes,proto3" json:"checkInValidMinutes"`
+	// 签退码有效时长（分钟）可选，<=0 表示不过期 @gotags: json:"checkOutValidMinutes"
+	CheckOutValidMinutes int32 `protobuf:"varint,3,opt,name=checkOutValidMinutes,proto3" json:"checkOutValidMinutes"`
+	// 码模式（1-静态码，2-动态轮换码）可选，默认静态码 @gotags: json:"codeMode"
+	CodeMode int32 `protobuf:"varint,4,opt,name=codeMode,proto3" json:"codeMode"`
+	// 动态码轮换周期（秒）可选，仅动态码有效，取值 30~60，默认 30 @gotags: json:"rotationSeconds"
+	RotationSeconds int32 `protobuf:"varint,5,opt,name=rotationSeconds,proto3" json:"rotationSeconds"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *MyActivityItem) GetDuration() float64 {
-	if x != nil {
-		return x.Duration
-	}
-	return 0
+func (x *GenerateAttendanceCodesRequest) Reset() {
+	*x = GenerateAttendanceCodesRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MyActivityItem) GetMaxPeople() int32 {
-	if x != nil {
-		return x.MaxPeople
-	}
-	return 0
+func (x *GenerateAttendanceCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *MyActivityItem) GetCurrentPeople() int32 {
-	if x != nil {
-		return x.CurrentPeople
-	}
-	return 0
-}
+func (*GenerateAttendanceCodesRequest) ProtoMessage() {}
 
-func (x *MyActivityItem) GetStatus() int32 {
+func (x *GenerateAttendanceCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[50]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *MyActivityItem) GetSignupTime() string {
-	if x != nil {
-		return x.SignupTime
-	}
-	return ""
+// Deprecated: Use GenerateAttendanceCodesRequest.ProtoReflect.Descriptor instead.
+func (*GenerateAttendanceCodesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *MyActivityItem) GetCheckInStatus() int32 {
+func (x *GenerateAttendanceCodesRequest) GetId() int64 {
 	if x != nil {
-		return x.CheckInStatus
+		return x.Id
 	}
 	return 0
 }
 
-func (x *MyActivityItem) GetCheckInTime() string {
-	if x != nil {
-		return x.CheckInTime
-	}
-	return ""
-}
-
-func (x *MyActivityItem) GetCheckOutStatus() int32 {
+func (x *GenerateAttendanceCodesRequest) GetCheckInValidMinutes() int32 {
 	if x != nil {
-		return x.CheckOutStatus
+		return x.CheckInValidMinutes
 	}
 	return 0
 }
 
-func (x *MyActivityItem) GetCheckOutTime() string {
+func (x *GenerateAttendanceCodesRequest) GetCheckOutValidMinutes() int32 {
 	if x != nil {
-		return x.CheckOutTime
+		return x.CheckOutValidMinutes
 	}
-	return ""
+	return 0
 }
 
-func (x *MyActivityItem) GetWorkHourStatus() int32 {
+func (x *GenerateAttendanceCodesRequest) GetCodeMode() int32 {
 	if x != nil {
-		return x.WorkHourStatus
+		return x.CodeMode
 	}
 	return 0
 }
 
-func (x *MyActivityItem) GetGrantedHours() float64 {
+func (x *GenerateAttendanceCodesRequest) GetRotationSeconds() int32 {
 	if x != nil {
-		return x.GrantedHours
+		return x.RotationSeconds
 	}
 	return 0
 }
 
-// CreateActivityRequest 创建活动请求
-type CreateActivityRequest struct {
+// GenerateAttendanceCodesResponse 生成签到码/签退码响应
+type GenerateAttendanceCodesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 组织ID 必填 @gotags: json:"orgId,required"
-	OrgId int64 `protobuf:"varint,1,opt,name=orgId,proto3" json:"orgId,required"`
-	// 活动标题 必填 @gotags: json:"title,required"
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,required"`
-	// 活动描述 可选 @gotags: json:"description"
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
-	// 封面图URL 可选 @gotags: json:"coverUrl"
-	CoverUrl string `protobuf:"bytes,4,opt,name=coverUrl,proto3" json:"coverUrl"`
-	// 开始时间 必填 @gotags: json:"startTime,required"
-	StartTime string `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime,required"`
-	// 结束时间 必填 @gotags: json:"endTime,required"
-	EndTime string `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime,required"`
-	// 地点名称 必填 @gotags: json:"location,required"
-	Location string `protobuf:"bytes,7,opt,name=location,proto3" json:"location,required"`
-	// 详细地址 可选 @gotags: json:"address"
-	Address string `protobuf:"bytes,8,opt,name=address,proto3" json:"address"`
-	// 预估工时（小时） 必填 @gotags: json:"duration,required"
-	Duration float64 `protobuf:"fixed64,9,opt,name=duration,proto3" json:"duration,required"`
-	// 最大招募人数（0表示不限） 必填 @gotags: json:"maxPeople,required"
-	MaxPeople     int32 `protobuf:"varint,10,opt,name=maxPeople,proto3" json:"maxPeople,required"`
+	// 是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	// 本次生成的签到码
+	CheckInCode string `protobuf:"bytes,2,opt,name=checkInCode,proto3" json:"checkInCode"`
+	// 本次生成的签退码
+	CheckOutCode string `protobuf:"bytes,3,opt,name=checkOutCode,proto3" json:"checkOutCode"`
+	// 当前码版本号
+	AttendanceCodeVersion int64 `protobuf:"varint,4,opt,name=attendanceCodeVersion,proto3" json:"attendanceCodeVersion"`
+	// 码更新时间
+	AttendanceCodeUpdatedAt string `protobuf:"bytes,5,opt,name=attendanceCodeUpdatedAt,proto3" json:"attendanceCodeUpdatedAt"`
+	// 签到码过期时间（为空表示不过期）
+	CheckInExpireAt string `protobuf:"bytes,6,opt,name=checkInExpireAt,proto3" json:"checkInExpireAt"`
+	// 签退码过期时间（为空表示不过期）
+	CheckOutExpireAt string `protobuf:"bytes,7,opt,name=checkOutExpireAt,proto3" json:"checkOutExpireAt"`
+	// 码模式（1-静态码，2-动态轮换码）
+	CodeMode int32 `protobuf:"varint,8,opt,name=codeMode,proto3" json:"codeMode"`
+	// 动态码轮换周期（秒），静态码为0
+	RotationSeconds int32 `protobuf:"varint,9,opt,name=rotationSeconds,proto3" json:"rotationSeconds"`
+	// 动态码下次轮换时间（静态码为空）
+	NextRotateAt  string `protobuf:"bytes,10,opt,name=nextRotateAt,proto3" json:"nextRotateAt"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateActivityRequest) Reset() {
-	*x = CreateActivityRequest{}
-	mi := &file_internal_api_activities_proto_msgTypes[19]
+func (x *GenerateAttendanceCodesResponse) Reset() {
+	*x = GenerateAttendanceCodesResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateActivityRequest) String() string {
+func (x *GenerateAttendanceCodesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateActivityRequest) ProtoMessage() {}
+func (*GenerateAttendanceCodesResponse) ProtoMessage() {}
 
-func (x *CreateActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[19]
+func (x *GenerateAttendanceCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1552,107 +4100,109 @@ func (x *CreateActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateActivityRequest.ProtoReflect.Descriptor instead.
-func (*CreateActivityRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use GenerateAttendanceCodesResponse.ProtoReflect.Descriptor instead.
+func (*GenerateAttendanceCodesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *CreateActivityRequest) GetOrgId() int64 {
+func (x *GenerateAttendanceCodesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.OrgId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *CreateActivityRequest) GetTitle() string {
+func (x *GenerateAttendanceCodesResponse) GetCheckInCode() string {
 	if x != nil {
-		return x.Title
+		return x.CheckInCode
 	}
 	return ""
 }
 
-func (x *CreateActivityRequest) GetDescription() string {
+func (x *GenerateAttendanceCodesResponse) GetCheckOutCode() string {
 	if x != nil {
-		return x.Description
+		return x.CheckOutCode
 	}
 	return ""
 }
 
-func (x *CreateActivityRequest) GetCoverUrl() string {
+func (x *GenerateAttendanceCodesResponse) GetAttendanceCodeVersion() int64 {
 	if x != nil {
-		return x.CoverUrl
+		return x.AttendanceCodeVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateActivityRequest) GetStartTime() string {
+func (x *GenerateAttendanceCodesResponse) GetAttendanceCodeUpdatedAt() string {
 	if x != nil {
-		return x.StartTime
+		return x.AttendanceCodeUpdatedAt
 	}
 	return ""
 }
 
-func (x *CreateActivityRequest) GetEndTime() string {
+func (x *GenerateAttendanceCodesResponse) GetCheckInExpireAt() string {
 	if x != nil {
-		return x.EndTime
+		return x.CheckInExpireAt
 	}
 	return ""
 }
 
-func (x *CreateActivityRequest) GetLocation() string {
+func (x *GenerateAttendanceCodesResponse) GetCheckOutExpireAt() string {
 	if x != nil {
-		return x.Location
+		return x.CheckOutExpireAt
 	}
 	return ""
 }
 
-func (x *CreateActivityRequest) GetAddress() string {
+func (x *GenerateAttendanceCodesResponse) GetCodeMode() int32 {
 	if x != nil {
-		return x.Address
+		return x.CodeMode
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateActivityRequest) GetDuration() float64 {
+func (x *GenerateAttendanceCodesResponse) GetRotationSeconds() int32 {
 	if x != nil {
-		return x.Duration
+		return x.RotationSeconds
 	}
 	return 0
 }
 
-func (x *CreateActivityRequest) GetMaxPeople() int32 {
+func (x *GenerateAttendanceCodesResponse) GetNextRotateAt() string {
 	if x != nil {
-		return x.MaxPeople
+		return x.NextRotateAt
 	}
-	return 0
+	return ""
 }
 
-// CreateActivityResponse 创建活动响应
-type CreateActivityResponse struct {
+// ResetAttendanceCodeRequest 重置签到码/签退码请求
+type ResetAttendanceCodeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 活动ID
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
-	// 消息
-	Message       string `protobuf:"bytes,2,opt,name=message,proto3" json:"message"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	// 重置码类型（1-签到码，2-签退码）必填 @gotags: json:"codeType,required"
+	CodeType int32 `protobuf:"varint,2,opt,name=codeType,proto3" json:"codeType,required"`
+	// 码有效时长（分钟）可选，<=0 表示不过期 @gotags: json:"validMinutes"
+	ValidMinutes  int32 `protobuf:"varint,3,opt,name=validMinutes,proto3" json:"validMinutes"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
-
-func (x *CreateActivityResponse) Reset() {
-	*x = CreateActivityResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[20]
+
+func (x *ResetAttendanceCodeRequest) Reset() {
+	*x = ResetAttendanceCodeRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateActivityResponse) String() string {
+func (x *ResetAttendanceCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateActivityResponse) ProtoMessage() {}
+func (*ResetAttendanceCodeRequest) ProtoMessage() {}
 
-func (x *CreateActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[20]
+func (x *ResetAttendanceCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1663,67 +4213,70 @@ func (x *CreateActivityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateActivityResponse.ProtoReflect.Descriptor instead.
-func (*CreateActivityResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use ResetAttendanceCodeRequest.ProtoReflect.Descriptor instead.
+func (*ResetAttendanceCodeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{52}
 }
 
-func (x *CreateActivityResponse) GetId() int64 {
+func (x *ResetAttendanceCodeRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *CreateActivityResponse) GetMessage() string {
+func (x *ResetAttendanceCodeRequest) GetCodeType() int32 {
 	if x != nil {
-		return x.Message
+		return x.CodeType
 	}
-	return ""
+	return 0
 }
 
-// UpdateActivityRequest 更新活动请求
-type UpdateActivityRequest struct {
+func (x *ResetAttendanceCodeRequest) GetValidMinutes() int32 {
+	if x != nil {
+		return x.ValidMinutes
+	}
+	return 0
+}
+
+// ResetAttendanceCodeResponse 重置签到码/签退码响应
+type ResetAttendanceCodeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 活动ID 必填 @gotags: path:"id,required"
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
-	// 活动标题 可选 @gotags: json:"title"
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title"`
-	// 活动描述 可选 @gotags: json:"description"
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description"`
-	// 封面图URL 可选 @gotags: json:"coverUrl"
-	CoverUrl string `protobuf:"bytes,4,opt,name=coverUrl,proto3" json:"coverUrl"`
-	// 开始时间 可选 @gotags: json:"startTime"
-	StartTime string `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime"`
-	// 结束时间 可选 @gotags: json:"endTime"
-	EndTime string `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime"`
-	// 地点名称 可选 @gotags: json:"location"
-	Location string `protobuf:"bytes,7,opt,name=location,proto3" json:"location"`
-	// 详细地址 可选 @gotags: json:"address"
-	Address string `protobuf:"bytes,8,opt,name=address,proto3" json:"address"`
-	// 预估工时（小时） 可选 @gotags: json:"duration"
-	Duration float64 `protobuf:"fixed64,9,opt,name=duration,proto3" json:"duration"`
-	// 最大招募人数（0表示不限） 可选 @gotags: json:"maxPeople"
-	MaxPeople     int32 `protobuf:"varint,10,opt,name=maxPeople,proto3" json:"maxPeople"`
+	// 是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	// 重置码类型（1-签到码，2-签退码）
+	CodeType int32 `protobuf:"varint,2,opt,name=codeType,proto3" json:"codeType"`
+	// 本次重置后的码
+	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code"`
+	// 本次重置后的码过期时间（为空表示不过期）
+	ExpireAt string `protobuf:"bytes,4,opt,name=expireAt,proto3" json:"expireAt"`
+	// 当前码版本号
+	AttendanceCodeVersion int64 `protobuf:"varint,5,opt,name=attendanceCodeVersion,proto3" json:"attendanceCodeVersion"`
+	// 码更新时间
+	AttendanceCodeUpdatedAt string `protobuf:"bytes,6,opt,name=attendanceCodeUpdatedAt,proto3" json:"attendanceCodeUpdatedAt"`
+	// 码模式（1-静态码，2-动态轮换码）
+	CodeMode int32 `protobuf:"varint,7,opt,name=codeMode,proto3" json:"codeMode"`
+	// 动态码下次轮换时间（静态码为空）
+	NextRotateAt  string `protobuf:"bytes,8,opt,name=nextRotateAt,proto3" json:"nextRotateAt"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateActivityRequest) Reset() {
-	*x = UpdateActivityRequest{}
-	mi := &file_internal_api_activities_proto_msgTypes[21]
+func (x *ResetAttendanceCodeResponse) Reset() {
+	*x = ResetAttendanceCodeResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateActivityRequest) String() string {
+func (x *ResetAttendanceCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateActivityRequest) ProtoMessage() {}
+func (*ResetAttendanceCodeResponse) ProtoMessage() {}
 
-func (x *UpdateActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[21]
+func (x *ResetAttendanceCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1734,105 +4287,91 @@ func (x *UpdateActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateActivityRequest.ProtoReflect.Descriptor instead.
-func (*UpdateActivityRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *UpdateActivityRequest) GetId() int64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *UpdateActivityRequest) GetTitle() string {
-	if x != nil {
-		return x.Title
-	}
-	return ""
+// Deprecated: Use ResetAttendanceCodeResponse.ProtoReflect.Descriptor instead.
+func (*ResetAttendanceCodeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *UpdateActivityRequest) GetDescription() string {
+func (x *ResetAttendanceCodeResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Description
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *UpdateActivityRequest) GetCoverUrl() string {
+func (x *ResetAttendanceCodeResponse) GetCodeType() int32 {
 	if x != nil {
-		return x.CoverUrl
+		return x.CodeType
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateActivityRequest) GetStartTime() string {
+func (x *ResetAttendanceCodeResponse) GetCode() string {
 	if x != nil {
-		return x.StartTime
+		return x.Code
 	}
 	return ""
 }
 
-func (x *UpdateActivityRequest) GetEndTime() string {
+func (x *ResetAttendanceCodeResponse) GetExpireAt() string {
 	if x != nil {
-		return x.EndTime
+		return x.ExpireAt
 	}
 	return ""
 }
 
-func (x *UpdateActivityRequest) GetLocation() string {
+func (x *ResetAttendanceCodeResponse) GetAttendanceCodeVersion() int64 {
 	if x != nil {
-		return x.Location
+		return x.AttendanceCodeVersion
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateActivityRequest) GetAddress() string {
+func (x *ResetAttendanceCodeResponse) GetAttendanceCodeUpdatedAt() string {
 	if x != nil {
-		return x.Address
+		return x.AttendanceCodeUpdatedAt
 	}
 	return ""
 }
 
-func (x *UpdateActivityRequest) GetDuration() float64 {
+func (x *ResetAttendanceCodeResponse) GetCodeMode() int32 {
 	if x != nil {
-		return x.Duration
+		return x.CodeMode
 	}
 	return 0
 }
 
-func (x *UpdateActivityRequest) GetMaxPeople() int32 {
+func (x *ResetAttendanceCodeResponse) GetNextRotateAt() string {
 	if x != nil {
-		return x.MaxPeople
+		return x.NextRotateAt
 	}
-	return 0
+	return ""
 }
 
-// UpdateActivityResponse 更新活动响应
-type UpdateActivityResponse struct {
+// GetActivityAttendanceCodesRequest 查询活动签到码/签退码请求
+type GetActivityAttendanceCodesRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 消息
-	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+	// 活动ID 必填 @gotags: path:"id,required"
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateActivityResponse) Reset() {
-	*x = UpdateActivityResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[22]
+func (x *GetActivityAttendanceCodesRequest) Reset() {
+	*x = GetActivityAttendanceCodesRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateActivityResponse) String() string {
+func (x *GetActivityAttendanceCodesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateActivityResponse) ProtoMessage() {}
+func (*GetActivityAttendanceCodesRequest) ProtoMessage() {}
 
-func (x *UpdateActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[22]
+func (x *GetActivityAttendanceCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1843,42 +4382,60 @@ func (x *UpdateActivityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateActivityResponse.ProtoReflect.Descriptor instead.
-func (*UpdateActivityResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetActivityAttendanceCodesRequest.ProtoReflect.Descriptor instead.
+func (*GetActivityAttendanceCodesRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *UpdateActivityResponse) GetMessage() string {
+func (x *GetActivityAttendanceCodesRequest) GetId() int64 {
 	if x != nil {
-		return x.Message
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-// DeleteActivityRequest 删除活动请求
-type DeleteActivityRequest struct {
+// GetActivityAttendanceCodesResponse 查询活动签到码/签退码响应
+type GetActivityAttendanceCodesResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 活动ID 必填 @gotags: path:"id,required"
-	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	// 是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	// 当前签到码
+	CheckInCode string `protobuf:"bytes,2,opt,name=checkInCode,proto3" json:"checkInCode"`
+	// 当前签退码
+	CheckOutCode string `protobuf:"bytes,3,opt,name=checkOutCode,proto3" json:"checkOutCode"`
+	// 签到码过期时间（为空表示不过期）
+	CheckInExpireAt string `protobuf:"bytes,4,opt,name=checkInExpireAt,proto3" json:"checkInExpireAt"`
+	// 签退码过期时间（为空表示不过期）
+	CheckOutExpireAt string `protobuf:"bytes,5,opt,name=checkOutExpireAt,proto3" json:"checkOutExpireAt"`
+	// 当前码版本号
+	AttendanceCodeVersion int64 `protobuf:"varint,6,opt,name=attendanceCodeVersion,proto3" json:"attendanceCodeVersion"`
+	// 码更新时间
+	AttendanceCodeUpdatedAt string `protobuf:"bytes,7,opt,name=attendanceCodeUpdatedAt,proto3" json:"attendanceCodeUpdatedAt"`
+	// 码模式（1-静态码，2-动态轮换码）
+	CodeMode int32 `protobuf:"varint,8,opt,name=codeMode,proto3" json:"codeMode"`
+	// 动态码轮换周期（秒），静态码为0
+	RotationSeconds int32 `protobuf:"varint,9,opt,name=rotationSeconds,proto3" json:"rotationSeconds"`
+	// 动态码下次轮换时间（静态码为空），前端据此刷新展示
+	NextRotateAt  string `protobuf:"bytes,10,opt,name=nextRotateAt,proto3" json:"nextRotateAt"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteActivityRequest) Reset() {
-	*x = DeleteActivityRequest{}
-	mi := &file_internal_api_activities_proto_msgTypes[23]
+func (x *GetActivityAttendanceCodesResponse) Reset() {
+	*x = GetActivityAttendanceCodesResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteActivityRequest) String() string {
+func (x *GetActivityAttendanceCodesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteActivityRequest) ProtoMessage() {}
+func (*GetActivityAttendanceCodesResponse) ProtoMessage() {}
 
-func (x *DeleteActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[23]
+func (x *GetActivityAttendanceCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1889,90 +4446,113 @@ func (x *DeleteActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteActivityRequest.ProtoReflect.Descriptor instead.
-func (*DeleteActivityRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetActivityAttendanceCodesResponse.ProtoReflect.Descriptor instead.
+func (*GetActivityAttendanceCodesResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *DeleteActivityRequest) GetId() int64 {
+func (x *GetActivityAttendanceCodesResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Id
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-// DeleteActivityResponse 删除活动响应
-type DeleteActivityResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 消息
-	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GetActivityAttendanceCodesResponse) GetCheckInCode() string {
+	if x != nil {
+		return x.CheckInCode
+	}
+	return ""
 }
 
-func (x *DeleteActivityResponse) Reset() {
-	*x = DeleteActivityResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[24]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *GetActivityAttendanceCodesResponse) GetCheckOutCode() string {
+	if x != nil {
+		return x.CheckOutCode
+	}
+	return ""
 }
 
-func (x *DeleteActivityResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *GetActivityAttendanceCodesResponse) GetCheckInExpireAt() string {
+	if x != nil {
+		return x.CheckInExpireAt
+	}
+	return ""
 }
 
-func (*DeleteActivityResponse) ProtoMessage() {}
+func (x *GetActivityAttendanceCodesResponse) GetCheckOutExpireAt() string {
+	if x != nil {
+		return x.CheckOutExpireAt
+	}
+	return ""
+}
+
+func (x *GetActivityAttendanceCodesResponse) GetAttendanceCodeVersion() int64 {
+	if x != nil {
+		return x.AttendanceCodeVersion
+	}
+	return 0
+}
+
+func (x *GetActivityAttendanceCodesResponse) GetAttendanceCodeUpdatedAt() string {
+	if x != nil {
+		return x.AttendanceCodeUpdatedAt
+	}
+	return ""
+}
 
-func (x *DeleteActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[24]
+func (x *GetActivityAttendanceCodesResponse) GetCodeMode() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CodeMode
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use DeleteActivityResponse.ProtoReflect.Descriptor instead.
-func (*DeleteActivityResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{24}
+func (x *GetActivityAttendanceCodesResponse) GetRotationSeconds() int32 {
+	if x != nil {
+		return x.RotationSeconds
+	}
+	return 0
 }
 
-func (x *DeleteActivityResponse) GetMessage() string {
+func (x *GetActivityAttendanceCodesResponse) GetNextRotateAt() string {
 	if x != nil {
-		return x.Message
+		return x.NextRotateAt
 	}
 	return ""
 }
 
-// CancelActivityRequest 取消活动请求
-type CancelActivityRequest struct {
+// AttendanceQRCodeRequest 获取签到/签退二维码请求
+type AttendanceQRCodeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 活动ID 必填 @gotags: path:"id,required"
 	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
-	// 取消原因 可选 @gotags: json:"reason"
-	Reason        string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason"`
+	// 码类型（1-签到码，2-签退码）必填 @gotags: query:"codeType,required"
+	CodeType int32 `protobuf:"varint,2,opt,name=codeType,proto3" json:"codeType" query:"codeType,required"`
+	// 图片格式 png/svg 可选，默认 png @gotags: query:"format"
+	Format string `protobuf:"bytes,3,opt,name=format,proto3" json:"format" query:"format"`
+	// 令牌有效时长（秒）可选，默认 60，最长 600 @gotags: query:"validSeconds"
+	ValidSeconds int32 `protobuf:"varint,4,opt,name=validSeconds,proto3" json:"validSeconds" query:"validSeconds"`
+	// 图片边长（像素）可选，默认 256 @gotags: query:"size"
+	Size          int32 `protobuf:"varint,5,opt,name=size,proto3" json:"size" query:"size"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelActivityRequest) Reset() {
-	*x = CancelActivityRequest{}
-	mi := &file_internal_api_activities_proto_msgTypes[25]
+func (x *AttendanceQRCodeRequest) Reset() {
+	*x = AttendanceQRCodeRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelActivityRequest) String() string {
+func (x *AttendanceQRCodeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelActivityRequest) ProtoMessage() {}
+func (*AttendanceQRCodeRequest) ProtoMessage() {}
 
-func (x *CancelActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[25]
+func (x *AttendanceQRCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1983,49 +4563,76 @@ func (x *CancelActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelActivityRequest.ProtoReflect.Descriptor instead.
-func (*CancelActivityRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use AttendanceQRCodeRequest.ProtoReflect.Descriptor instead.
+func (*AttendanceQRCodeRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CancelActivityRequest) GetId() int64 {
+func (x *AttendanceQRCodeRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *CancelActivityRequest) GetReason() string {
+func (x *AttendanceQRCodeRequest) GetCodeType() int32 {
 	if x != nil {
-		return x.Reason
+		return x.CodeType
+	}
+	return 0
+}
+
+func (x *AttendanceQRCodeRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
 	}
 	return ""
 }
 
-// CancelActivityResponse 取消活动响应
-type CancelActivityResponse struct {
+func (x *AttendanceQRCodeRequest) GetValidSeconds() int32 {
+	if x != nil {
+		return x.ValidSeconds
+	}
+	return 0
+}
+
+func (x *AttendanceQRCodeRequest) GetSize() int32 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+// AttendanceQRCodeResponse 签到/签退二维码（接口直接输出图片，过期时间通过响应头返回）
+type AttendanceQRCodeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 消息
-	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// 图片内容
+	Image []byte `protobuf:"bytes,1,opt,name=image,proto3" json:"image"`
+	// 图片类型
+	ContentType string `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType"`
+	// 令牌过期时间
+	ExpireAt string `protobuf:"bytes,3,opt,name=expireAt,proto3" json:"expireAt"`
+	// 令牌对应的码版本号
+	AttendanceCodeVersion int64 `protobuf:"varint,4,opt,name=attendanceCodeVersion,proto3" json:"attendanceCodeVersion"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
 }
 
-func (x *CancelActivityResponse) Reset() {
-	*x = CancelActivityResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[26]
+func (x *AttendanceQRCodeResponse) Reset() {
+	*x = AttendanceQRCodeResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelActivityResponse) String() string {
+func (x *AttendanceQRCodeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelActivityResponse) ProtoMessage() {}
+func (*AttendanceQRCodeResponse) ProtoMessage() {}
 
-func (x *CancelActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[26]
+func (x *AttendanceQRCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2036,42 +4643,69 @@ func (x *CancelActivityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelActivityResponse.ProtoReflect.Descriptor instead.
-func (*CancelActivityResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use AttendanceQRCodeResponse.ProtoReflect.Descriptor instead.
+func (*AttendanceQRCodeResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *CancelActivityResponse) GetMessage() string {
+func (x *AttendanceQRCodeResponse) GetImage() []byte {
 	if x != nil {
-		return x.Message
+		return x.Image
+	}
+	return nil
+}
+
+func (x *AttendanceQRCodeResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
 	}
 	return ""
 }
 
-// FinishActivityRequest 完结活动请求
-type FinishActivityRequest struct {
+func (x *AttendanceQRCodeResponse) GetExpireAt() string {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return ""
+}
+
+func (x *AttendanceQRCodeResponse) GetAttendanceCodeVersion() int64 {
+	if x != nil {
+		return x.AttendanceCodeVersion
+	}
+	return 0
+}
+
+// ActivityBatchAttendanceRow 批量补录中的一行（志愿者ID与报名ID至少填一个）
+type ActivityBatchAttendanceRow struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 活动ID 必填 @gotags: path:"id,required"
-	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	// 志愿者ID（volunteers.id）@gotags: json:"volunteerId"
+	VolunteerId int64 `protobuf:"varint,1,opt,name=volunteerId,proto3" json:"volunteerId"`
+	// 报名记录ID（activity_signups.id），优先于志愿者ID @gotags: json:"signupId"
+	SignupId int64 `protobuf:"varint,2,opt,name=signupId,proto3" json:"signupId"`
+	// 签到时间（原记录未签到时必填）格式: 2006-01-02 15:04:05 @gotags: json:"checkInTime"
+	CheckInTime string `protobuf:"bytes,3,opt,name=checkInTime,proto3" json:"checkInTime"`
+	// 签退时间（为空表示仅补录签到）格式: 2006-01-02 15:04:05 @gotags: json:"checkOutTime"
+	CheckOutTime  string `protobuf:"bytes,4,opt,name=checkOutTime,proto3" json:"checkOutTime"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FinishActivityRequest) Reset() {
-	*x = FinishActivityRequest{}
-	mi := &file_internal_api_activities_proto_msgTypes[27]
+func (x *ActivityBatchAttendanceRow) Reset() {
+	*x = ActivityBatchAttendanceRow{}
+	mi := &file_internal_api_activities_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FinishActivityRequest) String() string {
+func (x *ActivityBatchAttendanceRow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinishActivityRequest) ProtoMessage() {}
+func (*ActivityBatchAttendanceRow) ProtoMessage() {}
 
-func (x *FinishActivityRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[27]
+func (x *ActivityBatchAttendanceRow) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2082,42 +4716,67 @@ func (x *FinishActivityRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinishActivityRequest.ProtoReflect.Descriptor instead.
-func (*FinishActivityRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ActivityBatchAttendanceRow.ProtoReflect.Descriptor instead.
+func (*ActivityBatchAttendanceRow) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *FinishActivityRequest) GetId() int64 {
+func (x *ActivityBatchAttendanceRow) GetVolunteerId() int64 {
 	if x != nil {
-		return x.Id
+		return x.VolunteerId
 	}
 	return 0
 }
 
-// FinishActivityResponse 完结活动响应
-type FinishActivityResponse struct {
+func (x *ActivityBatchAttendanceRow) GetSignupId() int64 {
+	if x != nil {
+		return x.SignupId
+	}
+	return 0
+}
+
+func (x *ActivityBatchAttendanceRow) GetCheckInTime() string {
+	if x != nil {
+		return x.CheckInTime
+	}
+	return ""
+}
+
+func (x *ActivityBatchAttendanceRow) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
+}
+
+// ActivityBatchAttendanceRequest 批量补录签到签退请求
+type ActivityBatchAttendanceRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 消息
-	Message       string `protobuf:"bytes,1,opt,name=message,proto3" json:"message"`
+	// 活动ID 必填 @gotags: json:"activityId,required"
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
+	// 补录名单 必填，单次最多 200 行 @gotags: json:"rows,required"
+	Rows []*ActivityBatchAttendanceRow `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,required"`
+	// 补录原因 可选 @gotags: json:"reason"
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FinishActivityResponse) Reset() {
-	*x = FinishActivityResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[28]
+func (x *ActivityBatchAttendanceRequest) Reset() {
+	*x = ActivityBatchAttendanceRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FinishActivityResponse) String() string {
+func (x *ActivityBatchAttendanceRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FinishActivityResponse) ProtoMessage() {}
+func (*ActivityBatchAttendanceRequest) ProtoMessage() {}
 
-func (x *FinishActivityResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[28]
+func (x *ActivityBatchAttendanceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2128,46 +4787,70 @@ func (x *FinishActivityResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FinishActivityResponse.ProtoReflect.Descriptor instead.
-func (*FinishActivityResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ActivityBatchAttendanceRequest.ProtoReflect.Descriptor instead.
+func (*ActivityBatchAttendanceRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *FinishActivityResponse) GetMessage() string {
+func (x *ActivityBatchAttendanceRequest) GetActivityId() int64 {
 	if x != nil {
-		return x.Message
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *ActivityBatchAttendanceRequest) GetRows() []*ActivityBatchAttendanceRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ActivityBatchAttendanceRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-// GenerateAttendanceCodesRequest 生成签到码/签退码请求
-type GenerateAttendanceCodesRequest struct {
+// ActivityBatchAttendanceResult 单行处理结果
+type ActivityBatchAttendanceResult struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 活动ID 必填 @gotags: path:"id,required"
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
-	// 签到码有效时长（分钟）可选，<=0 表示不过期 @gotags: json:"checkInValidMinutes"
-	CheckInValidMinutes int32 `protobuf:"varint,2,opt,name=checkInValidMinutes,proto3" json:"checkInValidMinutes"`
-	// 签退码有效时长（分钟）可选，<=0 表示不过期 @gotags: json:"checkOutValidMinutes"
-	CheckOutValidMinutes int32 `protobuf:"varint,3,opt,name=checkOutValidMinutes,proto3" json:"checkOutValidMinutes"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+	// 行号（从0开始，与请求 rows 顺序一致）
+	RowIndex int32 `protobuf:"varint,1,opt,name=rowIndex,proto3" json:"rowIndex"`
+	// 志愿者ID
+	VolunteerId int64 `protobuf:"varint,2,opt,name=volunteerId,proto3" json:"volunteerId"`
+	// 报名记录ID
+	SignupId int64 `protobuf:"varint,3,opt,name=signupId,proto3" json:"signupId"`
+	// 处理状态（1-成功，2-已结算，3-未报名，4-时间无效，5-处理失败，6-已签到未签退）
+	Status int32 `protobuf:"varint,4,opt,name=status,proto3" json:"status"`
+	// 处理后的签到时间
+	CheckInTime string `protobuf:"bytes,5,opt,name=checkInTime,proto3" json:"checkInTime"`
+	// 处理后的签退时间
+	CheckOutTime string `protobuf:"bytes,6,opt,name=checkOutTime,proto3" json:"checkOutTime"`
+	// 本次（或已）发放工时
+	GrantedHours float64 `protobuf:"fixed64,7,opt,name=grantedHours,proto3" json:"grantedHours"`
+	// 失败原因
+	Message       string `protobuf:"bytes,8,opt,name=message,proto3" json:"message"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GenerateAttendanceCodesRequest) Reset() {
-	*x = GenerateAttendanceCodesRequest{}
-	mi := &file_internal_api_activities_proto_msgTypes[29]
+func (x *ActivityBatchAttendanceResult) Reset() {
+	*x = ActivityBatchAttendanceResult{}
+	mi := &file_internal_api_activities_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GenerateAttendanceCodesRequest) String() string {
+func (x *ActivityBatchAttendanceResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GenerateAttendanceCodesRequest) ProtoMessage() {}
+func (*ActivityBatchAttendanceResult) ProtoMessage() {}
 
-func (x *GenerateAttendanceCodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[29]
+func (x *ActivityBatchAttendanceResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2178,160 +4861,155 @@ func (x *GenerateAttendanceCodesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GenerateAttendanceCodesRequest.ProtoReflect.Descriptor instead.
-func (*GenerateAttendanceCodesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ActivityBatchAttendanceResult.ProtoReflect.Descriptor instead.
+func (*ActivityBatchAttendanceResult) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *GenerateAttendanceCodesRequest) GetId() int64 {
+func (x *ActivityBatchAttendanceResult) GetRowIndex() int32 {
 	if x != nil {
-		return x.Id
+		return x.RowIndex
 	}
 	return 0
 }
 
-func (x *GenerateAttendanceCodesRequest) GetCheckInValidMinutes() int32 {
+func (x *ActivityBatchAttendanceResult) GetVolunteerId() int64 {
 	if x != nil {
-		return x.CheckInValidMinutes
+		return x.VolunteerId
 	}
 	return 0
 }
 
-func (x *GenerateAttendanceCodesRequest) GetCheckOutValidMinutes() int32 {
+func (x *ActivityBatchAttendanceResult) GetSignupId() int64 {
 	if x != nil {
-		return x.CheckOutValidMinutes
+		return x.SignupId
 	}
 	return 0
 }
 
-// GenerateAttendanceCodesResponse 生成签到码/签退码响应
-type GenerateAttendanceCodesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否成功
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	// 本次生成的签到码
-	CheckInCode string `protobuf:"bytes,2,opt,name=checkInCode,proto3" json:"checkInCode"`
-	// 本次生成的签退码
-	CheckOutCode string `protobuf:"bytes,3,opt,name=checkOutCode,proto3" json:"checkOutCode"`
-	// 当前码版本号
-	AttendanceCodeVersion int64 `protobuf:"varint,4,opt,name=attendanceCodeVersion,proto3" json:"attendanceCodeVersion"`
-	// 码更新时间
-	AttendanceCodeUpdatedAt string `protobuf:"bytes,5,opt,name=attendanceCodeUpdatedAt,proto3" json:"attendanceCodeUpdatedAt"`
-	// 签到码过期时间（为空表示不过期）
-	CheckInExpireAt string `protobuf:"bytes,6,opt,name=checkInExpireAt,proto3" json:"checkInExpireAt"`
-	// 签退码过期时间（为空表示不过期）
-	CheckOutExpireAt string `protobuf:"bytes,7,opt,name=checkOutExpireAt,proto3" json:"checkOutExpireAt"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *GenerateAttendanceCodesResponse) Reset() {
-	*x = GenerateAttendanceCodesResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[30]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GenerateAttendanceCodesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ActivityBatchAttendanceResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
-func (*GenerateAttendanceCodesResponse) ProtoMessage() {}
-
-func (x *GenerateAttendanceCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[30]
+func (x *ActivityBatchAttendanceResult) GetCheckInTime() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CheckInTime
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use GenerateAttendanceCodesResponse.ProtoReflect.Descriptor instead.
-func (*GenerateAttendanceCodesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{30}
+func (x *ActivityBatchAttendanceResult) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
+	}
+	return ""
 }
 
-func (x *GenerateAttendanceCodesResponse) GetSuccess() bool {
+func (x *ActivityBatchAttendanceResult) GetGrantedHours() float64 {
 	if x != nil {
-		return x.Success
+		return x.GrantedHours
 	}
-	return false
+	return 0
 }
 
-func (x *GenerateAttendanceCodesResponse) GetCheckInCode() string {
+func (x *ActivityBatchAttendanceResult) GetMessage() string {
 	if x != nil {
-		return x.CheckInCode
+		return x.Message
 	}
 	return ""
 }
 
-func (x *GenerateAttendanceCodesResponse) GetCheckOutCode() string {
+// ActivityBatchAttendanceResponse 批量补录签到签退响应
+type ActivityBatchAttendanceResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 成功行数（含已结算、已签到未签退）
+	SuccessCount int32 `protobuf:"varint,1,opt,name=successCount,proto3" json:"successCount"`
+	// 失败行数
+	FailedCount int32 `protobuf:"varint,2,opt,name=failedCount,proto3" json:"failedCount"`
+	// 逐行结果
+	Results       []*ActivityBatchAttendanceResult `protobuf:"bytes,3,rep,name=results,proto3" json:"results"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ActivityBatchAttendanceResponse) Reset() {
+	*x = ActivityBatchAttendanceResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ActivityBatchAttendanceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ActivityBatchAttendanceResponse) ProtoMessage() {}
+
+func (x *ActivityBatchAttendanceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[61]
 	if x != nil {
-		return x.CheckOutCode
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GenerateAttendanceCodesResponse) GetAttendanceCodeVersion() int64 {
-	if x != nil {
-		return x.AttendanceCodeVersion
-	}
-	return 0
+// Deprecated: Use ActivityBatchAttendanceResponse.ProtoReflect.Descriptor instead.
+func (*ActivityBatchAttendanceResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *GenerateAttendanceCodesResponse) GetAttendanceCodeUpdatedAt() string {
+func (x *ActivityBatchAttendanceResponse) GetSuccessCount() int32 {
 	if x != nil {
-		return x.AttendanceCodeUpdatedAt
+		return x.SuccessCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateAttendanceCodesResponse) GetCheckInExpireAt() string {
+func (x *ActivityBatchAttendanceResponse) GetFailedCount() int32 {
 	if x != nil {
-		return x.CheckInExpireAt
+		return x.FailedCount
 	}
-	return ""
+	return 0
 }
 
-func (x *GenerateAttendanceCodesResponse) GetCheckOutExpireAt() string {
+func (x *ActivityBatchAttendanceResponse) GetResults() []*ActivityBatchAttendanceResult {
 	if x != nil {
-		return x.CheckOutExpireAt
+		return x.Results
 	}
-	return ""
+	return nil
 }
 
-// ResetAttendanceCodeRequest 重置签到码/签退码请求
-type ResetAttendanceCodeRequest struct {
+// PendingSettlementListRequest 待确认结算列表请求
+type PendingSettlementListRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// 活动ID 必填 @gotags: path:"id,required"
-	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
-	// 重置码类型（1-签到码，2-签退码）必填 @gotags: json:"codeType,required"
-	CodeType int32 `protobuf:"varint,2,opt,name=codeType,proto3" json:"codeType,required"`
-	// 码有效时长（分钟）可选，<=0 表示不过期 @gotags: json:"validMinutes"
-	ValidMinutes  int32 `protobuf:"varint,3,opt,name=validMinutes,proto3" json:"validMinutes"`
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResetAttendanceCodeRequest) Reset() {
-	*x = ResetAttendanceCodeRequest{}
-	mi := &file_internal_api_activities_proto_msgTypes[31]
+func (x *PendingSettlementListRequest) Reset() {
+	*x = PendingSettlementListRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetAttendanceCodeRequest) String() string {
+func (x *PendingSettlementListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetAttendanceCodeRequest) ProtoMessage() {}
+func (*PendingSettlementListRequest) ProtoMessage() {}
 
-func (x *ResetAttendanceCodeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[31]
+func (x *PendingSettlementListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2342,66 +5020,52 @@ func (x *ResetAttendanceCodeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetAttendanceCodeRequest.ProtoReflect.Descriptor instead.
-func (*ResetAttendanceCodeRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use PendingSettlementListRequest.ProtoReflect.Descriptor instead.
+func (*PendingSettlementListRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *ResetAttendanceCodeRequest) GetId() int64 {
+func (x *PendingSettlementListRequest) GetId() int64 {
 	if x != nil {
 		return x.Id
 	}
 	return 0
 }
 
-func (x *ResetAttendanceCodeRequest) GetCodeType() int32 {
-	if x != nil {
-		return x.CodeType
-	}
-	return 0
-}
-
-func (x *ResetAttendanceCodeRequest) GetValidMinutes() int32 {
-	if x != nil {
-		return x.ValidMinutes
-	}
-	return 0
-}
-
-// ResetAttendanceCodeResponse 重置签到码/签退码响应
-type ResetAttendanceCodeResponse struct {
+// PendingSettlementItem 待确认结算的报名
+type PendingSettlementItem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否成功
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	// 重置码类型（1-签到码，2-签退码）
-	CodeType int32 `protobuf:"varint,2,opt,name=codeType,proto3" json:"codeType"`
-	// 本次重置后的码
-	Code string `protobuf:"bytes,3,opt,name=code,proto3" json:"code"`
-	// 本次重置后的码过期时间（为空表示不过期）
-	ExpireAt string `protobuf:"bytes,4,opt,name=expireAt,proto3" json:"expireAt"`
-	// 当前码版本号
-	AttendanceCodeVersion int64 `protobuf:"varint,5,opt,name=attendanceCodeVersion,proto3" json:"attendanceCodeVersion"`
-	// 码更新时间
-	AttendanceCodeUpdatedAt string `protobuf:"bytes,6,opt,name=attendanceCodeUpdatedAt,proto3" json:"attendanceCodeUpdatedAt"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// 报名记录ID
+	SignupId int64 `protobuf:"varint,1,opt,name=signupId,proto3" json:"signupId"`
+	// 志愿者ID
+	VolunteerId int64 `protobuf:"varint,2,opt,name=volunteerId,proto3" json:"volunteerId"`
+	// 志愿者姓名
+	VolunteerName string `protobuf:"bytes,3,opt,name=volunteerName,proto3" json:"volunteerName"`
+	// 班次岗位ID（0表示不区分班次）
+	SlotId int64 `protobuf:"varint,4,opt,name=slotId,proto3" json:"slotId"`
+	// 签到时间
+	CheckInTime string `protobuf:"bytes,5,opt,name=checkInTime,proto3" json:"checkInTime"`
+	// 签到时采集的位置（未采集为空）
+	CheckInPosition *AttendancePosition `protobuf:"bytes,6,opt,name=checkInPosition,proto3" json:"checkInPosition"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *ResetAttendanceCodeResponse) Reset() {
-	*x = ResetAttendanceCodeResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[32]
+func (x *PendingSettlementItem) Reset() {
+	*x = PendingSettlementItem{}
+	mi := &file_internal_api_activities_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResetAttendanceCodeResponse) String() string {
+func (x *PendingSettlementItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResetAttendanceCodeResponse) ProtoMessage() {}
+func (*PendingSettlementItem) ProtoMessage() {}
 
-func (x *ResetAttendanceCodeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[32]
+func (x *PendingSettlementItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2412,77 +5076,77 @@ func (x *ResetAttendanceCodeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResetAttendanceCodeResponse.ProtoReflect.Descriptor instead.
-func (*ResetAttendanceCodeResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use PendingSettlementItem.ProtoReflect.Descriptor instead.
+func (*PendingSettlementItem) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *ResetAttendanceCodeResponse) GetSuccess() bool {
+func (x *PendingSettlementItem) GetSignupId() int64 {
 	if x != nil {
-		return x.Success
+		return x.SignupId
 	}
-	return false
+	return 0
 }
 
-func (x *ResetAttendanceCodeResponse) GetCodeType() int32 {
+func (x *PendingSettlementItem) GetVolunteerId() int64 {
 	if x != nil {
-		return x.CodeType
+		return x.VolunteerId
 	}
 	return 0
 }
 
-func (x *ResetAttendanceCodeResponse) GetCode() string {
+func (x *PendingSettlementItem) GetVolunteerName() string {
 	if x != nil {
-		return x.Code
+		return x.VolunteerName
 	}
 	return ""
 }
 
-func (x *ResetAttendanceCodeResponse) GetExpireAt() string {
+func (x *PendingSettlementItem) GetSlotId() int64 {
 	if x != nil {
-		return x.ExpireAt
+		return x.SlotId
 	}
-	return ""
+	return 0
 }
 
-func (x *ResetAttendanceCodeResponse) GetAttendanceCodeVersion() int64 {
+func (x *PendingSettlementItem) GetCheckInTime() string {
 	if x != nil {
-		return x.AttendanceCodeVersion
+		return x.CheckInTime
 	}
-	return 0
+	return ""
 }
 
-func (x *ResetAttendanceCodeResponse) GetAttendanceCodeUpdatedAt() string {
+func (x *PendingSettlementItem) GetCheckInPosition() *AttendancePosition {
 	if x != nil {
-		return x.AttendanceCodeUpdatedAt
+		return x.CheckInPosition
 	}
-	return ""
+	return nil
 }
 
-// GetActivityAttendanceCodesRequest 查询活动签到码/签退码请求
-type GetActivityAttendanceCodesRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// 活动ID 必填 @gotags: path:"id,required"
-	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+// PendingSettlementListResponse 待确认结算列表响应
+type PendingSettlementListResponse struct {
+	state         protoimpl.MessageState   `protogen:"open.v1"`
+	Total         int32                    `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	List          []*PendingSettlementItem `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetActivityAttendanceCodesRequest) Reset() {
-	*x = GetActivityAttendanceCodesRequest{}
-	mi := &file_internal_api_activities_proto_msgTypes[33]
+func (x *PendingSettlementListResponse) Reset() {
+	*x = PendingSettlementListResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActivityAttendanceCodesRequest) String() string {
+func (x *PendingSettlementListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActivityAttendanceCodesRequest) ProtoMessage() {}
+func (*PendingSettlementListResponse) ProtoMessage() {}
 
-func (x *GetActivityAttendanceCodesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[33]
+func (x *PendingSettlementListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2493,54 +5157,57 @@ func (x *GetActivityAttendanceCodesRequest) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActivityAttendanceCodesRequest.ProtoReflect.Descriptor instead.
-func (*GetActivityAttendanceCodesRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use PendingSettlementListResponse.ProtoReflect.Descriptor instead.
+func (*PendingSettlementListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *GetActivityAttendanceCodesRequest) GetId() int64 {
+func (x *PendingSettlementListResponse) GetTotal() int32 {
 	if x != nil {
-		return x.Id
+		return x.Total
 	}
 	return 0
 }
 
-// GetActivityAttendanceCodesResponse 查询活动签到码/签退码响应
-type GetActivityAttendanceCodesResponse struct {
+func (x *PendingSettlementListResponse) GetList() []*PendingSettlementItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// ConfirmPendingSettlementRequest 确认待结算报名请求
+type ConfirmPendingSettlementRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 是否成功
-	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
-	// 当前签到码
-	CheckInCode string `protobuf:"bytes,2,opt,name=checkInCode,proto3" json:"checkInCode"`
-	// 当前签退码
-	CheckOutCode string `protobuf:"bytes,3,opt,name=checkOutCode,proto3" json:"checkOutCode"`
-	// 签到码过期时间（为空表示不过期）
-	CheckInExpireAt string `protobuf:"bytes,4,opt,name=checkInExpireAt,proto3" json:"checkInExpireAt"`
-	// 签退码过期时间（为空表示不过期）
-	CheckOutExpireAt string `protobuf:"bytes,5,opt,name=checkOutExpireAt,proto3" json:"checkOutExpireAt"`
-	// 当前码版本号
-	AttendanceCodeVersion int64 `protobuf:"varint,6,opt,name=attendanceCodeVersion,proto3" json:"attendanceCodeVersion"`
-	// 码更新时间
-	AttendanceCodeUpdatedAt string `protobuf:"bytes,7,opt,name=attendanceCodeUpdatedAt,proto3" json:"attendanceCodeUpdatedAt"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+	// 活动ID 必填 @gotags: json:"activityId,required"
+	ActivityId int64 `protobuf:"varint,1,opt,name=activityId,proto3" json:"activityId,required"`
+	// 报名记录ID 必填 @gotags: json:"signupId,required"
+	SignupId int64 `protobuf:"varint,2,opt,name=signupId,proto3" json:"signupId,required"`
+	// 处理方式（1-补录签退并发放，2-不发放）必填 @gotags: json:"action,required"
+	Action int32 `protobuf:"varint,3,opt,name=action,proto3" json:"action,required"`
+	// 签退时间（发放时必填）格式: 2006-01-02 15:04:05 @gotags: json:"checkOutTime"
+	CheckOutTime string `protobuf:"bytes,4,opt,name=checkOutTime,proto3" json:"checkOutTime"`
+	// 处理原因 可选 @gotags: json:"reason"
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetActivityAttendanceCodesResponse) Reset() {
-	*x = GetActivityAttendanceCodesResponse{}
-	mi := &file_internal_api_activities_proto_msgTypes[34]
+func (x *ConfirmPendingSettlementRequest) Reset() {
+	*x = ConfirmPendingSettlementRequest{}
+	mi := &file_internal_api_activities_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetActivityAttendanceCodesResponse) String() string {
+func (x *ConfirmPendingSettlementRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetActivityAttendanceCodesResponse) ProtoMessage() {}
+func (*ConfirmPendingSettlementRequest) ProtoMessage() {}
 
-func (x *GetActivityAttendanceCodesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_activities_proto_msgTypes[34]
+func (x *ConfirmPendingSettlementRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2551,60 +5218,110 @@ func (x *GetActivityAttendanceCodesResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetActivityAttendanceCodesResponse.ProtoReflect.Descriptor instead.
-func (*GetActivityAttendanceCodesResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_activities_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ConfirmPendingSettlementRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmPendingSettlementRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *GetActivityAttendanceCodesResponse) GetSuccess() bool {
+func (x *ConfirmPendingSettlementRequest) GetActivityId() int64 {
 	if x != nil {
-		return x.Success
+		return x.ActivityId
 	}
-	return false
+	return 0
 }
 
-func (x *GetActivityAttendanceCodesResponse) GetCheckInCode() string {
+func (x *ConfirmPendingSettlementRequest) GetSignupId() int64 {
 	if x != nil {
-		return x.CheckInCode
+		return x.SignupId
 	}
-	return ""
+	return 0
 }
 
-func (x *GetActivityAttendanceCodesResponse) GetCheckOutCode() string {
+func (x *ConfirmPendingSettlementRequest) GetAction() int32 {
 	if x != nil {
-		return x.CheckOutCode
+		return x.Action
 	}
-	return ""
+	return 0
 }
 
-func (x *GetActivityAttendanceCodesResponse) GetCheckInExpireAt() string {
+func (x *ConfirmPendingSettlementRequest) GetCheckOutTime() string {
 	if x != nil {
-		return x.CheckInExpireAt
+		return x.CheckOutTime
 	}
 	return ""
 }
 
-func (x *GetActivityAttendanceCodesResponse) GetCheckOutExpireAt() string {
+func (x *ConfirmPendingSettlementRequest) GetReason() string {
 	if x != nil {
-		return x.CheckOutExpireAt
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *GetActivityAttendanceCodesResponse) GetAttendanceCodeVersion() int64 {
+// ConfirmPendingSettlementResponse 确认待结算报名响应
+type ConfirmPendingSettlementResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否成功
+	Success bool `protobuf:"varint,1,opt,name=success,proto3" json:"success"`
+	// 签退时间（不发放时为空）
+	CheckOutTime string `protobuf:"bytes,2,opt,name=checkOutTime,proto3" json:"checkOutTime"`
+	// 发放工时
+	GrantedHours  float64 `protobuf:"fixed64,3,opt,name=grantedHours,proto3" json:"grantedHours"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmPendingSettlementResponse) Reset() {
+	*x = ConfirmPendingSettlementResponse{}
+	mi := &file_internal_api_activities_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmPendingSettlementResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmPendingSettlementResponse) ProtoMessage() {}
+
+func (x *ConfirmPendingSettlementResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_activities_proto_msgTypes[66]
 	if x != nil {
-		return x.AttendanceCodeVersion
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *GetActivityAttendanceCodesResponse) GetAttendanceCodeUpdatedAt() string {
+// Deprecated: Use ConfirmPendingSettlementResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmPendingSettlementResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_activities_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ConfirmPendingSettlementResponse) GetSuccess() bool {
 	if x != nil {
-		return x.AttendanceCodeUpdatedAt
+		return x.Success
+	}
+	return false
+}
+
+func (x *ConfirmPendingSettlementResponse) GetCheckOutTime() string {
+	if x != nil {
+		return x.CheckOutTime
 	}
 	return ""
 }
 
+func (x *ConfirmPendingSettlementResponse) GetGrantedHours() float64 {
+	if x != nil {
+		return x.GrantedHours
+	}
+	return 0
+}
+
 var File_internal_api_activities_proto protoreflect.FileDescriptor
 
 const file_internal_api_activities_proto_rawDesc = "" +
@@ -2616,7 +5333,7 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	"\x06status\x18\x03 \x01(\x05R\x06status\"X\n" +
 	"\x14ActivityListResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12*\n" +
-	"\x04list\x18\x02 \x03(\v2\x16.activity.ActivityItemR\x04list\"\xfa\x02\n" +
+	"\x04list\x18\x02 \x03(\v2\x16.activity.ActivityItemR\x04list\"\x96\x03\n" +
 	"\fActivityItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2631,36 +5348,72 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	" \x01(\x05R\rcurrentPeople\x12\x16\n" +
 	"\x06status\x18\v \x01(\x05R\x06status\x12\"\n" +
 	"\fisRegistered\x18\f \x01(\bR\fisRegistered\x12\x16\n" +
-	"\x06isFull\x18\r \x01(\bR\x06isFull\"7\n" +
+	"\x06isFull\x18\r \x01(\bR\x06isFull\x12\x1a\n" +
+	"\bseriesId\x18\x0e \x01(\x03R\bseriesId\"O\n" +
 	"\x15ActivitySignupRequest\x12\x1e\n" +
 	"\n" +
 	"activityId\x18\x01 \x01(\x03R\n" +
-	"activityId\"2\n" +
+	"activityId\x12\x16\n" +
+	"\x06slotId\x18\x02 \x01(\x03R\x06slotId\"~\n" +
 	"\x16ActivitySignupResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"7\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1e\n" +
+	"\n" +
+	"waitlisted\x18\x02 \x01(\bR\n" +
+	"waitlisted\x12*\n" +
+	"\x10waitlistPosition\x18\x03 \x01(\x05R\x10waitlistPosition\"7\n" +
 	"\x15ActivityCancelRequest\x12\x1e\n" +
 	"\n" +
 	"activityId\x18\x01 \x01(\x03R\n" +
 	"activityId\"2\n" +
 	"\x16ActivityCancelResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"Z\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"/\n" +
+	"\x1dActivityWaitlistStatusRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xb4\x01\n" +
+	"\x1eActivityWaitlistStatusResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x1a\n" +
+	"\bposition\x18\x02 \x01(\x05R\bposition\x12\"\n" +
+	"\fwaitingCount\x18\x03 \x01(\x05R\fwaitingCount\x12\x1a\n" +
+	"\bjoinedAt\x18\x04 \x01(\tR\bjoinedAt\x12\x1e\n" +
+	"\n" +
+	"promotedAt\x18\x05 \x01(\tR\n" +
+	"promotedAt\">\n" +
+	"\x1cActivityWaitlistLeaveRequest\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\x01 \x01(\x03R\n" +
+	"activityId\"9\n" +
+	"\x1dActivityWaitlistLeaveResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xae\x01\n" +
 	"\x16ActivityCheckInRequest\x12\x1e\n" +
 	"\n" +
 	"activityId\x18\x01 \x01(\x03R\n" +
 	"activityId\x12 \n" +
-	"\vcheckInCode\x18\x02 \x01(\tR\vcheckInCode\"U\n" +
+	"\vcheckInCode\x18\x02 \x01(\tR\vcheckInCode\x12\x18\n" +
+	"\aqrToken\x18\x03 \x01(\tR\aqrToken\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\"\x91\x01\n" +
 	"\x17ActivityCheckInResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12 \n" +
-	"\vcheckInTime\x18\x02 \x01(\tR\vcheckInTime\"]\n" +
+	"\vcheckInTime\x18\x02 \x01(\tR\vcheckInTime\x12\x1e\n" +
+	"\n" +
+	"outOfFence\x18\x03 \x01(\bR\n" +
+	"outOfFence\x12\x1a\n" +
+	"\bdistance\x18\x04 \x01(\x05R\bdistance\"\xb1\x01\n" +
 	"\x17ActivityCheckOutRequest\x12\x1e\n" +
 	"\n" +
 	"activityId\x18\x01 \x01(\x03R\n" +
 	"activityId\x12\"\n" +
-	"\fcheckOutCode\x18\x02 \x01(\tR\fcheckOutCode\"|\n" +
+	"\fcheckOutCode\x18\x02 \x01(\tR\fcheckOutCode\x12\x18\n" +
+	"\aqrToken\x18\x03 \x01(\tR\aqrToken\x12\x1a\n" +
+	"\blatitude\x18\x04 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x05 \x01(\x01R\tlongitude\"\xb8\x01\n" +
 	"\x18ActivityCheckOutResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\"\n" +
 	"\fcheckOutTime\x18\x02 \x01(\tR\fcheckOutTime\x12\"\n" +
-	"\fgrantedHours\x18\x03 \x01(\x01R\fgrantedHours\"\xc5\x01\n" +
+	"\fgrantedHours\x18\x03 \x01(\x01R\fgrantedHours\x12\x1e\n" +
+	"\n" +
+	"outOfFence\x18\x04 \x01(\bR\n" +
+	"outOfFence\x12\x1a\n" +
+	"\bdistance\x18\x05 \x01(\x05R\bdistance\"\xc5\x01\n" +
 	"#ActivitySupplementAttendanceRequest\x12\x1e\n" +
 	"\n" +
 	"activityId\x18\x01 \x01(\x03R\n" +
@@ -2668,16 +5421,25 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	"\vvolunteerId\x18\x02 \x01(\x03R\vvolunteerId\x12 \n" +
 	"\vcheckInTime\x18\x03 \x01(\tR\vcheckInTime\x12\"\n" +
 	"\fcheckOutTime\x18\x04 \x01(\tR\fcheckOutTime\x12\x16\n" +
-	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xaa\x01\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\xbc\x02\n" +
 	"$ActivitySupplementAttendanceResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12 \n" +
 	"\vcheckInTime\x18\x02 \x01(\tR\vcheckInTime\x12\"\n" +
 	"\fcheckOutTime\x18\x03 \x01(\tR\fcheckOutTime\x12\"\n" +
-	"\fgrantedHours\x18\x04 \x01(\x01R\fgrantedHours\"'\n" +
+	"\fgrantedHours\x18\x04 \x01(\x01R\fgrantedHours\x12F\n" +
+	"\x0fcheckInPosition\x18\x05 \x01(\v2\x1c.activity.AttendancePositionR\x0fcheckInPosition\x12H\n" +
+	"\x10checkOutPosition\x18\x06 \x01(\v2\x1c.activity.AttendancePositionR\x10checkOutPosition\"\x8a\x01\n" +
+	"\x12AttendancePosition\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\x12\x1a\n" +
+	"\bdistance\x18\x03 \x01(\x05R\bdistance\x12\x1e\n" +
+	"\n" +
+	"outOfFence\x18\x04 \x01(\bR\n" +
+	"outOfFence\"'\n" +
 	"\x15ActivityDetailRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"L\n" +
 	"\x16ActivityDetailResponse\x122\n" +
-	"\bactivity\x18\x01 \x01(\v2\x16.activity.ActivityInfoR\bactivity\"\xaa\x05\n" +
+	"\bactivity\x18\x01 \x01(\v2\x16.activity.ActivityInfoR\bactivity\"\xfe\x06\n" +
 	"\fActivityInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05orgId\x18\x02 \x01(\x03R\x05orgId\x12\x18\n" +
@@ -2701,14 +5463,20 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	"\x0echeckOutStatus\x18\x13 \x01(\x05R\x0echeckOutStatus\x12\"\n" +
 	"\fcheckOutTime\x18\x14 \x01(\tR\fcheckOutTime\x12&\n" +
 	"\x0eworkHourStatus\x18\x15 \x01(\x05R\x0eworkHourStatus\x12\"\n" +
-	"\fgrantedHours\x18\x16 \x01(\x01R\fgrantedHours\"]\n" +
+	"\fgrantedHours\x18\x16 \x01(\x01R\fgrantedHours\x12\x1a\n" +
+	"\bseriesId\x18\x17 \x01(\x03R\bseriesId\x12\x1a\n" +
+	"\blatitude\x18\x18 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x19 \x01(\x01R\tlongitude\x12&\n" +
+	"\x0egeofenceRadius\x18\x1a \x01(\x05R\x0egeofenceRadius\x12*\n" +
+	"\x10autoSettlePolicy\x18\x1b \x01(\x05R\x10autoSettlePolicy\x12(\n" +
+	"\x0frequireVerified\x18\x1c \x01(\bR\x0frequireVerified\"]\n" +
 	"\x13MyActivitiesRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1a\n" +
 	"\bpageSize\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
 	"\x06status\x18\x03 \x01(\x05R\x06status\"Z\n" +
 	"\x14MyActivitiesResponse\x12\x14\n" +
 	"\x05total\x18\x01 \x01(\x05R\x05total\x12,\n" +
-	"\x04list\x18\x02 \x03(\v2\x18.activity.MyActivityItemR\x04list\"\xf0\x04\n" +
+	"\x04list\x18\x02 \x03(\v2\x18.activity.MyActivityItemR\x04list\"\x88\x05\n" +
 	"\x0eMyActivityItem\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05orgId\x18\x02 \x01(\x03R\x05orgId\x12\x18\n" +
@@ -2732,7 +5500,8 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	"\x0echeckOutStatus\x18\x11 \x01(\x05R\x0echeckOutStatus\x12\"\n" +
 	"\fcheckOutTime\x18\x12 \x01(\tR\fcheckOutTime\x12&\n" +
 	"\x0eworkHourStatus\x18\x13 \x01(\x05R\x0eworkHourStatus\x12\"\n" +
-	"\fgrantedHours\x18\x14 \x01(\x01R\fgrantedHours\"\xa9\x02\n" +
+	"\fgrantedHours\x18\x14 \x01(\x01R\fgrantedHours\x12\x16\n" +
+	"\x06slotId\x18\x15 \x01(\x03R\x06slotId\"\x85\x04\n" +
 	"\x15CreateActivityRequest\x12\x14\n" +
 	"\x05orgId\x18\x01 \x01(\x03R\x05orgId\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2744,10 +5513,16 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	"\aaddress\x18\b \x01(\tR\aaddress\x12\x1a\n" +
 	"\bduration\x18\t \x01(\x01R\bduration\x12\x1c\n" +
 	"\tmaxPeople\x18\n" +
-	" \x01(\x05R\tmaxPeople\"B\n" +
+	" \x01(\x05R\tmaxPeople\x12\x1a\n" +
+	"\blatitude\x18\v \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\f \x01(\x01R\tlongitude\x12&\n" +
+	"\x0egeofenceRadius\x18\r \x01(\x05R\x0egeofenceRadius\x12\"\n" +
+	"\fgeofenceMode\x18\x0e \x01(\x05R\fgeofenceMode\x12*\n" +
+	"\x10autoSettlePolicy\x18\x0f \x01(\x05R\x10autoSettlePolicy\x12(\n" +
+	"\x0frequireVerified\x18\x10 \x01(\bR\x0frequireVerified\"B\n" +
 	"\x16CreateActivityResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x95\x04\n" +
 	"\x15UpdateActivityRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
 	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
@@ -2759,26 +5534,129 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	"\aaddress\x18\b \x01(\tR\aaddress\x12\x1a\n" +
 	"\bduration\x18\t \x01(\x01R\bduration\x12\x1c\n" +
 	"\tmaxPeople\x18\n" +
-	" \x01(\x05R\tmaxPeople\"2\n" +
+	" \x01(\x05R\tmaxPeople\x12\x14\n" +
+	"\x05scope\x18\v \x01(\x05R\x05scope\x12\x1a\n" +
+	"\blatitude\x18\f \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\r \x01(\x01R\tlongitude\x12&\n" +
+	"\x0egeofenceRadius\x18\x0e \x01(\x05R\x0egeofenceRadius\x12\"\n" +
+	"\fgeofenceMode\x18\x0f \x01(\x05R\fgeofenceMode\x12*\n" +
+	"\x10autoSettlePolicy\x18\x10 \x01(\x05R\x10autoSettlePolicy\x12(\n" +
+	"\x0frequireVerified\x18\x11 \x01(\x05R\x0frequireVerified\"2\n" +
 	"\x16UpdateActivityResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"'\n" +
 	"\x15DeleteActivityRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
 	"\x16DeleteActivityResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"?\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"U\n" +
 	"\x15CancelActivityRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"2\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05scope\x18\x03 \x01(\x05R\x05scope\"2\n" +
 	"\x16CancelActivityResponse\x12\x18\n" +
 	"\amessage\x18\x01 \x01(\tR\amessage\"'\n" +
 	"\x15FinishActivityRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"2\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xca\x01\n" +
 	"\x16FinishActivityResponse\x12\x18\n" +
-	"\amessage\x18\x01 \x01(\tR\amessage\"\x96\x01\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\x12$\n" +
+	"\rattendedCount\x18\x02 \x01(\x05R\rattendedCount\x12 \n" +
+	"\vnoShowCount\x18\x03 \x01(\x05R\vnoShowCount\x12*\n" +
+	"\x10autoSettledCount\x18\x04 \x01(\x05R\x10autoSettledCount\x12\"\n" +
+	"\fflaggedCount\x18\x05 \x01(\x05R\fflaggedCount\"\xfd\x02\n" +
+	"\x1bCreateActivitySeriesRequest\x12\x14\n" +
+	"\x05orgId\x18\x01 \x01(\x03R\x05orgId\x12\x14\n" +
+	"\x05title\x18\x02 \x01(\tR\x05title\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1a\n" +
+	"\bcoverUrl\x18\x04 \x01(\tR\bcoverUrl\x12\x1c\n" +
+	"\tstartTime\x18\x05 \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x06 \x01(\tR\aendTime\x12\x1a\n" +
+	"\blocation\x18\a \x01(\tR\blocation\x12\x18\n" +
+	"\aaddress\x18\b \x01(\tR\aaddress\x12\x1a\n" +
+	"\bduration\x18\t \x01(\x01R\bduration\x12\x1c\n" +
+	"\tmaxPeople\x18\n" +
+	" \x01(\x05R\tmaxPeople\x12\x14\n" +
+	"\x05rrule\x18\v \x01(\tR\x05rrule\x12\x1c\n" +
+	"\tuntilDate\x18\f \x01(\tR\tuntilDate\x12\x18\n" +
+	"\aexdates\x18\r \x03(\tR\aexdates\"v\n" +
+	"\x1cCreateActivitySeriesResponse\x12\x1a\n" +
+	"\bseriesId\x18\x01 \x01(\x03R\bseriesId\x12 \n" +
+	"\vactivityIds\x18\x02 \x03(\x03R\vactivityIds\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"-\n" +
+	"\x1bActivitySeriesDetailRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xfa\x01\n" +
+	"\x1cActivitySeriesDetailResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x14\n" +
+	"\x05orgId\x18\x02 \x01(\x03R\x05orgId\x12\x14\n" +
+	"\x05title\x18\x03 \x01(\tR\x05title\x12\x14\n" +
+	"\x05rrule\x18\x04 \x01(\tR\x05rrule\x12\x1c\n" +
+	"\tuntilDate\x18\x05 \x01(\tR\tuntilDate\x12\x18\n" +
+	"\aexdates\x18\x06 \x03(\tR\aexdates\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x128\n" +
+	"\voccurrences\x18\b \x03(\v2\x16.activity.ActivityItemR\voccurrences\"9\n" +
+	"\x1bActivitySeriesSignupRequest\x12\x1a\n" +
+	"\bseriesId\x18\x01 \x01(\x03R\bseriesId\"\x82\x01\n" +
+	"\x1cActivitySeriesSignupResponse\x12\"\n" +
+	"\fsuccessCount\x18\x01 \x01(\x05R\fsuccessCount\x12>\n" +
+	"\aresults\x18\x02 \x03(\v2$.activity.ActivitySeriesSignupResultR\aresults\"\xda\x01\n" +
+	"\x1aActivitySeriesSignupResult\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1c\n" +
+	"\tstartTime\x18\x02 \x01(\tR\tstartTime\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x1e\n" +
+	"\n" +
+	"waitlisted\x18\x04 \x01(\bR\n" +
+	"waitlisted\x12*\n" +
+	"\x10waitlistPosition\x18\x05 \x01(\x05R\x10waitlistPosition\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"\x96\x02\n" +
+	"\x10ActivitySlotItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\x02 \x01(\x03R\n" +
+	"activityId\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x1a\n" +
+	"\broleName\x18\x04 \x01(\tR\broleName\x12\x1c\n" +
+	"\tstartTime\x18\x05 \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x06 \x01(\tR\aendTime\x12\x1c\n" +
+	"\tmaxPeople\x18\a \x01(\x05R\tmaxPeople\x12$\n" +
+	"\rcurrentPeople\x18\b \x01(\x05R\rcurrentPeople\x12&\n" +
+	"\x0erequiredSkills\x18\t \x03(\tR\x0erequiredSkills\")\n" +
+	"\x17ActivitySlotListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"J\n" +
+	"\x18ActivitySlotListResponse\x12.\n" +
+	"\x04list\x18\x01 \x03(\v2\x1a.activity.ActivitySlotItemR\x04list\"\xe9\x01\n" +
+	"\x19CreateActivitySlotRequest\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\broleName\x18\x03 \x01(\tR\broleName\x12\x1c\n" +
+	"\tstartTime\x18\x04 \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x05 \x01(\tR\aendTime\x12\x1c\n" +
+	"\tmaxPeople\x18\x06 \x01(\x05R\tmaxPeople\x12&\n" +
+	"\x0erequiredSkills\x18\a \x03(\tR\x0erequiredSkills\"F\n" +
+	"\x1aCreateActivitySlotResponse\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xd9\x01\n" +
+	"\x19UpdateActivitySlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\broleName\x18\x03 \x01(\tR\broleName\x12\x1c\n" +
+	"\tstartTime\x18\x04 \x01(\tR\tstartTime\x12\x18\n" +
+	"\aendTime\x18\x05 \x01(\tR\aendTime\x12\x1c\n" +
+	"\tmaxPeople\x18\x06 \x01(\x05R\tmaxPeople\x12&\n" +
+	"\x0erequiredSkills\x18\a \x03(\tR\x0erequiredSkills\"6\n" +
+	"\x1aUpdateActivitySlotResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"+\n" +
+	"\x19DeleteActivitySlotRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"6\n" +
+	"\x1aDeleteActivitySlotResponse\x12\x18\n" +
+	"\amessage\x18\x01 \x01(\tR\amessage\"\xdc\x01\n" +
 	"\x1eGenerateAttendanceCodesRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x120\n" +
 	"\x13checkInValidMinutes\x18\x02 \x01(\x05R\x13checkInValidMinutes\x122\n" +
-	"\x14checkOutValidMinutes\x18\x03 \x01(\x05R\x14checkOutValidMinutes\"\xc7\x02\n" +
+	"\x14checkOutValidMinutes\x18\x03 \x01(\x05R\x14checkOutValidMinutes\x12\x1a\n" +
+	"\bcodeMode\x18\x04 \x01(\x05R\bcodeMode\x12(\n" +
+	"\x0frotationSeconds\x18\x05 \x01(\x05R\x0frotationSeconds\"\xb1\x03\n" +
 	"\x1fGenerateAttendanceCodesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12 \n" +
 	"\vcheckInCode\x18\x02 \x01(\tR\vcheckInCode\x12\"\n" +
@@ -2786,20 +5664,26 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	"\x15attendanceCodeVersion\x18\x04 \x01(\x03R\x15attendanceCodeVersion\x128\n" +
 	"\x17attendanceCodeUpdatedAt\x18\x05 \x01(\tR\x17attendanceCodeUpdatedAt\x12(\n" +
 	"\x0fcheckInExpireAt\x18\x06 \x01(\tR\x0fcheckInExpireAt\x12*\n" +
-	"\x10checkOutExpireAt\x18\a \x01(\tR\x10checkOutExpireAt\"l\n" +
+	"\x10checkOutExpireAt\x18\a \x01(\tR\x10checkOutExpireAt\x12\x1a\n" +
+	"\bcodeMode\x18\b \x01(\x05R\bcodeMode\x12(\n" +
+	"\x0frotationSeconds\x18\t \x01(\x05R\x0frotationSeconds\x12\"\n" +
+	"\fnextRotateAt\x18\n" +
+	" \x01(\tR\fnextRotateAt\"l\n" +
 	"\x1aResetAttendanceCodeRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
 	"\bcodeType\x18\x02 \x01(\x05R\bcodeType\x12\"\n" +
-	"\fvalidMinutes\x18\x03 \x01(\x05R\fvalidMinutes\"\xf3\x01\n" +
+	"\fvalidMinutes\x18\x03 \x01(\x05R\fvalidMinutes\"\xb3\x02\n" +
 	"\x1bResetAttendanceCodeResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1a\n" +
 	"\bcodeType\x18\x02 \x01(\x05R\bcodeType\x12\x12\n" +
 	"\x04code\x18\x03 \x01(\tR\x04code\x12\x1a\n" +
 	"\bexpireAt\x18\x04 \x01(\tR\bexpireAt\x124\n" +
 	"\x15attendanceCodeVersion\x18\x05 \x01(\x03R\x15attendanceCodeVersion\x128\n" +
-	"\x17attendanceCodeUpdatedAt\x18\x06 \x01(\tR\x17attendanceCodeUpdatedAt\"3\n" +
+	"\x17attendanceCodeUpdatedAt\x18\x06 \x01(\tR\x17attendanceCodeUpdatedAt\x12\x1a\n" +
+	"\bcodeMode\x18\a \x01(\x05R\bcodeMode\x12\"\n" +
+	"\fnextRotateAt\x18\b \x01(\tR\fnextRotateAt\"3\n" +
 	"!GetActivityAttendanceCodesRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\xca\x02\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xb4\x03\n" +
 	"\"GetActivityAttendanceCodesResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12 \n" +
 	"\vcheckInCode\x18\x02 \x01(\tR\vcheckInCode\x12\"\n" +
@@ -2807,11 +5691,76 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	"\x0fcheckInExpireAt\x18\x04 \x01(\tR\x0fcheckInExpireAt\x12*\n" +
 	"\x10checkOutExpireAt\x18\x05 \x01(\tR\x10checkOutExpireAt\x124\n" +
 	"\x15attendanceCodeVersion\x18\x06 \x01(\x03R\x15attendanceCodeVersion\x128\n" +
-	"\x17attendanceCodeUpdatedAt\x18\a \x01(\tR\x17attendanceCodeUpdatedAt2\xcb\x10\n" +
+	"\x17attendanceCodeUpdatedAt\x18\a \x01(\tR\x17attendanceCodeUpdatedAt\x12\x1a\n" +
+	"\bcodeMode\x18\b \x01(\x05R\bcodeMode\x12(\n" +
+	"\x0frotationSeconds\x18\t \x01(\x05R\x0frotationSeconds\x12\"\n" +
+	"\fnextRotateAt\x18\n" +
+	" \x01(\tR\fnextRotateAt\"\x95\x01\n" +
+	"\x17AttendanceQRCodeRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bcodeType\x18\x02 \x01(\x05R\bcodeType\x12\x16\n" +
+	"\x06format\x18\x03 \x01(\tR\x06format\x12\"\n" +
+	"\fvalidSeconds\x18\x04 \x01(\x05R\fvalidSeconds\x12\x12\n" +
+	"\x04size\x18\x05 \x01(\x05R\x04size\"\xa4\x01\n" +
+	"\x18AttendanceQRCodeResponse\x12\x14\n" +
+	"\x05image\x18\x01 \x01(\fR\x05image\x12 \n" +
+	"\vcontentType\x18\x02 \x01(\tR\vcontentType\x12\x1a\n" +
+	"\bexpireAt\x18\x03 \x01(\tR\bexpireAt\x124\n" +
+	"\x15attendanceCodeVersion\x18\x04 \x01(\x03R\x15attendanceCodeVersion\"\xa0\x01\n" +
+	"\x1aActivityBatchAttendanceRow\x12 \n" +
+	"\vvolunteerId\x18\x01 \x01(\x03R\vvolunteerId\x12\x1a\n" +
+	"\bsignupId\x18\x02 \x01(\x03R\bsignupId\x12 \n" +
+	"\vcheckInTime\x18\x03 \x01(\tR\vcheckInTime\x12\"\n" +
+	"\fcheckOutTime\x18\x04 \x01(\tR\fcheckOutTime\"\x92\x01\n" +
+	"\x1eActivityBatchAttendanceRequest\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\x01 \x01(\x03R\n" +
+	"activityId\x128\n" +
+	"\x04rows\x18\x02 \x03(\v2$.activity.ActivityBatchAttendanceRowR\x04rows\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x95\x02\n" +
+	"\x1dActivityBatchAttendanceResult\x12\x1a\n" +
+	"\browIndex\x18\x01 \x01(\x05R\browIndex\x12 \n" +
+	"\vvolunteerId\x18\x02 \x01(\x03R\vvolunteerId\x12\x1a\n" +
+	"\bsignupId\x18\x03 \x01(\x03R\bsignupId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12 \n" +
+	"\vcheckInTime\x18\x05 \x01(\tR\vcheckInTime\x12\"\n" +
+	"\fcheckOutTime\x18\x06 \x01(\tR\fcheckOutTime\x12\"\n" +
+	"\fgrantedHours\x18\a \x01(\x01R\fgrantedHours\x12\x18\n" +
+	"\amessage\x18\b \x01(\tR\amessage\"\xaa\x01\n" +
+	"\x1fActivityBatchAttendanceResponse\x12\"\n" +
+	"\fsuccessCount\x18\x01 \x01(\x05R\fsuccessCount\x12 \n" +
+	"\vfailedCount\x18\x02 \x01(\x05R\vfailedCount\x12A\n" +
+	"\aresults\x18\x03 \x03(\v2'.activity.ActivityBatchAttendanceResultR\aresults\".\n" +
+	"\x1cPendingSettlementListRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"\xfd\x01\n" +
+	"\x15PendingSettlementItem\x12\x1a\n" +
+	"\bsignupId\x18\x01 \x01(\x03R\bsignupId\x12 \n" +
+	"\vvolunteerId\x18\x02 \x01(\x03R\vvolunteerId\x12$\n" +
+	"\rvolunteerName\x18\x03 \x01(\tR\rvolunteerName\x12\x16\n" +
+	"\x06slotId\x18\x04 \x01(\x03R\x06slotId\x12 \n" +
+	"\vcheckInTime\x18\x05 \x01(\tR\vcheckInTime\x12F\n" +
+	"\x0fcheckInPosition\x18\x06 \x01(\v2\x1c.activity.AttendancePositionR\x0fcheckInPosition\"j\n" +
+	"\x1dPendingSettlementListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x123\n" +
+	"\x04list\x18\x02 \x03(\v2\x1f.activity.PendingSettlementItemR\x04list\"\xb1\x01\n" +
+	"\x1fConfirmPendingSettlementRequest\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\x01 \x01(\x03R\n" +
+	"activityId\x12\x1a\n" +
+	"\bsignupId\x18\x02 \x01(\x03R\bsignupId\x12\x16\n" +
+	"\x06action\x18\x03 \x01(\x05R\x06action\x12\"\n" +
+	"\fcheckOutTime\x18\x04 \x01(\tR\fcheckOutTime\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x84\x01\n" +
+	" ConfirmPendingSettlementResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\"\n" +
+	"\fcheckOutTime\x18\x02 \x01(\tR\fcheckOutTime\x12\"\n" +
+	"\fgrantedHours\x18\x03 \x01(\x01R\fgrantedHours2\xc3\x1f\n" +
 	"\x0fActivityService\x12f\n" +
 	"\fActivityList\x12\x1d.activity.ActivityListRequest\x1a\x1e.activity.ActivityListResponse\"\x17\x82\xd3\xe4\x93\x02\x11\"\x0f/api/activities\x12v\n" +
 	"\x0eActivitySignup\x12\x1f.activity.ActivitySignupRequest\x1a .activity.ActivitySignupResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/activities/signup\x12v\n" +
-	"\x0eActivityCancel\x12\x1f.activity.ActivityCancelRequest\x1a .activity.ActivityCancelResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/activities/cancel\x12z\n" +
+	"\x0eActivityCancel\x12\x1f.activity.ActivityCancelRequest\x1a .activity.ActivityCancelResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/activities/cancel\x12\x91\x01\n" +
+	"\x16ActivityWaitlistStatus\x12'.activity.ActivityWaitlistStatusRequest\x1a(.activity.ActivityWaitlistStatusResponse\"$\x82\xd3\xe4\x93\x02\x1e\x12\x1c/api/activities/waitlist/:id\x12\x93\x01\n" +
+	"\x15ActivityWaitlistLeave\x12&.activity.ActivityWaitlistLeaveRequest\x1a'.activity.ActivityWaitlistLeaveResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/activities/waitlist/leave\x12z\n" +
 	"\x0fActivityCheckIn\x12 .activity.ActivityCheckInRequest\x1a!.activity.ActivityCheckInResponse\"\"\x82\xd3\xe4\x93\x02\x1c:\x01*\"\x17/api/activities/checkin\x12~\n" +
 	"\x10ActivityCheckOut\x12!.activity.ActivityCheckOutRequest\x1a\".activity.ActivityCheckOutResponse\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/api/activities/checkout\x12p\n" +
 	"\x0eActivityDetail\x12\x1f.activity.ActivityDetailRequest\x1a .activity.ActivityDetailResponse\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/api/activities/:id\x12i\n" +
@@ -2820,11 +5769,22 @@ const file_internal_api_activities_proto_rawDesc = "" +
 	"\x0eUpdateActivity\x12\x1f.activity.UpdateActivityRequest\x1a .activity.UpdateActivityResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/activities/:id\x12p\n" +
 	"\x0eDeleteActivity\x12\x1f.activity.DeleteActivityRequest\x1a .activity.DeleteActivityResponse\"\x1b\x82\xd3\xe4\x93\x02\x15*\x13/api/activities/:id\x12z\n" +
 	"\x0eCancelActivity\x12\x1f.activity.CancelActivityRequest\x1a .activity.CancelActivityResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/activities/cancel/:id\x12z\n" +
-	"\x0eFinishActivity\x12\x1f.activity.FinishActivityRequest\x1a .activity.FinishActivityResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/activities/finish/:id\x12\xa8\x01\n" +
+	"\x0eFinishActivity\x12\x1f.activity.FinishActivityRequest\x1a .activity.FinishActivityResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/activities/finish/:id\x12\x8f\x01\n" +
+	"\x14CreateActivitySeries\x12%.activity.CreateActivitySeriesRequest\x1a&.activity.CreateActivitySeriesResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/activities/series/create\x12\x89\x01\n" +
+	"\x14ActivitySeriesDetail\x12%.activity.ActivitySeriesDetailRequest\x1a&.activity.ActivitySeriesDetailResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/activities/series/:id\x12\x8f\x01\n" +
+	"\x14ActivitySeriesSignup\x12%.activity.ActivitySeriesSignupRequest\x1a&.activity.ActivitySeriesSignupResponse\"(\x82\xd3\xe4\x93\x02\":\x01*\"\x1d/api/activities/series/signup\x12|\n" +
+	"\x10ActivitySlotList\x12!.activity.ActivitySlotListRequest\x1a\".activity.ActivitySlotListResponse\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/api/activities/slots/:id\x12\x88\x01\n" +
+	"\x12CreateActivitySlot\x12#.activity.CreateActivitySlotRequest\x1a$.activity.CreateActivitySlotResponse\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/api/activities/slots/create\x12\x8c\x01\n" +
+	"\x12UpdateActivitySlot\x12#.activity.UpdateActivitySlotRequest\x1a$.activity.UpdateActivitySlotResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/activities/slots/update/:id\x12\x8c\x01\n" +
+	"\x12DeleteActivitySlot\x12#.activity.DeleteActivitySlotRequest\x1a$.activity.DeleteActivitySlotResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/activities/slots/delete/:id\x12\xa8\x01\n" +
 	"\x17GenerateAttendanceCodes\x12(.activity.GenerateAttendanceCodesRequest\x1a).activity.GenerateAttendanceCodesResponse\"8\x82\xd3\xe4\x93\x022:\x01*\"-/api/activities/attendance-codes/generate/:id\x12\x99\x01\n" +
 	"\x13ResetAttendanceCode\x12$.activity.ResetAttendanceCodeRequest\x1a%.activity.ResetAttendanceCodeResponse\"5\x82\xd3\xe4\x93\x02/:\x01*\"*/api/activities/attendance-codes/reset/:id\x12\xa5\x01\n" +
-	"\x1aGetActivityAttendanceCodes\x12+.activity.GetActivityAttendanceCodesRequest\x1a,.activity.GetActivityAttendanceCodesResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/activities/attendance-codes/:id\x12\xaf\x01\n" +
-	"\x1cActivitySupplementAttendance\x12-.activity.ActivitySupplementAttendanceRequest\x1a..activity.ActivitySupplementAttendanceResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/activities/supplement-attendance\x1a\x0f\xcaA\f0.0.0.0:8080B#Z!volunteer-system/internal/api;apib\x06proto3"
+	"\x1aGetActivityAttendanceCodes\x12+.activity.GetActivityAttendanceCodesRequest\x1a,.activity.GetActivityAttendanceCodesResponse\",\x82\xd3\xe4\x93\x02&\x12$/api/activities/attendance-codes/:id\x12\x8e\x01\n" +
+	"\x10AttendanceQRCode\x12!.activity.AttendanceQRCodeRequest\x1a\".activity.AttendanceQRCodeResponse\"3\x82\xd3\xe4\x93\x02-\x12+/api/activities/attendance-codes/qrcode/:id\x12\xaf\x01\n" +
+	"\x1cActivitySupplementAttendance\x12-.activity.ActivitySupplementAttendanceRequest\x1a..activity.ActivitySupplementAttendanceResponse\"0\x82\xd3\xe4\x93\x02*:\x01*\"%/api/activities/supplement-attendance\x12\x99\x01\n" +
+	"\x15PendingSettlementList\x12&.activity.PendingSettlementListRequest\x1a'.activity.PendingSettlementListResponse\"/\x82\xd3\xe4\x93\x02)\x12'/api/activities/pending-settlements/:id\x12\xa9\x01\n" +
+	"\x18ConfirmPendingSettlement\x12).activity.ConfirmPendingSettlementRequest\x1a*.activity.ConfirmPendingSettlementResponse\"6\x82\xd3\xe4\x93\x020:\x01*\"+/api/activities/pending-settlements/confirm\x12\x9b\x01\n" +
+	"\x17ActivityBatchAttendance\x12(.activity.ActivityBatchAttendanceRequest\x1a).activity.ActivityBatchAttendanceResponse\"+\x82\xd3\xe4\x93\x02%:\x01*\" /api/activities/batch-attendance\x1a\x0f\xcaA\f0.0.0.0:8080B#Z!volunteer-system/internal/api;apib\x06proto3"
 
 var (
 	file_internal_api_activities_proto_rawDescOnce sync.Once
@@ -2838,7 +5798,7 @@ func file_internal_api_activities_proto_rawDescGZIP() []byte {
 	return file_internal_api_activities_proto_rawDescData
 }
 
-var file_internal_api_activities_proto_msgTypes = make([]protoimpl.MessageInfo, 35)
+var file_internal_api_activities_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
 var file_internal_api_activities_proto_goTypes = []any{
 	(*ActivityListRequest)(nil),                  // 0: activity.ActivityListRequest
 	(*ActivityListResponse)(nil),                 // 1: activity.ActivityListResponse
@@ -2847,76 +5807,143 @@ var file_internal_api_activities_proto_goTypes = []any{
 	(*ActivitySignupResponse)(nil),               // 4: activity.ActivitySignupResponse
 	(*ActivityCancelRequest)(nil),                // 5: activity.ActivityCancelRequest
 	(*ActivityCancelResponse)(nil),               // 6: activity.ActivityCancelResponse
-	(*ActivityCheckInRequest)(nil),               // 7: activity.ActivityCheckInRequest
-	(*ActivityCheckInResponse)(nil),              // 8: activity.ActivityCheckInResponse
-	(*ActivityCheckOutRequest)(nil),              // 9: activity.ActivityCheckOutRequest
-	(*ActivityCheckOutResponse)(nil),             // 10: activity.ActivityCheckOutResponse
-	(*ActivitySupplementAttendanceRequest)(nil),  // 11: activity.ActivitySupplementAttendanceRequest
-	(*ActivitySupplementAttendanceResponse)(nil), // 12: activity.ActivitySupplementAttendanceResponse
-	(*ActivityDetailRequest)(nil),                // 13: activity.ActivityDetailRequest
-	(*ActivityDetailResponse)(nil),               // 14: activity.ActivityDetailResponse
-	(*ActivityInfo)(nil),                         // 15: activity.ActivityInfo
-	(*MyActivitiesRequest)(nil),                  // 16: activity.MyActivitiesRequest
-	(*MyActivitiesResponse)(nil),                 // 17: activity.MyActivitiesResponse
-	(*MyActivityItem)(nil),                       // 18: activity.MyActivityItem
-	(*CreateActivityRequest)(nil),                // 19: activity.CreateActivityRequest
-	(*CreateActivityResponse)(nil),               // 20: activity.CreateActivityResponse
-	(*UpdateActivityRequest)(nil),                // 21: activity.UpdateActivityRequest
-	(*UpdateActivityResponse)(nil),               // 22: activity.UpdateActivityResponse
-	(*DeleteActivityRequest)(nil),                // 23: activity.DeleteActivityRequest
-	(*DeleteActivityResponse)(nil),               // 24: activity.DeleteActivityResponse
-	(*CancelActivityRequest)(nil),                // 25: activity.CancelActivityRequest
-	(*CancelActivityResponse)(nil),               // 26: activity.CancelActivityResponse
-	(*FinishActivityRequest)(nil),                // 27: activity.FinishActivityRequest
-	(*FinishActivityResponse)(nil),               // 28: activity.FinishActivityResponse
-	(*GenerateAttendanceCodesRequest)(nil),       // 29: activity.GenerateAttendanceCodesRequest
-	(*GenerateAttendanceCodesResponse)(nil),      // 30: activity.GenerateAttendanceCodesResponse
-	(*ResetAttendanceCodeRequest)(nil),           // 31: activity.ResetAttendanceCodeRequest
-	(*ResetAttendanceCodeResponse)(nil),          // 32: activity.ResetAttendanceCodeResponse
-	(*GetActivityAttendanceCodesRequest)(nil),    // 33: activity.GetActivityAttendanceCodesRequest
-	(*GetActivityAttendanceCodesResponse)(nil),   // 34: activity.GetActivityAttendanceCodesResponse
+	(*ActivityWaitlistStatusRequest)(nil),        // 7: activity.ActivityWaitlistStatusRequest
+	(*ActivityWaitlistStatusResponse)(nil),       // 8: activity.ActivityWaitlistStatusResponse
+	(*ActivityWaitlistLeaveRequest)(nil),         // 9: activity.ActivityWaitlistLeaveRequest
+	(*ActivityWaitlistLeaveResponse)(nil),        // 10: activity.ActivityWaitlistLeaveResponse
+	(*ActivityCheckInRequest)(nil),               // 11: activity.ActivityCheckInRequest
+	(*ActivityCheckInResponse)(nil),              // 12: activity.ActivityCheckInResponse
+	(*ActivityCheckOutRequest)(nil),              // 13: activity.ActivityCheckOutRequest
+	(*ActivityCheckOutResponse)(nil),             // 14: activity.ActivityCheckOutResponse
+	(*ActivitySupplementAttendanceRequest)(nil),  // 15: activity.ActivitySupplementAttendanceRequest
+	(*ActivitySupplementAttendanceResponse)(nil), // 16: activity.ActivitySupplementAttendanceResponse
+	(*AttendancePosition)(nil),                   // 17: activity.AttendancePosition
+	(*ActivityDetailRequest)(nil),                // 18: activity.ActivityDetailRequest
+	(*ActivityDetailResponse)(nil),               // 19: activity.ActivityDetailResponse
+	(*ActivityInfo)(nil),                         // 20: activity.ActivityInfo
+	(*MyActivitiesRequest)(nil),                  // 21: activity.MyActivitiesRequest
+	(*MyActivitiesResponse)(nil),                 // 22: activity.MyActivitiesResponse
+	(*MyActivityItem)(nil),                       // 23: activity.MyActivityItem
+	(*CreateActivityRequest)(nil),                // 24: activity.CreateActivityRequest
+	(*CreateActivityResponse)(nil),               // 25: activity.CreateActivityResponse
+	(*UpdateActivityRequest)(nil),                // 26: activity.UpdateActivityRequest
+	(*UpdateActivityResponse)(nil),               // 27: activity.UpdateActivityResponse
+	(*DeleteActivityRequest)(nil),                // 28: activity.DeleteActivityRequest
+	(*DeleteActivityResponse)(nil),               // 29: activity.DeleteActivityResponse
+	(*CancelActivityRequest)(nil),                // 30: activity.CancelActivityRequest
+	(*CancelActivityResponse)(nil),               // 31: activity.CancelActivityResponse
+	(*FinishActivityRequest)(nil),                // 32: activity.FinishActivityRequest
+	(*FinishActivityResponse)(nil),               // 33: activity.FinishActivityResponse
+	(*CreateActivitySeriesRequest)(nil),          // 34: activity.CreateActivitySeriesRequest
+	(*CreateActivitySeriesResponse)(nil),         // 35: activity.CreateActivitySeriesResponse
+	(*ActivitySeriesDetailRequest)(nil),          // 36: activity.ActivitySeriesDetailRequest
+	(*ActivitySeriesDetailResponse)(nil),         // 37: activity.ActivitySeriesDetailResponse
+	(*ActivitySeriesSignupRequest)(nil),          // 38: activity.ActivitySeriesSignupRequest
+	(*ActivitySeriesSignupResponse)(nil),         // 39: activity.ActivitySeriesSignupResponse
+	(*ActivitySeriesSignupResult)(nil),           // 40: activity.ActivitySeriesSignupResult
+	(*ActivitySlotItem)(nil),                     // 41: activity.ActivitySlotItem
+	(*ActivitySlotListRequest)(nil),              // 42: activity.ActivitySlotListRequest
+	(*ActivitySlotListResponse)(nil),             // 43: activity.ActivitySlotListResponse
+	(*CreateActivitySlotRequest)(nil),            // 44: activity.CreateActivitySlotRequest
+	(*CreateActivitySlotResponse)(nil),           // 45: activity.CreateActivitySlotResponse
+	(*UpdateActivitySlotRequest)(nil),            // 46: activity.UpdateActivitySlotRequest
+	(*UpdateActivitySlotResponse)(nil),           // 47: activity.UpdateActivitySlotResponse
+	(*DeleteActivitySlotRequest)(nil),            // 48: activity.DeleteActivitySlotRequest
+	(*DeleteActivitySlotResponse)(nil),           // 49: activity.DeleteActivitySlotResponse
+	(*GenerateAttendanceCodesRequest)(nil),       // 50: activity.GenerateAttendanceCodesRequest
+	(*GenerateAttendanceCodesResponse)(nil),      // 51: activity.GenerateAttendanceCodesResponse
+	(*ResetAttendanceCodeRequest)(nil),           // 52: activity.ResetAttendanceCodeRequest
+	(*ResetAttendanceCodeResponse)(nil),          // 53: activity.ResetAttendanceCodeResponse
+	(*GetActivityAttendanceCodesRequest)(nil),    // 54: activity.GetActivityAttendanceCodesRequest
+	(*GetActivityAttendanceCodesResponse)(nil),   // 55: activity.GetActivityAttendanceCodesResponse
+	(*AttendanceQRCodeRequest)(nil),              // 56: activity.AttendanceQRCodeRequest
+	(*AttendanceQRCodeResponse)(nil),             // 57: activity.AttendanceQRCodeResponse
+	(*ActivityBatchAttendanceRow)(nil),           // 58: activity.ActivityBatchAttendanceRow
+	(*ActivityBatchAttendanceRequest)(nil),       // 59: activity.ActivityBatchAttendanceRequest
+	(*ActivityBatchAttendanceResult)(nil),        // 60: activity.ActivityBatchAttendanceResult
+	(*ActivityBatchAttendanceResponse)(nil),      // 61: activity.ActivityBatchAttendanceResponse
+	(*PendingSettlementListRequest)(nil),         // 62: activity.PendingSettlementListRequest
+	(*PendingSettlementItem)(nil),                // 63: activity.PendingSettlementItem
+	(*PendingSettlementListResponse)(nil),        // 64: activity.PendingSettlementListResponse
+	(*ConfirmPendingSettlementRequest)(nil),      // 65: activity.ConfirmPendingSettlementRequest
+	(*ConfirmPendingSettlementResponse)(nil),     // 66: activity.ConfirmPendingSettlementResponse
 }
 var file_internal_api_activities_proto_depIdxs = []int32{
 	2,  // 0: activity.ActivityListResponse.list:type_name -> activity.ActivityItem
-	15, // 1: activity.ActivityDetailResponse.activity:type_name -> activity.ActivityInfo
-	18, // 2: activity.MyActivitiesResponse.list:type_name -> activity.MyActivityItem
-	0,  // 3: activity.ActivityService.ActivityList:input_type -> activity.ActivityListRequest
-	3,  // 4: activity.ActivityService.ActivitySignup:input_type -> activity.ActivitySignupRequest
-	5,  // 5: activity.ActivityService.ActivityCancel:input_type -> activity.ActivityCancelRequest
-	7,  // 6: activity.ActivityService.ActivityCheckIn:input_type -> activity.ActivityCheckInRequest
-	9,  // 7: activity.ActivityService.ActivityCheckOut:input_type -> activity.ActivityCheckOutRequest
-	13, // 8: activity.ActivityService.ActivityDetail:input_type -> activity.ActivityDetailRequest
-	16, // 9: activity.ActivityService.MyActivities:input_type -> activity.MyActivitiesRequest
-	19, // 10: activity.ActivityService.CreateActivity:input_type -> activity.CreateActivityRequest
-	21, // 11: activity.ActivityService.UpdateActivity:input_type -> activity.UpdateActivityRequest
-	23, // 12: activity.ActivityService.DeleteActivity:input_type -> activity.DeleteActivityRequest
-	25, // 13: activity.ActivityService.CancelActivity:input_type -> activity.CancelActivityRequest
-	27, // 14: activity.ActivityService.FinishActivity:input_type -> activity.FinishActivityRequest
-	29, // 15: activity.ActivityService.GenerateAttendanceCodes:input_type -> activity.GenerateAttendanceCodesRequest
-	31, // 16: activity.ActivityService.ResetAttendanceCode:input_type -> activity.ResetAttendanceCodeRequest
-	33, // 17: activity.ActivityService.GetActivityAttendanceCodes:input_type -> activity.GetActivityAttendanceCodesRequest
-	11, // 18: activity.ActivityService.ActivitySupplementAttendance:input_type -> activity.ActivitySupplementAttendanceRequest
-	1,  // 19: activity.ActivityService.ActivityList:output_type -> activity.ActivityListResponse
-	4,  // 20: activity.ActivityService.ActivitySignup:output_type -> activity.ActivitySignupResponse
-	6,  // 21: activity.ActivityService.ActivityCancel:output_type -> activity.ActivityCancelResponse
-	8,  // 22: activity.ActivityService.ActivityCheckIn:output_type -> activity.ActivityCheckInResponse
-	10, // 23: activity.ActivityService.ActivityCheckOut:output_type -> activity.ActivityCheckOutResponse
-	14, // 24: activity.ActivityService.ActivityDetail:output_type -> activity.ActivityDetailResponse
-	17, // 25: activity.ActivityService.MyActivities:output_type -> activity.MyActivitiesResponse
-	20, // 26: activity.ActivityService.CreateActivity:output_type -> activity.CreateActivityResponse
-	22, // 27: activity.ActivityService.UpdateActivity:output_type -> activity.UpdateActivityResponse
-	24, // 28: activity.ActivityService.DeleteActivity:output_type -> activity.DeleteActivityResponse
-	26, // 29: activity.ActivityService.CancelActivity:output_type -> activity.CancelActivityResponse
-	28, // 30: activity.ActivityService.FinishActivity:output_type -> activity.FinishActivityResponse
-	30, // 31: activity.ActivityService.GenerateAttendanceCodes:output_type -> activity.GenerateAttendanceCodesResponse
-	32, // 32: activity.ActivityService.ResetAttendanceCode:output_type -> activity.ResetAttendanceCodeResponse
-	34, // 33: activity.ActivityService.GetActivityAttendanceCodes:output_type -> activity.GetActivityAttendanceCodesResponse
-	12, // 34: activity.ActivityService.ActivitySupplementAttendance:output_type -> activity.ActivitySupplementAttendanceResponse
-	19, // [19:35] is the sub-list for method output_type
-	3,  // [3:19] is the sub-list for method input_type
-	3,  // [3:3] is the sub-list for extension type_name
-	3,  // [3:3] is the sub-list for extension extendee
-	0,  // [0:3] is the sub-list for field type_name
+	17, // 1: activity.ActivitySupplementAttendanceResponse.checkInPosition:type_name -> activity.AttendancePosition
+	17, // 2: activity.ActivitySupplementAttendanceResponse.checkOutPosition:type_name -> activity.AttendancePosition
+	20, // 3: activity.ActivityDetailResponse.activity:type_name -> activity.ActivityInfo
+	23, // 4: activity.MyActivitiesResponse.list:type_name -> activity.MyActivityItem
+	2,  // 5: activity.ActivitySeriesDetailResponse.occurrences:type_name -> activity.ActivityItem
+	40, // 6: activity.ActivitySeriesSignupResponse.results:type_name -> activity.ActivitySeriesSignupResult
+	41, // 7: activity.ActivitySlotListResponse.list:type_name -> activity.ActivitySlotItem
+	58, // 8: activity.ActivityBatchAttendanceRequest.rows:type_name -> activity.ActivityBatchAttendanceRow
+	60, // 9: activity.ActivityBatchAttendanceResponse.results:type_name -> activity.ActivityBatchAttendanceResult
+	17, // 10: activity.PendingSettlementItem.checkInPosition:type_name -> activity.AttendancePosition
+	63, // 11: activity.PendingSettlementListResponse.list:type_name -> activity.PendingSettlementItem
+	0,  // 12: activity.ActivityService.ActivityList:input_type -> activity.ActivityListRequest
+	3,  // 13: activity.ActivityService.ActivitySignup:input_type -> activity.ActivitySignupRequest
+	5,  // 14: activity.ActivityService.ActivityCancel:input_type -> activity.ActivityCancelRequest
+	7,  // 15: activity.ActivityService.ActivityWaitlistStatus:input_type -> activity.ActivityWaitlistStatusRequest
+	9,  // 16: activity.ActivityService.ActivityWaitlistLeave:input_type -> activity.ActivityWaitlistLeaveRequest
+	11, // 17: activity.ActivityService.ActivityCheckIn:input_type -> activity.ActivityCheckInRequest
+	13, // 18: activity.ActivityService.ActivityCheckOut:input_type -> activity.ActivityCheckOutRequest
+	18, // 19: activity.ActivityService.ActivityDetail:input_type -> activity.ActivityDetailRequest
+	21, // 20: activity.ActivityService.MyActivities:input_type -> activity.MyActivitiesRequest
+	24, // 21: activity.ActivityService.CreateActivity:input_type -> activity.CreateActivityRequest
+	26, // 22: activity.ActivityService.UpdateActivity:input_type -> activity.UpdateActivityRequest
+	28, // 23: activity.ActivityService.DeleteActivity:input_type -> activity.DeleteActivityRequest
+	30, // 24: activity.ActivityService.CancelActivity:input_type -> activity.CancelActivityRequest
+	32, // 25: activity.ActivityService.FinishActivity:input_type -> activity.FinishActivityRequest
+	34, // 26: activity.ActivityService.CreateActivitySeries:input_type -> activity.CreateActivitySeriesRequest
+	36, // 27: activity.ActivityService.ActivitySeriesDetail:input_type -> activity.ActivitySeriesDetailRequest
+	38, // 28: activity.ActivityService.ActivitySeriesSignup:input_type -> activity.ActivitySeriesSignupRequest
+	42, // 29: activity.ActivityService.ActivitySlotList:input_type -> activity.ActivitySlotListRequest
+	44, // 30: activity.ActivityService.CreateActivitySlot:input_type -> activity.CreateActivitySlotRequest
+	46, // 31: activity.ActivityService.UpdateActivitySlot:input_type -> activity.UpdateActivitySlotRequest
+	48, // 32: activity.ActivityService.DeleteActivitySlot:input_type -> activity.DeleteActivitySlotRequest
+	50, // 33: activity.ActivityService.GenerateAttendanceCodes:input_type -> activity.GenerateAttendanceCodesRequest
+	52, // 34: activity.ActivityService.ResetAttendanceCode:input_type -> activity.ResetAttendanceCodeRequest
+	54, // 35: activity.ActivityService.GetActivityAttendanceCodes:input_type -> activity.GetActivityAttendanceCodesRequest
+	56, // 36: activity.ActivityService.AttendanceQRCode:input_type -> activity.AttendanceQRCodeRequest
+	15, // 37: activity.ActivityService.ActivitySupplementAttendance:input_type -> activity.ActivitySupplementAttendanceRequest
+	62, // 38: activity.ActivityService.PendingSettlementList:input_type -> activity.PendingSettlementListRequest
+	65, // 39: activity.ActivityService.ConfirmPendingSettlement:input_type -> activity.ConfirmPendingSettlementRequest
+	59, // 40: activity.ActivityService.ActivityBatchAttendance:input_type -> activity.ActivityBatchAttendanceRequest
+	1,  // 41: activity.ActivityService.ActivityList:output_type -> activity.ActivityListResponse
+	4,  // 42: activity.ActivityService.ActivitySignup:output_type -> activity.ActivitySignupResponse
+	6,  // 43: activity.ActivityService.ActivityCancel:output_type -> activity.ActivityCancelResponse
+	8,  // 44: activity.ActivityService.ActivityWaitlistStatus:output_type -> activity.ActivityWaitlistStatusResponse
+	10, // 45: activity.ActivityService.ActivityWaitlistLeave:output_type -> activity.ActivityWaitlistLeaveResponse
+	12, // 46: activity.ActivityService.ActivityCheckIn:output_type -> activity.ActivityCheckInResponse
+	14, // 47: activity.ActivityService.ActivityCheckOut:output_type -> activity.ActivityCheckOutResponse
+	19, // 48: activity.ActivityService.ActivityDetail:output_type -> activity.ActivityDetailResponse
+	22, // 49: activity.ActivityService.MyActivities:output_type -> activity.MyActivitiesResponse
+	25, // 50: activity.ActivityService.CreateActivity:output_type -> activity.CreateActivityResponse
+	27, // 51: activity.ActivityService.UpdateActivity:output_type -> activity.UpdateActivityResponse
+	29, // 52: activity.ActivityService.DeleteActivity:output_type -> activity.DeleteActivityResponse
+	31, // 53: activity.ActivityService.CancelActivity:output_type -> activity.CancelActivityResponse
+	33, // 54: activity.ActivityService.FinishActivity:output_type -> activity.FinishActivityResponse
+	35, // 55: activity.ActivityService.CreateActivitySeries:output_type -> activity.CreateActivitySeriesResponse
+	37, // 56: activity.ActivityService.ActivitySeriesDetail:output_type -> activity.ActivitySeriesDetailResponse
+	39, // 57: activity.ActivityService.ActivitySeriesSignup:output_type -> activity.ActivitySeriesSignupResponse
+	43, // 58: activity.ActivityService.ActivitySlotList:output_type -> activity.ActivitySlotListResponse
+	45, // 59: activity.ActivityService.CreateActivitySlot:output_type -> activity.CreateActivitySlotResponse
+	47, // 60: activity.ActivityService.UpdateActivitySlot:output_type -> activity.UpdateActivitySlotResponse
+	49, // 61: activity.ActivityService.DeleteActivitySlot:output_type -> activity.DeleteActivitySlotResponse
+	51, // 62: activity.ActivityService.GenerateAttendanceCodes:output_type -> activity.GenerateAttendanceCodesResponse
+	53, // 63: activity.ActivityService.ResetAttendanceCode:output_type -> activity.ResetAttendanceCodeResponse
+	55, // 64: activity.ActivityService.GetActivityAttendanceCodes:output_type -> activity.GetActivityAttendanceCodesResponse
+	57, // 65: activity.ActivityService.AttendanceQRCode:output_type -> activity.AttendanceQRCodeResponse
+	16, // 66: activity.ActivityService.ActivitySupplementAttendance:output_type -> activity.ActivitySupplementAttendanceResponse
+	64, // 67: activity.ActivityService.PendingSettlementList:output_type -> activity.PendingSettlementListResponse
+	66, // 68: activity.ActivityService.ConfirmPendingSettlement:output_type -> activity.ConfirmPendingSettlementResponse
+	61, // 69: activity.ActivityService.ActivityBatchAttendance:output_type -> activity.ActivityBatchAttendanceResponse
+	41, // [41:70] is the sub-list for method output_type
+	12, // [12:41] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_internal_api_activities_proto_init() }
@@ -2930,7 +5957,7 @@ func file_internal_api_activities_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_api_activities_proto_rawDesc), len(file_internal_api_activities_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   35,
+			NumMessages:   67,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -232,6 +232,442 @@ func (x *PendingVolunteerJoinOrgAuditItem) GetCreatedAt() string {
 	return ""
 }
 
+// 统一审核收件箱查询参数
+type AuditInboxRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 页码（从 1 开始） 可选 @gotags: json:"page"
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	// 每页条数 可选 @gotags: json:"pageSize"
+	PageSize int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize"`
+	// 审核类型: 1-志愿者实名,2-组织资质,3-加入/退出组织,4-活动报名，支持多选 可选 @gotags: json:"targetType"
+	TargetType []int32 `protobuf:"varint,3,rep,packed,name=targetType,proto3" json:"targetType"`
+	// 审核状态: 1-待审核,2-已通过,3-已驳回，支持多选 可选 @gotags: json:"status"
+	Status []int32 `protobuf:"varint,4,rep,packed,name=status,proto3" json:"status"`
+	// 活动ID 可选 @gotags: json:"activityId"
+	ActivityId int64 `protobuf:"varint,5,opt,name=activityId,proto3" json:"activityId"`
+	// 组织ID（仅平台管理员生效，组织端固定为当前组织） 可选 @gotags: json:"orgId"
+	OrgId int64 `protobuf:"varint,6,opt,name=orgId,proto3" json:"orgId"`
+	// 提交人账号ID 可选 @gotags: json:"submitterId"
+	SubmitterId int64 `protobuf:"varint,7,opt,name=submitterId,proto3" json:"submitterId"`
+	// 提交日期起（YYYY-MM-DD，含） 可选 @gotags: json:"startDate"
+	StartDate string `protobuf:"bytes,8,opt,name=startDate,proto3" json:"startDate"`
+	// 提交日期止（YYYY-MM-DD，含） 可选 @gotags: json:"endDate"
+	EndDate string `protobuf:"bytes,9,opt,name=endDate,proto3" json:"endDate"`
+	// 仅查看已超过审核时限的待审核记录 可选 @gotags: json:"overdue"
+	Overdue       bool `protobuf:"varint,10,opt,name=overdue,proto3" json:"overdue"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditInboxRequest) Reset() {
+	*x = AuditInboxRequest{}
+	mi := &file_internal_api_audit_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditInboxRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditInboxRequest) ProtoMessage() {}
+
+func (x *AuditInboxRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditInboxRequest.ProtoReflect.Descriptor instead.
+func (*AuditInboxRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *AuditInboxRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *AuditInboxRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *AuditInboxRequest) GetTargetType() []int32 {
+	if x != nil {
+		return x.TargetType
+	}
+	return nil
+}
+
+func (x *AuditInboxRequest) GetStatus() []int32 {
+	if x != nil {
+		return x.Status
+	}
+	return nil
+}
+
+func (x *AuditInboxRequest) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *AuditInboxRequest) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *AuditInboxRequest) GetSubmitterId() int64 {
+	if x != nil {
+		return x.SubmitterId
+	}
+	return 0
+}
+
+func (x *AuditInboxRequest) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *AuditInboxRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *AuditInboxRequest) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+// 统一审核收件箱查询结果
+type AuditInboxResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 总记录数
+	Total int32 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	// 当前页数据
+	List          []*AuditInboxItem `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditInboxResponse) Reset() {
+	*x = AuditInboxResponse{}
+	mi := &file_internal_api_audit_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditInboxResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditInboxResponse) ProtoMessage() {}
+
+func (x *AuditInboxResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditInboxResponse.ProtoReflect.Descriptor instead.
+func (*AuditInboxResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *AuditInboxResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *AuditInboxResponse) GetList() []*AuditInboxItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 审核收件箱条目
+type AuditInboxItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 审核记录 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 审核类型
+	TargetType int32 `protobuf:"varint,2,opt,name=targetType,proto3" json:"targetType"`
+	// 审核目标 ID（未生效的新增申请为 0）
+	TargetId int64 `protobuf:"varint,3,opt,name=targetId,proto3" json:"targetId"`
+	// 操作类型: 1-新增,2-更新,3-删除
+	OperationType int32 `protobuf:"varint,4,opt,name=operationType,proto3" json:"operationType"`
+	// 审核状态
+	Status int32 `protobuf:"varint,5,opt,name=status,proto3" json:"status"`
+	// 主标题（按审核类型解析快照生成）
+	Title string `protobuf:"bytes,6,opt,name=title,proto3" json:"title"`
+	// 副标题
+	SubTitle string `protobuf:"bytes,7,opt,name=subTitle,proto3" json:"subTitle"`
+	// 类型相关的摘要字段
+	Fields []*AuditInboxField `protobuf:"bytes,8,rep,name=fields,proto3" json:"fields"`
+	// 所属组织 ID
+	OrgId int64 `protobuf:"varint,9,opt,name=orgId,proto3" json:"orgId"`
+	// 所属活动 ID
+	ActivityId int64 `protobuf:"varint,10,opt,name=activityId,proto3" json:"activityId"`
+	// 提交人账号 ID
+	SubmitterId int64 `protobuf:"varint,11,opt,name=submitterId,proto3" json:"submitterId"`
+	// 审核人 ID
+	AuditorId int64 `protobuf:"varint,12,opt,name=auditorId,proto3" json:"auditorId"`
+	// 驳回原因
+	RejectReason string `protobuf:"bytes,13,opt,name=rejectReason,proto3" json:"rejectReason"`
+	// 审核时间
+	AuditTime string `protobuf:"bytes,14,opt,name=auditTime,proto3" json:"auditTime"`
+	// 提交时间
+	CreatedAt string `protobuf:"bytes,15,opt,name=createdAt,proto3" json:"createdAt"`
+	// 当前审批阶段（配置审批链时）
+	CurrentStage int32 `protobuf:"varint,16,opt,name=currentStage,proto3" json:"currentStage"`
+	// 审核处理时限（待审核且该审核类型配置了时限时返回）
+	Deadline string `protobuf:"bytes,17,opt,name=deadline,proto3" json:"deadline"`
+	// 是否已超过审核时限
+	Overdue       bool `protobuf:"varint,18,opt,name=overdue,proto3" json:"overdue"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditInboxItem) Reset() {
+	*x = AuditInboxItem{}
+	mi := &file_internal_api_audit_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditInboxItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditInboxItem) ProtoMessage() {}
+
+func (x *AuditInboxItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditInboxItem.ProtoReflect.Descriptor instead.
+func (*AuditInboxItem) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *AuditInboxItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetTargetType() int32 {
+	if x != nil {
+		return x.TargetType
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetTargetId() int64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetOperationType() int32 {
+	if x != nil {
+		return x.OperationType
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetTitle() string {
+	if x != nil {
+		return x.Title
+	}
+	return ""
+}
+
+func (x *AuditInboxItem) GetSubTitle() string {
+	if x != nil {
+		return x.SubTitle
+	}
+	return ""
+}
+
+func (x *AuditInboxItem) GetFields() []*AuditInboxField {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+func (x *AuditInboxItem) GetOrgId() int64 {
+	if x != nil {
+		return x.OrgId
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetSubmitterId() int64 {
+	if x != nil {
+		return x.SubmitterId
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetAuditorId() int64 {
+	if x != nil {
+		return x.AuditorId
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *AuditInboxItem) GetAuditTime() string {
+	if x != nil {
+		return x.AuditTime
+	}
+	return ""
+}
+
+func (x *AuditInboxItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *AuditInboxItem) GetCurrentStage() int32 {
+	if x != nil {
+		return x.CurrentStage
+	}
+	return 0
+}
+
+func (x *AuditInboxItem) GetDeadline() string {
+	if x != nil {
+		return x.Deadline
+	}
+	return ""
+}
+
+func (x *AuditInboxItem) GetOverdue() bool {
+	if x != nil {
+		return x.Overdue
+	}
+	return false
+}
+
+// 审核摘要字段
+type AuditInboxField struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 字段名称
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label"`
+	// 字段值
+	Value         string `protobuf:"bytes,2,opt,name=value,proto3" json:"value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditInboxField) Reset() {
+	*x = AuditInboxField{}
+	mi := &file_internal_api_audit_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditInboxField) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditInboxField) ProtoMessage() {}
+
+func (x *AuditInboxField) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditInboxField.ProtoReflect.Descriptor instead.
+func (*AuditInboxField) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *AuditInboxField) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *AuditInboxField) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 // 执行审核请求参数
 type AuditApprovalRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -245,7 +681,7 @@ type AuditApprovalRequest struct {
 
 func (x *AuditApprovalRequest) Reset() {
 	*x = AuditApprovalRequest{}
-	mi := &file_internal_api_audit_proto_msgTypes[3]
+	mi := &file_internal_api_audit_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -257,7 +693,7 @@ func (x *AuditApprovalRequest) String() string {
 func (*AuditApprovalRequest) ProtoMessage() {}
 
 func (x *AuditApprovalRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_audit_proto_msgTypes[3]
+	mi := &file_internal_api_audit_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -270,7 +706,7 @@ func (x *AuditApprovalRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditApprovalRequest.ProtoReflect.Descriptor instead.
 func (*AuditApprovalRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_audit_proto_rawDescGZIP(), []int{3}
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *AuditApprovalRequest) GetId() int64 {
@@ -289,14 +725,18 @@ func (x *AuditApprovalRequest) GetReason() string {
 
 // 执行审核返回结果
 type AuditApprovalResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 审核状态：配置审批链时未到最后阶段仍为 1-待审核
+	Status int32 `protobuf:"varint,1,opt,name=status,proto3" json:"status"`
+	// 当前审批阶段
+	CurrentStage  int32 `protobuf:"varint,2,opt,name=currentStage,proto3" json:"currentStage"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AuditApprovalResponse) Reset() {
 	*x = AuditApprovalResponse{}
-	mi := &file_internal_api_audit_proto_msgTypes[4]
+	mi := &file_internal_api_audit_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -308,7 +748,7 @@ func (x *AuditApprovalResponse) String() string {
 func (*AuditApprovalResponse) ProtoMessage() {}
 
 func (x *AuditApprovalResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_audit_proto_msgTypes[4]
+	mi := &file_internal_api_audit_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -321,7 +761,21 @@ func (x *AuditApprovalResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditApprovalResponse.ProtoReflect.Descriptor instead.
 func (*AuditApprovalResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_audit_proto_rawDescGZIP(), []int{4}
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *AuditApprovalResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AuditApprovalResponse) GetCurrentStage() int32 {
+	if x != nil {
+		return x.CurrentStage
+	}
+	return 0
 }
 
 // 执行审核驳回请求参数
@@ -337,7 +791,7 @@ type AuditRejectionRequest struct {
 
 func (x *AuditRejectionRequest) Reset() {
 	*x = AuditRejectionRequest{}
-	mi := &file_internal_api_audit_proto_msgTypes[5]
+	mi := &file_internal_api_audit_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -349,7 +803,7 @@ func (x *AuditRejectionRequest) String() string {
 func (*AuditRejectionRequest) ProtoMessage() {}
 
 func (x *AuditRejectionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_audit_proto_msgTypes[5]
+	mi := &file_internal_api_audit_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -362,7 +816,7 @@ func (x *AuditRejectionRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditRejectionRequest.ProtoReflect.Descriptor instead.
 func (*AuditRejectionRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_audit_proto_rawDescGZIP(), []int{5}
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *AuditRejectionRequest) GetId() int64 {
@@ -388,19 +842,201 @@ type AuditRejectionResponse struct {
 
 func (x *AuditRejectionResponse) Reset() {
 	*x = AuditRejectionResponse{}
-	mi := &file_internal_api_audit_proto_msgTypes[6]
+	mi := &file_internal_api_audit_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRejectionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRejectionResponse) ProtoMessage() {}
+
+func (x *AuditRejectionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRejectionResponse.ProtoReflect.Descriptor instead.
+func (*AuditRejectionResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{10}
+}
+
+// 批量审核请求参数
+type BatchAuditRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 审核记录 ID 列表（与 filter 二选一，单次最多 200 条） 可选 @gotags: json:"ids"
+	Ids []int64 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids"`
+	// 按收件箱条件筛选待审核记录（仅处理待审核状态，按提交先后最多处理 200 条） 可选 @gotags: json:"filter"
+	Filter *AuditInboxRequest `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter"`
+	// 审核意见/驳回原因（驳回时必填） 可选 @gotags: json:"reason"
+	Reason        string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAuditRequest) Reset() {
+	*x = BatchAuditRequest{}
+	mi := &file_internal_api_audit_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAuditRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAuditRequest) ProtoMessage() {}
+
+func (x *BatchAuditRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAuditRequest.ProtoReflect.Descriptor instead.
+func (*BatchAuditRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchAuditRequest) GetIds() []int64 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+func (x *BatchAuditRequest) GetFilter() *AuditInboxRequest {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *BatchAuditRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// 批量审核结果
+type BatchAuditResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 处理记录数
+	Total int32 `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	// 成功数
+	Succeeded int32 `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded"`
+	// 失败数
+	Failed int32 `protobuf:"varint,3,opt,name=failed,proto3" json:"failed"`
+	// 逐条结果（按处理顺序）
+	Results       []*BatchAuditResult `protobuf:"bytes,4,rep,name=results,proto3" json:"results"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAuditResponse) Reset() {
+	*x = BatchAuditResponse{}
+	mi := &file_internal_api_audit_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchAuditResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchAuditResponse) ProtoMessage() {}
+
+func (x *BatchAuditResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchAuditResponse.ProtoReflect.Descriptor instead.
+func (*BatchAuditResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BatchAuditResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *BatchAuditResponse) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *BatchAuditResponse) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *BatchAuditResponse) GetResults() []*BatchAuditResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+// 单条记录的批量审核结果
+type BatchAuditResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 审核记录 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 是否成功
+	Success bool `protobuf:"varint,2,opt,name=success,proto3" json:"success"`
+	// 失败原因
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message"`
+	// 处理后的审核状态：配置审批链时未到最后阶段仍为 1-待审核
+	Status        int32 `protobuf:"varint,4,opt,name=status,proto3" json:"status"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchAuditResult) Reset() {
+	*x = BatchAuditResult{}
+	mi := &file_internal_api_audit_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AuditRejectionResponse) String() string {
+func (x *BatchAuditResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AuditRejectionResponse) ProtoMessage() {}
+func (*BatchAuditResult) ProtoMessage() {}
 
-func (x *AuditRejectionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_audit_proto_msgTypes[6]
+func (x *BatchAuditResult) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -411,9 +1047,37 @@ func (x *AuditRejectionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AuditRejectionResponse.ProtoReflect.Descriptor instead.
-func (*AuditRejectionResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_audit_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use BatchAuditResult.ProtoReflect.Descriptor instead.
+func (*BatchAuditResult) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchAuditResult) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BatchAuditResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *BatchAuditResult) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BatchAuditResult) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
 }
 
 // 审核记录详情查询参数
@@ -427,7 +1091,7 @@ type AuditRecordDetailRequest struct {
 
 func (x *AuditRecordDetailRequest) Reset() {
 	*x = AuditRecordDetailRequest{}
-	mi := &file_internal_api_audit_proto_msgTypes[7]
+	mi := &file_internal_api_audit_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -439,7 +1103,7 @@ func (x *AuditRecordDetailRequest) String() string {
 func (*AuditRecordDetailRequest) ProtoMessage() {}
 
 func (x *AuditRecordDetailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_audit_proto_msgTypes[7]
+	mi := &file_internal_api_audit_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -452,7 +1116,7 @@ func (x *AuditRecordDetailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditRecordDetailRequest.ProtoReflect.Descriptor instead.
 func (*AuditRecordDetailRequest) Descriptor() ([]byte, []int) {
-	return file_internal_api_audit_proto_rawDescGZIP(), []int{7}
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *AuditRecordDetailRequest) GetId() int64 {
@@ -473,7 +1137,7 @@ type AuditRecordDetailResponse struct {
 
 func (x *AuditRecordDetailResponse) Reset() {
 	*x = AuditRecordDetailResponse{}
-	mi := &file_internal_api_audit_proto_msgTypes[8]
+	mi := &file_internal_api_audit_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -485,7 +1149,7 @@ func (x *AuditRecordDetailResponse) String() string {
 func (*AuditRecordDetailResponse) ProtoMessage() {}
 
 func (x *AuditRecordDetailResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_audit_proto_msgTypes[8]
+	mi := &file_internal_api_audit_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -498,7 +1162,7 @@ func (x *AuditRecordDetailResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditRecordDetailResponse.ProtoReflect.Descriptor instead.
 func (*AuditRecordDetailResponse) Descriptor() ([]byte, []int) {
-	return file_internal_api_audit_proto_rawDescGZIP(), []int{8}
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *AuditRecordDetailResponse) GetRecord() *AuditRecordDetail {
@@ -532,14 +1196,24 @@ type AuditRecordDetail struct {
 	// 审核时间
 	AuditTime string `protobuf:"bytes,10,opt,name=auditTime,proto3" json:"auditTime"`
 	// 记录创建时间
-	CreatedAt     string `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	CreatedAt string `protobuf:"bytes,11,opt,name=createdAt,proto3" json:"createdAt"`
+	// 同一审核目标的历次审核记录（按提交时间倒序，含本条）
+	History []*AuditRecordHistoryItem `protobuf:"bytes,12,rep,name=history,proto3" json:"history"`
+	// 本次提交的资质材料（组织资质审核）
+	Documents []*AuditRecordDocument `protobuf:"bytes,13,rep,name=documents,proto3" json:"documents"`
+	// 当前审批阶段
+	CurrentStage int32 `protobuf:"varint,14,opt,name=currentStage,proto3" json:"currentStage"`
+	// 审批链阶段数，未配置审批链时为 0
+	StageCount int32 `protobuf:"varint,15,opt,name=stageCount,proto3" json:"stageCount"`
+	// 各阶段审批决定（按审批先后）
+	StageDecisions []*AuditStageDecisionItem `protobuf:"bytes,16,rep,name=stageDecisions,proto3" json:"stageDecisions"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *AuditRecordDetail) Reset() {
 	*x = AuditRecordDetail{}
-	mi := &file_internal_api_audit_proto_msgTypes[9]
+	mi := &file_internal_api_audit_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -551,7 +1225,7 @@ func (x *AuditRecordDetail) String() string {
 func (*AuditRecordDetail) ProtoMessage() {}
 
 func (x *AuditRecordDetail) ProtoReflect() protoreflect.Message {
-	mi := &file_internal_api_audit_proto_msgTypes[9]
+	mi := &file_internal_api_audit_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -564,7 +1238,7 @@ func (x *AuditRecordDetail) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AuditRecordDetail.ProtoReflect.Descriptor instead.
 func (*AuditRecordDetail) Descriptor() ([]byte, []int) {
-	return file_internal_api_audit_proto_rawDescGZIP(), []int{9}
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *AuditRecordDetail) GetId() int64 {
@@ -644,6 +1318,305 @@ func (x *AuditRecordDetail) GetCreatedAt() string {
 	return ""
 }
 
+func (x *AuditRecordDetail) GetHistory() []*AuditRecordHistoryItem {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *AuditRecordDetail) GetDocuments() []*AuditRecordDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *AuditRecordDetail) GetCurrentStage() int32 {
+	if x != nil {
+		return x.CurrentStage
+	}
+	return 0
+}
+
+func (x *AuditRecordDetail) GetStageCount() int32 {
+	if x != nil {
+		return x.StageCount
+	}
+	return 0
+}
+
+func (x *AuditRecordDetail) GetStageDecisions() []*AuditStageDecisionItem {
+	if x != nil {
+		return x.StageDecisions
+	}
+	return nil
+}
+
+// 审批阶段决定
+type AuditStageDecisionItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 阶段序号
+	StageNo int32 `protobuf:"varint,1,opt,name=stageNo,proto3" json:"stageNo"`
+	// 审批时的角色: 2-管理员, 3-负责人, 9-组织主账号, 10-平台管理员
+	ApproverRole int32 `protobuf:"varint,2,opt,name=approverRole,proto3" json:"approverRole"`
+	// 审批人 ID
+	AuditorId int64 `protobuf:"varint,3,opt,name=auditorId,proto3" json:"auditorId"`
+	// 决定: 1-通过, 2-驳回
+	Decision int32 `protobuf:"varint,4,opt,name=decision,proto3" json:"decision"`
+	// 审批意见/驳回原因
+	Reason string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason"`
+	// 审批时间
+	CreatedAt     string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditStageDecisionItem) Reset() {
+	*x = AuditStageDecisionItem{}
+	mi := &file_internal_api_audit_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditStageDecisionItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditStageDecisionItem) ProtoMessage() {}
+
+func (x *AuditStageDecisionItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditStageDecisionItem.ProtoReflect.Descriptor instead.
+func (*AuditStageDecisionItem) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *AuditStageDecisionItem) GetStageNo() int32 {
+	if x != nil {
+		return x.StageNo
+	}
+	return 0
+}
+
+func (x *AuditStageDecisionItem) GetApproverRole() int32 {
+	if x != nil {
+		return x.ApproverRole
+	}
+	return 0
+}
+
+func (x *AuditStageDecisionItem) GetAuditorId() int64 {
+	if x != nil {
+		return x.AuditorId
+	}
+	return 0
+}
+
+func (x *AuditStageDecisionItem) GetDecision() int32 {
+	if x != nil {
+		return x.Decision
+	}
+	return 0
+}
+
+func (x *AuditStageDecisionItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *AuditStageDecisionItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 审核目标的历史审核记录
+type AuditRecordHistoryItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 审核记录 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 审核状态
+	Status int32 `protobuf:"varint,2,opt,name=status,proto3" json:"status"`
+	// 审核人 ID
+	AuditorId int64 `protobuf:"varint,3,opt,name=auditorId,proto3" json:"auditorId"`
+	// 驳回原因
+	RejectReason string `protobuf:"bytes,4,opt,name=rejectReason,proto3" json:"rejectReason"`
+	// 审核时间
+	AuditTime string `protobuf:"bytes,5,opt,name=auditTime,proto3" json:"auditTime"`
+	// 记录创建时间
+	CreatedAt     string `protobuf:"bytes,6,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRecordHistoryItem) Reset() {
+	*x = AuditRecordHistoryItem{}
+	mi := &file_internal_api_audit_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecordHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecordHistoryItem) ProtoMessage() {}
+
+func (x *AuditRecordHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecordHistoryItem.ProtoReflect.Descriptor instead.
+func (*AuditRecordHistoryItem) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *AuditRecordHistoryItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditRecordHistoryItem) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *AuditRecordHistoryItem) GetAuditorId() int64 {
+	if x != nil {
+		return x.AuditorId
+	}
+	return 0
+}
+
+func (x *AuditRecordHistoryItem) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *AuditRecordHistoryItem) GetAuditTime() string {
+	if x != nil {
+		return x.AuditTime
+	}
+	return ""
+}
+
+func (x *AuditRecordHistoryItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// 审核附带的材料
+type AuditRecordDocument struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 材料 ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 材料类型: 1-登记证书/营业执照, 2-其他证明材料
+	DocType int32 `protobuf:"varint,2,opt,name=docType,proto3" json:"docType"`
+	// 文件名
+	FileName string `protobuf:"bytes,3,opt,name=fileName,proto3" json:"fileName"`
+	// 文件大小（字节）
+	FileSize int64 `protobuf:"varint,4,opt,name=fileSize,proto3" json:"fileSize"`
+	// 上传时间
+	CreatedAt     string `protobuf:"bytes,5,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuditRecordDocument) Reset() {
+	*x = AuditRecordDocument{}
+	mi := &file_internal_api_audit_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuditRecordDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuditRecordDocument) ProtoMessage() {}
+
+func (x *AuditRecordDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_audit_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuditRecordDocument.ProtoReflect.Descriptor instead.
+func (*AuditRecordDocument) Descriptor() ([]byte, []int) {
+	return file_internal_api_audit_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *AuditRecordDocument) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AuditRecordDocument) GetDocType() int32 {
+	if x != nil {
+		return x.DocType
+	}
+	return 0
+}
+
+func (x *AuditRecordDocument) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *AuditRecordDocument) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *AuditRecordDocument) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
 var File_internal_api_audit_proto protoreflect.FileDescriptor
 
 const file_internal_api_audit_proto_rawDesc = "" +
@@ -662,19 +1635,81 @@ const file_internal_api_audit_proto_rawDesc = "" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x14\n" +
 	"\x05title\x18\x03 \x01(\tR\x05title\x12\x1a\n" +
 	"\bsubTitle\x18\x04 \x01(\tR\bsubTitle\x12\x1c\n" +
-	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\">\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt\"\xa5\x02\n" +
+	"\x11AuditInboxRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x05R\bpageSize\x12\x1e\n" +
+	"\n" +
+	"targetType\x18\x03 \x03(\x05R\n" +
+	"targetType\x12\x16\n" +
+	"\x06status\x18\x04 \x03(\x05R\x06status\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\x05 \x01(\x03R\n" +
+	"activityId\x12\x14\n" +
+	"\x05orgId\x18\x06 \x01(\x03R\x05orgId\x12 \n" +
+	"\vsubmitterId\x18\a \x01(\x03R\vsubmitterId\x12\x1c\n" +
+	"\tstartDate\x18\b \x01(\tR\tstartDate\x12\x18\n" +
+	"\aendDate\x18\t \x01(\tR\aendDate\x12\x18\n" +
+	"\aoverdue\x18\n" +
+	" \x01(\bR\aoverdue\"U\n" +
+	"\x12AuditInboxResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12)\n" +
+	"\x04list\x18\x02 \x03(\v2\x15.audit.AuditInboxItemR\x04list\"\xac\x04\n" +
+	"\x0eAuditInboxItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
+	"\n" +
+	"targetType\x18\x02 \x01(\x05R\n" +
+	"targetType\x12\x1a\n" +
+	"\btargetId\x18\x03 \x01(\x03R\btargetId\x12$\n" +
+	"\roperationType\x18\x04 \x01(\x05R\roperationType\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\x05R\x06status\x12\x14\n" +
+	"\x05title\x18\x06 \x01(\tR\x05title\x12\x1a\n" +
+	"\bsubTitle\x18\a \x01(\tR\bsubTitle\x12.\n" +
+	"\x06fields\x18\b \x03(\v2\x16.audit.AuditInboxFieldR\x06fields\x12\x14\n" +
+	"\x05orgId\x18\t \x01(\x03R\x05orgId\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\n" +
+	" \x01(\x03R\n" +
+	"activityId\x12 \n" +
+	"\vsubmitterId\x18\v \x01(\x03R\vsubmitterId\x12\x1c\n" +
+	"\tauditorId\x18\f \x01(\x03R\tauditorId\x12\"\n" +
+	"\frejectReason\x18\r \x01(\tR\frejectReason\x12\x1c\n" +
+	"\tauditTime\x18\x0e \x01(\tR\tauditTime\x12\x1c\n" +
+	"\tcreatedAt\x18\x0f \x01(\tR\tcreatedAt\x12\"\n" +
+	"\fcurrentStage\x18\x10 \x01(\x05R\fcurrentStage\x12\x1a\n" +
+	"\bdeadline\x18\x11 \x01(\tR\bdeadline\x12\x18\n" +
+	"\aoverdue\x18\x12 \x01(\bR\aoverdue\"=\n" +
+	"\x0fAuditInboxField\x12\x14\n" +
+	"\x05label\x18\x01 \x01(\tR\x05label\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value\">\n" +
 	"\x14AuditApprovalRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
-	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x17\n" +
-	"\x15AuditApprovalResponse\"?\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\"S\n" +
+	"\x15AuditApprovalResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\"\n" +
+	"\fcurrentStage\x18\x02 \x01(\x05R\fcurrentStage\"?\n" +
 	"\x15AuditRejectionRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
 	"\x06reason\x18\x02 \x01(\tR\x06reason\"\x18\n" +
-	"\x16AuditRejectionResponse\"*\n" +
+	"\x16AuditRejectionResponse\"o\n" +
+	"\x11BatchAuditRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x03(\x03R\x03ids\x120\n" +
+	"\x06filter\x18\x02 \x01(\v2\x18.audit.AuditInboxRequestR\x06filter\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\x93\x01\n" +
+	"\x12BatchAuditResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x121\n" +
+	"\aresults\x18\x04 \x03(\v2\x17.audit.BatchAuditResultR\aresults\"n\n" +
+	"\x10BatchAuditResult\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\"*\n" +
 	"\x18AuditRecordDetailRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"M\n" +
 	"\x19AuditRecordDetailResponse\x120\n" +
-	"\x06record\x18\x01 \x01(\v2\x18.audit.AuditRecordDetailR\x06record\"\xd7\x02\n" +
+	"\x06record\x18\x01 \x01(\v2\x18.audit.AuditRecordDetailR\x06record\"\xd5\x04\n" +
 	"\x11AuditRecordDetail\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1e\n" +
 	"\n" +
@@ -693,12 +1728,44 @@ const file_internal_api_audit_proto_rawDesc = "" +
 	"\frejectReason\x18\t \x01(\tR\frejectReason\x12\x1c\n" +
 	"\tauditTime\x18\n" +
 	" \x01(\tR\tauditTime\x12\x1c\n" +
-	"\tcreatedAt\x18\v \x01(\tR\tcreatedAt2\xac\x04\n" +
+	"\tcreatedAt\x18\v \x01(\tR\tcreatedAt\x127\n" +
+	"\ahistory\x18\f \x03(\v2\x1d.audit.AuditRecordHistoryItemR\ahistory\x128\n" +
+	"\tdocuments\x18\r \x03(\v2\x1a.audit.AuditRecordDocumentR\tdocuments\x12\"\n" +
+	"\fcurrentStage\x18\x0e \x01(\x05R\fcurrentStage\x12\x1e\n" +
+	"\n" +
+	"stageCount\x18\x0f \x01(\x05R\n" +
+	"stageCount\x12E\n" +
+	"\x0estageDecisions\x18\x10 \x03(\v2\x1d.audit.AuditStageDecisionItemR\x0estageDecisions\"\xc6\x01\n" +
+	"\x16AuditStageDecisionItem\x12\x18\n" +
+	"\astageNo\x18\x01 \x01(\x05R\astageNo\x12\"\n" +
+	"\fapproverRole\x18\x02 \x01(\x05R\fapproverRole\x12\x1c\n" +
+	"\tauditorId\x18\x03 \x01(\x03R\tauditorId\x12\x1a\n" +
+	"\bdecision\x18\x04 \x01(\x05R\bdecision\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\"\xbe\x01\n" +
+	"\x16AuditRecordHistoryItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x1c\n" +
+	"\tauditorId\x18\x03 \x01(\x03R\tauditorId\x12\"\n" +
+	"\frejectReason\x18\x04 \x01(\tR\frejectReason\x12\x1c\n" +
+	"\tauditTime\x18\x05 \x01(\tR\tauditTime\x12\x1c\n" +
+	"\tcreatedAt\x18\x06 \x01(\tR\tcreatedAt\"\x95\x01\n" +
+	"\x13AuditRecordDocument\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\adocType\x18\x02 \x01(\x05R\adocType\x12\x1a\n" +
+	"\bfileName\x18\x03 \x01(\tR\bfileName\x12\x1a\n" +
+	"\bfileSize\x18\x04 \x01(\x03R\bfileSize\x12\x1c\n" +
+	"\tcreatedAt\x18\x05 \x01(\tR\tcreatedAt2\xf1\a\n" +
 	"\fAuditService\x12\xb3\x01\n" +
-	" PendingVolunteerJoinOrgAuditList\x12..audit.PendingVolunteerJoinOrgAuditListRequest\x1a/.audit.PendingVolunteerJoinOrgAuditListResponse\".\x82\xd3\xe4\x93\x02(\"&/api/audits/volunteer-join-org/pending\x12k\n" +
+	" PendingVolunteerJoinOrgAuditList\x12..audit.PendingVolunteerJoinOrgAuditListRequest\x1a/.audit.PendingVolunteerJoinOrgAuditListResponse\".\x82\xd3\xe4\x93\x02(\"&/api/audits/volunteer-join-org/pending\x12_\n" +
+	"\n" +
+	"AuditInbox\x12\x18.audit.AuditInboxRequest\x1a\x19.audit.AuditInboxResponse\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/api/audits/inbox\x12k\n" +
 	"\rAuditApproval\x12\x1b.audit.AuditApprovalRequest\x1a\x1c.audit.AuditApprovalResponse\"\x1f\x82\xd3\xe4\x93\x02\x19:\x01*\"\x14/api/audits/approval\x12o\n" +
-	"\x0eAuditRejection\x12\x1c.audit.AuditRejectionRequest\x1a\x1d.audit.AuditRejectionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/audits/rejection\x12w\n" +
-	"\x11AuditRecordDetail\x12\x1f.audit.AuditRecordDetailRequest\x1a .audit.AuditRecordDetailResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/audits/records/:id\x1a\x0f\xcaA\f0.0.0.0:8080B#Z!volunteer-system/internal/api;apib\x06proto3"
+	"\x0eAuditRejection\x12\x1c.audit.AuditRejectionRequest\x1a\x1d.audit.AuditRejectionResponse\" \x82\xd3\xe4\x93\x02\x1a:\x01*\"\x15/api/audits/rejection\x12p\n" +
+	"\x12BatchAuditApproval\x12\x18.audit.BatchAuditRequest\x1a\x19.audit.BatchAuditResponse\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/api/audits/batch-approval\x12r\n" +
+	"\x13BatchAuditRejection\x12\x18.audit.BatchAuditRequest\x1a\x19.audit.BatchAuditResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/audits/batch-rejection\x12w\n" +
+	"\x11AuditRecordDetail\x12\x1f.audit.AuditRecordDetailRequest\x1a .audit.AuditRecordDetailResponse\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/api/audits/records/:id\x12|\n" +
+	"\x13MyAuditRecordDetail\x12\x1f.audit.AuditRecordDetailRequest\x1a .audit.AuditRecordDetailResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/audits/my-records/:id\x1a\x0f\xcaA\f0.0.0.0:8080B#Z!volunteer-system/internal/api;apib\x06proto3"
 
 var (
 	file_internal_api_audit_proto_rawDescOnce sync.Once
@@ -712,35 +1779,60 @@ func file_internal_api_audit_proto_rawDescGZIP() []byte {
 	return file_internal_api_audit_proto_rawDescData
 }
 
-var file_internal_api_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_internal_api_audit_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
 var file_internal_api_audit_proto_goTypes = []any{
 	(*PendingVolunteerJoinOrgAuditListRequest)(nil),  // 0: audit.PendingVolunteerJoinOrgAuditListRequest
 	(*PendingVolunteerJoinOrgAuditListResponse)(nil), // 1: audit.PendingVolunteerJoinOrgAuditListResponse
 	(*PendingVolunteerJoinOrgAuditItem)(nil),         // 2: audit.PendingVolunteerJoinOrgAuditItem
-	(*AuditApprovalRequest)(nil),                     // 3: audit.AuditApprovalRequest
-	(*AuditApprovalResponse)(nil),                    // 4: audit.AuditApprovalResponse
-	(*AuditRejectionRequest)(nil),                    // 5: audit.AuditRejectionRequest
-	(*AuditRejectionResponse)(nil),                   // 6: audit.AuditRejectionResponse
-	(*AuditRecordDetailRequest)(nil),                 // 7: audit.AuditRecordDetailRequest
-	(*AuditRecordDetailResponse)(nil),                // 8: audit.AuditRecordDetailResponse
-	(*AuditRecordDetail)(nil),                        // 9: audit.AuditRecordDetail
+	(*AuditInboxRequest)(nil),                        // 3: audit.AuditInboxRequest
+	(*AuditInboxResponse)(nil),                       // 4: audit.AuditInboxResponse
+	(*AuditInboxItem)(nil),                           // 5: audit.AuditInboxItem
+	(*AuditInboxField)(nil),                          // 6: audit.AuditInboxField
+	(*AuditApprovalRequest)(nil),                     // 7: audit.AuditApprovalRequest
+	(*AuditApprovalResponse)(nil),                    // 8: audit.AuditApprovalResponse
+	(*AuditRejectionRequest)(nil),                    // 9: audit.AuditRejectionRequest
+	(*AuditRejectionResponse)(nil),                   // 10: audit.AuditRejectionResponse
+	(*BatchAuditRequest)(nil),                        // 11: audit.BatchAuditRequest
+	(*BatchAuditResponse)(nil),                       // 12: audit.BatchAuditResponse
+	(*BatchAuditResult)(nil),                         // 13: audit.BatchAuditResult
+	(*AuditRecordDetailRequest)(nil),                 // 14: audit.AuditRecordDetailRequest
+	(*AuditRecordDetailResponse)(nil),                // 15: audit.AuditRecordDetailResponse
+	(*AuditRecordDetail)(nil),                        // 16: audit.AuditRecordDetail
+	(*AuditStageDecisionItem)(nil),                   // 17: audit.AuditStageDecisionItem
+	(*AuditRecordHistoryItem)(nil),                   // 18: audit.AuditRecordHistoryItem
+	(*AuditRecordDocument)(nil),                      // 19: audit.AuditRecordDocument
 }
 var file_internal_api_audit_proto_depIdxs = []int32{
-	2, // 0: audit.PendingVolunteerJoinOrgAuditListResponse.list:type_name -> audit.PendingVolunteerJoinOrgAuditItem
-	9, // 1: audit.AuditRecordDetailResponse.record:type_name -> audit.AuditRecordDetail
-	0, // 2: audit.AuditService.PendingVolunteerJoinOrgAuditList:input_type -> audit.PendingVolunteerJoinOrgAuditListRequest
-	3, // 3: audit.AuditService.AuditApproval:input_type -> audit.AuditApprovalRequest
-	5, // 4: audit.AuditService.AuditRejection:input_type -> audit.AuditRejectionRequest
-	7, // 5: audit.AuditService.AuditRecordDetail:input_type -> audit.AuditRecordDetailRequest
-	1, // 6: audit.AuditService.PendingVolunteerJoinOrgAuditList:output_type -> audit.PendingVolunteerJoinOrgAuditListResponse
-	4, // 7: audit.AuditService.AuditApproval:output_type -> audit.AuditApprovalResponse
-	6, // 8: audit.AuditService.AuditRejection:output_type -> audit.AuditRejectionResponse
-	8, // 9: audit.AuditService.AuditRecordDetail:output_type -> audit.AuditRecordDetailResponse
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	2,  // 0: audit.PendingVolunteerJoinOrgAuditListResponse.list:type_name -> audit.PendingVolunteerJoinOrgAuditItem
+	5,  // 1: audit.AuditInboxResponse.list:type_name -> audit.AuditInboxItem
+	6,  // 2: audit.AuditInboxItem.fields:type_name -> audit.AuditInboxField
+	3,  // 3: audit.BatchAuditRequest.filter:type_name -> audit.AuditInboxRequest
+	13, // 4: audit.BatchAuditResponse.results:type_name -> audit.BatchAuditResult
+	16, // 5: audit.AuditRecordDetailResponse.record:type_name -> audit.AuditRecordDetail
+	18, // 6: audit.AuditRecordDetail.history:type_name -> audit.AuditRecordHistoryItem
+	19, // 7: audit.AuditRecordDetail.documents:type_name -> audit.AuditRecordDocument
+	17, // 8: audit.AuditRecordDetail.stageDecisions:type_name -> audit.AuditStageDecisionItem
+	0,  // 9: audit.AuditService.PendingVolunteerJoinOrgAuditList:input_type -> audit.PendingVolunteerJoinOrgAuditListRequest
+	3,  // 10: audit.AuditService.AuditInbox:input_type -> audit.AuditInboxRequest
+	7,  // 11: audit.AuditService.AuditApproval:input_type -> audit.AuditApprovalRequest
+	9,  // 12: audit.AuditService.AuditRejection:input_type -> audit.AuditRejectionRequest
+	11, // 13: audit.AuditService.BatchAuditApproval:input_type -> audit.BatchAuditRequest
+	11, // 14: audit.AuditService.BatchAuditRejection:input_type -> audit.BatchAuditRequest
+	14, // 15: audit.AuditService.AuditRecordDetail:input_type -> audit.AuditRecordDetailRequest
+	14, // 16: audit.AuditService.MyAuditRecordDetail:input_type -> audit.AuditRecordDetailRequest
+	1,  // 17: audit.AuditService.PendingVolunteerJoinOrgAuditList:output_type -> audit.PendingVolunteerJoinOrgAuditListResponse
+	4,  // 18: audit.AuditService.AuditInbox:output_type -> audit.AuditInboxResponse
+	8,  // 19: audit.AuditService.AuditApproval:output_type -> audit.AuditApprovalResponse
+	10, // 20: audit.AuditService.AuditRejection:output_type -> audit.AuditRejectionResponse
+	12, // 21: audit.AuditService.BatchAuditApproval:output_type -> audit.BatchAuditResponse
+	12, // 22: audit.AuditService.BatchAuditRejection:output_type -> audit.BatchAuditResponse
+	15, // 23: audit.AuditService.AuditRecordDetail:output_type -> audit.AuditRecordDetailResponse
+	15, // 24: audit.AuditService.MyAuditRecordDetail:output_type -> audit.AuditRecordDetailResponse
+	17, // [17:25] is the sub-list for method output_type
+	9,  // [9:17] is the sub-list for method input_type
+	9,  // [9:9] is the sub-list for extension type_name
+	9,  // [9:9] is the sub-list for extension extendee
+	0,  // [0:9] is the sub-list for field type_name
 }
 
 func init() { file_internal_api_audit_proto_init() }
@@ -754,7 +1846,7 @@ func file_internal_api_audit_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_api_audit_proto_rawDesc), len(file_internal_api_audit_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   20,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
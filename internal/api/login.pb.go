@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.11
+// 	protoc-gen-go v1.36.9
 // 	protoc        v6.31.0
 // source: internal/api/login.proto
 
@@ -31,7 +31,7 @@ type LoginRequest struct {
 	Identifier string `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,required"`
 	// 密码 必填 @gotags: json:"password,required"
 	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,required"`
-	// 身份类型 volunteer-志愿者 organization-组织管理者 必填 @gotags: json:"identity,required"
+	// 身份类型 volunteer-志愿者 organization-组织管理者 admin-平台管理员 必填 @gotags: json:"identity,required"
 	Identity      string `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,required"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
@@ -515,8 +515,8 @@ func (x *VolunteerOrganizationsResponse) GetList() []*OrganizationMemberInfo {
 // MemberStatusUpdateRequest 更新成员状态请求
 type MemberStatusUpdateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// 组织管理者ID 必填 @gotags: json:"accountId,required"
-	AccountId int64 `protobuf:"varint,1,opt,name=accountId,proto3" json:"accountId,required"`
+	// 已废弃，操作人以登录账号为准 可选 @gotags: json:"accountId"
+	AccountId int64 `protobuf:"varint,1,opt,name=accountId,proto3" json:"accountId"`
 	// 成员关系ID 必填 @gotags: json:"membershipId,required"
 	MembershipId int64 `protobuf:"varint,2,opt,name=membershipId,proto3" json:"membershipId,required"`
 	// 成员状态 必填 @gotags: json:"status,required"
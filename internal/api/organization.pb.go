@@ -426,7 +426,9 @@ type OrganizationInfo struct {
 	// 创建时间
 	CreatedAt string `protobuf:"bytes,17,opt,name=createdAt,proto3" json:"createdAt"`
 	// 更新时间
-	UpdatedAt     string `protobuf:"bytes,18,opt,name=updatedAt,proto3" json:"updatedAt"`
+	UpdatedAt string `protobuf:"bytes,18,opt,name=updatedAt,proto3" json:"updatedAt"`
+	// 资质认证状态: 0-未认证, 1-审核中, 2-已认证, 3-已驳回
+	VerifyStatus  int32 `protobuf:"varint,19,opt,name=verifyStatus,proto3" json:"verifyStatus"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -573,6 +575,13 @@ func (x *OrganizationInfo) GetUpdatedAt() string {
 	return ""
 }
 
+func (x *OrganizationInfo) GetVerifyStatus() int32 {
+	if x != nil {
+		return x.VerifyStatus
+	}
+	return 0
+}
+
 // OrganizationCreateRequest 创建组织请求
 type OrganizationCreateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1552,7 +1561,7 @@ const file_internal_api_organization_proto_rawDesc = "" +
 	"\x19OrganizationDetailRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"`\n" +
 	"\x1aOrganizationDetailResponse\x12B\n" +
-	"\forganization\x18\x01 \x01(\v2\x1e.organization.OrganizationInfoR\forganization\"\xfa\x03\n" +
+	"\forganization\x18\x01 \x01(\v2\x1e.organization.OrganizationInfoR\forganization\"\x9e\x04\n" +
 	"\x10OrganizationInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
 	"\taccountId\x18\x02 \x01(\x03R\taccountId\x12\x12\n" +
@@ -1572,7 +1581,8 @@ const file_internal_api_organization_proto_rawDesc = "" +
 	"websiteUrl\x12\x18\n" +
 	"\alogoUrl\x18\x0e \x01(\tR\alogoUrl\x12\x1c\n" +
 	"\tcreatedAt\x18\x11 \x01(\tR\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\x12 \x01(\tR\tupdatedAtJ\x04\b\x0f\x10\x10J\x04\b\x10\x10\x11\"\xf5\x02\n" +
+	"\tupdatedAt\x18\x12 \x01(\tR\tupdatedAt\x12\"\n" +
+	"\fverifyStatus\x18\x13 \x01(\x05R\fverifyStatusJ\x04\b\x0f\x10\x10J\x04\b\x10\x10\x11\"\xf5\x02\n" +
 	"\x19OrganizationCreateRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
 	"\x10organizationCode\x18\x02 \x01(\tR\x10organizationCode\x12$\n" +
@@ -498,7 +498,9 @@ type VolunteerInfo struct {
 	// 更新时间
 	UpdatedAt string `protobuf:"bytes,14,opt,name=updatedAt,proto3" json:"updatedAt"`
 	// 志愿者状态
-	Status        int32 `protobuf:"varint,15,opt,name=status,proto3" json:"status"`
+	Status int32 `protobuf:"varint,15,opt,name=status,proto3" json:"status"`
+	// 技能标签
+	Skills        []string `protobuf:"bytes,16,rep,name=skills,proto3" json:"skills"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -638,6 +640,13 @@ func (x *VolunteerInfo) GetStatus() int32 {
 	return 0
 }
 
+func (x *VolunteerInfo) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
 // VolunteerUpdateRequest 更新志愿者请求
 type VolunteerUpdateRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -652,7 +661,9 @@ type VolunteerUpdateRequest struct {
 	// 头像URL 可选 @gotags: json:"avatarUrl"
 	AvatarUrl string `protobuf:"bytes,5,opt,name=avatarUrl,proto3" json:"avatarUrl"`
 	// 个人简介 可选 @gotags: json:"introduction"
-	Introduction  string `protobuf:"bytes,6,opt,name=introduction,proto3" json:"introduction"`
+	Introduction string `protobuf:"bytes,6,opt,name=introduction,proto3" json:"introduction"`
+	// 技能标签（整体覆盖） 可选 @gotags: json:"skills"
+	Skills        []string `protobuf:"bytes,7,rep,name=skills,proto3" json:"skills"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -729,6 +740,13 @@ func (x *VolunteerUpdateRequest) GetIntroduction() string {
 	return ""
 }
 
+func (x *VolunteerUpdateRequest) GetSkills() []string {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
 // VolunteerUpdateResponse 更新志愿者响应
 type VolunteerUpdateResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -884,86 +902,1070 @@ func (x *BaseVolunteer) GetLeaveDate() string {
 	return ""
 }
 
-var File_internal_api_volunteer_proto protoreflect.FileDescriptor
+// CreditScoreLogListRequest 信用分流水查询请求
+type CreditScoreLogListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 页码 可选 @gotags: query:"page"
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page" query:"page"`
+	// 页大小 可选 @gotags: query:"pageSize"
+	PageSize int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize" query:"pageSize"`
+	// 活动ID 可选 @gotags: json:"activityId"
+	ActivityId int64 `protobuf:"varint,3,opt,name=activityId,proto3" json:"activityId"`
+	// 志愿者ID（仅组织侧生效）可选 @gotags: json:"volunteerId"
+	VolunteerId int64 `protobuf:"varint,4,opt,name=volunteerId,proto3" json:"volunteerId"`
+	// 变动类型: 1-爽约扣分,2-连续出勤奖励,3-爽约撤销返还 可选 @gotags: json:"changeType"
+	ChangeType    int32 `protobuf:"varint,5,opt,name=changeType,proto3" json:"changeType"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_internal_api_volunteer_proto_rawDesc = "" +
-	"\n" +
-	"\x1cinternal/api/volunteer.proto\x12\tvolunteer\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\"`\n" +
-	"\x14VolunteerListRequest\x12\x18\n" +
-	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x12\n" +
-	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
-	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\"_\n" +
-	"\x15VolunteerListResponse\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x05R\x05total\x120\n" +
-	"\x04list\x18\x02 \x03(\v2\x1c.volunteer.VolunteerListItemR\x04list\"\xef\x02\n" +
-	"\x11VolunteerListItem\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
-	"\taccountId\x18\x02 \x01(\x03R\taccountId\x12\x1a\n" +
-	"\brealName\x18\x03 \x01(\tR\brealName\x12\x16\n" +
-	"\x06gender\x18\x04 \x01(\x05R\x06gender\x12\x1c\n" +
-	"\tavatarUrl\x18\x05 \x01(\tR\tavatarUrl\x12\x1e\n" +
-	"\n" +
-	"totalHours\x18\x06 \x01(\x01R\n" +
-	"totalHours\x12\"\n" +
-	"\fserviceCount\x18\a \x01(\x05R\fserviceCount\x12 \n" +
-	"\vcreditScore\x18\b \x01(\x05R\vcreditScore\x12 \n" +
-	"\vauditStatus\x18\t \x01(\x05R\vauditStatus\x12\x1c\n" +
-	"\tcreatedAt\x18\n" +
-	" \x01(\tR\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\v \x01(\tR\tupdatedAt\x12\x16\n" +
-	"\x06status\x18\f \x01(\x05R\x06status\"(\n" +
-	"\x16VolunteerDetailRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"Q\n" +
-	"\x17VolunteerDetailResponse\x126\n" +
-	"\tvolunteer\x18\x01 \x01(\v2\x18.volunteer.VolunteerInfoR\tvolunteer\"\"\n" +
-	"\x10MyProfileRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"K\n" +
-	"\x11MyProfileResponse\x126\n" +
-	"\tvolunteer\x18\x01 \x01(\v2\x18.volunteer.VolunteerInfoR\tvolunteer\"\xc3\x03\n" +
-	"\rVolunteerInfo\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
-	"\taccountId\x18\x02 \x01(\x03R\taccountId\x12\x1a\n" +
-	"\brealName\x18\x03 \x01(\tR\brealName\x12\x16\n" +
-	"\x06gender\x18\x04 \x01(\x05R\x06gender\x12\x1a\n" +
-	"\bbirthday\x18\x05 \x01(\tR\bbirthday\x12\x16\n" +
-	"\x06idCard\x18\x06 \x01(\tR\x06idCard\x12\x1c\n" +
-	"\tavatarUrl\x18\a \x01(\tR\tavatarUrl\x12\"\n" +
-	"\fintroduction\x18\b \x01(\tR\fintroduction\x12\x1e\n" +
-	"\n" +
-	"totalHours\x18\t \x01(\x01R\n" +
-	"totalHours\x12\"\n" +
-	"\fserviceCount\x18\n" +
-	" \x01(\x05R\fserviceCount\x12 \n" +
-	"\vcreditScore\x18\v \x01(\x05R\vcreditScore\x12 \n" +
-	"\vauditStatus\x18\f \x01(\x05R\vauditStatus\x12\x1c\n" +
-	"\tcreatedAt\x18\r \x01(\tR\tcreatedAt\x12\x1c\n" +
-	"\tupdatedAt\x18\x0e \x01(\tR\tupdatedAt\x12\x16\n" +
-	"\x06status\x18\x0f \x01(\x05R\x06status\"\xcc\x01\n" +
-	"\x16VolunteerUpdateRequest\x12 \n" +
-	"\vvolunteerId\x18\x01 \x01(\x03R\vvolunteerId\x12\x1a\n" +
-	"\brealName\x18\x02 \x01(\tR\brealName\x12\x16\n" +
-	"\x06gender\x18\x03 \x01(\x05R\x06gender\x12\x1a\n" +
-	"\bbirthday\x18\x04 \x01(\tR\bbirthday\x12\x1c\n" +
-	"\tavatarUrl\x18\x05 \x01(\tR\tavatarUrl\x12\"\n" +
-	"\fintroduction\x18\x06 \x01(\tR\fintroduction\"\x19\n" +
-	"\x17VolunteerUpdateResponse\"\xff\x01\n" +
-	"\rBaseVolunteer\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12$\n" +
-	"\rvolunteerCode\x18\x02 \x01(\tR\rvolunteerCode\x12\x14\n" +
-	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
-	"\x05email\x18\x04 \x01(\tR\x05email\x12\x16\n" +
-	"\x06gender\x18\x05 \x01(\tR\x06gender\x12\x16\n" +
-	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1e\n" +
-	"\n" +
-	"statusName\x18\a \x01(\tR\n" +
-	"statusName\x12\x1a\n" +
-	"\bjoinDate\x18\b \x01(\tR\bjoinDate\x12\x1c\n" +
-	"\tleaveDate\x18\t \x01(\tR\tleaveDate2\xfd\x03\n" +
+func (x *CreditScoreLogListRequest) Reset() {
+	*x = CreditScoreLogListRequest{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditScoreLogListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditScoreLogListRequest) ProtoMessage() {}
+
+func (x *CreditScoreLogListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditScoreLogListRequest.ProtoReflect.Descriptor instead.
+func (*CreditScoreLogListRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CreditScoreLogListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *CreditScoreLogListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *CreditScoreLogListRequest) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *CreditScoreLogListRequest) GetVolunteerId() int64 {
+	if x != nil {
+		return x.VolunteerId
+	}
+	return 0
+}
+
+func (x *CreditScoreLogListRequest) GetChangeType() int32 {
+	if x != nil {
+		return x.ChangeType
+	}
+	return 0
+}
+
+// CreditScoreLogListResponse 信用分流水查询响应
+type CreditScoreLogListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	List          []*CreditScoreLogItem  `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditScoreLogListResponse) Reset() {
+	*x = CreditScoreLogListResponse{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditScoreLogListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditScoreLogListResponse) ProtoMessage() {}
+
+func (x *CreditScoreLogListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditScoreLogListResponse.ProtoReflect.Descriptor instead.
+func (*CreditScoreLogListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CreditScoreLogListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *CreditScoreLogListResponse) GetList() []*CreditScoreLogItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// CreditScoreLogItem 信用分流水项
+type CreditScoreLogItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 流水ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 志愿者ID（volunteers.id）
+	VolunteerId int64 `protobuf:"varint,2,opt,name=volunteerId,proto3" json:"volunteerId"`
+	// 活动ID
+	ActivityId int64 `protobuf:"varint,3,opt,name=activityId,proto3" json:"activityId"`
+	// 报名ID
+	SignupId int64 `protobuf:"varint,4,opt,name=signupId,proto3" json:"signupId"`
+	// 变动类型: 1-爽约扣分,2-连续出勤奖励,3-爽约撤销返还
+	ChangeType int32 `protobuf:"varint,5,opt,name=changeType,proto3" json:"changeType"`
+	// 信用分增量（扣分为负数）
+	ScoreDelta int32 `protobuf:"varint,6,opt,name=scoreDelta,proto3" json:"scoreDelta"`
+	// 变更前信用分
+	BeforeScore int32 `protobuf:"varint,7,opt,name=beforeScore,proto3" json:"beforeScore"`
+	// 变更后信用分
+	AfterScore int32 `protobuf:"varint,8,opt,name=afterScore,proto3" json:"afterScore"`
+	// 关联原流水ID（撤销场景）
+	RefLogId int64 `protobuf:"varint,9,opt,name=refLogId,proto3" json:"refLogId"`
+	// 变动原因
+	Reason string `protobuf:"bytes,10,opt,name=reason,proto3" json:"reason"`
+	// 操作人账号ID（系统自动结算为0）
+	OperatorId int64 `protobuf:"varint,11,opt,name=operatorId,proto3" json:"operatorId"`
+	// 创建时间
+	CreatedAt     string `protobuf:"bytes,12,opt,name=createdAt,proto3" json:"createdAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreditScoreLogItem) Reset() {
+	*x = CreditScoreLogItem{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreditScoreLogItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreditScoreLogItem) ProtoMessage() {}
+
+func (x *CreditScoreLogItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreditScoreLogItem.ProtoReflect.Descriptor instead.
+func (*CreditScoreLogItem) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreditScoreLogItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetVolunteerId() int64 {
+	if x != nil {
+		return x.VolunteerId
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetActivityId() int64 {
+	if x != nil {
+		return x.ActivityId
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetSignupId() int64 {
+	if x != nil {
+		return x.SignupId
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetChangeType() int32 {
+	if x != nil {
+		return x.ChangeType
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetScoreDelta() int32 {
+	if x != nil {
+		return x.ScoreDelta
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetBeforeScore() int32 {
+	if x != nil {
+		return x.BeforeScore
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetAfterScore() int32 {
+	if x != nil {
+		return x.AfterScore
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetRefLogId() int64 {
+	if x != nil {
+		return x.RefLogId
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CreditScoreLogItem) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CreditScoreLogItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+// ImportVolunteersRequest 批量导入志愿者请求（文件通过 multipart 字段 file 上传）
+type ImportVolunteersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 是否同时加入本组织（正式成员）可选 @gotags: form:"joinOrg"
+	JoinOrg bool `protobuf:"varint,1,opt,name=joinOrg,proto3" json:"joinOrg" form:"joinOrg"`
+	// 默认登录密码（文件未提供密码列时使用）可选 @gotags: form:"defaultPassword"
+	DefaultPassword string `protobuf:"bytes,2,opt,name=defaultPassword,proto3" json:"defaultPassword" form:"defaultPassword"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ImportVolunteersRequest) Reset() {
+	*x = ImportVolunteersRequest{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVolunteersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVolunteersRequest) ProtoMessage() {}
+
+func (x *ImportVolunteersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVolunteersRequest.ProtoReflect.Descriptor instead.
+func (*ImportVolunteersRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ImportVolunteersRequest) GetJoinOrg() bool {
+	if x != nil {
+		return x.JoinOrg
+	}
+	return false
+}
+
+func (x *ImportVolunteersRequest) GetDefaultPassword() string {
+	if x != nil {
+		return x.DefaultPassword
+	}
+	return ""
+}
+
+// ImportVolunteersResponse 批量导入志愿者响应（导入在异步任务中执行，通过 /api/jobs/detail/:id 查询进度与结果）
+type ImportVolunteersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 异步任务ID
+	JobId int64 `protobuf:"varint,1,opt,name=jobId,proto3" json:"jobId"`
+	// 数据行数
+	TotalRows     int32 `protobuf:"varint,2,opt,name=totalRows,proto3" json:"totalRows"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportVolunteersResponse) Reset() {
+	*x = ImportVolunteersResponse{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportVolunteersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportVolunteersResponse) ProtoMessage() {}
+
+func (x *ImportVolunteersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportVolunteersResponse.ProtoReflect.Descriptor instead.
+func (*ImportVolunteersResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportVolunteersResponse) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *ImportVolunteersResponse) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+// VolunteerImportBatchListRequest 导入批次列表请求
+type VolunteerImportBatchListRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 页码 可选 @gotags: json:"page"
+	Page int32 `protobuf:"varint,1,opt,name=page,proto3" json:"page"`
+	// 页大小 可选 @gotags: json:"pageSize"
+	PageSize      int32 `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolunteerImportBatchListRequest) Reset() {
+	*x = VolunteerImportBatchListRequest{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolunteerImportBatchListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolunteerImportBatchListRequest) ProtoMessage() {}
+
+func (x *VolunteerImportBatchListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolunteerImportBatchListRequest.ProtoReflect.Descriptor instead.
+func (*VolunteerImportBatchListRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *VolunteerImportBatchListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *VolunteerImportBatchListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// VolunteerImportBatchListResponse 导入批次列表响应
+type VolunteerImportBatchListResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Total         int32                       `protobuf:"varint,1,opt,name=total,proto3" json:"total"`
+	List          []*VolunteerImportBatchItem `protobuf:"bytes,2,rep,name=list,proto3" json:"list"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolunteerImportBatchListResponse) Reset() {
+	*x = VolunteerImportBatchListResponse{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolunteerImportBatchListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolunteerImportBatchListResponse) ProtoMessage() {}
+
+func (x *VolunteerImportBatchListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolunteerImportBatchListResponse.ProtoReflect.Descriptor instead.
+func (*VolunteerImportBatchListResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *VolunteerImportBatchListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *VolunteerImportBatchListResponse) GetList() []*VolunteerImportBatchItem {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// VolunteerImportBatchItem 导入批次
+type VolunteerImportBatchItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 批次ID
+	Id int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id"`
+	// 文件名
+	FileName string `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName"`
+	// 数据行数
+	TotalRows int32 `protobuf:"varint,3,opt,name=totalRows,proto3" json:"totalRows"`
+	// 成功行数
+	SuccessCount int32 `protobuf:"varint,4,opt,name=successCount,proto3" json:"successCount"`
+	// 失败行数
+	FailedCount int32 `protobuf:"varint,5,opt,name=failedCount,proto3" json:"failedCount"`
+	// 是否同时加入本组织
+	JoinOrg bool `protobuf:"varint,6,opt,name=joinOrg,proto3" json:"joinOrg"`
+	// 操作人账号ID
+	OperatorId int64 `protobuf:"varint,7,opt,name=operatorId,proto3" json:"operatorId"`
+	// 导入时间
+	CreatedAt string `protobuf:"bytes,8,opt,name=createdAt,proto3" json:"createdAt"`
+	// 异步任务ID
+	JobId         int64 `protobuf:"varint,9,opt,name=jobId,proto3" json:"jobId"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VolunteerImportBatchItem) Reset() {
+	*x = VolunteerImportBatchItem{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VolunteerImportBatchItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VolunteerImportBatchItem) ProtoMessage() {}
+
+func (x *VolunteerImportBatchItem) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VolunteerImportBatchItem.ProtoReflect.Descriptor instead.
+func (*VolunteerImportBatchItem) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *VolunteerImportBatchItem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *VolunteerImportBatchItem) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+func (x *VolunteerImportBatchItem) GetTotalRows() int32 {
+	if x != nil {
+		return x.TotalRows
+	}
+	return 0
+}
+
+func (x *VolunteerImportBatchItem) GetSuccessCount() int32 {
+	if x != nil {
+		return x.SuccessCount
+	}
+	return 0
+}
+
+func (x *VolunteerImportBatchItem) GetFailedCount() int32 {
+	if x != nil {
+		return x.FailedCount
+	}
+	return 0
+}
+
+func (x *VolunteerImportBatchItem) GetJoinOrg() bool {
+	if x != nil {
+		return x.JoinOrg
+	}
+	return false
+}
+
+func (x *VolunteerImportBatchItem) GetOperatorId() int64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *VolunteerImportBatchItem) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+func (x *VolunteerImportBatchItem) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+// DownloadVolunteerImportReceiptRequest 下载导入回执请求
+type DownloadVolunteerImportReceiptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 批次ID 必填 @gotags: path:"id,required"
+	Id            int64 `protobuf:"varint,1,opt,name=id,proto3" json:"id" path:"id,required"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadVolunteerImportReceiptRequest) Reset() {
+	*x = DownloadVolunteerImportReceiptRequest{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadVolunteerImportReceiptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadVolunteerImportReceiptRequest) ProtoMessage() {}
+
+func (x *DownloadVolunteerImportReceiptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadVolunteerImportReceiptRequest.ProtoReflect.Descriptor instead.
+func (*DownloadVolunteerImportReceiptRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DownloadVolunteerImportReceiptRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+// DownloadVolunteerImportReceiptResponse 导入回执文件（handler 直接输出文件内容）
+type DownloadVolunteerImportReceiptResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Content       []byte                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content"`
+	FileName      string                 `protobuf:"bytes,2,opt,name=fileName,proto3" json:"fileName"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadVolunteerImportReceiptResponse) Reset() {
+	*x = DownloadVolunteerImportReceiptResponse{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadVolunteerImportReceiptResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadVolunteerImportReceiptResponse) ProtoMessage() {}
+
+func (x *DownloadVolunteerImportReceiptResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadVolunteerImportReceiptResponse.ProtoReflect.Descriptor instead.
+func (*DownloadVolunteerImportReceiptResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *DownloadVolunteerImportReceiptResponse) GetContent() []byte {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+func (x *DownloadVolunteerImportReceiptResponse) GetFileName() string {
+	if x != nil {
+		return x.FileName
+	}
+	return ""
+}
+
+// SubmitVerificationRequest 提交实名认证请求
+type SubmitVerificationRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 真实姓名 必填 @gotags: json:"realName,required"
+	RealName string `protobuf:"bytes,1,opt,name=realName,proto3" json:"realName,required"`
+	// 18位居民身份证号 必填 @gotags: json:"idCard,required"
+	IdCard        string `protobuf:"bytes,2,opt,name=idCard,proto3" json:"idCard,required"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitVerificationRequest) Reset() {
+	*x = SubmitVerificationRequest{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitVerificationRequest) ProtoMessage() {}
+
+func (x *SubmitVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitVerificationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *SubmitVerificationRequest) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *SubmitVerificationRequest) GetIdCard() string {
+	if x != nil {
+		return x.IdCard
+	}
+	return ""
+}
+
+// MyVerificationRequest 查询实名认证状态请求
+type MyVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MyVerificationRequest) Reset() {
+	*x = MyVerificationRequest{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MyVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MyVerificationRequest) ProtoMessage() {}
+
+func (x *MyVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MyVerificationRequest.ProtoReflect.Descriptor instead.
+func (*MyVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{22}
+}
+
+// VerificationStatusResponse 实名认证状态
+type VerificationStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 实名认证状态: 0-未认证, 1-审核中, 2-已通过, 3-驳回
+	AuditStatus int32 `protobuf:"varint,1,opt,name=auditStatus,proto3" json:"auditStatus"`
+	// 真实姓名（脱敏）
+	RealName string `protobuf:"bytes,2,opt,name=realName,proto3" json:"realName"`
+	// 身份证号（脱敏）
+	IdCard string `protobuf:"bytes,3,opt,name=idCard,proto3" json:"idCard"`
+	// 最近一次审核记录ID
+	AuditRecordId int64 `protobuf:"varint,4,opt,name=auditRecordId,proto3" json:"auditRecordId"`
+	// 驳回原因
+	RejectReason string `protobuf:"bytes,5,opt,name=rejectReason,proto3" json:"rejectReason"`
+	// 提交时间
+	SubmittedAt   string `protobuf:"bytes,6,opt,name=submittedAt,proto3" json:"submittedAt"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerificationStatusResponse) Reset() {
+	*x = VerificationStatusResponse{}
+	mi := &file_internal_api_volunteer_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerificationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerificationStatusResponse) ProtoMessage() {}
+
+func (x *VerificationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_internal_api_volunteer_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerificationStatusResponse.ProtoReflect.Descriptor instead.
+func (*VerificationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_internal_api_volunteer_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *VerificationStatusResponse) GetAuditStatus() int32 {
+	if x != nil {
+		return x.AuditStatus
+	}
+	return 0
+}
+
+func (x *VerificationStatusResponse) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *VerificationStatusResponse) GetIdCard() string {
+	if x != nil {
+		return x.IdCard
+	}
+	return ""
+}
+
+func (x *VerificationStatusResponse) GetAuditRecordId() int64 {
+	if x != nil {
+		return x.AuditRecordId
+	}
+	return 0
+}
+
+func (x *VerificationStatusResponse) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *VerificationStatusResponse) GetSubmittedAt() string {
+	if x != nil {
+		return x.SubmittedAt
+	}
+	return ""
+}
+
+var File_internal_api_volunteer_proto protoreflect.FileDescriptor
+
+const file_internal_api_volunteer_proto_rawDesc = "" +
+	"\n" +
+	"\x1cinternal/api/volunteer.proto\x12\tvolunteer\x1a\x1cgoogle/api/annotations.proto\x1a\x17google/api/client.proto\"`\n" +
+	"\x14VolunteerListRequest\x12\x18\n" +
+	"\akeyword\x18\x01 \x01(\tR\akeyword\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\"_\n" +
+	"\x15VolunteerListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x120\n" +
+	"\x04list\x18\x02 \x03(\v2\x1c.volunteer.VolunteerListItemR\x04list\"\xef\x02\n" +
+	"\x11VolunteerListItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\x03R\taccountId\x12\x1a\n" +
+	"\brealName\x18\x03 \x01(\tR\brealName\x12\x16\n" +
+	"\x06gender\x18\x04 \x01(\x05R\x06gender\x12\x1c\n" +
+	"\tavatarUrl\x18\x05 \x01(\tR\tavatarUrl\x12\x1e\n" +
+	"\n" +
+	"totalHours\x18\x06 \x01(\x01R\n" +
+	"totalHours\x12\"\n" +
+	"\fserviceCount\x18\a \x01(\x05R\fserviceCount\x12 \n" +
+	"\vcreditScore\x18\b \x01(\x05R\vcreditScore\x12 \n" +
+	"\vauditStatus\x18\t \x01(\x05R\vauditStatus\x12\x1c\n" +
+	"\tcreatedAt\x18\n" +
+	" \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\v \x01(\tR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\f \x01(\x05R\x06status\"(\n" +
+	"\x16VolunteerDetailRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"Q\n" +
+	"\x17VolunteerDetailResponse\x126\n" +
+	"\tvolunteer\x18\x01 \x01(\v2\x18.volunteer.VolunteerInfoR\tvolunteer\"\"\n" +
+	"\x10MyProfileRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"K\n" +
+	"\x11MyProfileResponse\x126\n" +
+	"\tvolunteer\x18\x01 \x01(\v2\x18.volunteer.VolunteerInfoR\tvolunteer\"\xdb\x03\n" +
+	"\rVolunteerInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1c\n" +
+	"\taccountId\x18\x02 \x01(\x03R\taccountId\x12\x1a\n" +
+	"\brealName\x18\x03 \x01(\tR\brealName\x12\x16\n" +
+	"\x06gender\x18\x04 \x01(\x05R\x06gender\x12\x1a\n" +
+	"\bbirthday\x18\x05 \x01(\tR\bbirthday\x12\x16\n" +
+	"\x06idCard\x18\x06 \x01(\tR\x06idCard\x12\x1c\n" +
+	"\tavatarUrl\x18\a \x01(\tR\tavatarUrl\x12\"\n" +
+	"\fintroduction\x18\b \x01(\tR\fintroduction\x12\x1e\n" +
+	"\n" +
+	"totalHours\x18\t \x01(\x01R\n" +
+	"totalHours\x12\"\n" +
+	"\fserviceCount\x18\n" +
+	" \x01(\x05R\fserviceCount\x12 \n" +
+	"\vcreditScore\x18\v \x01(\x05R\vcreditScore\x12 \n" +
+	"\vauditStatus\x18\f \x01(\x05R\vauditStatus\x12\x1c\n" +
+	"\tcreatedAt\x18\r \x01(\tR\tcreatedAt\x12\x1c\n" +
+	"\tupdatedAt\x18\x0e \x01(\tR\tupdatedAt\x12\x16\n" +
+	"\x06status\x18\x0f \x01(\x05R\x06status\x12\x16\n" +
+	"\x06skills\x18\x10 \x03(\tR\x06skills\"\xe4\x01\n" +
+	"\x16VolunteerUpdateRequest\x12 \n" +
+	"\vvolunteerId\x18\x01 \x01(\x03R\vvolunteerId\x12\x1a\n" +
+	"\brealName\x18\x02 \x01(\tR\brealName\x12\x16\n" +
+	"\x06gender\x18\x03 \x01(\x05R\x06gender\x12\x1a\n" +
+	"\bbirthday\x18\x04 \x01(\tR\bbirthday\x12\x1c\n" +
+	"\tavatarUrl\x18\x05 \x01(\tR\tavatarUrl\x12\"\n" +
+	"\fintroduction\x18\x06 \x01(\tR\fintroduction\x12\x16\n" +
+	"\x06skills\x18\a \x03(\tR\x06skills\"\x19\n" +
+	"\x17VolunteerUpdateResponse\"\xff\x01\n" +
+	"\rBaseVolunteer\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12$\n" +
+	"\rvolunteerCode\x18\x02 \x01(\tR\rvolunteerCode\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x16\n" +
+	"\x06gender\x18\x05 \x01(\tR\x06gender\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12\x1e\n" +
+	"\n" +
+	"statusName\x18\a \x01(\tR\n" +
+	"statusName\x12\x1a\n" +
+	"\bjoinDate\x18\b \x01(\tR\bjoinDate\x12\x1c\n" +
+	"\tleaveDate\x18\t \x01(\tR\tleaveDate\"\xad\x01\n" +
+	"\x19CreditScoreLogListRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x05R\bpageSize\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\x03 \x01(\x03R\n" +
+	"activityId\x12 \n" +
+	"\vvolunteerId\x18\x04 \x01(\x03R\vvolunteerId\x12\x1e\n" +
+	"\n" +
+	"changeType\x18\x05 \x01(\x05R\n" +
+	"changeType\"e\n" +
+	"\x1aCreditScoreLogListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x121\n" +
+	"\x04list\x18\x02 \x03(\v2\x1d.volunteer.CreditScoreLogItemR\x04list\"\xf6\x02\n" +
+	"\x12CreditScoreLogItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12 \n" +
+	"\vvolunteerId\x18\x02 \x01(\x03R\vvolunteerId\x12\x1e\n" +
+	"\n" +
+	"activityId\x18\x03 \x01(\x03R\n" +
+	"activityId\x12\x1a\n" +
+	"\bsignupId\x18\x04 \x01(\x03R\bsignupId\x12\x1e\n" +
+	"\n" +
+	"changeType\x18\x05 \x01(\x05R\n" +
+	"changeType\x12\x1e\n" +
+	"\n" +
+	"scoreDelta\x18\x06 \x01(\x05R\n" +
+	"scoreDelta\x12 \n" +
+	"\vbeforeScore\x18\a \x01(\x05R\vbeforeScore\x12\x1e\n" +
+	"\n" +
+	"afterScore\x18\b \x01(\x05R\n" +
+	"afterScore\x12\x1a\n" +
+	"\brefLogId\x18\t \x01(\x03R\brefLogId\x12\x16\n" +
+	"\x06reason\x18\n" +
+	" \x01(\tR\x06reason\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\v \x01(\x03R\n" +
+	"operatorId\x12\x1c\n" +
+	"\tcreatedAt\x18\f \x01(\tR\tcreatedAt\"]\n" +
+	"\x17ImportVolunteersRequest\x12\x18\n" +
+	"\ajoinOrg\x18\x01 \x01(\bR\ajoinOrg\x12(\n" +
+	"\x0fdefaultPassword\x18\x02 \x01(\tR\x0fdefaultPassword\"N\n" +
+	"\x18ImportVolunteersResponse\x12\x14\n" +
+	"\x05jobId\x18\x01 \x01(\x03R\x05jobId\x12\x1c\n" +
+	"\ttotalRows\x18\x02 \x01(\x05R\ttotalRows\"Q\n" +
+	"\x1fVolunteerImportBatchListRequest\x12\x12\n" +
+	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x05R\bpageSize\"q\n" +
+	" VolunteerImportBatchListResponse\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x127\n" +
+	"\x04list\x18\x02 \x03(\v2#.volunteer.VolunteerImportBatchItemR\x04list\"\x98\x02\n" +
+	"\x18VolunteerImportBatchItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1a\n" +
+	"\bfileName\x18\x02 \x01(\tR\bfileName\x12\x1c\n" +
+	"\ttotalRows\x18\x03 \x01(\x05R\ttotalRows\x12\"\n" +
+	"\fsuccessCount\x18\x04 \x01(\x05R\fsuccessCount\x12 \n" +
+	"\vfailedCount\x18\x05 \x01(\x05R\vfailedCount\x12\x18\n" +
+	"\ajoinOrg\x18\x06 \x01(\bR\ajoinOrg\x12\x1e\n" +
+	"\n" +
+	"operatorId\x18\a \x01(\x03R\n" +
+	"operatorId\x12\x1c\n" +
+	"\tcreatedAt\x18\b \x01(\tR\tcreatedAt\x12\x14\n" +
+	"\x05jobId\x18\t \x01(\x03R\x05jobId\"7\n" +
+	"%DownloadVolunteerImportReceiptRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"^\n" +
+	"&DownloadVolunteerImportReceiptResponse\x12\x18\n" +
+	"\acontent\x18\x01 \x01(\fR\acontent\x12\x1a\n" +
+	"\bfileName\x18\x02 \x01(\tR\bfileName\"O\n" +
+	"\x19SubmitVerificationRequest\x12\x1a\n" +
+	"\brealName\x18\x01 \x01(\tR\brealName\x12\x16\n" +
+	"\x06idCard\x18\x02 \x01(\tR\x06idCard\"\x17\n" +
+	"\x15MyVerificationRequest\"\xde\x01\n" +
+	"\x1aVerificationStatusResponse\x12 \n" +
+	"\vauditStatus\x18\x01 \x01(\x05R\vauditStatus\x12\x1a\n" +
+	"\brealName\x18\x02 \x01(\tR\brealName\x12\x16\n" +
+	"\x06idCard\x18\x03 \x01(\tR\x06idCard\x12$\n" +
+	"\rauditRecordId\x18\x04 \x01(\x03R\rauditRecordId\x12\"\n" +
+	"\frejectReason\x18\x05 \x01(\tR\frejectReason\x12 \n" +
+	"\vsubmittedAt\x18\x06 \x01(\tR\vsubmittedAt2\xf7\n" +
+	"\n" +
 	"\x10VolunteerService\x12p\n" +
 	"\rVolunteerList\x12\x1f.volunteer.VolunteerListRequest\x1a .volunteer.VolunteerListResponse\"\x1c\x82\xd3\xe4\x93\x02\x16\"\x14/api/volunteers/list\x12|\n" +
 	"\x0fVolunteerDetail\x12!.volunteer.VolunteerDetailRequest\x1a\".volunteer.VolunteerDetailResponse\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/api/volunteers/detail/:id\x12n\n" +
 	"\tMyProfile\x12\x1b.volunteer.MyProfileRequest\x1a\x1c.volunteer.MyProfileResponse\"&\x82\xd3\xe4\x93\x02 \x12\x1e/api/volunteers/my/profile/:id\x12x\n" +
-	"\x0fVolunteerUpdate\x12!.volunteer.VolunteerUpdateRequest\x1a\".volunteer.VolunteerUpdateResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/volunteers/:id\x1a\x0f\xcaA\f0.0.0.0:8080B#Z!volunteer-system/internal/api;apib\x06proto3"
+	"\x0fVolunteerUpdate\x12!.volunteer.VolunteerUpdateRequest\x1a\".volunteer.VolunteerUpdateResponse\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/api/volunteers/:id\x12\x89\x01\n" +
+	"\x12CreditScoreLogList\x12$.volunteer.CreditScoreLogListRequest\x1a%.volunteer.CreditScoreLogListResponse\"&\x82\xd3\xe4\x93\x02 :\x01*\"\x1b/api/volunteers/credit-logs\x12~\n" +
+	"\x10ImportVolunteers\x12\".volunteer.ImportVolunteersRequest\x1a#.volunteer.ImportVolunteersResponse\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/api/volunteers/import\x12\x9e\x01\n" +
+	"\x18VolunteerImportBatchList\x12*.volunteer.VolunteerImportBatchListRequest\x1a+.volunteer.VolunteerImportBatchListResponse\")\x82\xd3\xe4\x93\x02#:\x01*\"\x1e/api/volunteers/import/batches\x12\xb1\x01\n" +
+	"\x1eDownloadVolunteerImportReceipt\x120.volunteer.DownloadVolunteerImportReceiptRequest\x1a1.volunteer.DownloadVolunteerImportReceiptResponse\"*\x82\xd3\xe4\x93\x02$\x12\"/api/volunteers/import/receipt/:id\x12\x91\x01\n" +
+	"\x12SubmitVerification\x12$.volunteer.SubmitVerificationRequest\x1a%.volunteer.VerificationStatusResponse\".\x82\xd3\xe4\x93\x02(:\x01*\"#/api/volunteers/verification/submit\x12\x82\x01\n" +
+	"\x0eMyVerification\x12 .volunteer.MyVerificationRequest\x1a%.volunteer.VerificationStatusResponse\"'\x82\xd3\xe4\x93\x02!\x12\x1f/api/volunteers/verification/my\x1a\x0f\xcaA\f0.0.0.0:8080B#Z!volunteer-system/internal/api;apib\x06proto3"
 
 var (
 	file_internal_api_volunteer_proto_rawDescOnce sync.Once
@@ -977,37 +1979,64 @@ func file_internal_api_volunteer_proto_rawDescGZIP() []byte {
 	return file_internal_api_volunteer_proto_rawDescData
 }
 
-var file_internal_api_volunteer_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_internal_api_volunteer_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_internal_api_volunteer_proto_goTypes = []any{
-	(*VolunteerListRequest)(nil),    // 0: volunteer.VolunteerListRequest
-	(*VolunteerListResponse)(nil),   // 1: volunteer.VolunteerListResponse
-	(*VolunteerListItem)(nil),       // 2: volunteer.VolunteerListItem
-	(*VolunteerDetailRequest)(nil),  // 3: volunteer.VolunteerDetailRequest
-	(*VolunteerDetailResponse)(nil), // 4: volunteer.VolunteerDetailResponse
-	(*MyProfileRequest)(nil),        // 5: volunteer.MyProfileRequest
-	(*MyProfileResponse)(nil),       // 6: volunteer.MyProfileResponse
-	(*VolunteerInfo)(nil),           // 7: volunteer.VolunteerInfo
-	(*VolunteerUpdateRequest)(nil),  // 8: volunteer.VolunteerUpdateRequest
-	(*VolunteerUpdateResponse)(nil), // 9: volunteer.VolunteerUpdateResponse
-	(*BaseVolunteer)(nil),           // 10: volunteer.BaseVolunteer
+	(*VolunteerListRequest)(nil),                   // 0: volunteer.VolunteerListRequest
+	(*VolunteerListResponse)(nil),                  // 1: volunteer.VolunteerListResponse
+	(*VolunteerListItem)(nil),                      // 2: volunteer.VolunteerListItem
+	(*VolunteerDetailRequest)(nil),                 // 3: volunteer.VolunteerDetailRequest
+	(*VolunteerDetailResponse)(nil),                // 4: volunteer.VolunteerDetailResponse
+	(*MyProfileRequest)(nil),                       // 5: volunteer.MyProfileRequest
+	(*MyProfileResponse)(nil),                      // 6: volunteer.MyProfileResponse
+	(*VolunteerInfo)(nil),                          // 7: volunteer.VolunteerInfo
+	(*VolunteerUpdateRequest)(nil),                 // 8: volunteer.VolunteerUpdateRequest
+	(*VolunteerUpdateResponse)(nil),                // 9: volunteer.VolunteerUpdateResponse
+	(*BaseVolunteer)(nil),                          // 10: volunteer.BaseVolunteer
+	(*CreditScoreLogListRequest)(nil),              // 11: volunteer.CreditScoreLogListRequest
+	(*CreditScoreLogListResponse)(nil),             // 12: volunteer.CreditScoreLogListResponse
+	(*CreditScoreLogItem)(nil),                     // 13: volunteer.CreditScoreLogItem
+	(*ImportVolunteersRequest)(nil),                // 14: volunteer.ImportVolunteersRequest
+	(*ImportVolunteersResponse)(nil),               // 15: volunteer.ImportVolunteersResponse
+	(*VolunteerImportBatchListRequest)(nil),        // 16: volunteer.VolunteerImportBatchListRequest
+	(*VolunteerImportBatchListResponse)(nil),       // 17: volunteer.VolunteerImportBatchListResponse
+	(*VolunteerImportBatchItem)(nil),               // 18: volunteer.VolunteerImportBatchItem
+	(*DownloadVolunteerImportReceiptRequest)(nil),  // 19: volunteer.DownloadVolunteerImportReceiptRequest
+	(*DownloadVolunteerImportReceiptResponse)(nil), // 20: volunteer.DownloadVolunteerImportReceiptResponse
+	(*SubmitVerificationRequest)(nil),              // 21: volunteer.SubmitVerificationRequest
+	(*MyVerificationRequest)(nil),                  // 22: volunteer.MyVerificationRequest
+	(*VerificationStatusResponse)(nil),             // 23: volunteer.VerificationStatusResponse
 }
 var file_internal_api_volunteer_proto_depIdxs = []int32{
-	2, // 0: volunteer.VolunteerListResponse.list:type_name -> volunteer.VolunteerListItem
-	7, // 1: volunteer.VolunteerDetailResponse.volunteer:type_name -> volunteer.VolunteerInfo
-	7, // 2: volunteer.MyProfileResponse.volunteer:type_name -> volunteer.VolunteerInfo
-	0, // 3: volunteer.VolunteerService.VolunteerList:input_type -> volunteer.VolunteerListRequest
-	3, // 4: volunteer.VolunteerService.VolunteerDetail:input_type -> volunteer.VolunteerDetailRequest
-	5, // 5: volunteer.VolunteerService.MyProfile:input_type -> volunteer.MyProfileRequest
-	8, // 6: volunteer.VolunteerService.VolunteerUpdate:input_type -> volunteer.VolunteerUpdateRequest
-	1, // 7: volunteer.VolunteerService.VolunteerList:output_type -> volunteer.VolunteerListResponse
-	4, // 8: volunteer.VolunteerService.VolunteerDetail:output_type -> volunteer.VolunteerDetailResponse
-	6, // 9: volunteer.VolunteerService.MyProfile:output_type -> volunteer.MyProfileResponse
-	9, // 10: volunteer.VolunteerService.VolunteerUpdate:output_type -> volunteer.VolunteerUpdateResponse
-	7, // [7:11] is the sub-list for method output_type
-	3, // [3:7] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	2,  // 0: volunteer.VolunteerListResponse.list:type_name -> volunteer.VolunteerListItem
+	7,  // 1: volunteer.VolunteerDetailResponse.volunteer:type_name -> volunteer.VolunteerInfo
+	7,  // 2: volunteer.MyProfileResponse.volunteer:type_name -> volunteer.VolunteerInfo
+	13, // 3: volunteer.CreditScoreLogListResponse.list:type_name -> volunteer.CreditScoreLogItem
+	18, // 4: volunteer.VolunteerImportBatchListResponse.list:type_name -> volunteer.VolunteerImportBatchItem
+	0,  // 5: volunteer.VolunteerService.VolunteerList:input_type -> volunteer.VolunteerListRequest
+	3,  // 6: volunteer.VolunteerService.VolunteerDetail:input_type -> volunteer.VolunteerDetailRequest
+	5,  // 7: volunteer.VolunteerService.MyProfile:input_type -> volunteer.MyProfileRequest
+	8,  // 8: volunteer.VolunteerService.VolunteerUpdate:input_type -> volunteer.VolunteerUpdateRequest
+	11, // 9: volunteer.VolunteerService.CreditScoreLogList:input_type -> volunteer.CreditScoreLogListRequest
+	14, // 10: volunteer.VolunteerService.ImportVolunteers:input_type -> volunteer.ImportVolunteersRequest
+	16, // 11: volunteer.VolunteerService.VolunteerImportBatchList:input_type -> volunteer.VolunteerImportBatchListRequest
+	19, // 12: volunteer.VolunteerService.DownloadVolunteerImportReceipt:input_type -> volunteer.DownloadVolunteerImportReceiptRequest
+	21, // 13: volunteer.VolunteerService.SubmitVerification:input_type -> volunteer.SubmitVerificationRequest
+	22, // 14: volunteer.VolunteerService.MyVerification:input_type -> volunteer.MyVerificationRequest
+	1,  // 15: volunteer.VolunteerService.VolunteerList:output_type -> volunteer.VolunteerListResponse
+	4,  // 16: volunteer.VolunteerService.VolunteerDetail:output_type -> volunteer.VolunteerDetailResponse
+	6,  // 17: volunteer.VolunteerService.MyProfile:output_type -> volunteer.MyProfileResponse
+	9,  // 18: volunteer.VolunteerService.VolunteerUpdate:output_type -> volunteer.VolunteerUpdateResponse
+	12, // 19: volunteer.VolunteerService.CreditScoreLogList:output_type -> volunteer.CreditScoreLogListResponse
+	15, // 20: volunteer.VolunteerService.ImportVolunteers:output_type -> volunteer.ImportVolunteersResponse
+	17, // 21: volunteer.VolunteerService.VolunteerImportBatchList:output_type -> volunteer.VolunteerImportBatchListResponse
+	20, // 22: volunteer.VolunteerService.DownloadVolunteerImportReceipt:output_type -> volunteer.DownloadVolunteerImportReceiptResponse
+	23, // 23: volunteer.VolunteerService.SubmitVerification:output_type -> volunteer.VerificationStatusResponse
+	23, // 24: volunteer.VolunteerService.MyVerification:output_type -> volunteer.VerificationStatusResponse
+	15, // [15:25] is the sub-list for method output_type
+	5,  // [5:15] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_internal_api_volunteer_proto_init() }
@@ -1021,7 +2050,7 @@ func file_internal_api_volunteer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_internal_api_volunteer_proto_rawDesc), len(file_internal_api_volunteer_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
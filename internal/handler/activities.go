@@ -7,6 +7,7 @@ import (
 	"volunteer-system/internal/service"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
 )
 
 // ActivityList 获取活动列表
@@ -54,6 +55,36 @@ func ActivityCancel(ctx context.Context, c *app.RequestContext) {
 	response.Success(c, data)
 }
 
+// ActivityWaitlistStatus 查询我的候补排队状态
+func ActivityWaitlistStatus(ctx context.Context, c *app.RequestContext) {
+	var req api.ActivityWaitlistStatusRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).ActivityWaitlistStatus(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// ActivityWaitlistLeave 退出候补队列
+func ActivityWaitlistLeave(ctx context.Context, c *app.RequestContext) {
+	var req api.ActivityWaitlistLeaveRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).ActivityWaitlistLeave(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
 // ActivityCheckIn 活动签到（志愿者侧）
 func ActivityCheckIn(ctx context.Context, c *app.RequestContext) {
 	var req api.ActivityCheckInRequest
@@ -191,6 +222,111 @@ func FinishActivity(ctx context.Context, c *app.RequestContext) {
 	response.Success(c, data)
 }
 
+// CreateActivitySeries 创建系列活动
+func CreateActivitySeries(ctx context.Context, c *app.RequestContext) {
+	var req api.CreateActivitySeriesRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).CreateActivitySeries(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// ActivitySeriesDetail 查询系列活动详情
+func ActivitySeriesDetail(ctx context.Context, c *app.RequestContext) {
+	var req api.ActivitySeriesDetailRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).ActivitySeriesDetail(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// ActivitySeriesSignup 报名系列活动
+func ActivitySeriesSignup(ctx context.Context, c *app.RequestContext) {
+	var req api.ActivitySeriesSignupRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).ActivitySeriesSignup(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// ActivitySlotList 查询活动班次岗位列表
+func ActivitySlotList(ctx context.Context, c *app.RequestContext) {
+	var req api.ActivitySlotListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).ActivitySlotList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// CreateActivitySlot 创建活动班次岗位（组织侧）
+func CreateActivitySlot(ctx context.Context, c *app.RequestContext) {
+	var req api.CreateActivitySlotRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).CreateActivitySlot(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// UpdateActivitySlot 更新活动班次岗位（组织侧）
+func UpdateActivitySlot(ctx context.Context, c *app.RequestContext) {
+	var req api.UpdateActivitySlotRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).UpdateActivitySlot(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// DeleteActivitySlot 删除活动班次岗位（组织侧）
+func DeleteActivitySlot(ctx context.Context, c *app.RequestContext) {
+	var req api.DeleteActivitySlotRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).DeleteActivitySlot(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
 // GenerateAttendanceCodes 生成签到码/签退码（组织侧）
 func GenerateAttendanceCodes(ctx context.Context, c *app.RequestContext) {
 	var req api.GenerateAttendanceCodesRequest
@@ -236,6 +372,23 @@ func GetActivityAttendanceCodes(ctx context.Context, c *app.RequestContext) {
 	response.Success(c, data)
 }
 
+// AttendanceQRCode 获取签到/签退二维码图片（组织侧）
+func AttendanceQRCode(ctx context.Context, c *app.RequestContext) {
+	var req api.AttendanceQRCodeRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).AttendanceQRCode(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	c.Response.Header.Set("Cache-Control", "no-store")
+	c.Response.Header.Set("X-QRCode-Expire-At", data.ExpireAt)
+	c.Data(consts.StatusOK, data.ContentType, data.Image)
+}
+
 // ActivitySupplementAttendance 活动签到签退补录（组织侧）
 func ActivitySupplementAttendance(ctx context.Context, c *app.RequestContext) {
 	var req api.ActivitySupplementAttendanceRequest
@@ -250,3 +403,48 @@ func ActivitySupplementAttendance(ctx context.Context, c *app.RequestContext) {
 	}
 	response.Success(c, data)
 }
+
+// ActivityBatchAttendance 批量签到签退补录（组织侧）
+func ActivityBatchAttendance(ctx context.Context, c *app.RequestContext) {
+	var req api.ActivityBatchAttendanceRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).ActivityBatchAttendance(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// PendingSettlementList 待确认结算的报名列表（组织侧）
+func PendingSettlementList(ctx context.Context, c *app.RequestContext) {
+	var req api.PendingSettlementListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).PendingSettlementList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// ConfirmPendingSettlement 确认待结算报名（组织侧）
+func ConfirmPendingSettlement(ctx context.Context, c *app.RequestContext) {
+	var req api.ConfirmPendingSettlementRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewActivityService(ctx, c).ConfirmPendingSettlement(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
@@ -0,0 +1,70 @@
+package handler
+
+import (
+	"context"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/response"
+	"volunteer-system/internal/service"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// AdminAccountList 平台账号列表
+func AdminAccountList(ctx context.Context, c *app.RequestContext) {
+	var req api.AdminAccountListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewAdminService(ctx, c).AdminAccountList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// AdminUpdateAccountStatus 启用/禁用账号
+func AdminUpdateAccountStatus(ctx context.Context, c *app.RequestContext) {
+	var req api.AdminUpdateAccountStatusRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewAdminService(ctx, c).AdminUpdateAccountStatus(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// AdminOrganizationList 平台组织列表
+func AdminOrganizationList(ctx context.Context, c *app.RequestContext) {
+	var req api.AdminOrganizationListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewAdminService(ctx, c).AdminOrganizationList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// AdminAuditList 平台审核列表
+func AdminAuditList(ctx context.Context, c *app.RequestContext) {
+	var req api.AdminAuditListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewAdminService(ctx, c).AdminAuditList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
@@ -24,6 +24,22 @@ func PendingVolunteerJoinOrgAuditList(ctx context.Context, c *app.RequestContext
 	response.Success(c, data)
 }
 
+// AuditInbox 统一审核收件箱
+func AuditInbox(ctx context.Context, c *app.RequestContext) {
+	var req api.AuditInboxRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+
+	data, err := service.NewAuditService(ctx, c).AuditInbox(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
 func AuditApproval(ctx context.Context, c *app.RequestContext) {
 	var req api.AuditApprovalRequest
 	if err := c.BindAndValidate(&req); err != nil {
@@ -54,6 +70,38 @@ func AuditRejection(ctx context.Context, c *app.RequestContext) {
 	response.Success(c, data)
 }
 
+// BatchAuditApproval 批量审核通过
+func BatchAuditApproval(ctx context.Context, c *app.RequestContext) {
+	var req api.BatchAuditRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+
+	data, err := service.NewAuditService(ctx, c).BatchAuditApproval(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// BatchAuditRejection 批量审核驳回
+func BatchAuditRejection(ctx context.Context, c *app.RequestContext) {
+	var req api.BatchAuditRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+
+	data, err := service.NewAuditService(ctx, c).BatchAuditRejection(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
 func AuditRecordDetail(ctx context.Context, c *app.RequestContext) {
 	var req api.AuditRecordDetailRequest
 	if err := c.BindAndValidate(&req); err != nil {
@@ -68,3 +116,67 @@ func AuditRecordDetail(ctx context.Context, c *app.RequestContext) {
 	}
 	response.Success(c, data)
 }
+
+// ListAuditApprovalChains 查询审核审批链配置
+func ListAuditApprovalChains(ctx context.Context, c *app.RequestContext) {
+	var req api.ListAuditApprovalChainsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+
+	data, err := service.NewAuditService(ctx, c).ListAuditApprovalChains(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// SaveAuditApprovalChain 保存审核审批链
+func SaveAuditApprovalChain(ctx context.Context, c *app.RequestContext) {
+	var req api.SaveAuditApprovalChainRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+
+	data, err := service.NewAuditService(ctx, c).SaveAuditApprovalChain(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// ListAuditReminders 查询当前账号的审核超时提醒
+func ListAuditReminders(ctx context.Context, c *app.RequestContext) {
+	var req api.ListAuditRemindersRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+
+	data, err := service.NewAuditService(ctx, c).ListAuditReminders(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// ReadAuditReminders 标记审核超时提醒已读
+func ReadAuditReminders(ctx context.Context, c *app.RequestContext) {
+	var req api.ReadAuditRemindersRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+
+	data, err := service.NewAuditService(ctx, c).ReadAuditReminders(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
@@ -0,0 +1,165 @@
+package handler
+
+import (
+	"context"
+	"net/url"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/response"
+	"volunteer-system/internal/service"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// IssueCertificate 签发志愿服务证书
+func IssueCertificate(ctx context.Context, c *app.RequestContext) {
+	var req api.IssueCertificateRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).IssueCertificate(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// CertificateList 证书签发记录查询
+func CertificateList(ctx context.Context, c *app.RequestContext) {
+	var req api.CertificateListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).CertificateList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// VerifyCertificate 证书公开核验（无需登录）
+func VerifyCertificate(ctx context.Context, c *app.RequestContext) {
+	var req api.VerifyCertificateRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).VerifyCertificate(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// DownloadCertificate 下载证书 PDF
+func DownloadCertificate(ctx context.Context, c *app.RequestContext) {
+	var req api.DownloadCertificateRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).DownloadCertificate(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	c.Response.Header.Set("Content-Disposition", "attachment; filename*=UTF-8''"+url.PathEscape(data.FileName))
+	c.Data(consts.StatusOK, "application/pdf", data.Content)
+}
+
+// CreateCertificateTemplate 创建证书模板（组织侧）
+func CreateCertificateTemplate(ctx context.Context, c *app.RequestContext) {
+	var req api.CreateCertificateTemplateRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).CreateCertificateTemplate(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// UpdateCertificateTemplate 修改证书模板（组织侧）
+func UpdateCertificateTemplate(ctx context.Context, c *app.RequestContext) {
+	var req api.UpdateCertificateTemplateRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).UpdateCertificateTemplate(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// DeleteCertificateTemplate 删除证书模板（组织侧）
+func DeleteCertificateTemplate(ctx context.Context, c *app.RequestContext) {
+	var req api.DeleteCertificateTemplateRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).DeleteCertificateTemplate(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// CertificateTemplateList 证书模板列表（组织侧）
+func CertificateTemplateList(ctx context.Context, c *app.RequestContext) {
+	var req api.CertificateTemplateListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).CertificateTemplateList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// CertificateTemplateDetail 证书模板详情（组织侧）
+func CertificateTemplateDetail(ctx context.Context, c *app.RequestContext) {
+	var req api.CertificateTemplateDetailRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).CertificateTemplateDetail(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// PreviewCertificateTemplate 预览证书模板（组织侧，输出示例 PDF）
+func PreviewCertificateTemplate(ctx context.Context, c *app.RequestContext) {
+	var req api.PreviewCertificateTemplateRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewCertificateService(ctx, c).PreviewCertificateTemplate(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	c.Response.Header.Set("Cache-Control", "no-store")
+	c.Response.Header.Set("Content-Disposition", "inline; filename*=UTF-8''"+url.PathEscape(data.FileName))
+	c.Data(consts.StatusOK, "application/pdf", data.Content)
+}
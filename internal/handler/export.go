@@ -0,0 +1,55 @@
+package handler
+
+import (
+	"context"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/response"
+	"volunteer-system/internal/service"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// ExportActivities 提交活动列表导出任务
+func ExportActivities(ctx context.Context, c *app.RequestContext) {
+	var req api.ExportActivitiesRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewExportService(ctx, c).ExportActivities(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// ExportActivitySignups 提交活动报名名单导出任务
+func ExportActivitySignups(ctx context.Context, c *app.RequestContext) {
+	var req api.ExportActivitySignupsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewExportService(ctx, c).ExportActivitySignups(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// ExportWorkHourLogs 提交工时流水导出任务
+func ExportWorkHourLogs(ctx context.Context, c *app.RequestContext) {
+	var req api.ExportWorkHourLogsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewExportService(ctx, c).ExportWorkHourLogs(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"context"
+	"net/url"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/response"
+	"volunteer-system/internal/service"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// JobList 我提交的异步任务列表
+func JobList(ctx context.Context, c *app.RequestContext) {
+	var req api.JobListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewJobService(ctx, c).JobList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// JobDetail 异步任务详情（状态、进度、结果）
+func JobDetail(ctx context.Context, c *app.RequestContext) {
+	var req api.JobDetailRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewJobService(ctx, c).JobDetail(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// CancelJob 取消异步任务
+func CancelJob(ctx context.Context, c *app.RequestContext) {
+	var req api.CancelJobRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewJobService(ctx, c).CancelJob(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// DownloadJobResult 下载异步任务结果文件（以文件流输出，不受响应体大小影响）
+func DownloadJobResult(ctx context.Context, c *app.RequestContext) {
+	var req api.DownloadJobResultRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewJobService(ctx, c).DownloadJobResult(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	c.Response.Header.Set("Content-Disposition", "attachment; filename*=UTF-8''"+url.PathEscape(data.FileName))
+	c.File(data.FilePath)
+}
@@ -0,0 +1,130 @@
+package handler
+
+import (
+	"context"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/response"
+	"volunteer-system/internal/service"
+
+	"github.com/cloudwego/hertz/pkg/app"
+)
+
+// InviteOrgAdmin 邀请组织协作管理员
+func InviteOrgAdmin(ctx context.Context, c *app.RequestContext) {
+	var req api.InviteOrgAdminRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrgAdminService(ctx, c).InviteOrgAdmin(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// OrgAdminList 组织协作管理员列表
+func OrgAdminList(ctx context.Context, c *app.RequestContext) {
+	var req api.OrgAdminListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrgAdminService(ctx, c).OrgAdminList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// UpdateOrgAdminRole 调整协作管理员角色
+func UpdateOrgAdminRole(ctx context.Context, c *app.RequestContext) {
+	var req api.UpdateOrgAdminRoleRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrgAdminService(ctx, c).UpdateOrgAdminRole(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// RevokeOrgAdmin 撤销协作管理员
+func RevokeOrgAdmin(ctx context.Context, c *app.RequestContext) {
+	var req api.OrgAdminIDRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrgAdminService(ctx, c).RevokeOrgAdmin(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// MyOrgAdminInvitations 我的协作管理邀请
+func MyOrgAdminInvitations(ctx context.Context, c *app.RequestContext) {
+	var req api.MyOrgAdminInvitationsRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrgAdminService(ctx, c).MyOrgAdminInvitations(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// AcceptOrgAdminInvitation 接受协作管理邀请
+func AcceptOrgAdminInvitation(ctx context.Context, c *app.RequestContext) {
+	var req api.OrgAdminIDRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrgAdminService(ctx, c).AcceptOrgAdminInvitation(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// DeclineOrgAdminInvitation 拒绝协作管理邀请
+func DeclineOrgAdminInvitation(ctx context.Context, c *app.RequestContext) {
+	var req api.OrgAdminIDRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrgAdminService(ctx, c).DeclineOrgAdminInvitation(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// TransferOrgOwnership 转让组织主账号
+func TransferOrgOwnership(ctx context.Context, c *app.RequestContext) {
+	var req api.TransferOrgOwnershipRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrgAdminService(ctx, c).TransferOrgOwnership(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
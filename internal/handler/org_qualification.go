@@ -0,0 +1,90 @@
+package handler
+
+import (
+	"context"
+	"net/url"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/response"
+	"volunteer-system/internal/service"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// UploadQualificationDocument 上传组织资质材料
+func UploadQualificationDocument(ctx context.Context, c *app.RequestContext) {
+	var req api.UploadQualificationDocumentRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	// 未上传文件时 file 为 nil，由 service 返回提示
+	file, _ := c.FormFile("file")
+	data, err := service.NewOrganizationService(ctx, c).UploadQualificationDocument(&req, file)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// DeleteQualificationDocument 删除尚未提交的资质材料
+func DeleteQualificationDocument(ctx context.Context, c *app.RequestContext) {
+	var req api.DeleteQualificationDocumentRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrganizationService(ctx, c).DeleteQualificationDocument(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// DownloadQualificationDocument 下载资质材料
+func DownloadQualificationDocument(ctx context.Context, c *app.RequestContext) {
+	var req api.DownloadQualificationDocumentRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrganizationService(ctx, c).DownloadQualificationDocument(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	c.Response.Header.Set("Content-Disposition", "attachment; filename*=UTF-8''"+url.PathEscape(data.FileName))
+	c.Data(consts.StatusOK, data.ContentType, data.Content)
+}
+
+// SubmitQualification 提交组织资质审核
+func SubmitQualification(ctx context.Context, c *app.RequestContext) {
+	var req api.SubmitQualificationRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrganizationService(ctx, c).SubmitQualification(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// QualificationStatus 查询组织资质认证状态
+func QualificationStatus(ctx context.Context, c *app.RequestContext) {
+	var req api.QualificationStatusRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewOrganizationService(ctx, c).QualificationStatus(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
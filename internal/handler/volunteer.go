@@ -2,11 +2,13 @@ package handler
 
 import (
 	"context"
+	"net/url"
 	"volunteer-system/internal/api"
 	"volunteer-system/internal/response"
 	"volunteer-system/internal/service"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
 )
 
 func VolunteerList(ctx context.Context, c *app.RequestContext) {
@@ -64,3 +66,92 @@ func VolunteerUpdate(ctx context.Context, c *app.RequestContext) {
 	}
 	response.Success(c, data)
 }
+
+// SubmitVerification 提交实名认证
+func SubmitVerification(ctx context.Context, c *app.RequestContext) {
+	var req api.SubmitVerificationRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewVolunteerService(ctx, c).SubmitVerification(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+// MyVerification 查询我的实名认证状态
+func MyVerification(ctx context.Context, c *app.RequestContext) {
+	var req api.MyVerificationRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewVolunteerService(ctx, c).MyVerification(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+func CreditScoreLogList(ctx context.Context, c *app.RequestContext) {
+	var req api.CreditScoreLogListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewVolunteerService(ctx, c).CreditScoreLogList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+func ImportVolunteers(ctx context.Context, c *app.RequestContext) {
+	var req api.ImportVolunteersRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	// 未上传文件时 file 为 nil，由 service 返回提示
+	file, _ := c.FormFile("file")
+	data, err := service.NewVolunteerService(ctx, c).ImportVolunteers(&req, file)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+func VolunteerImportBatchList(ctx context.Context, c *app.RequestContext) {
+	var req api.VolunteerImportBatchListRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewVolunteerService(ctx, c).VolunteerImportBatchList(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	response.Success(c, data)
+}
+
+func DownloadVolunteerImportReceipt(ctx context.Context, c *app.RequestContext) {
+	var req api.DownloadVolunteerImportReceiptRequest
+	if err := c.BindAndValidate(&req); err != nil {
+		response.Fail(c, err)
+		return
+	}
+	data, err := service.NewVolunteerService(ctx, c).DownloadVolunteerImportReceipt(&req)
+	if err != nil {
+		response.Fail(c, err)
+		return
+	}
+	c.Response.Header.Set("Content-Disposition", "attachment; filename*=UTF-8''"+url.PathEscape(data.FileName))
+	c.Data(consts.StatusOK, "text/csv; charset=utf-8", data.Content)
+}
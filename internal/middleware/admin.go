@@ -0,0 +1,41 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+
+	"volunteer-system/internal/model"
+	"volunteer-system/internal/repository"
+	"volunteer-system/internal/response"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// AdminAuth 平台管理员校验中间件，需在 Auth 之后使用。
+// 每次请求都查询账号身份与状态，管理员被禁用后立即失去后台权限。
+func AdminAuth() app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		userID, err := GetUserIDInt(c)
+		if err != nil {
+			response.FailWithCode(c, consts.StatusUnauthorized, errors.New("未登录"))
+			c.Abort()
+			return
+		}
+
+		repo := repository.NewRepository(ctx, c)
+		account, err := repo.FindByID(repo.DB, userID)
+		if err != nil || account.IdentityType != model.RegisterTypeAdminCode {
+			response.FailWithCode(c, consts.StatusForbidden, errors.New("无平台管理员权限"))
+			c.Abort()
+			return
+		}
+		if account.Status != model.SysAccountNormal {
+			response.FailWithCode(c, consts.StatusForbidden, errors.New("账号已被禁用"))
+			c.Abort()
+			return
+		}
+
+		c.Next(ctx)
+	}
+}
@@ -0,0 +1,201 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"volunteer-system/internal/model"
+	"volunteer-system/internal/repository"
+	"volunteer-system/internal/response"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"github.com/cloudwego/hertz/pkg/protocol/consts"
+)
+
+// 组织权限相关的上下文键与请求头
+const (
+	ActingOrgIDKey  = "acting_org_id"
+	OrgRoleKey      = "org_role"
+	ActingOrgHeader = "X-Org-Id"
+)
+
+// RequireOrgPermission 组织权限校验中间件，需在 Auth 之后使用。
+// 操作的组织取自请求头 X-Org-Id（或查询参数 orgId），组织账号未指定时默认为其名下组织；
+// 组织主账号拥有全部权限，协作管理员按授权角色、志愿者按正式成员角色判定是否具备该权限。
+// 校验通过后将组织ID与角色写入上下文，供服务层校验资源归属。
+func RequireOrgPermission(permission string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		userID, err := GetUserIDInt(c)
+		if err != nil {
+			response.FailWithCode(c, consts.StatusUnauthorized, errors.New("未登录"))
+			c.Abort()
+			return
+		}
+
+		orgID, err := requestedOrgID(c)
+		if err != nil {
+			response.Fail(c, err)
+			c.Abort()
+			return
+		}
+
+		repo := repository.NewRepository(ctx, c)
+		if authorizeOrgPermission(repo, c, userID, orgID, permission) {
+			c.Next(ctx)
+		}
+	}
+}
+
+// OptionalOrgPermission 志愿者与组织共用接口的组织权限校验中间件，需在 Auth 之后使用。
+// 请求指定了组织或当前账号为组织账号时按 RequireOrgPermission 校验；
+// 否则视为志愿者查看本人数据，不写入操作组织，由服务层按本人身份处理。
+func OptionalOrgPermission(permission string) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+		userID, err := GetUserIDInt(c)
+		if err != nil {
+			response.FailWithCode(c, consts.StatusUnauthorized, errors.New("未登录"))
+			c.Abort()
+			return
+		}
+
+		orgID, err := requestedOrgID(c)
+		if err != nil {
+			response.Fail(c, err)
+			c.Abort()
+			return
+		}
+
+		repo := repository.NewRepository(ctx, c)
+		if orgID <= 0 {
+			account, err := repo.FindByID(repo.DB, userID)
+			if err != nil {
+				response.FailWithCode(c, consts.StatusForbidden, errors.New("账号不存在"))
+				c.Abort()
+				return
+			}
+			if account.IdentityType != model.RegisterTypeOrganizationCode {
+				c.Next(ctx)
+				return
+			}
+		}
+		if authorizeOrgPermission(repo, c, userID, orgID, permission) {
+			c.Next(ctx)
+		}
+	}
+}
+
+// authorizeOrgPermission 校验账号在组织中具备指定权限并写入上下文，失败时输出错误响应并中止请求
+func authorizeOrgPermission(repo *repository.Repository, c *app.RequestContext, userID, orgID int64, permission string) bool {
+	orgID, role, err := resolveOrgRole(repo, userID, orgID)
+	if err != nil {
+		response.FailWithCode(c, consts.StatusForbidden, err)
+		c.Abort()
+		return false
+	}
+	if !model.OrgRoleHasPermission(role, permission) {
+		response.FailWithCode(c, consts.StatusForbidden, errors.New("无权执行该操作"))
+		c.Abort()
+		return false
+	}
+
+	c.Set(ActingOrgIDKey, orgID)
+	c.Set(OrgRoleKey, role)
+	return true
+}
+
+// requestedOrgID 解析请求指定的组织ID，未指定时返回 0
+func requestedOrgID(c *app.RequestContext) (int64, error) {
+	raw := string(c.GetHeader(ActingOrgHeader))
+	if raw == "" {
+		raw = c.Query("orgId")
+	}
+	if raw == "" {
+		return 0, nil
+	}
+	orgID, err := strconv.ParseInt(raw, 10, 64)
+	if err != nil || orgID <= 0 {
+		return 0, errors.New("组织ID格式错误")
+	}
+	return orgID, nil
+}
+
+// resolveOrgRole 计算账号在组织中的角色，返回实际操作的组织ID。
+// 角色来源依次为：组织主账号、有效的协作管理员授权、志愿者正式成员角色。
+func resolveOrgRole(repo *repository.Repository, accountID, orgID int64) (int64, int32, error) {
+	account, err := repo.FindByID(repo.DB, accountID)
+	if err != nil {
+		return 0, 0, errors.New("账号不存在")
+	}
+	if account.Status != model.SysAccountNormal {
+		return 0, 0, errors.New("账号已被禁用")
+	}
+	if account.IdentityType != model.RegisterTypeOrganizationCode && account.IdentityType != model.RegisterTypeVolunteerCode {
+		return 0, 0, errors.New("无权操作该组织")
+	}
+
+	if orgID <= 0 {
+		if account.IdentityType != model.RegisterTypeOrganizationCode {
+			return 0, 0, errors.New("请指定操作的组织")
+		}
+		org, err := repo.GetOrganizationByAccountID(repo.DB, accountID)
+		if err != nil {
+			return 0, 0, errors.New("组织信息不存在")
+		}
+		return org.ID, model.OrgRoleOwner, nil
+	}
+
+	org, err := repo.GetOrganizationByID(repo.DB, orgID)
+	if err != nil {
+		return 0, 0, errors.New("无权操作该组织")
+	}
+	if org.AccountID == accountID {
+		return org.ID, model.OrgRoleOwner, nil
+	}
+
+	grant, err := repo.FindOrgAdmin(repo.DB, org.ID, accountID)
+	if err != nil {
+		return 0, 0, errors.New("查询组织权限失败")
+	}
+	if grant != nil && grant.Status == model.OrgAdminStatusActive {
+		return org.ID, grant.Role, nil
+	}
+
+	if account.IdentityType == model.RegisterTypeVolunteerCode {
+		volunteer, err := repo.FindVolunteerByAccountID(repo.DB, accountID)
+		if err != nil || volunteer == nil {
+			return 0, 0, errors.New("志愿者信息不存在")
+		}
+		member, err := repo.FindMembershipByOrgAndVolunteer(repo.DB, org.ID, volunteer.ID)
+		if err == nil && member != nil && member.Status == model.MemberStatusActive {
+			return org.ID, member.Role, nil
+		}
+	}
+	return 0, 0, errors.New("无权操作该组织")
+}
+
+// GetActingOrgID 获取权限中间件解析出的操作组织ID，未经过权限中间件时返回 false
+func GetActingOrgID(c *app.RequestContext) (int64, bool) {
+	if c == nil {
+		return 0, false
+	}
+	value, exists := c.Get(ActingOrgIDKey)
+	if !exists {
+		return 0, false
+	}
+	orgID, ok := value.(int64)
+	return orgID, ok && orgID > 0
+}
+
+// GetOrgRole 获取当前账号在操作组织中的角色
+func GetOrgRole(c *app.RequestContext) (int32, bool) {
+	if c == nil {
+		return 0, false
+	}
+	value, exists := c.Get(OrgRoleKey)
+	if !exists {
+		return 0, false
+	}
+	role, ok := value.(int32)
+	return role, ok
+}
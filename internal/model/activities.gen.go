@@ -12,21 +12,29 @@ const TableNameActivity = "activities"
 
 // Activity 活动主表
 type Activity struct {
-	ID            int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                      // 主键ID
-	OrgID         int64     `gorm:"column:org_id;not null;comment:发布组织ID (关联organizations.id)" json:"org_id"`            // 发布组织ID (关联organizations.id)
-	Title         string    `gorm:"column:title;not null;comment:活动标题" json:"title"`                                     // 活动标题
-	Description   string    `gorm:"column:description;not null;comment:活动描述/副标题" json:"description"`                     // 活动描述/副标题
-	CoverURL      string    `gorm:"column:cover_url;not null;comment:活动封面图URL" json:"cover_url"`                         // 活动封面图URL
-	StartTime     time.Time `gorm:"column:start_time;not null;default:CURRENT_TIMESTAMP;comment:开始时间" json:"start_time"` // 开始时间
-	EndTime       time.Time `gorm:"column:end_time;not null;default:CURRENT_TIMESTAMP;comment:结束时间" json:"end_time"`     // 结束时间
-	Location      string    `gorm:"column:location;not null;comment:地点名称" json:"location"`                               // 地点名称
-	Address       string    `gorm:"column:address;not null;comment:详细地址" json:"address"`                                 // 详细地址
-	Duration      float64   `gorm:"column:duration;not null;default:0.0;comment:预估工时(小时)" json:"duration"`               // 预估工时(小时)
-	MaxPeople     int32     `gorm:"column:max_people;not null;comment:最大招募人数 (0表示不限)" json:"max_people"`                 // 最大招募人数 (0表示不限)
-	CurrentPeople int32     `gorm:"column:current_people;not null;comment:当前已报名人数(冗余字段)" json:"current_people"`          // 当前已报名人数(冗余字段)
-	Status        int32     `gorm:"column:status;not null;default:1;comment:状态: 1-报名中, 2-已结束, 3-已取消" json:"status"`      // 状态: 1-报名中, 2-已结束, 3-已取消
-	CreatedAt     time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
-	UpdatedAt     time.Time `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
+	ID               int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                                                              // 主键ID
+	OrgID            int64     `gorm:"column:org_id;not null;comment:发布组织ID (关联organizations.id)" json:"org_id"`                                                    // 发布组织ID (关联organizations.id)
+	SeriesID         int64     `gorm:"column:series_id;not null;comment:所属活动系列ID（0表示单次活动）" json:"series_id"`                                                        // 所属活动系列ID（0表示单次活动）
+	Title            string    `gorm:"column:title;not null;comment:活动标题" json:"title"`                                                                             // 活动标题
+	Description      string    `gorm:"column:description;not null;comment:活动描述/副标题" json:"description"`                                                             // 活动描述/副标题
+	CoverURL         string    `gorm:"column:cover_url;not null;comment:活动封面图URL" json:"cover_url"`                                                                 // 活动封面图URL
+	StartTime        time.Time `gorm:"column:start_time;not null;default:CURRENT_TIMESTAMP;comment:开始时间" json:"start_time"`                                         // 开始时间
+	EndTime          time.Time `gorm:"column:end_time;not null;default:CURRENT_TIMESTAMP;comment:结束时间" json:"end_time"`                                             // 结束时间
+	Location         string    `gorm:"column:location;not null;comment:地点名称" json:"location"`                                                                       // 地点名称
+	Address          string    `gorm:"column:address;not null;comment:详细地址" json:"address"`                                                                         // 详细地址
+	Latitude         float64   `gorm:"column:latitude;not null;comment:活动地点纬度" json:"latitude"`                                                                     // 活动地点纬度
+	Longitude        float64   `gorm:"column:longitude;not null;comment:活动地点经度" json:"longitude"`                                                                   // 活动地点经度
+	GeofenceRadius   int32     `gorm:"column:geofence_radius;not null;comment:签到围栏半径（米，0表示不启用）" json:"geofence_radius"`                                             // 签到围栏半径（米，0表示不启用）
+	GeofenceMode     int32     `gorm:"column:geofence_mode;not null;default:1;comment:围栏外处理方式: 1-拒绝签到, 2-允许并标记" json:"geofence_mode"`                               // 围栏外处理方式: 1-拒绝签到, 2-允许并标记
+	AutoSettlePolicy int32     `gorm:"column:auto_settle_policy;not null;default:2;comment:未签退自动结算策略: 1-按计划时长发放, 2-按结束时间发放, 3-不发放并标记待确认" json:"auto_settle_policy"` // 未签退自动结算策略: 1-按计划时长发放, 2-按结束时间发放, 3-不发放并标记待确认
+	RequireVerified  int32     `gorm:"column:require_verified;not null;comment:是否仅限实名认证志愿者报名: 0-否, 1-是" json:"require_verified"`                                    // 是否仅限实名认证志愿者报名: 0-否, 1-是
+	Duration         float64   `gorm:"column:duration;not null;default:0.0;comment:预估工时(小时)" json:"duration"`                                                       // 预估工时(小时)
+	MaxPeople        int32     `gorm:"column:max_people;not null;comment:最大招募人数 (0表示不限)" json:"max_people"`                                                         // 最大招募人数 (0表示不限)
+	CurrentPeople    int32     `gorm:"column:current_people;not null;comment:当前已报名人数(冗余字段)" json:"current_people"`                                                  // 当前已报名人数(冗余字段)
+	SignupClosed     int32     `gorm:"column:signup_closed;not null;comment:报名是否已截止: 0-否, 1-是" json:"signup_closed"`                                                // 报名是否已截止: 0-否, 1-是
+	Status           int32     `gorm:"column:status;not null;default:1;comment:状态: 1-报名中, 2-已结束, 3-已取消" json:"status"`                                              // 状态: 1-报名中, 2-已结束, 3-已取消
+	CreatedAt        time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                         // 创建时间
+	UpdatedAt        time.Time `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                         // 更新时间
 }
 
 // TableName Activity's table name
@@ -0,0 +1,37 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameActivitySeries = "activity_series"
+
+// ActivitySeries 活动系列表
+type ActivitySeries struct {
+	ID             int64      `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                                                      // 主键ID
+	OrgID          int64      `gorm:"column:org_id;not null;comment:发布组织ID（关联 organizations.id）" json:"org_id"`                                            // 发布组织ID（关联 organizations.id）
+	Title          string     `gorm:"column:title;not null;comment:系列标题（场次默认标题）" json:"title"`                                                             // 系列标题（场次默认标题）
+	Description    string     `gorm:"column:description;not null;comment:系列描述（场次默认描述）" json:"description"`                                                 // 系列描述（场次默认描述）
+	CoverURL       string     `gorm:"column:cover_url;not null;comment:封面图URL" json:"cover_url"`                                                           // 封面图URL
+	Location       string     `gorm:"column:location;not null;comment:地点名称" json:"location"`                                                               // 地点名称
+	Address        string     `gorm:"column:address;not null;comment:详细地址" json:"address"`                                                                 // 详细地址
+	Duration       float64    `gorm:"column:duration;not null;default:0;comment:每场预估工时(小时)" json:"duration"`                                               // 每场预估工时(小时)
+	MaxPeople      int32      `gorm:"column:max_people;not null;comment:每场最大招募人数 (0表示不限)" json:"max_people"`                                               // 每场最大招募人数 (0表示不限)
+	Rrule          string     `gorm:"column:rrule;not null;comment:重复规则（RRULE 子集：FREQ=WEEKLY|MONTHLY;INTERVAL;BYDAY;BYMONTHDAY;COUNT;UNTIL）" json:"rrule"` // 重复规则（RRULE 子集：FREQ=WEEKLY|MONTHLY;INTERVAL;BYDAY;BYMONTHDAY;COUNT;UNTIL）
+	FirstStartTime time.Time  `gorm:"column:first_start_time;not null;comment:首场开始时间" json:"first_start_time"`                                             // 首场开始时间
+	FirstEndTime   time.Time  `gorm:"column:first_end_time;not null;comment:首场结束时间" json:"first_end_time"`                                                 // 首场结束时间
+	UntilDate      *time.Time `gorm:"column:until_date;comment:系列截止日期（含当天）" json:"until_date"`                                                             // 系列截止日期（含当天）
+	Exdates        string     `gorm:"column:exdates;not null;comment:例外日期（YYYY-MM-DD，英文逗号分隔）" json:"exdates"`                                              // 例外日期（YYYY-MM-DD，英文逗号分隔）
+	Status         int32      `gorm:"column:status;not null;default:1;comment:系列状态：1-正常，2-已取消" json:"status"`                                              // 系列状态：1-正常，2-已取消
+	CreatedAt      time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                                 // 创建时间
+	UpdatedAt      time.Time  `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                                 // 更新时间
+}
+
+// TableName ActivitySeries's table name
+func (*ActivitySeries) TableName() string {
+	return TableNameActivitySeries
+}
@@ -0,0 +1,31 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameActivitySlot = "activity_slots"
+
+// ActivitySlot 活动班次岗位表
+type ActivitySlot struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                      // 主键ID
+	ActivityID     int64     `gorm:"column:activity_id;not null;comment:活动ID（关联 activities.id）" json:"activity_id"`       // 活动ID（关联 activities.id）
+	Name           string    `gorm:"column:name;not null;comment:班次/岗位名称，如：上午班-摄影" json:"name"`                           // 班次/岗位名称，如：上午班-摄影
+	RoleName       string    `gorm:"column:role_name;not null;comment:岗位角色，如：组长、摄影、分拣" json:"role_name"`                  // 岗位角色，如：组长、摄影、分拣
+	StartTime      time.Time `gorm:"column:start_time;not null;comment:班次开始时间" json:"start_time"`                         // 班次开始时间
+	EndTime        time.Time `gorm:"column:end_time;not null;comment:班次结束时间" json:"end_time"`                             // 班次结束时间
+	MaxPeople      int32     `gorm:"column:max_people;not null;comment:班次最大人数 (0表示不限)" json:"max_people"`                 // 班次最大人数 (0表示不限)
+	CurrentPeople  int32     `gorm:"column:current_people;not null;comment:班次当前已报名人数(冗余字段)" json:"current_people"`        // 班次当前已报名人数(冗余字段)
+	RequiredSkills string    `gorm:"column:required_skills;not null;comment:所需技能（英文逗号分隔）" json:"required_skills"`         // 所需技能（英文逗号分隔）
+	CreatedAt      time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
+	UpdatedAt      time.Time `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
+}
+
+// TableName ActivitySlot's table name
+func (*ActivitySlot) TableName() string {
+	return TableNameActivitySlot
+}
@@ -0,0 +1,30 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameActivityWaitlist = "activity_waitlists"
+
+// ActivityWaitlist 活动候补队列表
+type ActivityWaitlist struct {
+	ID            int64      `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID（同时作为排队先后顺序）" json:"id"`                         // 主键ID（同时作为排队先后顺序）
+	ActivityID    int64      `gorm:"column:activity_id;not null;comment:活动ID（关联 activities.id）" json:"activity_id"`                      // 活动ID（关联 activities.id）
+	VolunteerID   int64      `gorm:"column:volunteer_id;not null;comment:志愿者ID（关联 volunteers.id）" json:"volunteer_id"`                   // 志愿者ID（关联 volunteers.id）
+	SlotID        int64      `gorm:"column:slot_id;not null;comment:意向班次岗位ID（关联 activity_slots.id）" json:"slot_id"`                      // 意向班次岗位ID（关联 activity_slots.id）
+	AccountID     int64      `gorm:"column:account_id;not null;comment:排队人账号ID（递补时作为报名审核提交人）" json:"account_id"`                         // 排队人账号ID（递补时作为报名审核提交人）
+	Status        int32      `gorm:"column:status;not null;default:1;comment:候补状态：1-排队中，2-已递补，3-已退出" json:"status"`                      // 候补状态：1-排队中，2-已递补，3-已退出
+	AuditRecordID int64      `gorm:"column:audit_record_id;not null;comment:递补时生成的报名审核记录ID（关联 audit_records.id）" json:"audit_record_id"` // 递补时生成的报名审核记录ID（关联 audit_records.id）
+	PromotedAt    *time.Time `gorm:"column:promoted_at;comment:递补时间" json:"promoted_at"`                                                 // 递补时间
+	CreatedAt     time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间（入队时间）" json:"created_at"`          // 创建时间（入队时间）
+	UpdatedAt     time.Time  `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                // 更新时间
+}
+
+// TableName ActivityWaitlist's table name
+func (*ActivityWaitlist) TableName() string {
+	return TableNameActivityWaitlist
+}
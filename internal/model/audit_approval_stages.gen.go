@@ -0,0 +1,28 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameAuditApprovalStage = "audit_approval_stages"
+
+// AuditApprovalStage 审核审批链阶段配置表
+type AuditApprovalStage struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                                   // 主键ID
+	OrgID        int64     `gorm:"column:org_id;not null;comment:组织ID（关联 organizations.id），平台审核为 0" json:"org_id"`                   // 组织ID（关联 organizations.id），平台审核为 0
+	TargetType   int32     `gorm:"column:target_type;not null;comment:审核类型: 1-志愿者实名, 2-组织资质, 3-加入组织申请, 4-活动报名" json:"target_type"`   // 审核类型: 1-志愿者实名, 2-组织资质, 3-加入组织申请, 4-活动报名
+	StageNo      int32     `gorm:"column:stage_no;not null;comment:阶段序号，从 1 开始" json:"stage_no"`                                     // 阶段序号，从 1 开始
+	ApproverRole int32     `gorm:"column:approver_role;not null;comment:审批角色: 2-管理员, 3-负责人, 9-组织主账号, 10-平台管理员" json:"approver_role"` // 审批角色: 2-管理员, 3-负责人, 9-组织主账号, 10-平台管理员
+	ApproveMode  int32     `gorm:"column:approve_mode;not null;default:1;comment:通过方式: 1-任一审批人通过, 2-该角色全部审批人通过" json:"approve_mode"` // 通过方式: 1-任一审批人通过, 2-该角色全部审批人通过
+	UpdatedBy    int64     `gorm:"column:updated_by;not null;comment:配置人账号ID" json:"updated_by"`                                     // 配置人账号ID
+	CreatedAt    time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`              // 创建时间
+}
+
+// TableName AuditApprovalStage's table name
+func (*AuditApprovalStage) TableName() string {
+	return TableNameAuditApprovalStage
+}
@@ -12,19 +12,24 @@ const TableNameAuditRecord = "audit_records"
 
 // AuditRecord 通用审核记录表
 type AuditRecord struct {
-	ID            int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                                 // 主键ID
-	TargetType    int32     `gorm:"column:target_type;not null;comment:审核类型: 1-志愿者实名, 2-组织资质, 3-加入组织申请, 4-活动报名" json:"target_type"` // 审核类型: 1-志愿者实名, 2-组织资质, 3-加入组织申请, 4-活动报名
-	TargetID      int64     `gorm:"column:target_id;not null;comment:关联目标表的主键ID" json:"target_id"`                                  // 关联目标表的主键ID
-	CreatorID     int64     `gorm:"column:creator_id;not null;comment:提交人账号ID(关联sys_accounts.id)" json:"creator_id"`                // 提交人账号ID(关联sys_accounts.id)
-	AuditorID     int64     `gorm:"column:auditor_id;not null;comment:审核人账号ID(关联sys_accounts.id)" json:"auditor_id"`                // 审核人账号ID(关联sys_accounts.id)
-	OldContent    string    `gorm:"column:old_content;not null;comment:变更前数据快照(JSON形式)" json:"old_content"`                         // 变更前数据快照(JSON形式)
-	NewContent    string    `gorm:"column:new_content;not null;comment:变更后数据快照(JSON形式)" json:"new_content"`                         // 变更后数据快照(JSON形式)
-	AuditResult   int32     `gorm:"column:audit_result;not null;comment:审核结论: 1-通过, 2-驳回" json:"audit_result"`                      // 审核结论: 1-通过, 2-驳回
-	RejectReason  string    `gorm:"column:reject_reason;not null;comment:驳回原因/备注" json:"reject_reason"`                             // 驳回原因/备注
-	AuditTime     time.Time `gorm:"column:audit_time;not null;default:CURRENT_TIMESTAMP;comment:审核时间" json:"audit_time"`            // 审核时间
-	CreatedAt     time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`            // 创建时间
-	OperationType int32     `gorm:"column:operation_type;not null;comment:操作类型: 1-新增, 2-更新, 3-删除" json:"operation_type"`            // 操作类型: 1-新增, 2-更新, 3-删除
-	Status        int32     `gorm:"column:status;not null;comment:审核状态 1-待审核 2-已审核" json:"status"`                                  // 审核状态 1-待审核 2-已审核
+	ID             int64      `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                                 // 主键ID
+	TargetType     int32      `gorm:"column:target_type;not null;comment:审核类型: 1-志愿者实名, 2-组织资质, 3-加入组织申请, 4-活动报名" json:"target_type"` // 审核类型: 1-志愿者实名, 2-组织资质, 3-加入组织申请, 4-活动报名
+	TargetID       int64      `gorm:"column:target_id;not null;comment:关联目标表的主键ID" json:"target_id"`                                  // 关联目标表的主键ID
+	OrgID          int64      `gorm:"column:org_id;not null;comment:所属组织ID（关联 organizations.id），平台审核为组织资质对应组织或 0" json:"org_id"`      // 所属组织ID（关联 organizations.id），平台审核为组织资质对应组织或 0
+	ActivityID     int64      `gorm:"column:activity_id;not null;comment:所属活动ID（关联 activities.id），仅活动报名审核" json:"activity_id"`        // 所属活动ID（关联 activities.id），仅活动报名审核
+	CreatorID      int64      `gorm:"column:creator_id;not null;comment:提交人账号ID(关联sys_accounts.id)" json:"creator_id"`                // 提交人账号ID(关联sys_accounts.id)
+	AuditorID      int64      `gorm:"column:auditor_id;not null;comment:审核人账号ID(关联sys_accounts.id)" json:"auditor_id"`                // 审核人账号ID(关联sys_accounts.id)
+	OldContent     string     `gorm:"column:old_content;not null;comment:变更前数据快照(JSON形式)" json:"old_content"`                         // 变更前数据快照(JSON形式)
+	NewContent     string     `gorm:"column:new_content;not null;comment:变更后数据快照(JSON形式)" json:"new_content"`                         // 变更后数据快照(JSON形式)
+	AuditResult    int32      `gorm:"column:audit_result;not null;comment:审核结论: 1-通过, 2-驳回" json:"audit_result"`                      // 审核结论: 1-通过, 2-驳回
+	RejectReason   string     `gorm:"column:reject_reason;not null;comment:驳回原因/备注" json:"reject_reason"`                             // 驳回原因/备注
+	AuditTime      time.Time  `gorm:"column:audit_time;not null;default:CURRENT_TIMESTAMP;comment:审核时间" json:"audit_time"`            // 审核时间
+	CreatedAt      time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`            // 创建时间
+	OperationType  int32      `gorm:"column:operation_type;not null;comment:操作类型: 1-新增, 2-更新, 3-删除" json:"operation_type"`            // 操作类型: 1-新增, 2-更新, 3-删除
+	Status         int32      `gorm:"column:status;not null;comment:审核状态 1-待审核 2-已审核" json:"status"`                                  // 审核状态 1-待审核 2-已审核
+	CurrentStage   int32      `gorm:"column:current_stage;not null;default:1;comment:当前审批阶段（从 1 开始），未配置审批链时为 1" json:"current_stage"` // 当前审批阶段（从 1 开始），未配置审批链时为 1
+	LastRemindedAt *time.Time `gorm:"column:last_reminded_at;comment:最近一次超时提醒时间" json:"last_reminded_at"`                             // 最近一次超时提醒时间
+	EscalatedAt    *time.Time `gorm:"column:escalated_at;comment:超时自动处理时间" json:"escalated_at"`                                       // 超时自动处理时间
 }
 
 // TableName AuditRecord's table name
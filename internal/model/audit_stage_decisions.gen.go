@@ -0,0 +1,28 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameAuditStageDecision = "audit_stage_decisions"
+
+// AuditStageDecision 审核审批阶段决定表
+type AuditStageDecision struct {
+	ID            int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                             // 主键ID
+	AuditRecordID int64     `gorm:"column:audit_record_id;not null;comment:审核记录ID（关联 audit_records.id）" json:"audit_record_id"` // 审核记录ID（关联 audit_records.id）
+	StageNo       int32     `gorm:"column:stage_no;not null;comment:阶段序号" json:"stage_no"`                                      // 阶段序号
+	ApproverRole  int32     `gorm:"column:approver_role;not null;comment:审批时的角色" json:"approver_role"`                          // 审批时的角色
+	AuditorID     int64     `gorm:"column:auditor_id;not null;comment:审批人账号ID(关联sys_accounts.id)" json:"auditor_id"`            // 审批人账号ID(关联sys_accounts.id)
+	Decision      int32     `gorm:"column:decision;not null;comment:决定: 1-通过, 2-驳回" json:"decision"`                            // 决定: 1-通过, 2-驳回
+	Reason        string    `gorm:"column:reason;not null;comment:审批意见/驳回原因" json:"reason"`                                     // 审批意见/驳回原因
+	CreatedAt     time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`        // 创建时间
+}
+
+// TableName AuditStageDecision's table name
+func (*AuditStageDecision) TableName() string {
+	return TableNameAuditStageDecision
+}
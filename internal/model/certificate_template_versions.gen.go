@@ -0,0 +1,37 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameCertificateTemplateVersion = "certificate_template_versions"
+
+// CertificateTemplateVersion 证书模板版本表
+type CertificateTemplateVersion struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                           // 主键ID
+	TemplateID     int64     `gorm:"column:template_id;not null;comment:模板ID（关联 certificate_templates.id）" json:"template_id"` // 模板ID（关联 certificate_templates.id）
+	OrgID          int64     `gorm:"column:org_id;not null;comment:所属组织ID" json:"org_id"`                                      // 所属组织ID
+	Version        int32     `gorm:"column:version;not null;comment:版本号" json:"version"`                                       // 版本号
+	Title          string    `gorm:"column:title;not null;comment:证书标题" json:"title"`                                          // 证书标题
+	ActivityBody   string    `gorm:"column:activity_body;not null;comment:单次活动证书正文（支持占位符）" json:"activity_body"`               // 单次活动证书正文（支持占位符）
+	CumulativeBody string    `gorm:"column:cumulative_body;not null;comment:累计证书正文（支持占位符）" json:"cumulative_body"`             // 累计证书正文（支持占位符）
+	Signatory      string    `gorm:"column:signatory;not null;comment:落款署名" json:"signatory"`                                  // 落款署名
+	ShowLogo       int32     `gorm:"column:show_logo;not null;default:1;comment:是否展示组织Logo: 0-否, 1-是" json:"show_logo"`        // 是否展示组织Logo: 0-否, 1-是
+	LogoURL        string    `gorm:"column:logo_url;not null;comment:组织Logo原始地址（版本快照）" json:"logo_url"`                        // 组织Logo原始地址（版本快照）
+	LogoPath       string    `gorm:"column:logo_path;not null;comment:组织Logo本地存储路径（相对上传目录）" json:"logo_path"`                  // 组织Logo本地存储路径（相对上传目录）
+	SealURL        string    `gorm:"column:seal_url;not null;comment:印章图片原始地址" json:"seal_url"`                                // 印章图片原始地址
+	SealPath       string    `gorm:"column:seal_path;not null;comment:印章图片本地存储路径（相对上传目录）" json:"seal_path"`                    // 印章图片本地存储路径（相对上传目录）
+	Layout         int32     `gorm:"column:layout;not null;default:1;comment:版式: 1-A4横版, 2-A4竖版" json:"layout"`                // 版式: 1-A4横版, 2-A4竖版
+	ThemeColor     string    `gorm:"column:theme_color;not null;default:#A82020;comment:主题色（#RRGGBB）" json:"theme_color"`      // 主题色（#RRGGBB）
+	CreatedBy      int64     `gorm:"column:created_by;not null;comment:创建人账号ID" json:"created_by"`                             // 创建人账号ID
+	CreatedAt      time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`      // 创建时间
+}
+
+// TableName CertificateTemplateVersion's table name
+func (*CertificateTemplateVersion) TableName() string {
+	return TableNameCertificateTemplateVersion
+}
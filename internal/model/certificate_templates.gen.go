@@ -0,0 +1,30 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameCertificateTemplate = "certificate_templates"
+
+// CertificateTemplate 证书模板表
+type CertificateTemplate struct {
+	ID               int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                                                   // 主键ID
+	OrgID            int64     `gorm:"column:org_id;not null;comment:所属组织ID（关联 organizations.id）" json:"org_id"`                                         // 所属组织ID（关联 organizations.id）
+	Name             string    `gorm:"column:name;not null;comment:模板名称" json:"name"`                                                                    // 模板名称
+	CurrentVersionID int64     `gorm:"column:current_version_id;not null;comment:当前版本ID（关联 certificate_template_versions.id）" json:"current_version_id"` // 当前版本ID（关联 certificate_template_versions.id）
+	Version          int32     `gorm:"column:version;not null;default:1;comment:当前版本号" json:"version"`                                                   // 当前版本号
+	IsDefault        int32     `gorm:"column:is_default;not null;comment:是否组织默认模板: 0-否, 1-是" json:"is_default"`                                          // 是否组织默认模板: 0-否, 1-是
+	Status           int32     `gorm:"column:status;not null;default:1;comment:状态: 1-启用, 2-已删除" json:"status"`                                           // 状态: 1-启用, 2-已删除
+	CreatedBy        int64     `gorm:"column:created_by;not null;comment:创建人账号ID" json:"created_by"`                                                     // 创建人账号ID
+	CreatedAt        time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`                              // 创建时间
+	UpdatedAt        time.Time `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`                              // 更新时间
+}
+
+// TableName CertificateTemplate's table name
+func (*CertificateTemplate) TableName() string {
+	return TableNameCertificateTemplate
+}
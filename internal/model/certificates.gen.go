@@ -0,0 +1,43 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameCertificate = "certificates"
+
+// Certificate 志愿服务证书表
+type Certificate struct {
+	ID                int64      `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                          // 主键ID
+	SerialNo          string     `gorm:"column:serial_no;not null;comment:证书编号" json:"serial_no"`                                 // 证书编号
+	CertType          int32      `gorm:"column:cert_type;not null;default:1;comment:证书类型: 1-单次活动, 2-时间段累计" json:"cert_type"`      // 证书类型: 1-单次活动, 2-时间段累计
+	VolunteerID       int64      `gorm:"column:volunteer_id;not null;comment:志愿者ID（关联 volunteers.id）" json:"volunteer_id"`        // 志愿者ID（关联 volunteers.id）
+	OrgID             int64      `gorm:"column:org_id;not null;comment:签发组织ID（关联 organizations.id）" json:"org_id"`                // 签发组织ID（关联 organizations.id）
+	ActivityID        int64      `gorm:"column:activity_id;not null;comment:活动ID（累计证书为0）" json:"activity_id"`                     // 活动ID（累计证书为0）
+	TemplateVersionID int64      `gorm:"column:template_version_id;not null;comment:模板版本ID（0-系统默认样式）" json:"template_version_id"` // 模板版本ID（0-系统默认样式）
+	VolunteerName     string     `gorm:"column:volunteer_name;not null;comment:志愿者姓名（签发快照）" json:"volunteer_name"`                // 志愿者姓名（签发快照）
+	OrgName           string     `gorm:"column:org_name;not null;comment:签发组织名称（签发快照）" json:"org_name"`                           // 签发组织名称（签发快照）
+	ActivityTitle     string     `gorm:"column:activity_title;not null;comment:活动标题（签发快照）" json:"activity_title"`                 // 活动标题（签发快照）
+	PeriodStart       time.Time  `gorm:"column:period_start;not null;comment:服务开始时间" json:"period_start"`                         // 服务开始时间
+	PeriodEnd         time.Time  `gorm:"column:period_end;not null;comment:服务结束时间" json:"period_end"`                             // 服务结束时间
+	TotalHours        float64    `gorm:"column:total_hours;not null;default:0.00;comment:证书工时" json:"total_hours"`                // 证书工时
+	ServiceCount      int32      `gorm:"column:service_count;not null;comment:服务次数" json:"service_count"`                         // 服务次数
+	WorkHourLogIDs    string     `gorm:"column:work_hour_log_ids;not null;comment:证书涵盖的工时流水ID（英文逗号分隔）" json:"work_hour_log_ids"`  // 证书涵盖的工时流水ID（英文逗号分隔）
+	Signature         string     `gorm:"column:signature;not null;comment:证书签名（HMAC-SHA256）" json:"signature"`                    // 证书签名（HMAC-SHA256）
+	Status            int32      `gorm:"column:status;not null;default:1;comment:状态: 1-有效, 2-已撤销" json:"status"`                  // 状态: 1-有效, 2-已撤销
+	IssuerID          int64      `gorm:"column:issuer_id;not null;comment:签发操作人账号ID" json:"issuer_id"`                            // 签发操作人账号ID
+	IssuedAt          time.Time  `gorm:"column:issued_at;not null;default:CURRENT_TIMESTAMP;comment:签发时间" json:"issued_at"`       // 签发时间
+	RevokedAt         *time.Time `gorm:"column:revoked_at;comment:撤销时间" json:"revoked_at"`                                        // 撤销时间
+	RevokeReason      string     `gorm:"column:revoke_reason;not null;comment:撤销原因" json:"revoke_reason"`                         // 撤销原因
+	CreatedAt         time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`     // 创建时间
+	UpdatedAt         time.Time  `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`     // 更新时间
+}
+
+// TableName Certificate's table name
+func (*Certificate) TableName() string {
+	return TableNameCertificate
+}
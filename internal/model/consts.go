@@ -4,10 +4,12 @@ const (
 	// 注册类型
 	RegisterTypeVolunteer    = "volunteer"    // 志愿者注册
 	RegisterTypeOrganization = "organization" // 组织注册
+	RegisterTypeAdmin        = "admin"        // 平台管理员（不开放注册，通过命令行创建）
 
 	// 注册类型数字映射
 	RegisterTypeVolunteerCode    = 1 // 志愿者注册
 	RegisterTypeOrganizationCode = 2 // 组织管理者注册
+	RegisterTypeAdminCode        = 3 // 平台管理员
 
 	// 审核目标类型（对应 audit_records.target_type）
 	AuditTargetVolunteer int32 = 1 // 志愿者实名审核
@@ -24,6 +26,25 @@ const (
 	auditResultPassCode   int32 = 1 // 通过
 	auditResultRejectCode int32 = 2 // 驳回
 
+	// 审批链阶段通过方式（audit_approval_stages.approve_mode）
+	ApproveModeAnyOf int32 = 1 // 任一审批人通过即完成该阶段
+	ApproveModeAllOf int32 = 2 // 该角色全部审批人通过方可完成该阶段
+
+	// 审批阶段决定（audit_stage_decisions.decision）
+	StageDecisionApproved int32 = 1 // 通过
+	StageDecisionRejected int32 = 2 // 驳回
+
+	// 审批链最多阶段数
+	MaxAuditApprovalStages = 5
+
+	// 审核超时自动处理方式（audit_sla.rules[].auto_action）
+	AuditAutoActionNone    = "none"    // 不自动处理，仅提醒
+	AuditAutoActionApprove = "approve" // 超时自动通过
+	AuditAutoActionReject  = "reject"  // 超时自动驳回
+
+	// 系统自动处理审核时记录的审核人（audit_records.auditor_id）
+	SystemAuditorID int64 = 0
+
 	// 审核类型（当前仅支持志愿者加入组织审核）
 	AuditTypeVolunteerJoinOrganization int32 = AuditTargetMember // 志愿者加入组织
 
@@ -38,6 +59,12 @@ const (
 	MemberRoleManager int32 = 2 // 管理员
 	MemberRoleLeader  int32 = 3 // 负责人
 
+	// 组织协作管理员状态（org_admins.status），角色沿用成员角色编码
+	OrgAdminStatusPending  int32 = 1 // 待接受
+	OrgAdminStatusActive   int32 = 2 // 有效
+	OrgAdminStatusDeclined int32 = 3 // 已拒绝
+	OrgAdminStatusRevoked  int32 = 4 // 已撤销
+
 	// 数据操作类型
 	OperationTypeCreate int32 = 1 // 新增
 	OperationTypeUpdate int32 = 2 // 更新
@@ -47,6 +74,16 @@ const (
 	OrganizationDisabled int32 = 0 // 停用
 	OrganizationNormal   int32 = 1 // 正常
 
+	// 组织资质认证状态（organizations.verify_status）
+	OrgVerifyStatusUnverified int32 = 0 // 未认证
+	OrgVerifyStatusPending    int32 = 1 // 审核中
+	OrgVerifyStatusVerified   int32 = 2 // 已认证
+	OrgVerifyStatusRejected   int32 = 3 // 已驳回
+
+	// 组织资质材料类型（org_qualification_documents.doc_type）
+	OrgQualificationDocLicense int32 = 1 // 登记证书/营业执照
+	OrgQualificationDocOther   int32 = 2 // 其他证明材料
+
 	// 志愿者状态
 	VolunteerActiveStatus   int32 = 1 // 活跃
 	VolunteerInactiveStatus int32 = 2 // 非活跃
@@ -64,6 +101,11 @@ const (
 	ActivitySignupStatusRejected int32 = 3 // 报名驳回
 	ActivitySignupStatusCanceled int32 = 4 // 已取消
 
+	// 活动候补状态（activity_waitlists.status）
+	ActivityWaitlistStatusWaiting  int32 = 1 // 排队中
+	ActivityWaitlistStatusPromoted int32 = 2 // 已递补（已生成报名审核）
+	ActivityWaitlistStatusLeft     int32 = 3 // 已退出
+
 	// 活动状态（activities.status）
 	ActivityStatusRecruiting int32 = 1 // 报名中
 	ActivityStatusFinished   int32 = 2 // 已结束
@@ -75,10 +117,75 @@ const (
 	ActivityCheckOutPending int32 = 0 // 未签退
 	ActivityCheckOutDone    int32 = 1 // 已签退
 
+	// 活动系列状态（activity_series.status）
+	ActivitySeriesStatusActive   int32 = 1 // 正常
+	ActivitySeriesStatusCanceled int32 = 2 // 已取消
+
+	// 系列活动编辑/取消范围
+	ActivityEditScopeSingle    int32 = 1 // 仅本场
+	ActivityEditScopeFollowing int32 = 2 // 本场及之后
+
 	// 活动码类型（签到码/签退码）
 	AttendanceCodeTypeCheckIn  int32 = 1 // 签到码
 	AttendanceCodeTypeCheckOut int32 = 2 // 签退码
 
+	// 活动码模式（activities.attendance_code_mode）
+	AttendanceCodeModeStatic   int32 = 1 // 静态码
+	AttendanceCodeModeRotating int32 = 2 // 动态轮换码（TOTP）
+
+	// 签到围栏外处理方式（activities.geofence_mode）
+	GeofenceModeReject int32 = 1 // 拒绝签到
+	GeofenceModeFlag   int32 = 2 // 允许并标记
+
+	// 未签退自动结算策略（activities.auto_settle_policy）
+	AutoSettlePolicyDuration int32 = 1 // 按计划时长发放
+	AutoSettlePolicyEndTime  int32 = 2 // 按结束时间发放
+	AutoSettlePolicyReview   int32 = 3 // 不发放并标记待确认
+
+	// 结算复核状态（activity_signups.settle_review_status）
+	SettleReviewNone      int32 = 0 // 无需复核
+	SettleReviewPending   int32 = 1 // 待组织确认
+	SettleReviewGranted   int32 = 2 // 已确认发放
+	SettleReviewDismissed int32 = 3 // 已确认不发放
+
+	// 待确认结算处理方式
+	SettleConfirmActionGrant   int32 = 1 // 补录签退并发放
+	SettleConfirmActionDismiss int32 = 2 // 不发放
+
+	// 证书类型（certificates.cert_type）
+	CertificateTypeActivity   int32 = 1 // 单次活动
+	CertificateTypeCumulative int32 = 2 // 时间段累计
+
+	// 证书状态（certificates.status）
+	CertificateStatusValid   int32 = 1 // 有效
+	CertificateStatusRevoked int32 = 2 // 已撤销
+
+	// 证书模板状态（certificate_templates.status）
+	CertificateTemplateStatusEnabled int32 = 1 // 启用
+	CertificateTemplateStatusDeleted int32 = 2 // 已删除
+
+	// 证书版式（certificate_template_versions.layout）
+	CertificateLayoutLandscape int32 = 1 // A4横版
+	CertificateLayoutPortrait  int32 = 2 // A4竖版
+
+	// 批量补录单行结果状态
+	AttendanceBatchResultSuccess        int32 = 1 // 成功
+	AttendanceBatchResultAlreadySettled int32 = 2 // 已结算（幂等跳过）
+	AttendanceBatchResultNotSignedUp    int32 = 3 // 未报名或报名状态不允许补录
+	AttendanceBatchResultInvalidTime    int32 = 4 // 时间无效
+	AttendanceBatchResultFailed         int32 = 5 // 处理失败
+	AttendanceBatchResultCheckedIn      int32 = 6 // 已签到未签退（仅补录签到时无变更，未发放工时）
+
+	// 出勤结果（activity_signups.attendance_result）
+	AttendanceResultPending  int32 = 0 // 未判定
+	AttendanceResultAttended int32 = 1 // 已出勤
+	AttendanceResultNoShow   int32 = 2 // 爽约
+
+	// 信用分变动类型（credit_score_logs.change_type）
+	CreditChangeNoShow       int32 = 1 // 爽约扣分
+	CreditChangeStreakReward int32 = 2 // 连续出勤奖励
+	CreditChangeNoShowRevoke int32 = 3 // 爽约撤销返还
+
 	// 工时结算状态（activity_signups.work_hour_status）
 	WorkHourStatusPending int32 = 0 // 未结算
 	WorkHourStatusGranted int32 = 1 // 已发放
@@ -92,8 +199,79 @@ const (
 	// 账号状态
 	SysAccountNotNormal int32 = 0 // 禁用
 	SysAccountNormal    int32 = 1 // 正常
+
+	// 异步任务状态（jobs.status）
+	JobStatusPending   int32 = 1 // 排队中（含等待重试）
+	JobStatusRunning   int32 = 2 // 执行中
+	JobStatusSucceeded int32 = 3 // 已完成
+	JobStatusFailed    int32 = 4 // 失败
+	JobStatusCancelled int32 = 5 // 已取消
+)
+
+// 异步任务类型（jobs.job_type）
+const (
+	JobTypeVolunteerImport      = "volunteer_import"       // 志愿者批量导入
+	JobTypeActivityExport       = "activity_export"        // 活动列表导出
+	JobTypeActivitySignupExport = "activity_signup_export" // 活动报名名单导出
+	JobTypeWorkHourLogExport    = "work_hour_log_export"   // 工时流水导出
+)
+
+// 组织权限点（RBAC）：组织主账号拥有全部权限，成员按 org_members.role 授权
+const (
+	PermActivityCreate      = "activity.create"      // 创建活动/系列活动
+	PermActivityUpdate      = "activity.update"      // 编辑、取消、完结活动及管理班次
+	PermActivityDelete      = "activity.delete"      // 删除活动
+	PermActivityAttendance  = "activity.attendance"  // 签到签退码、补签、批量签到及待确认结算
+	PermMemberApprove       = "member.approve"       // 审核/变更成员状态
+	PermWorkHourVoid        = "workhour.void"        // 作废工时
+	PermWorkHourRecalculate = "workhour.recalculate" // 重算工时
+	PermOrgQualification    = "org.qualification"    // 上传资质材料、提交组织资质审核
+	PermAuditReview         = "audit.review"         // 查看组织审核收件箱（成员申请、活动报名）
+	PermAuditChain          = "audit.chain"          // 配置组织审核审批链
+	PermWorkHourView        = "workhour.view"        // 查看本组织工时流水、信用分流水
+	PermCertificateManage   = "certificate.manage"   // 签发、查看证书及管理证书模板
+	PermVolunteerImport     = "volunteer.import"     // 批量导入志愿者
+	PermDataExport          = "data.export"          // 导出活动、报名与工时数据
 )
 
+// OrgRoleOwner 组织主账号（organizations.account_id），不存于 org_members，仅用于权限判定
+const OrgRoleOwner int32 = 9
+
+// ApproverRolePlatformAdmin 审批链中的平台管理员角色，仅用于志愿者实名、组织资质审核
+const ApproverRolePlatformAdmin int32 = 10
+
+// memberRolePermissions 成员角色 -> 权限点
+var memberRolePermissions = map[int32][]string{
+	MemberRoleManager: {
+		PermActivityCreate,
+		PermActivityUpdate,
+		PermActivityAttendance,
+		PermMemberApprove,
+		PermWorkHourVoid,
+		PermWorkHourRecalculate,
+		PermAuditReview,
+		PermWorkHourView,
+		PermCertificateManage,
+		PermDataExport,
+	},
+	MemberRoleLeader: {
+		PermActivityCreate,
+		PermActivityUpdate,
+		PermActivityDelete,
+		PermActivityAttendance,
+		PermMemberApprove,
+		PermWorkHourVoid,
+		PermWorkHourRecalculate,
+		PermOrgQualification,
+		PermAuditReview,
+		PermAuditChain,
+		PermWorkHourView,
+		PermCertificateManage,
+		PermVolunteerImport,
+		PermDataExport,
+	},
+}
+
 // GetRegisterTypeCode 根据注册类型字符串返回对应的数字代码
 func GetRegisterTypeCode(registerType string) int {
 	switch registerType {
@@ -116,6 +294,11 @@ func IsValidAuditTargetType(targetType int32) bool {
 	}
 }
 
+// IsPlatformAuditTarget 返回审核目标是否由平台管理员处理（志愿者实名、组织资质），其余由组织处理
+func IsPlatformAuditTarget(targetType int32) bool {
+	return targetType == AuditTargetVolunteer || targetType == AuditTargetOrg
+}
+
 // IsValidAuditResult 返回审核结果是否合法
 func IsValidAuditResult(auditResult int32) bool {
 	return auditResult == auditResultPassCode || auditResult == auditResultRejectCode
@@ -137,3 +320,64 @@ func ResolveAuditResult(auditStatus int32) int32 {
 func IsValidAttendanceCodeType(codeType int32) bool {
 	return codeType == AttendanceCodeTypeCheckIn || codeType == AttendanceCodeTypeCheckOut
 }
+
+// IsValidAttendanceCodeMode returns whether code mode is valid.
+func IsValidAttendanceCodeMode(codeMode int32) bool {
+	return codeMode == AttendanceCodeModeStatic || codeMode == AttendanceCodeModeRotating
+}
+
+// IsValidAutoSettlePolicy returns whether auto settle policy is valid.
+func IsValidAutoSettlePolicy(policy int32) bool {
+	return policy == AutoSettlePolicyDuration || policy == AutoSettlePolicyEndTime || policy == AutoSettlePolicyReview
+}
+
+// IsValidCertificateLayout returns whether certificate layout is valid.
+func IsValidCertificateLayout(layout int32) bool {
+	return layout == CertificateLayoutLandscape || layout == CertificateLayoutPortrait
+}
+
+// IsFinishedJobStatus returns whether job status is terminal.
+func IsFinishedJobStatus(status int32) bool {
+	return status == JobStatusSucceeded || status == JobStatusFailed || status == JobStatusCancelled
+}
+
+// OrgRoleHasPermission 返回组织角色是否拥有指定权限，组织主账号拥有全部权限
+func OrgRoleHasPermission(role int32, permission string) bool {
+	if role == OrgRoleOwner {
+		return true
+	}
+	for _, p := range memberRolePermissions[role] {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
+
+// IsValidOrgAdminRole returns whether org admin role is valid.
+func IsValidOrgAdminRole(role int32) bool {
+	return role == MemberRoleManager || role == MemberRoleLeader
+}
+
+// IsValidOrgQualificationDocType returns whether qualification document type is valid.
+func IsValidOrgQualificationDocType(docType int32) bool {
+	return docType == OrgQualificationDocLicense || docType == OrgQualificationDocOther
+}
+
+// IsValidApproveMode returns whether approval stage mode is valid.
+func IsValidApproveMode(mode int32) bool {
+	return mode == ApproveModeAnyOf || mode == ApproveModeAllOf
+}
+
+// IsValidApproverRole 返回审批角色是否适用于该审核类型：平台审核仅限平台管理员，组织内审核为组织管理角色
+func IsValidApproverRole(targetType, role int32) bool {
+	if IsPlatformAuditTarget(targetType) {
+		return role == ApproverRolePlatformAdmin
+	}
+	return role == MemberRoleManager || role == MemberRoleLeader || role == OrgRoleOwner
+}
+
+// IsValidAuditAutoAction 返回审核超时自动处理方式是否合法
+func IsValidAuditAutoAction(action string) bool {
+	return action == AuditAutoActionNone || action == AuditAutoActionApprove || action == AuditAutoActionReject
+}
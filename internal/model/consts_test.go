@@ -0,0 +1,52 @@
+package model
+
+import "testing"
+
+var allOrgPermissions = []string{
+	PermActivityCreate,
+	PermActivityUpdate,
+	PermActivityDelete,
+	PermActivityAttendance,
+	PermMemberApprove,
+	PermWorkHourVoid,
+	PermWorkHourRecalculate,
+	PermOrgQualification,
+	PermAuditReview,
+	PermAuditChain,
+	PermWorkHourView,
+	PermCertificateManage,
+	PermVolunteerImport,
+	PermDataExport,
+}
+
+func TestOrgRoleHasPermission(t *testing.T) {
+	managerDenied := map[string]bool{
+		PermActivityDelete:   true,
+		PermOrgQualification: true,
+		PermAuditChain:       true,
+		PermVolunteerImport:  true,
+	}
+
+	cases := []struct {
+		name string
+		role int32
+		want func(permission string) bool
+	}{
+		{name: "owner", role: OrgRoleOwner, want: func(string) bool { return true }},
+		{name: "leader", role: MemberRoleLeader, want: func(string) bool { return true }},
+		{name: "manager", role: MemberRoleManager, want: func(p string) bool { return !managerDenied[p] }},
+		{name: "member", role: MemberRoleMember, want: func(string) bool { return false }},
+		{name: "no role", role: 0, want: func(string) bool { return false }},
+		{name: "platform admin role", role: ApproverRolePlatformAdmin, want: func(string) bool { return false }},
+	}
+	for _, tc := range cases {
+		for _, permission := range allOrgPermissions {
+			if got := OrgRoleHasPermission(tc.role, permission); got != tc.want(permission) {
+				t.Fatalf("%s: OrgRoleHasPermission(%d, %q) = %v, want %v", tc.name, tc.role, permission, got, tc.want(permission))
+			}
+		}
+		if OrgRoleHasPermission(tc.role, "unknown.permission") && tc.role != OrgRoleOwner {
+			t.Fatalf("%s: unknown permission should be denied", tc.name)
+		}
+	}
+}
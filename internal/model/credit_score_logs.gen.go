@@ -0,0 +1,34 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameCreditScoreLog = "credit_score_logs"
+
+// CreditScoreLog 志愿者信用分流水表
+type CreditScoreLog struct {
+	ID             int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                                 // 主键ID
+	VolunteerID    int64     `gorm:"column:volunteer_id;not null;comment:志愿者ID（关联 volunteers.id）" json:"volunteer_id"`               // 志愿者ID（关联 volunteers.id）
+	ActivityID     int64     `gorm:"column:activity_id;not null;comment:活动ID（关联 activities.id）" json:"activity_id"`                  // 活动ID（关联 activities.id）
+	SignupID       int64     `gorm:"column:signup_id;not null;comment:报名ID（关联 activity_signups.id）" json:"signup_id"`                // 报名ID（关联 activity_signups.id）
+	ChangeType     int32     `gorm:"column:change_type;not null;default:1;comment:变动类型：1-爽约扣分，2-连续出勤奖励，3-爽约撤销返还" json:"change_type"` // 变动类型：1-爽约扣分，2-连续出勤奖励，3-爽约撤销返还
+	ScoreDelta     int32     `gorm:"column:score_delta;not null;comment:信用分增量（扣分为负数）" json:"score_delta"`                            // 信用分增量（扣分为负数）
+	BeforeScore    int32     `gorm:"column:before_score;not null;comment:变更前信用分" json:"before_score"`                                // 变更前信用分
+	AfterScore     int32     `gorm:"column:after_score;not null;comment:变更后信用分" json:"after_score"`                                  // 变更后信用分
+	IdempotencyKey string    `gorm:"column:idempotency_key;not null;comment:幂等键（防重复扣分/奖励）" json:"idempotency_key"`                   // 幂等键（防重复扣分/奖励）
+	RefLogID       int64     `gorm:"column:ref_log_id;not null;comment:关联原流水ID（撤销场景）" json:"ref_log_id"`                             // 关联原流水ID（撤销场景）
+	Reason         string    `gorm:"column:reason;not null;comment:变动原因" json:"reason"`                                              // 变动原因
+	OperatorID     int64     `gorm:"column:operator_id;not null;comment:操作人账号ID（系统自动结算为0）" json:"operator_id"`                       // 操作人账号ID（系统自动结算为0）
+	CreatedAt      time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`            // 创建时间
+	UpdatedAt      time.Time `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`            // 更新时间
+}
+
+// TableName CreditScoreLog's table name
+func (*CreditScoreLog) TableName() string {
+	return TableNameCreditScoreLog
+}
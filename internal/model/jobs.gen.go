@@ -0,0 +1,41 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameJob = "jobs"
+
+// Job 异步任务表
+type Job struct {
+	ID              int64      `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                                 // 主键ID
+	JobType         string     `gorm:"column:job_type;not null;comment:任务类型（如 volunteer_import）" json:"job_type"`                      // 任务类型（如 volunteer_import）
+	AccountID       int64      `gorm:"column:account_id;not null;comment:提交人账号ID" json:"account_id"`                                   // 提交人账号ID
+	OrgID           int64      `gorm:"column:org_id;not null;comment:所属组织ID（非组织任务为0）" json:"org_id"`                                   // 所属组织ID（非组织任务为0）
+	Status          int32      `gorm:"column:status;not null;default:1;comment:状态: 1-排队中, 2-执行中, 3-已完成, 4-失败, 5-已取消" json:"status"`    // 状态: 1-排队中, 2-执行中, 3-已完成, 4-失败, 5-已取消
+	Payload         string     `gorm:"column:payload;not null;comment:任务参数（JSON）" json:"payload"`                                      // 任务参数（JSON）
+	ProgressDone    int32      `gorm:"column:progress_done;not null;comment:已处理数量" json:"progress_done"`                               // 已处理数量
+	ProgressTotal   int32      `gorm:"column:progress_total;not null;comment:总数量（未知时为0）" json:"progress_total"`                        // 总数量（未知时为0）
+	Attempts        int32      `gorm:"column:attempts;not null;comment:已执行次数" json:"attempts"`                                         // 已执行次数
+	MaxAttempts     int32      `gorm:"column:max_attempts;not null;default:1;comment:最大执行次数（含首次）" json:"max_attempts"`                 // 最大执行次数（含首次）
+	NextRunAt       time.Time  `gorm:"column:next_run_at;not null;default:CURRENT_TIMESTAMP;comment:下次可执行时间（重试退避）" json:"next_run_at"` // 下次可执行时间（重试退避）
+	CancelRequested int32      `gorm:"column:cancel_requested;not null;comment:是否已请求取消: 0-否, 1-是" json:"cancel_requested"`             // 是否已请求取消: 0-否, 1-是
+	Result          *string    `gorm:"column:result;comment:执行结果摘要（JSON）" json:"result"`                                               // 执行结果摘要（JSON）
+	ResultFile      string     `gorm:"column:result_file;not null;comment:结果文件路径（相对上传目录）" json:"result_file"`                          // 结果文件路径（相对上传目录）
+	ResultFileName  string     `gorm:"column:result_file_name;not null;comment:结果文件下载名" json:"result_file_name"`                       // 结果文件下载名
+	ErrorMessage    string     `gorm:"column:error_message;not null;comment:最近一次失败原因" json:"error_message"`                            // 最近一次失败原因
+	HeartbeatAt     *time.Time `gorm:"column:heartbeat_at;comment:执行心跳时间（用于识别中断的任务）" json:"heartbeat_at"`                              // 执行心跳时间（用于识别中断的任务）
+	StartedAt       *time.Time `gorm:"column:started_at;comment:最近一次开始执行时间" json:"started_at"`                                         // 最近一次开始执行时间
+	FinishedAt      *time.Time `gorm:"column:finished_at;comment:结束时间" json:"finished_at"`                                             // 结束时间
+	CreatedAt       time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`            // 创建时间
+	UpdatedAt       time.Time  `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`            // 更新时间
+}
+
+// TableName Job's table name
+func (*Job) TableName() string {
+	return TableNameJob
+}
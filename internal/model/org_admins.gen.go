@@ -0,0 +1,30 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameOrgAdmin = "org_admins"
+
+// OrgAdmin 组织协作管理员表
+type OrgAdmin struct {
+	ID         int64      `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                       // 主键ID
+	OrgID      int64      `gorm:"column:org_id;not null;comment:组织ID（关联 organizations.id）" json:"org_id"`               // 组织ID（关联 organizations.id）
+	AccountID  int64      `gorm:"column:account_id;not null;comment:被授权账号ID（关联 sys_accounts.id）" json:"account_id"`     // 被授权账号ID（关联 sys_accounts.id）
+	Role       int32      `gorm:"column:role;not null;default:2;comment:角色: 2-管理员, 3-负责人" json:"role"`                  // 角色: 2-管理员, 3-负责人
+	Status     int32      `gorm:"column:status;not null;default:1;comment:状态: 1-待接受, 2-有效, 3-已拒绝, 4-已撤销" json:"status"` // 状态: 1-待接受, 2-有效, 3-已拒绝, 4-已撤销
+	InvitedBy  int64      `gorm:"column:invited_by;not null;comment:邀请人账号ID" json:"invited_by"`                         // 邀请人账号ID
+	AcceptedAt *time.Time `gorm:"column:accepted_at;comment:接受邀请时间" json:"accepted_at"`                                 // 接受邀请时间
+	RevokedAt  *time.Time `gorm:"column:revoked_at;comment:撤销时间" json:"revoked_at"`                                     // 撤销时间
+	CreatedAt  time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`  // 创建时间
+	UpdatedAt  time.Time  `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`  // 更新时间
+}
+
+// TableName OrgAdmin's table name
+func (*OrgAdmin) TableName() string {
+	return TableNameOrgAdmin
+}
@@ -0,0 +1,29 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameOrgQualificationDocument = "org_qualification_documents"
+
+// OrgQualificationDocument 组织资质材料表
+type OrgQualificationDocument struct {
+	ID            int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                                   // 主键ID
+	OrgID         int64     `gorm:"column:org_id;not null;comment:组织ID（关联 organizations.id）" json:"org_id"`                           // 组织ID（关联 organizations.id）
+	AuditRecordID int64     `gorm:"column:audit_record_id;not null;comment:审核记录ID（关联 audit_records.id），0-未提交" json:"audit_record_id"` // 审核记录ID（关联 audit_records.id），0-未提交
+	DocType       int32     `gorm:"column:doc_type;not null;default:1;comment:材料类型: 1-登记证书/营业执照, 2-其他证明材料" json:"doc_type"`           // 材料类型: 1-登记证书/营业执照, 2-其他证明材料
+	FileName      string    `gorm:"column:file_name;not null;comment:原始文件名" json:"file_name"`                                         // 原始文件名
+	FilePath      string    `gorm:"column:file_path;not null;comment:文件相对路径（上传目录下）" json:"file_path"`                                 // 文件相对路径（上传目录下）
+	FileSize      int64     `gorm:"column:file_size;not null;comment:文件大小（字节）" json:"file_size"`                                      // 文件大小（字节）
+	UploadedBy    int64     `gorm:"column:uploaded_by;not null;comment:上传人账号ID" json:"uploaded_by"`                                   // 上传人账号ID
+	CreatedAt     time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`              // 创建时间
+}
+
+// TableName OrgQualificationDocument's table name
+func (*OrgQualificationDocument) TableName() string {
+	return TableNameOrgQualificationDocument
+}
@@ -0,0 +1,31 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameVolunteerImportBatch = "volunteer_import_batches"
+
+// VolunteerImportBatch 志愿者导入批次表
+type VolunteerImportBatch struct {
+	ID           int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                      // 主键ID
+	OrgID        int64     `gorm:"column:org_id;not null;comment:导入组织ID（关联 organizations.id）" json:"org_id"`            // 导入组织ID（关联 organizations.id）
+	OperatorID   int64     `gorm:"column:operator_id;not null;comment:操作人账号ID" json:"operator_id"`                      // 操作人账号ID
+	JobID        int64     `gorm:"column:job_id;not null;comment:异步任务ID（关联 jobs.id）" json:"job_id"`                     // 异步任务ID（关联 jobs.id）
+	FileName     string    `gorm:"column:file_name;not null;comment:导入文件名" json:"file_name"`                            // 导入文件名
+	TotalRows    int32     `gorm:"column:total_rows;not null;comment:数据行数（不含表头）" json:"total_rows"`                     // 数据行数（不含表头）
+	SuccessCount int32     `gorm:"column:success_count;not null;comment:导入成功行数" json:"success_count"`                   // 导入成功行数
+	FailedCount  int32     `gorm:"column:failed_count;not null;comment:导入失败行数" json:"failed_count"`                     // 导入失败行数
+	JoinOrg      int32     `gorm:"column:join_org;not null;comment:是否同时加入本组织: 0-否, 1-是" json:"join_org"`                // 是否同时加入本组织: 0-否, 1-是
+	CreatedAt    time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"` // 创建时间
+	UpdatedAt    time.Time `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"` // 更新时间
+}
+
+// TableName VolunteerImportBatch's table name
+func (*VolunteerImportBatch) TableName() string {
+	return TableNameVolunteerImportBatch
+}
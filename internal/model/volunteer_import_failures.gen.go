@@ -0,0 +1,28 @@
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+// Code generated by gorm.io/gen. DO NOT EDIT.
+
+package model
+
+import (
+	"time"
+)
+
+const TableNameVolunteerImportFailure = "volunteer_import_failures"
+
+// VolunteerImportFailure 志愿者导入失败明细表
+type VolunteerImportFailure struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                          // 主键ID
+	BatchID   int64     `gorm:"column:batch_id;not null;comment:导入批次ID（关联 volunteer_import_batches.id）" json:"batch_id"` // 导入批次ID（关联 volunteer_import_batches.id）
+	RowNo     int32     `gorm:"column:row_no;not null;comment:文件中的行号（表头为第1行）" json:"row_no"`                             // 文件中的行号（表头为第1行）
+	Name      string    `gorm:"column:name;not null;comment:姓名" json:"name"`                                             // 姓名
+	Phone     string    `gorm:"column:phone;not null;comment:手机号（脱敏）" json:"phone"`                                      // 手机号（脱敏）
+	Email     string    `gorm:"column:email;not null;comment:邮箱" json:"email"`                                           // 邮箱
+	Reason    string    `gorm:"column:reason;not null;comment:失败原因" json:"reason"`                                       // 失败原因
+	CreatedAt time.Time `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`     // 创建时间
+}
+
+// TableName VolunteerImportFailure's table name
+func (*VolunteerImportFailure) TableName() string {
+	return TableNameVolunteerImportFailure
+}
@@ -12,21 +12,24 @@ const TableNameVolunteer = "volunteers"
 
 // Volunteer 志愿者档案表
 type Volunteer struct {
-	ID           int64      `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                              // 主键ID
-	AccountID    int64      `gorm:"column:account_id;not null;comment:关联sys_accounts.id" json:"account_id"`                      // 关联sys_accounts.id
-	RealName     string     `gorm:"column:real_name;not null;comment:真实姓名" json:"real_name"`                                     // 真实姓名
-	Gender       int32      `gorm:"column:gender;not null;comment:性别: 0-未知, 1-男, 2-女" json:"gender"`                             // 性别: 0-未知, 1-男, 2-女
-	Birthday     *time.Time `gorm:"column:birthday;comment:出生日期" json:"birthday"`                                                // 出生日期
-	IDCard       string     `gorm:"column:id_card;not null;comment:身份证号 (建议AES加密存储)" json:"id_card"`                             // 身份证号 (建议AES加密存储)
-	AvatarURL    string     `gorm:"column:avatar_url;not null;comment:头像URL" json:"avatar_url"`                                  // 头像URL
-	Introduction string     `gorm:"column:introduction;not null;comment:个人简介" json:"introduction"`                               // 个人简介
-	TotalHours   float64    `gorm:"column:total_hours;not null;default:0.0;comment:累计服务时长(小时)" json:"total_hours"`               // 累计服务时长(小时)
-	ServiceCount int32      `gorm:"column:service_count;not null;comment:累计服务次数" json:"service_count"`                           // 累计服务次数
-	CreditScore  int32      `gorm:"column:credit_score;not null;default:100;comment:信用分(默认100)" json:"credit_score"`             // 信用分(默认100)
-	Status       int32      `gorm:"column:status;not null;default:1;comment:志愿者状态: 1-活跃, 2-非活跃, 3-暂停" json:"status"`             // 志愿者状态: 1-活跃, 2-非活跃, 3-暂停
-	AuditStatus  int32      `gorm:"column:audit_status;not null;comment:实名认证状态: 0-未认证, 1-审核中, 2-已通过, 3-已驳回" json:"audit_status"` // 实名认证状态: 0-未认证, 1-审核中, 2-已通过, 3-已驳回
-	CreatedAt    time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`         // 创建时间
-	UpdatedAt    time.Time  `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`         // 更新时间
+	ID               int64      `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键ID" json:"id"`                              // 主键ID
+	AccountID        int64      `gorm:"column:account_id;not null;comment:关联sys_accounts.id" json:"account_id"`                      // 关联sys_accounts.id
+	RealName         string     `gorm:"column:real_name;not null;comment:真实姓名" json:"real_name"`                                     // 真实姓名
+	Gender           int32      `gorm:"column:gender;not null;comment:性别: 0-未知, 1-男, 2-女" json:"gender"`                             // 性别: 0-未知, 1-男, 2-女
+	Birthday         *time.Time `gorm:"column:birthday;comment:出生日期" json:"birthday"`                                                // 出生日期
+	IDCard           string     `gorm:"column:id_card;not null;comment:身份证号（AES加密存储，实名认证通过后写入）" json:"id_card"`                      // 身份证号（AES加密存储，实名认证通过后写入）
+	IDCardHash       string     `gorm:"column:id_card_hash;not null;comment:身份证号哈希（唯一性校验）" json:"id_card_hash"`                      // 身份证号哈希（唯一性校验）
+	AvatarURL        string     `gorm:"column:avatar_url;not null;comment:头像URL" json:"avatar_url"`                                  // 头像URL
+	Introduction     string     `gorm:"column:introduction;not null;comment:个人简介" json:"introduction"`                               // 个人简介
+	Skills           string     `gorm:"column:skills;not null;comment:技能标签（英文逗号分隔）" json:"skills"`                                   // 技能标签（英文逗号分隔）
+	TotalHours       float64    `gorm:"column:total_hours;not null;default:0.0;comment:累计服务时长(小时)" json:"total_hours"`               // 累计服务时长(小时)
+	ServiceCount     int32      `gorm:"column:service_count;not null;comment:累计服务次数" json:"service_count"`                           // 累计服务次数
+	CreditScore      int32      `gorm:"column:credit_score;not null;default:100;comment:信用分(默认100)" json:"credit_score"`             // 信用分(默认100)
+	AttendanceStreak int32      `gorm:"column:attendance_streak;not null;comment:连续出勤次数（爽约清零）" json:"attendance_streak"`             // 连续出勤次数（爽约清零）
+	Status           int32      `gorm:"column:status;not null;default:1;comment:志愿者状态: 1-活跃, 2-非活跃, 3-暂停" json:"status"`             // 志愿者状态: 1-活跃, 2-非活跃, 3-暂停
+	AuditStatus      int32      `gorm:"column:audit_status;not null;comment:实名认证状态: 0-未认证, 1-审核中, 2-已通过, 3-已驳回" json:"audit_status"` // 实名认证状态: 0-未认证, 1-审核中, 2-已通过, 3-已驳回
+	CreatedAt        time.Time  `gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP;comment:创建时间" json:"created_at"`         // 创建时间
+	UpdatedAt        time.Time  `gorm:"column:updated_at;not null;default:CURRENT_TIMESTAMP;comment:更新时间" json:"updated_at"`         // 更新时间
 }
 
 // TableName Volunteer's table name
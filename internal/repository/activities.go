@@ -17,6 +17,12 @@ type ActivityAttendanceCode struct {
 	CheckOutCodeExpireAt    *time.Time `gorm:"column:check_out_code_expire_at"`
 	AttendanceCodeVersion   int64      `gorm:"column:attendance_code_version"`
 	AttendanceCodeUpdatedAt *time.Time `gorm:"column:attendance_code_updated_at"`
+	CheckInCodeHash         string     `gorm:"column:check_in_code_hash"`
+	CheckOutCodeHash        string     `gorm:"column:check_out_code_hash"`
+	AttendanceCodeMode      int32      `gorm:"column:attendance_code_mode"`
+	AttendanceCodePeriod    int32      `gorm:"column:attendance_code_period"`
+	CheckInCodeSecret       string     `gorm:"column:check_in_code_secret"`
+	CheckOutCodeSecret      string     `gorm:"column:check_out_code_secret"`
 }
 
 // GetActivitiesByStatus 根据状态查询活动列表
@@ -47,8 +53,9 @@ func (r *Repository) GetActivitiesByStatus(db *gorm.DB, status int32, limit, off
 
 	// 使用 base session 分页查询数据
 	querySession := baseSession.Select("act.*")
+	// 按 id 兜底排序，保证同一秒创建的活动（如系列活动）分页时顺序稳定
 	err = querySession.Offset(offset).Limit(limit).
-		Order("act.created_at DESC").
+		Order("act.created_at DESC, act.id DESC").
 		Find(&activities).Error
 	if err != nil {
 		return nil, 0, err
@@ -86,7 +93,8 @@ func (r *Repository) GetActivityAttendanceCodeByID(db *gorm.DB, id int64) (*Acti
 	err := db.WithContext(r.ctx).
 		Table("activities").
 		Select(
-			"id, check_in_code, check_out_code, check_in_code_expire_at, check_out_code_expire_at, attendance_code_version, attendance_code_updated_at",
+			"id, check_in_code, check_out_code, check_in_code_expire_at, check_out_code_expire_at, attendance_code_version, attendance_code_updated_at, "+
+				"check_in_code_hash, check_out_code_hash, attendance_code_mode, attendance_code_period, check_in_code_secret, check_out_code_secret",
 		).
 		Where("id = ?", id).
 		First(&codeInfo).Error
@@ -373,6 +381,14 @@ func (r *Repository) UpdateActivity(db *gorm.DB, activity *model.Activity) error
 	return db.WithContext(r.ctx).Save(activity).Error
 }
 
+// UpdateActivityByID 按列更新活动，仅写入 updates 中的字段
+func (r *Repository) UpdateActivityByID(db *gorm.DB, id int64, updates map[string]any) error {
+	return db.WithContext(r.ctx).
+		Model(&model.Activity{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
 // DeleteActivity 删除活动（软删除）
 func (r *Repository) DeleteActivity(db *gorm.DB, id int64) error {
 	return db.WithContext(r.ctx).Delete(&model.Activity{}, id).Error
@@ -393,6 +409,122 @@ func (r *Repository) FinishActivity(db *gorm.DB, id int64) error {
 		Update("status", model.ActivityStatusFinished).Error
 }
 
+// FinishRecruitingActivity 将报名中的活动置为已结束（状态已变化时返回 gorm.ErrRecordNotFound）
+func (r *Repository) FinishRecruitingActivity(db *gorm.DB, id int64) error {
+	result := db.WithContext(r.ctx).
+		Model(&model.Activity{}).
+		Where("id = ? AND status = ?", id, model.ActivityStatusRecruiting).
+		Update("status", model.ActivityStatusFinished)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CloseActivitySignups 截止开始时间早于 deadline 的报名中活动的报名
+func (r *Repository) CloseActivitySignups(db *gorm.DB, deadline time.Time) (int64, error) {
+	result := db.WithContext(r.ctx).
+		Model(&model.Activity{}).
+		Where("status = ? AND signup_closed = 0 AND start_time <= ?", model.ActivityStatusRecruiting, deadline).
+		Update("signup_closed", 1)
+	return result.RowsAffected, result.Error
+}
+
+// ListEndedRecruitingActivities 查询结束时间早于 endBefore 但仍处于报名中的活动
+func (r *Repository) ListEndedRecruitingActivities(db *gorm.DB, endBefore time.Time, limit int) ([]*model.Activity, error) {
+	var activities []*model.Activity
+	err := db.WithContext(r.ctx).
+		Where("status = ? AND end_time <= ?", model.ActivityStatusRecruiting, endBefore).
+		Order("end_time ASC, id ASC").
+		Limit(limit).
+		Find(&activities).Error
+	if err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// ExpireEndedActivityAttendanceCodes 将已结束活动仍有效的签到/签退码置为过期，并推进码版本使二维码失效
+func (r *Repository) ExpireEndedActivityAttendanceCodes(db *gorm.DB, endBefore, now time.Time) (int64, error) {
+	result := db.WithContext(r.ctx).
+		Model(&model.Activity{}).
+		Where("attendance_code_version > 0").
+		Where("end_time <= ? OR status <> ?", endBefore, model.ActivityStatusRecruiting).
+		Where("check_in_code_expire_at IS NULL OR check_in_code_expire_at > ? OR check_out_code_expire_at IS NULL OR check_out_code_expire_at > ?", now, now).
+		Updates(map[string]any{
+			"check_in_code_expire_at":    now,
+			"check_out_code_expire_at":   now,
+			"attendance_code_version":    gorm.Expr("attendance_code_version + 1"),
+			"attendance_code_updated_at": now,
+		})
+	return result.RowsAffected, result.Error
+}
+
+// ListFinishedUncheckedOutSignups 查询已结束活动中已签到但未签退的报名
+func (r *Repository) ListFinishedUncheckedOutSignups(db *gorm.DB, limit int) ([]*model.ActivitySignup, error) {
+	var signups []*model.ActivitySignup
+	err := db.WithContext(r.ctx).
+		Table("activity_signups as s").
+		Select("s.*").
+		Joins("JOIN activities as a ON a.id = s.activity_id").
+		Where("a.status = ? AND s.status = ? AND s.check_in_status = ? AND s.check_out_status = ? AND s.settle_review_status = ?",
+			model.ActivityStatusFinished, model.ActivitySignupStatusSuccess, model.ActivityCheckInDone, model.ActivityCheckOutPending, model.SettleReviewNone).
+		Order("s.id ASC").
+		Limit(limit).
+		Find(&signups).Error
+	if err != nil {
+		return nil, err
+	}
+	return signups, nil
+}
+
+// ListUncheckedOutSignupIDs 查询活动下已签到未签退且未进入复核的报名ID
+func (r *Repository) ListUncheckedOutSignupIDs(db *gorm.DB, activityID int64) ([]int64, error) {
+	var ids []int64
+	err := db.WithContext(r.ctx).
+		Model(&model.ActivitySignup{}).
+		Where("activity_id = ? AND status = ? AND check_in_status = ? AND check_out_status = ? AND settle_review_status = ?",
+			activityID, model.ActivitySignupStatusSuccess, model.ActivityCheckInDone, model.ActivityCheckOutPending, model.SettleReviewNone).
+		Order("id ASC").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ListPendingSettlementSignups 查询活动下待组织确认结算的报名
+func (r *Repository) ListPendingSettlementSignups(db *gorm.DB, activityID int64) ([]*model.ActivitySignup, error) {
+	var signups []*model.ActivitySignup
+	err := db.WithContext(r.ctx).
+		Where("activity_id = ? AND settle_review_status = ?", activityID, model.SettleReviewPending).
+		Order("id ASC").
+		Find(&signups).Error
+	if err != nil {
+		return nil, err
+	}
+	return signups, nil
+}
+
+// ListFinishedActivitiesWithUnsettledAttendance 查询已结束但仍有未判定出勤结果报名的活动
+func (r *Repository) ListFinishedActivitiesWithUnsettledAttendance(db *gorm.DB, limit int) ([]*model.Activity, error) {
+	var activities []*model.Activity
+	err := db.WithContext(r.ctx).
+		Where("status = ?", model.ActivityStatusFinished).
+		Where("EXISTS (SELECT 1 FROM activity_signups s WHERE s.activity_id = activities.id AND s.status = ? AND s.attendance_result = ?)",
+			model.ActivitySignupStatusSuccess, model.AttendanceResultPending).
+		Order("end_time ASC, id ASC").
+		Limit(limit).
+		Find(&activities).Error
+	if err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
 // GetOrganizationByAccountID 根据账号ID获取组织
 func (r *Repository) GetOrganizationByAccountID(db *gorm.DB, accountID int64) (*model.Organization, error) {
 	var org model.Organization
@@ -427,8 +559,9 @@ func (r *Repository) GetOrganizationActivities(db *gorm.DB, orgID int64, limit,
 
 	// 使用 base session 分页查询数据
 	querySession := baseSession.Select("act.*")
+	// 按 id 兜底排序，保证同一秒创建的活动（如系列活动）分页时顺序稳定
 	err = querySession.Offset(offset).Limit(limit).
-		Order("act.created_at DESC").
+		Order("act.created_at DESC, act.id DESC").
 		Find(&activities).Error
 	if err != nil {
 		return nil, 0, err
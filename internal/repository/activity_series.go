@@ -0,0 +1,71 @@
+package repository
+
+import (
+	"time"
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateActivitySeries 创建活动系列
+func (r *Repository) CreateActivitySeries(db *gorm.DB, series *model.ActivitySeries) error {
+	return db.WithContext(r.ctx).Create(series).Error
+}
+
+// GetActivitySeriesByID 根据ID查询活动系列
+func (r *Repository) GetActivitySeriesByID(db *gorm.DB, id int64) (*model.ActivitySeries, error) {
+	var series model.ActivitySeries
+	err := db.WithContext(r.ctx).Where("id = ?", id).First(&series).Error
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// GetActivitySeriesByIDForUpdate 根据ID查询活动系列并加行锁
+func (r *Repository) GetActivitySeriesByIDForUpdate(db *gorm.DB, id int64) (*model.ActivitySeries, error) {
+	var series model.ActivitySeries
+	err := db.WithContext(r.ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&series).Error
+	if err != nil {
+		return nil, err
+	}
+	return &series, nil
+}
+
+// UpdateActivitySeriesByID 更新活动系列字段
+func (r *Repository) UpdateActivitySeriesByID(db *gorm.DB, id int64, updates map[string]any) error {
+	return db.WithContext(r.ctx).
+		Model(&model.ActivitySeries{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+// ListSeriesActivities 按开始时间查询系列下的全部场次
+func (r *Repository) ListSeriesActivities(db *gorm.DB, seriesID int64) ([]*model.Activity, error) {
+	var activities []*model.Activity
+	err := db.WithContext(r.ctx).
+		Where("series_id = ?", seriesID).
+		Order("start_time ASC").
+		Find(&activities).Error
+	if err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
+
+// ListSeriesActivitiesFrom 按开始时间查询系列中指定时间及之后的场次
+func (r *Repository) ListSeriesActivitiesFrom(db *gorm.DB, seriesID int64, from time.Time) ([]*model.Activity, error) {
+	var activities []*model.Activity
+	err := db.WithContext(r.ctx).
+		Where("series_id = ? AND start_time >= ?", seriesID, from).
+		Order("start_time ASC").
+		Find(&activities).Error
+	if err != nil {
+		return nil, err
+	}
+	return activities, nil
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateActivitySlot 创建活动班次岗位
+func (r *Repository) CreateActivitySlot(db *gorm.DB, slot *model.ActivitySlot) error {
+	return db.WithContext(r.ctx).Create(slot).Error
+}
+
+// GetActivitySlotByID 根据ID查询班次岗位
+func (r *Repository) GetActivitySlotByID(db *gorm.DB, id int64) (*model.ActivitySlot, error) {
+	var slot model.ActivitySlot
+	err := db.WithContext(r.ctx).Where("id = ?", id).First(&slot).Error
+	if err != nil {
+		return nil, err
+	}
+	return &slot, nil
+}
+
+// GetActivitySlotByIDForUpdate 根据ID查询班次岗位并加行锁
+func (r *Repository) GetActivitySlotByIDForUpdate(db *gorm.DB, id int64) (*model.ActivitySlot, error) {
+	var slot model.ActivitySlot
+	err := db.WithContext(r.ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&slot).Error
+	if err != nil {
+		return nil, err
+	}
+	return &slot, nil
+}
+
+// ListActivitySlots 按开始时间查询活动下的全部班次岗位
+func (r *Repository) ListActivitySlots(db *gorm.DB, activityID int64) ([]*model.ActivitySlot, error) {
+	var slots []*model.ActivitySlot
+	err := db.WithContext(r.ctx).
+		Where("activity_id = ?", activityID).
+		Order("start_time ASC, id ASC").
+		Find(&slots).Error
+	if err != nil {
+		return nil, err
+	}
+	return slots, nil
+}
+
+// CountActivitySlots 统计活动下的班次岗位数量
+func (r *Repository) CountActivitySlots(db *gorm.DB, activityID int64) (int64, error) {
+	var count int64
+	err := db.WithContext(r.ctx).
+		Model(&model.ActivitySlot{}).
+		Where("activity_id = ?", activityID).
+		Count(&count).Error
+	return count, err
+}
+
+// UpdateActivitySlotByID 更新班次岗位字段
+func (r *Repository) UpdateActivitySlotByID(db *gorm.DB, id int64, updates map[string]any) error {
+	return db.WithContext(r.ctx).
+		Model(&model.ActivitySlot{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+// DeleteActivitySlot 删除班次岗位
+func (r *Repository) DeleteActivitySlot(db *gorm.DB, id int64) error {
+	return db.WithContext(r.ctx).Delete(&model.ActivitySlot{}, id).Error
+}
+
+// IncrementActivitySlotPeople 增加班次当前报名人数（原子操作），班次已满时返回 ErrRecordNotFound
+func (r *Repository) IncrementActivitySlotPeople(db *gorm.DB, slotID int64) error {
+	result := db.WithContext(r.ctx).Model(&model.ActivitySlot{}).
+		Where("id = ? AND (current_people < max_people OR max_people = 0)", slotID).
+		Update("current_people", gorm.Expr("current_people + 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// DecrementActivitySlotPeople 减少班次当前报名人数
+func (r *Repository) DecrementActivitySlotPeople(db *gorm.DB, slotID int64) error {
+	result := db.WithContext(r.ctx).Model(&model.ActivitySlot{}).
+		Where("id = ? AND current_people > 0", slotID).
+		Update("current_people", gorm.Expr("current_people - 1"))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
@@ -69,3 +69,45 @@ func (r *Repository) UpdateAuditRecordByID(db *gorm.DB, id int64, updates map[st
 		Where("id = ?", id).
 		Updates(updates).Error
 }
+
+// FindLatestAuditRecordByTarget finds the latest audit record of a target, returns nil when absent.
+func (r *Repository) FindLatestAuditRecordByTarget(db *gorm.DB, targetType int32, targetID int64) (*model.AuditRecord, error) {
+	var records []*model.AuditRecord
+	if err := db.WithContext(r.ctx).
+		Model(&model.AuditRecord{}).
+		Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Order("id DESC").
+		Limit(1).
+		Find(&records).Error; err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+	return records[0], nil
+}
+
+// ListAuditRecordsByTarget lists all audit records of a target, newest first.
+func (r *Repository) ListAuditRecordsByTarget(db *gorm.DB, targetType int32, targetID int64) ([]*model.AuditRecord, error) {
+	var records []*model.AuditRecord
+	err := db.WithContext(r.ctx).
+		Model(&model.AuditRecord{}).
+		Where("target_type = ? AND target_id = ?", targetType, targetID).
+		Order("id DESC").
+		Find(&records).Error
+	return records, err
+}
+
+// ListAuditRecordsInSubmitOrder lists audit records matching queryMap, oldest first.
+func (r *Repository) ListAuditRecordsInSubmitOrder(db *gorm.DB, queryMap map[string]any, limit int) ([]*model.AuditRecord, error) {
+	var records []*model.AuditRecord
+	query := db.WithContext(r.ctx).Model(&model.AuditRecord{})
+	for key, value := range queryMap {
+		query = query.Where(key, value)
+	}
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Order("id ASC").Find(&records).Error
+	return records, err
+}
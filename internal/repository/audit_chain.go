@@ -0,0 +1,112 @@
+package repository
+
+import (
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// ListAuditApprovalStages 查询组织某审核类型的审批链阶段，按阶段序号升序；未配置时返回空列表
+func (r *Repository) ListAuditApprovalStages(db *gorm.DB, orgID int64, targetType int32) ([]*model.AuditApprovalStage, error) {
+	stages := make([]*model.AuditApprovalStage, 0)
+	err := db.WithContext(r.ctx).
+		Where("org_id = ? AND target_type = ?", orgID, targetType).
+		Order("stage_no ASC").
+		Find(&stages).Error
+	return stages, err
+}
+
+// ListAuditApprovalStagesByOrg 查询组织全部审批链阶段
+func (r *Repository) ListAuditApprovalStagesByOrg(db *gorm.DB, orgID int64) ([]*model.AuditApprovalStage, error) {
+	stages := make([]*model.AuditApprovalStage, 0)
+	err := db.WithContext(r.ctx).
+		Where("org_id = ?", orgID).
+		Order("target_type ASC, stage_no ASC").
+		Find(&stages).Error
+	return stages, err
+}
+
+// ReplaceAuditApprovalStages 以新阶段整体替换组织某审核类型的审批链，stages 为空时即清除审批链
+func (r *Repository) ReplaceAuditApprovalStages(db *gorm.DB, orgID int64, targetType int32, stages []*model.AuditApprovalStage) error {
+	if err := db.WithContext(r.ctx).
+		Where("org_id = ? AND target_type = ?", orgID, targetType).
+		Delete(&model.AuditApprovalStage{}).Error; err != nil {
+		return err
+	}
+	if len(stages) == 0 {
+		return nil
+	}
+	return db.WithContext(r.ctx).Create(&stages).Error
+}
+
+// CreateAuditStageDecision 记录审批阶段决定
+func (r *Repository) CreateAuditStageDecision(db *gorm.DB, decision *model.AuditStageDecision) error {
+	return db.WithContext(r.ctx).Create(decision).Error
+}
+
+// ListAuditStageDecisions 查询审核记录的全部阶段决定，按决定先后排序
+func (r *Repository) ListAuditStageDecisions(db *gorm.DB, recordID int64) ([]*model.AuditStageDecision, error) {
+	decisions := make([]*model.AuditStageDecision, 0)
+	err := db.WithContext(r.ctx).
+		Where("audit_record_id = ?", recordID).
+		Order("id ASC").
+		Find(&decisions).Error
+	return decisions, err
+}
+
+// GetAuditRecordForUpdate 加行锁读取审核记录，用于串行化同一记录的多阶段审批
+func (r *Repository) GetAuditRecordForUpdate(db *gorm.DB, id int64) (*model.AuditRecord, error) {
+	var record model.AuditRecord
+	if err := db.WithContext(r.ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ?", id).
+		First(&record).Error; err != nil {
+		return nil, err
+	}
+	return &record, nil
+}
+
+// ListOrgRoleAccountIDs 查询在组织中持有指定管理角色的正常账号：有效的协作管理员授权，
+// 以及未被授权覆盖角色的正式成员（与权限中间件的角色判定顺序一致）
+func (r *Repository) ListOrgRoleAccountIDs(db *gorm.DB, orgID int64, role int32) ([]int64, error) {
+	ids := make([]int64, 0)
+	var granted []int64
+	err := db.WithContext(r.ctx).
+		Table("org_admins AS oa").
+		Joins("JOIN sys_accounts AS acc ON acc.id = oa.account_id").
+		Where("oa.org_id = ? AND oa.role = ? AND oa.status = ? AND acc.status = ?", orgID, role, model.OrgAdminStatusActive, model.SysAccountNormal).
+		Pluck("oa.account_id", &granted).Error
+	if err != nil {
+		return nil, err
+	}
+	ids = append(ids, granted...)
+
+	grantedAny := db.WithContext(r.ctx).
+		Model(&model.OrgAdmin{}).
+		Select("account_id").
+		Where("org_id = ? AND status = ?", orgID, model.OrgAdminStatusActive)
+	var members []int64
+	err = db.WithContext(r.ctx).
+		Table("org_members AS om").
+		Joins("JOIN volunteers AS v ON v.id = om.volunteer_id").
+		Joins("JOIN sys_accounts AS acc ON acc.id = v.account_id").
+		Joins("JOIN organizations AS org ON org.id = om.org_id").
+		Where("om.org_id = ? AND om.role = ? AND om.status = ? AND acc.status = ?", orgID, role, model.MemberStatusActive, model.SysAccountNormal).
+		Where("v.account_id <> org.account_id AND v.account_id NOT IN (?)", grantedAny).
+		Pluck("v.account_id", &members).Error
+	if err != nil {
+		return nil, err
+	}
+	return append(ids, members...), nil
+}
+
+// ListActiveAccountIDsByIdentity 查询指定身份的正常账号ID
+func (r *Repository) ListActiveAccountIDsByIdentity(db *gorm.DB, identityType int) ([]int64, error) {
+	ids := make([]int64, 0)
+	err := db.WithContext(r.ctx).
+		Model(&model.SysAccount{}).
+		Where("identity_type = ? AND status = ?", identityType, model.SysAccountNormal).
+		Pluck("id", &ids).Error
+	return ids, err
+}
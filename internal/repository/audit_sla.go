@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"time"
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// ListAuditRecordsToRemind 查询已超过处理时限（提交时间早于 deadline）且需要提醒的待审核记录：
+// 从未提醒过，或上次提醒早于 remindBefore，按提交先后排序
+func (r *Repository) ListAuditRecordsToRemind(db *gorm.DB, targetType int32, deadline, remindBefore time.Time, limit int) ([]*model.AuditRecord, error) {
+	records := make([]*model.AuditRecord, 0)
+	query := db.WithContext(r.ctx).
+		Where("status = ? AND target_type = ? AND created_at < ?", model.AuditStatusPending, targetType, deadline).
+		Where("last_reminded_at IS NULL OR last_reminded_at < ?", remindBefore).
+		Order("id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Find(&records).Error
+	return records, err
+}
+
+// ListAuditRecordsToEscalate 查询提交时间早于 before 且尚未尝试超时自动处理的待审核记录，按提交先后排序
+func (r *Repository) ListAuditRecordsToEscalate(db *gorm.DB, targetType int32, before time.Time, limit int) ([]*model.AuditRecord, error) {
+	records := make([]*model.AuditRecord, 0)
+	query := db.WithContext(r.ctx).
+		Where("status = ? AND target_type = ? AND created_at < ? AND escalated_at IS NULL", model.AuditStatusPending, targetType, before).
+		Order("id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	err := query.Find(&records).Error
+	return records, err
+}
+
+// CreateAuditReminders 批量写入审核超时提醒
+func (r *Repository) CreateAuditReminders(db *gorm.DB, reminders []*model.AuditReminder) error {
+	if len(reminders) == 0 {
+		return nil
+	}
+	return db.WithContext(r.ctx).Create(&reminders).Error
+}
+
+// ListAuditReminders 分页查询账号收到的审核超时提醒，按提醒时间倒序
+func (r *Repository) ListAuditReminders(db *gorm.DB, accountID int64, unreadOnly bool, limit, offset int) ([]*model.AuditReminder, int64, error) {
+	var total int64
+	reminders := make([]*model.AuditReminder, 0)
+	query := db.WithContext(r.ctx).
+		Model(&model.AuditReminder{}).
+		Where("account_id = ?", accountID)
+	if unreadOnly {
+		query = query.Where("read_at IS NULL")
+	}
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return reminders, 0, nil
+	}
+	err := query.Order("id DESC").Limit(limit).Offset(offset).Find(&reminders).Error
+	return reminders, total, err
+}
+
+// CountUnreadAuditReminders 统计账号未读的审核超时提醒数
+func (r *Repository) CountUnreadAuditReminders(db *gorm.DB, accountID int64) (int64, error) {
+	var count int64
+	err := db.WithContext(r.ctx).
+		Model(&model.AuditReminder{}).
+		Where("account_id = ? AND read_at IS NULL", accountID).
+		Count(&count).Error
+	return count, err
+}
+
+// MarkAuditRemindersRead 将账号的未读提醒标记为已读，ids 为空时标记全部，返回更新行数
+func (r *Repository) MarkAuditRemindersRead(db *gorm.DB, accountID int64, ids []int64, readAt time.Time) (int64, error) {
+	query := db.WithContext(r.ctx).
+		Model(&model.AuditReminder{}).
+		Where("account_id = ? AND read_at IS NULL", accountID)
+	if len(ids) > 0 {
+		query = query.Where("id IN ?", ids)
+	}
+	result := query.Update("read_at", readAt)
+	return result.RowsAffected, result.Error
+}
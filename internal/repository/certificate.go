@@ -0,0 +1,124 @@
+package repository
+
+import (
+	"time"
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// CreateCertificate 创建证书签发记录
+func (r *Repository) CreateCertificate(db *gorm.DB, cert *model.Certificate) error {
+	return db.WithContext(r.ctx).Create(cert).Error
+}
+
+// GetCertificateByID 根据ID获取证书
+func (r *Repository) GetCertificateByID(db *gorm.DB, id int64) (*model.Certificate, error) {
+	var cert model.Certificate
+	if err := db.WithContext(r.ctx).Where("id = ?", id).First(&cert).Error; err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// GetCertificateBySerialNo 根据证书编号获取证书
+func (r *Repository) GetCertificateBySerialNo(db *gorm.DB, serialNo string) (*model.Certificate, error) {
+	var cert model.Certificate
+	if err := db.WithContext(r.ctx).Where("serial_no = ?", serialNo).First(&cert).Error; err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
+
+// FindValidCertificate 查询内容完全一致的有效证书（同一批工时流水重复签发时复用），不存在时返回 nil
+func (r *Repository) FindValidCertificate(db *gorm.DB, cert *model.Certificate) (*model.Certificate, error) {
+	var existing model.Certificate
+	err := db.WithContext(r.ctx).
+		Where("volunteer_id = ? AND org_id = ? AND activity_id = ? AND cert_type = ?", cert.VolunteerID, cert.OrgID, cert.ActivityID, cert.CertType).
+		Where("period_start = ? AND period_end = ? AND work_hour_log_ids = ? AND template_version_id = ? AND status = ?", cert.PeriodStart, cert.PeriodEnd, cert.WorkHourLogIDs, cert.TemplateVersionID, model.CertificateStatusValid).
+		Order("id DESC").
+		First(&existing).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &existing, nil
+}
+
+// ListCertificates 查询证书签发记录
+func (r *Repository) ListCertificates(db *gorm.DB, queryMap map[string]any, limit, offset int) ([]*model.Certificate, int64, error) {
+	var certs []*model.Certificate
+	var total int64
+
+	query := db.WithContext(r.ctx).Model(&model.Certificate{})
+	for key, value := range queryMap {
+		query = query.Where(key, value)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return certs, 0, nil
+	}
+
+	if err := query.Offset(offset).
+		Limit(limit).
+		Order("issued_at DESC, id DESC").
+		Find(&certs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return certs, total, nil
+}
+
+// ListGrantedSignupsForCertificate 查询志愿者在组织活动中已发放工时的报名记录。
+// activityID > 0 时只查该活动；否则按活动开始时间落在 [periodStart, periodEnd] 内筛选。
+func (r *Repository) ListGrantedSignupsForCertificate(db *gorm.DB, volunteerID, orgID, activityID int64, periodStart, periodEnd time.Time) ([]*model.ActivitySignup, error) {
+	var signups []*model.ActivitySignup
+	query := db.WithContext(r.ctx).
+		Where("volunteer_id = ? AND work_hour_status = ? AND granted_hours > 0", volunteerID, model.WorkHourStatusGranted)
+	if activityID > 0 {
+		query = query.Where("activity_id = ?", activityID)
+	} else {
+		query = query.Where("activity_id IN (SELECT id FROM activities WHERE org_id = ? AND start_time >= ? AND start_time <= ?)", orgID, periodStart, periodEnd)
+	}
+	if err := query.Order("activity_id ASC, id ASC").Find(&signups).Error; err != nil {
+		return nil, err
+	}
+	return signups, nil
+}
+
+// RevokeCertificate 撤销有效证书（已撤销时返回 gorm.ErrRecordNotFound）
+func (r *Repository) RevokeCertificate(db *gorm.DB, id int64, reason string, revokedAt time.Time) error {
+	result := db.WithContext(r.ctx).
+		Model(&model.Certificate{}).
+		Where("id = ? AND status = ?", id, model.CertificateStatusValid).
+		Updates(map[string]any{
+			"status":        model.CertificateStatusRevoked,
+			"revoked_at":    revokedAt,
+			"revoke_reason": reason,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// RevokeCertificatesByWorkHourLogID 撤销涵盖指定工时流水的全部有效证书，返回撤销数量
+func (r *Repository) RevokeCertificatesByWorkHourLogID(db *gorm.DB, workHourLogID int64, reason string, revokedAt time.Time) (int64, error) {
+	result := db.WithContext(r.ctx).
+		Model(&model.Certificate{}).
+		Where("status = ? AND FIND_IN_SET(?, work_hour_log_ids) > 0", model.CertificateStatusValid, workHourLogID).
+		Updates(map[string]any{
+			"status":        model.CertificateStatusRevoked,
+			"revoked_at":    revokedAt,
+			"revoke_reason": reason,
+		})
+	return result.RowsAffected, result.Error
+}
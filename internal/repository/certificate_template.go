@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateCertificateTemplate 创建证书模板
+func (r *Repository) CreateCertificateTemplate(db *gorm.DB, tpl *model.CertificateTemplate) error {
+	return db.WithContext(r.ctx).Create(tpl).Error
+}
+
+// GetCertificateTemplateByID 根据ID获取未删除的证书模板
+func (r *Repository) GetCertificateTemplateByID(db *gorm.DB, id int64) (*model.CertificateTemplate, error) {
+	var tpl model.CertificateTemplate
+	err := db.WithContext(r.ctx).
+		Where("id = ? AND status = ?", id, model.CertificateTemplateStatusEnabled).
+		First(&tpl).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tpl, nil
+}
+
+// GetCertificateTemplateByIDForUpdate 根据ID获取未删除的证书模板（加行锁）
+func (r *Repository) GetCertificateTemplateByIDForUpdate(db *gorm.DB, id int64) (*model.CertificateTemplate, error) {
+	var tpl model.CertificateTemplate
+	err := db.WithContext(r.ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND status = ?", id, model.CertificateTemplateStatusEnabled).
+		First(&tpl).Error
+	if err != nil {
+		return nil, err
+	}
+	return &tpl, nil
+}
+
+// GetDefaultCertificateTemplate 获取组织默认证书模板，不存在时返回 nil
+func (r *Repository) GetDefaultCertificateTemplate(db *gorm.DB, orgID int64) (*model.CertificateTemplate, error) {
+	var tpl model.CertificateTemplate
+	err := db.WithContext(r.ctx).
+		Where("org_id = ? AND status = ? AND is_default = 1", orgID, model.CertificateTemplateStatusEnabled).
+		Order("id DESC").
+		First(&tpl).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &tpl, nil
+}
+
+// UpdateCertificateTemplateByID 更新证书模板
+func (r *Repository) UpdateCertificateTemplateByID(db *gorm.DB, id int64, updates map[string]any) error {
+	return db.WithContext(r.ctx).
+		Model(&model.CertificateTemplate{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+// ClearDefaultCertificateTemplates 取消组织下除 exceptID 外的默认模板
+func (r *Repository) ClearDefaultCertificateTemplates(db *gorm.DB, orgID, exceptID int64) error {
+	return db.WithContext(r.ctx).
+		Model(&model.CertificateTemplate{}).
+		Where("org_id = ? AND id <> ? AND is_default = 1", orgID, exceptID).
+		Update("is_default", 0).Error
+}
+
+// ListCertificateTemplates 查询组织的证书模板
+func (r *Repository) ListCertificateTemplates(db *gorm.DB, orgID int64, limit, offset int) ([]*model.CertificateTemplate, int64, error) {
+	var templates []*model.CertificateTemplate
+	var total int64
+
+	query := db.WithContext(r.ctx).
+		Model(&model.CertificateTemplate{}).
+		Where("org_id = ? AND status = ?", orgID, model.CertificateTemplateStatusEnabled)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return templates, 0, nil
+	}
+
+	if err := query.Offset(offset).
+		Limit(limit).
+		Order("is_default DESC, updated_at DESC, id DESC").
+		Find(&templates).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return templates, total, nil
+}
+
+// CreateCertificateTemplateVersion 创建证书模板版本快照
+func (r *Repository) CreateCertificateTemplateVersion(db *gorm.DB, version *model.CertificateTemplateVersion) error {
+	return db.WithContext(r.ctx).Create(version).Error
+}
+
+// GetCertificateTemplateVersionByID 根据ID获取证书模板版本
+func (r *Repository) GetCertificateTemplateVersionByID(db *gorm.DB, id int64) (*model.CertificateTemplateVersion, error) {
+	var version model.CertificateTemplateVersion
+	if err := db.WithContext(r.ctx).Where("id = ?", id).First(&version).Error; err != nil {
+		return nil, err
+	}
+	return &version, nil
+}
+
+// GetCertificateTemplateVersionsByIDs 批量获取证书模板版本
+func (r *Repository) GetCertificateTemplateVersionsByIDs(db *gorm.DB, ids []int64) (map[int64]*model.CertificateTemplateVersion, error) {
+	result := make(map[int64]*model.CertificateTemplateVersion)
+	if len(ids) == 0 {
+		return result, nil
+	}
+	var versions []*model.CertificateTemplateVersion
+	if err := db.WithContext(r.ctx).Where("id IN ?", ids).Find(&versions).Error; err != nil {
+		return nil, err
+	}
+	for _, version := range versions {
+		result[version.ID] = version
+	}
+	return result, nil
+}
+
+// ListCertificateTemplateVersions 查询模板的全部版本（新版本在前）
+func (r *Repository) ListCertificateTemplateVersions(db *gorm.DB, templateID int64) ([]*model.CertificateTemplateVersion, error) {
+	var versions []*model.CertificateTemplateVersion
+	err := db.WithContext(r.ctx).
+		Where("template_id = ?", templateID).
+		Order("version DESC").
+		Find(&versions).Error
+	if err != nil {
+		return nil, err
+	}
+	return versions, nil
+}
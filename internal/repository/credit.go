@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// CreateCreditScoreLog 创建信用分流水
+func (r *Repository) CreateCreditScoreLog(db *gorm.DB, logItem *model.CreditScoreLog) error {
+	return db.WithContext(r.ctx).Create(logItem).Error
+}
+
+// GetCreditScoreLogByIdempotencyKey 根据幂等键获取信用分流水
+func (r *Repository) GetCreditScoreLogByIdempotencyKey(db *gorm.DB, key string) (*model.CreditScoreLog, error) {
+	var logItem model.CreditScoreLog
+	err := db.WithContext(r.ctx).Where("idempotency_key = ?", key).First(&logItem).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &logItem, nil
+}
+
+// ListCreditScoreLogs 查询信用分流水
+func (r *Repository) ListCreditScoreLogs(db *gorm.DB, queryMap map[string]any, limit, offset int) ([]*model.CreditScoreLog, int64, error) {
+	var logs []*model.CreditScoreLog
+	var total int64
+
+	query := db.WithContext(r.ctx).Model(&model.CreditScoreLog{})
+	for key, value := range queryMap {
+		query = query.Where(key, value)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return logs, 0, nil
+	}
+
+	if err := query.Offset(offset).
+		Limit(limit).
+		Order("created_at DESC, id DESC").
+		Find(&logs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return logs, total, nil
+}
+
+// ListUnsettledAttendanceSignupIDs 查询活动下报名成功但尚未判定出勤结果的报名ID
+func (r *Repository) ListUnsettledAttendanceSignupIDs(db *gorm.DB, activityID int64) ([]int64, error) {
+	var ids []int64
+	err := db.WithContext(r.ctx).
+		Model(&model.ActivitySignup{}).
+		Where("activity_id = ? AND status = ? AND attendance_result = ?", activityID, model.ActivitySignupStatusSuccess, model.AttendanceResultPending).
+		Order("id ASC").
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
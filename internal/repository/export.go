@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"time"
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// ActivitySignupExportRow 活动报名名单导出行（报名记录关联志愿者姓名、账号手机号与班次名称）
+type ActivitySignupExportRow struct {
+	ID                 int64      `gorm:"column:id"`
+	VolunteerID        int64      `gorm:"column:volunteer_id"`
+	RealName           string     `gorm:"column:real_name"`
+	Mobile             string     `gorm:"column:mobile"` // 加密存储的手机号
+	SlotName           string     `gorm:"column:slot_name"`
+	SignupTime         time.Time  `gorm:"column:signup_time"`
+	Status             int32      `gorm:"column:status"`
+	CheckInTime        *time.Time `gorm:"column:check_in_time"`
+	CheckInOutOfFence  int32      `gorm:"column:check_in_out_of_fence"`
+	CheckOutTime       *time.Time `gorm:"column:check_out_time"`
+	CheckOutOutOfFence int32      `gorm:"column:check_out_out_of_fence"`
+	AttendanceResult   int32      `gorm:"column:attendance_result"`
+	WorkHourStatus     int32      `gorm:"column:work_hour_status"`
+	GrantedHours       float64    `gorm:"column:granted_hours"`
+	GrantedAt          *time.Time `gorm:"column:granted_at"`
+}
+
+// WorkHourLogExportRow 工时流水导出行（流水关联活动标题、志愿者姓名与账号手机号）
+type WorkHourLogExportRow struct {
+	ID                int64     `gorm:"column:id"`
+	ActivityID        int64     `gorm:"column:activity_id"`
+	ActivityTitle     string    `gorm:"column:activity_title"`
+	SignupID          int64     `gorm:"column:signup_id"`
+	VolunteerID       int64     `gorm:"column:volunteer_id"`
+	RealName          string    `gorm:"column:real_name"`
+	Mobile            string    `gorm:"column:mobile"` // 加密存储的手机号
+	OperationType     int32     `gorm:"column:operation_type"`
+	HoursDelta        float64   `gorm:"column:hours_delta"`
+	ServiceCountDelta int64     `gorm:"column:service_count_delta"`
+	AfterTotalHours   float64   `gorm:"column:after_total_hours"`
+	Reason            string    `gorm:"column:reason"`
+	OperatorID        int64     `gorm:"column:operator_id"`
+	CreatedAt         time.Time `gorm:"column:created_at"`
+}
+
+// CountActivitySignupExportRows 统计活动全部报名记录数（含已取消、已驳回）
+func (r *Repository) CountActivitySignupExportRows(db *gorm.DB, activityID int64) (int64, error) {
+	var count int64
+	err := db.WithContext(r.ctx).
+		Model(&model.ActivitySignup{}).
+		Where("activity_id = ?", activityID).
+		Count(&count).Error
+	return count, err
+}
+
+// ListActivitySignupExportRows 按报名ID升序查询 afterID 之后的一批报名名单，供导出分批读取
+func (r *Repository) ListActivitySignupExportRows(db *gorm.DB, activityID, afterID int64, limit int) ([]*ActivitySignupExportRow, error) {
+	rows := make([]*ActivitySignupExportRow, 0)
+	err := db.WithContext(r.ctx).
+		Table("activity_signups AS s").
+		Select(`s.id, s.volunteer_id, COALESCE(v.real_name, '') AS real_name, COALESCE(a.mobile, '') AS mobile,
+			COALESCE(sl.name, '') AS slot_name, s.signup_time, s.status,
+			s.check_in_time, s.check_in_out_of_fence, s.check_out_time, s.check_out_out_of_fence,
+			s.attendance_result, s.work_hour_status, s.granted_hours, s.granted_at`).
+		Joins("LEFT JOIN volunteers AS v ON v.id = s.volunteer_id").
+		Joins("LEFT JOIN sys_accounts AS a ON a.id = v.account_id").
+		Joins("LEFT JOIN activity_slots AS sl ON sl.id = s.slot_id").
+		Where("s.activity_id = ? AND s.id > ?", activityID, afterID).
+		Order("s.id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// workHourLogExportQuery 组织活动在 [start, end) 内产生的工时流水，activityID 为 0 时不按活动过滤
+func (r *Repository) workHourLogExportQuery(db *gorm.DB, orgID, activityID int64, start, end time.Time) *gorm.DB {
+	query := db.WithContext(r.ctx).
+		Table("work_hour_logs AS l").
+		Joins("JOIN activities AS act ON act.id = l.activity_id").
+		Where("act.org_id = ? AND l.created_at >= ? AND l.created_at < ?", orgID, start, end)
+	if activityID > 0 {
+		query = query.Where("l.activity_id = ?", activityID)
+	}
+	return query
+}
+
+// CountWorkHourLogExportRows 统计待导出的工时流水数量
+func (r *Repository) CountWorkHourLogExportRows(db *gorm.DB, orgID, activityID int64, start, end time.Time) (int64, error) {
+	var count int64
+	err := r.workHourLogExportQuery(db, orgID, activityID, start, end).Count(&count).Error
+	return count, err
+}
+
+// ListWorkHourLogExportRows 按流水ID升序查询 afterID 之后的一批工时流水，供导出分批读取
+func (r *Repository) ListWorkHourLogExportRows(db *gorm.DB, orgID, activityID int64, start, end time.Time, afterID int64, limit int) ([]*WorkHourLogExportRow, error) {
+	rows := make([]*WorkHourLogExportRow, 0)
+	err := r.workHourLogExportQuery(db, orgID, activityID, start, end).
+		Select(`l.id, l.activity_id, act.title AS activity_title, l.signup_id, l.volunteer_id,
+			COALESCE(v.real_name, '') AS real_name, COALESCE(a.mobile, '') AS mobile,
+			l.operation_type, l.hours_delta, l.service_count_delta, l.after_total_hours,
+			l.reason, l.operator_id, l.created_at`).
+		Joins("LEFT JOIN volunteers AS v ON v.id = l.volunteer_id").
+		Joins("LEFT JOIN sys_accounts AS a ON a.id = v.account_id").
+		Where("l.id > ?", afterID).
+		Order("l.id ASC").
+		Limit(limit).
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
@@ -0,0 +1,257 @@
+package repository
+
+import (
+	"errors"
+	"strconv"
+	"time"
+	"volunteer-system/config"
+	"volunteer-system/internal/model"
+
+	goredis "github.com/redis/go-redis/v9"
+	"gorm.io/gorm"
+)
+
+const (
+	jobQueueKey        = "jobs:queue"
+	jobDelayedQueueKey = "jobs:delayed"
+)
+
+// ErrJobQueueUnavailable Redis 不可用时返回，调用方改为轮询数据库
+var ErrJobQueueUnavailable = errors.New("任务队列不可用")
+
+// CreateJob 创建异步任务
+func (r *Repository) CreateJob(db *gorm.DB, job *model.Job) error {
+	return db.WithContext(r.ctx).Create(job).Error
+}
+
+// GetJobByID 根据ID获取异步任务
+func (r *Repository) GetJobByID(db *gorm.DB, id int64) (*model.Job, error) {
+	var job model.Job
+	if err := db.WithContext(r.ctx).Where("id = ?", id).First(&job).Error; err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+// ListJobs 分页查询账号提交的异步任务，jobType 为空、status 为 0 时不过滤
+func (r *Repository) ListJobs(db *gorm.DB, accountID int64, jobType string, status int32, limit, offset int) ([]*model.Job, int64, error) {
+	var jobs []*model.Job
+	var total int64
+
+	query := db.WithContext(r.ctx).
+		Model(&model.Job{}).
+		Where("account_id = ?", accountID)
+	if jobType != "" {
+		query = query.Where("job_type = ?", jobType)
+	}
+	if status > 0 {
+		query = query.Where("status = ?", status)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return jobs, 0, nil
+	}
+
+	if err := query.Offset(offset).
+		Limit(limit).
+		Order("id DESC").
+		Find(&jobs).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return jobs, total, nil
+}
+
+// ClaimJob 领取到期的排队任务并标记为执行中，任务已被领取、未到期或已取消时返回 gorm.ErrRecordNotFound
+func (r *Repository) ClaimJob(db *gorm.DB, id int64, now time.Time) error {
+	result := db.WithContext(r.ctx).
+		Model(&model.Job{}).
+		Where("id = ? AND status = ? AND next_run_at <= ? AND cancel_requested = 0", id, model.JobStatusPending, now).
+		Updates(map[string]any{
+			"status":       model.JobStatusRunning,
+			"attempts":     gorm.Expr("attempts + 1"),
+			"started_at":   now,
+			"heartbeat_at": now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateRunningJob 更新执行中的任务，任务已不在执行中时返回 gorm.ErrRecordNotFound
+func (r *Repository) UpdateRunningJob(db *gorm.DB, id int64, updates map[string]any) error {
+	result := db.WithContext(r.ctx).
+		Model(&model.Job{}).
+		Where("id = ? AND status = ?", id, model.JobStatusRunning).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// CancelPendingJob 取消排队中的任务，任务已不在排队时返回 gorm.ErrRecordNotFound
+func (r *Repository) CancelPendingJob(db *gorm.DB, id int64, now time.Time) error {
+	result := db.WithContext(r.ctx).
+		Model(&model.Job{}).
+		Where("id = ? AND status = ?", id, model.JobStatusPending).
+		Updates(map[string]any{
+			"status":           model.JobStatusCancelled,
+			"cancel_requested": 1,
+			"finished_at":      now,
+		})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// RequestJobCancel 标记执行中的任务需要取消，由执行该任务的 worker 在心跳时感知
+func (r *Repository) RequestJobCancel(db *gorm.DB, id int64) error {
+	return r.UpdateRunningJob(db, id, map[string]any{"cancel_requested": 1})
+}
+
+// ListDueJobIDs 查询已到执行时间的排队任务ID
+func (r *Repository) ListDueJobIDs(db *gorm.DB, before time.Time, limit int) ([]int64, error) {
+	ids := make([]int64, 0)
+	err := db.WithContext(r.ctx).
+		Model(&model.Job{}).
+		Where("status = ? AND next_run_at <= ? AND cancel_requested = 0", model.JobStatusPending, before).
+		Order("next_run_at ASC, id ASC").
+		Limit(limit).
+		Pluck("id", &ids).Error
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ListStaleRunningJobs 查询心跳超时的执行中任务（worker 异常退出导致）
+func (r *Repository) ListStaleRunningJobs(db *gorm.DB, staleBefore time.Time, limit int) ([]*model.Job, error) {
+	jobs := make([]*model.Job, 0)
+	err := db.WithContext(r.ctx).
+		Where("status = ? AND (heartbeat_at IS NULL OR heartbeat_at < ?)", model.JobStatusRunning, staleBefore).
+		Order("id ASC").
+		Limit(limit).
+		Find(&jobs).Error
+	if err != nil {
+		return nil, err
+	}
+	return jobs, nil
+}
+
+// UpdateStaleRunningJob 更新心跳超时的执行中任务，任务已恢复心跳或已结束时返回 gorm.ErrRecordNotFound
+func (r *Repository) UpdateStaleRunningJob(db *gorm.DB, id int64, staleBefore time.Time, updates map[string]any) error {
+	result := db.WithContext(r.ctx).
+		Model(&model.Job{}).
+		Where("id = ? AND status = ? AND (heartbeat_at IS NULL OR heartbeat_at < ?)", id, model.JobStatusRunning, staleBefore).
+		Updates(updates)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// HasJobQueue Redis 任务队列是否可用
+func (r *Repository) HasJobQueue() bool {
+	return r.rDB != nil
+}
+
+// EnqueueJob 将任务ID推入待执行队列
+func (r *Repository) EnqueueJob(id int64) error {
+	if r.rDB == nil {
+		return ErrJobQueueUnavailable
+	}
+	return r.rDB.LPush(r.ctx, jobQueueKeyPrefix()+jobQueueKey, id).Err()
+}
+
+// EnqueueDelayedJob 将任务ID放入延迟队列，到期后由 PromoteDueJobs 转入待执行队列
+func (r *Repository) EnqueueDelayedJob(id int64, runAt time.Time) error {
+	if r.rDB == nil {
+		return ErrJobQueueUnavailable
+	}
+	return r.rDB.ZAdd(r.ctx, jobQueueKeyPrefix()+jobDelayedQueueKey, goredis.Z{
+		Score:  float64(runAt.Unix()),
+		Member: id,
+	}).Err()
+}
+
+// DequeueJob 阻塞等待待执行的任务ID，超时返回 0
+func (r *Repository) DequeueJob(timeout time.Duration) (int64, error) {
+	if r.rDB == nil {
+		return 0, ErrJobQueueUnavailable
+	}
+	values, err := r.rDB.BRPop(r.ctx, timeout, jobQueueKeyPrefix()+jobQueueKey).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	// BRPOP 返回 [key, value]
+	if len(values) != 2 {
+		return 0, nil
+	}
+	id, err := strconv.ParseInt(values[1], 10, 64)
+	if err != nil {
+		return 0, nil
+	}
+	return id, nil
+}
+
+// PromoteDueJobs 将到期的延迟任务转入待执行队列，返回转移数量。
+// 多实例同时执行时以 ZREM 成功与否判定归属，避免重复入队。
+func (r *Repository) PromoteDueJobs(now time.Time, limit int64) (int64, error) {
+	if r.rDB == nil {
+		return 0, ErrJobQueueUnavailable
+	}
+	delayedKey := jobQueueKeyPrefix() + jobDelayedQueueKey
+	members, err := r.rDB.ZRangeByScore(r.ctx, delayedKey, &goredis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.Unix(), 10),
+		Count: limit,
+	}).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	var promoted int64
+	for _, member := range members {
+		removed, err := r.rDB.ZRem(r.ctx, delayedKey, member).Result()
+		if err != nil {
+			return promoted, err
+		}
+		if removed == 0 {
+			continue
+		}
+		if err := r.rDB.LPush(r.ctx, jobQueueKeyPrefix()+jobQueueKey, member).Err(); err != nil {
+			return promoted, err
+		}
+		promoted++
+	}
+	return promoted, nil
+}
+
+func jobQueueKeyPrefix() string {
+	cfg := config.GetConfig()
+	if cfg == nil || cfg.Redis == nil {
+		return ""
+	}
+	return cfg.Redis.KeyPrefix
+}
@@ -0,0 +1,108 @@
+package repository
+
+import (
+	"errors"
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// OrgAdminRow 协作管理员及账号信息
+type OrgAdminRow struct {
+	model.OrgAdmin
+	Username string `gorm:"column:username"`
+	Email    string `gorm:"column:email"`
+}
+
+// OrgAdminInvitationRow 账号收到的协作管理员授权及组织信息
+type OrgAdminInvitationRow struct {
+	model.OrgAdmin
+	OrgName string `gorm:"column:org_name"`
+}
+
+// CreateOrgAdmin 创建协作管理员授权
+func (r *Repository) CreateOrgAdmin(db *gorm.DB, admin *model.OrgAdmin) error {
+	return db.WithContext(r.ctx).Create(admin).Error
+}
+
+// UpdateOrgAdminFields 更新协作管理员授权
+func (r *Repository) UpdateOrgAdminFields(db *gorm.DB, id int64, updates map[string]any) error {
+	return db.WithContext(r.ctx).
+		Model(&model.OrgAdmin{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+// GetOrgAdminByID 根据ID获取协作管理员授权
+func (r *Repository) GetOrgAdminByID(db *gorm.DB, id int64) (*model.OrgAdmin, error) {
+	var admin model.OrgAdmin
+	if err := db.WithContext(r.ctx).Where("id = ?", id).First(&admin).Error; err != nil {
+		return nil, err
+	}
+	return &admin, nil
+}
+
+// FindOrgAdmin 查询账号在组织中的协作管理员授权，不存在时返回 nil
+func (r *Repository) FindOrgAdmin(db *gorm.DB, orgID, accountID int64) (*model.OrgAdmin, error) {
+	var admin model.OrgAdmin
+	err := db.WithContext(r.ctx).
+		Where("org_id = ? AND account_id = ?", orgID, accountID).
+		First(&admin).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &admin, nil
+}
+
+// ListOrgAdmins 查询组织的协作管理员（不含已拒绝、已撤销）
+func (r *Repository) ListOrgAdmins(db *gorm.DB, orgID int64) ([]*OrgAdminRow, error) {
+	rows := make([]*OrgAdminRow, 0)
+	err := db.WithContext(r.ctx).
+		Table("org_admins AS oa").
+		Select("oa.*, COALESCE(acc.username, '') AS username, COALESCE(acc.email, '') AS email").
+		Joins("LEFT JOIN sys_accounts AS acc ON acc.id = oa.account_id").
+		Where("oa.org_id = ? AND oa.status IN ?", orgID, []int32{model.OrgAdminStatusPending, model.OrgAdminStatusActive}).
+		Order("oa.id ASC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// ListOrgAdminInvitations 查询账号收到的协作管理员授权（待接受与有效）
+func (r *Repository) ListOrgAdminInvitations(db *gorm.DB, accountID int64) ([]*OrgAdminInvitationRow, error) {
+	rows := make([]*OrgAdminInvitationRow, 0)
+	err := db.WithContext(r.ctx).
+		Table("org_admins AS oa").
+		Select("oa.*, COALESCE(org.org_name, '') AS org_name").
+		Joins("LEFT JOIN organizations AS org ON org.id = oa.org_id").
+		Where("oa.account_id = ? AND oa.status IN ?", accountID, []int32{model.OrgAdminStatusPending, model.OrgAdminStatusActive}).
+		Order("oa.id DESC").
+		Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// FindManagedOrganizations 查询账号可管理的组织：名下组织及有效的协作管理员授权组织
+func (r *Repository) FindManagedOrganizations(db *gorm.DB, accountID int64) ([]*model.Organization, error) {
+	organizations := make([]*model.Organization, 0)
+	granted := db.WithContext(r.ctx).
+		Model(&model.OrgAdmin{}).
+		Select("org_id").
+		Where("account_id = ? AND status = ?", accountID, model.OrgAdminStatusActive)
+	err := db.WithContext(r.ctx).
+		Model(&model.Organization{}).
+		Where("account_id = ? OR id IN (?)", accountID, granted).
+		Order("id ASC").
+		Find(&organizations).Error
+	if err != nil {
+		return nil, err
+	}
+	return organizations, nil
+}
@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// CreateOrgQualificationDocument 保存组织资质材料
+func (r *Repository) CreateOrgQualificationDocument(db *gorm.DB, doc *model.OrgQualificationDocument) error {
+	return db.WithContext(r.ctx).Create(doc).Error
+}
+
+// GetOrgQualificationDocumentByID 根据ID获取组织资质材料
+func (r *Repository) GetOrgQualificationDocumentByID(db *gorm.DB, id int64) (*model.OrgQualificationDocument, error) {
+	var doc model.OrgQualificationDocument
+	if err := db.WithContext(r.ctx).Where("id = ?", id).First(&doc).Error; err != nil {
+		return nil, err
+	}
+	return &doc, nil
+}
+
+// DeleteOrgQualificationDocument 删除组织资质材料
+func (r *Repository) DeleteOrgQualificationDocument(db *gorm.DB, id int64) error {
+	return db.WithContext(r.ctx).Delete(&model.OrgQualificationDocument{}, id).Error
+}
+
+// ListOrgQualificationDocuments 查询组织某次提交的资质材料，auditRecordID 为 0 时查询尚未提交的材料
+func (r *Repository) ListOrgQualificationDocuments(db *gorm.DB, orgID, auditRecordID int64) ([]*model.OrgQualificationDocument, error) {
+	var docs []*model.OrgQualificationDocument
+	err := db.WithContext(r.ctx).
+		Where("org_id = ? AND audit_record_id = ?", orgID, auditRecordID).
+		Order("doc_type ASC, id ASC").
+		Find(&docs).Error
+	return docs, err
+}
+
+// ListOrgQualificationDocumentsByRecordIDs 批量查询审核记录绑定的资质材料
+func (r *Repository) ListOrgQualificationDocumentsByRecordIDs(db *gorm.DB, auditRecordIDs []int64) ([]*model.OrgQualificationDocument, error) {
+	var docs []*model.OrgQualificationDocument
+	if len(auditRecordIDs) == 0 {
+		return docs, nil
+	}
+	err := db.WithContext(r.ctx).
+		Where("audit_record_id IN ?", auditRecordIDs).
+		Order("doc_type ASC, id ASC").
+		Find(&docs).Error
+	return docs, err
+}
+
+// BindOrgQualificationDocuments 将组织尚未提交的资质材料绑定到审核记录
+func (r *Repository) BindOrgQualificationDocuments(db *gorm.DB, orgID, auditRecordID int64) error {
+	return db.WithContext(r.ctx).
+		Model(&model.OrgQualificationDocument{}).
+		Where("org_id = ? AND audit_record_id = 0", orgID).
+		Update("audit_record_id", auditRecordID).Error
+}
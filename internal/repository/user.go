@@ -91,4 +91,54 @@ func (r *Repository) FindByEmail(db *gorm.DB, email string) (*model.SysAccount,
 		return nil, err
 	}
 	return &user, nil
-}
\ No newline at end of file
+}
+
+// ListAccounts 分页查询系统账号（平台管理端）
+func (r *Repository) ListAccounts(db *gorm.DB, queryMap map[string]any, limit, offset int) ([]*model.SysAccount, int64, error) {
+	var accounts []*model.SysAccount
+	var total int64
+
+	query := db.WithContext(r.ctx).Model(&model.SysAccount{})
+	for key, value := range queryMap {
+		query = query.Where(key, value)
+	}
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return accounts, 0, nil
+	}
+
+	if err := query.Offset(offset).
+		Limit(limit).
+		Order("id DESC").
+		Find(&accounts).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return accounts, total, nil
+}
+
+// UpdateAccountStatus 更新账号状态
+func (r *Repository) UpdateAccountStatus(db *gorm.DB, id int64, status int32) error {
+	return db.WithContext(r.ctx).Model(&model.SysAccount{}).
+		Where("id = ?", id).
+		Update("status", status).Error
+}
+
+// GetAccountsByIDs 批量查询账号，返回 ID -> 账号
+func (r *Repository) GetAccountsByIDs(db *gorm.DB, ids []int64) (map[int64]*model.SysAccount, error) {
+	result := make(map[int64]*model.SysAccount, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+	var accounts []*model.SysAccount
+	if err := db.WithContext(r.ctx).Where("id IN ?", ids).Find(&accounts).Error; err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		result[account.ID] = account
+	}
+	return result, nil
+}
@@ -127,3 +127,14 @@ func (r *Repository) FindVolunteerIDsByKeyword(db *gorm.DB, keyword string) ([]i
 	}
 	return volunteerIDs, nil
 }
+
+// ExistsVerifiedVolunteerByIDCardHash 查询身份证号是否已被其他志愿者实名认证
+func (r *Repository) ExistsVerifiedVolunteerByIDCardHash(db *gorm.DB, idCardHash string, excludeVolunteerID int64) (bool, error) {
+	var count int64
+	if err := db.WithContext(r.ctx).Model(&model.Volunteer{}).
+		Where("id_card_hash = ? AND id <> ? AND audit_status = ?", idCardHash, excludeVolunteerID, model.VolunteerAuditStatusApproved).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
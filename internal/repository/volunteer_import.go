@@ -0,0 +1,75 @@
+package repository
+
+import (
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// CreateVolunteerImportBatch 创建志愿者导入批次
+func (r *Repository) CreateVolunteerImportBatch(db *gorm.DB, batch *model.VolunteerImportBatch) error {
+	return db.WithContext(r.ctx).Create(batch).Error
+}
+
+// UpdateVolunteerImportBatchByID 更新志愿者导入批次
+func (r *Repository) UpdateVolunteerImportBatchByID(db *gorm.DB, id int64, updates map[string]any) error {
+	return db.WithContext(r.ctx).
+		Model(&model.VolunteerImportBatch{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
+
+// GetVolunteerImportBatchByID 根据ID获取志愿者导入批次
+func (r *Repository) GetVolunteerImportBatchByID(db *gorm.DB, id int64) (*model.VolunteerImportBatch, error) {
+	var batch model.VolunteerImportBatch
+	if err := db.WithContext(r.ctx).Where("id = ?", id).First(&batch).Error; err != nil {
+		return nil, err
+	}
+	return &batch, nil
+}
+
+// ListVolunteerImportBatches 分页查询组织的志愿者导入批次
+func (r *Repository) ListVolunteerImportBatches(db *gorm.DB, orgID int64, limit, offset int) ([]*model.VolunteerImportBatch, int64, error) {
+	var batches []*model.VolunteerImportBatch
+	var total int64
+
+	query := db.WithContext(r.ctx).
+		Model(&model.VolunteerImportBatch{}).
+		Where("org_id = ?", orgID)
+
+	if err := query.Count(&total).Error; err != nil {
+		return nil, 0, err
+	}
+	if total == 0 {
+		return batches, 0, nil
+	}
+
+	if err := query.Offset(offset).
+		Limit(limit).
+		Order("id DESC").
+		Find(&batches).Error; err != nil {
+		return nil, 0, err
+	}
+
+	return batches, total, nil
+}
+
+// CreateVolunteerImportFailures 批量保存导入失败明细
+func (r *Repository) CreateVolunteerImportFailures(db *gorm.DB, failures []*model.VolunteerImportFailure) error {
+	if len(failures) == 0 {
+		return nil
+	}
+	return db.WithContext(r.ctx).CreateInBatches(failures, 200).Error
+}
+
+// ListVolunteerImportFailures 查询导入批次的全部失败明细（按行号排序）
+func (r *Repository) ListVolunteerImportFailures(db *gorm.DB, batchID int64) ([]*model.VolunteerImportFailure, error) {
+	failures := make([]*model.VolunteerImportFailure, 0)
+	if err := db.WithContext(r.ctx).
+		Where("batch_id = ?", batchID).
+		Order("row_no ASC, id ASC").
+		Find(&failures).Error; err != nil {
+		return nil, err
+	}
+	return failures, nil
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CreateActivityWaitlist 写入候补记录
+func (r *Repository) CreateActivityWaitlist(db *gorm.DB, entry *model.ActivityWaitlist) error {
+	return db.WithContext(r.ctx).Create(entry).Error
+}
+
+// GetWaitingWaitlistEntry 查询志愿者在活动下排队中的候补记录，不存在时返回 nil
+func (r *Repository) GetWaitingWaitlistEntry(db *gorm.DB, activityID, volunteerID int64) (*model.ActivityWaitlist, error) {
+	var entry model.ActivityWaitlist
+	err := db.WithContext(r.ctx).
+		Where("activity_id = ? AND volunteer_id = ? AND status = ?", activityID, volunteerID, model.ActivityWaitlistStatusWaiting).
+		Order("id ASC").
+		First(&entry).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// GetLatestWaitlistEntry 查询志愿者在活动下最近一条候补记录（任意状态），不存在时返回 nil
+func (r *Repository) GetLatestWaitlistEntry(db *gorm.DB, activityID, volunteerID int64) (*model.ActivityWaitlist, error) {
+	var entry model.ActivityWaitlist
+	err := db.WithContext(r.ctx).
+		Where("activity_id = ? AND volunteer_id = ?", activityID, volunteerID).
+		Order("id DESC").
+		First(&entry).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &entry, nil
+}
+
+// CountWaitlistPosition 统计候补记录当前排位（排在其前面的排队人数 + 1）
+func (r *Repository) CountWaitlistPosition(db *gorm.DB, activityID, entryID int64) (int64, error) {
+	var count int64
+	err := db.WithContext(r.ctx).
+		Model(&model.ActivityWaitlist{}).
+		Where("activity_id = ? AND status = ? AND id <= ?", activityID, model.ActivityWaitlistStatusWaiting, entryID).
+		Count(&count).Error
+	return count, err
+}
+
+// CountWaitingWaitlist 统计活动排队中的候补人数
+func (r *Repository) CountWaitingWaitlist(db *gorm.DB, activityID int64) (int64, error) {
+	var count int64
+	err := db.WithContext(r.ctx).
+		Model(&model.ActivityWaitlist{}).
+		Where("activity_id = ? AND status = ?", activityID, model.ActivityWaitlistStatusWaiting).
+		Count(&count).Error
+	return count, err
+}
+
+// CountPromotedWaitlistHolding 统计已递补且报名审核仍待处理的人数（这部分名额视为已占用）
+func (r *Repository) CountPromotedWaitlistHolding(db *gorm.DB, activityID int64) (int64, error) {
+	var count int64
+	err := db.WithContext(r.ctx).
+		Model(&model.ActivityWaitlist{}).
+		Where("activity_id = ? AND status = ?", activityID, model.ActivityWaitlistStatusPromoted).
+		Where("audit_record_id IN (SELECT id FROM audit_records WHERE status = ?)", model.AuditStatusPending).
+		Count(&count).Error
+	return count, err
+}
+
+// CountWaitingWaitlistBySlot 统计班次排队中的候补人数
+func (r *Repository) CountWaitingWaitlistBySlot(db *gorm.DB, activityID, slotID int64) (int64, error) {
+	var count int64
+	err := db.WithContext(r.ctx).
+		Model(&model.ActivityWaitlist{}).
+		Where("activity_id = ? AND slot_id = ? AND status = ?", activityID, slotID, model.ActivityWaitlistStatusWaiting).
+		Count(&count).Error
+	return count, err
+}
+
+// CountPromotedWaitlistHoldingBySlot 统计班次已递补且报名审核仍待处理的人数（这部分班次名额视为已占用）
+func (r *Repository) CountPromotedWaitlistHoldingBySlot(db *gorm.DB, slotID int64) (int64, error) {
+	var count int64
+	err := db.WithContext(r.ctx).
+		Model(&model.ActivityWaitlist{}).
+		Where("slot_id = ? AND status = ?", slotID, model.ActivityWaitlistStatusPromoted).
+		Where("audit_record_id IN (SELECT id FROM audit_records WHERE status = ?)", model.AuditStatusPending).
+		Count(&count).Error
+	return count, err
+}
+
+// ListWaitingWaitlistForUpdate 按入队顺序查询排队中的候补记录并加行锁，limit<=0 表示不限制
+func (r *Repository) ListWaitingWaitlistForUpdate(db *gorm.DB, activityID int64, limit int) ([]*model.ActivityWaitlist, error) {
+	var list []*model.ActivityWaitlist
+	query := db.WithContext(r.ctx).
+		Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("activity_id = ? AND status = ?", activityID, model.ActivityWaitlistStatusWaiting).
+		Order("id ASC")
+	if limit > 0 {
+		query = query.Limit(limit)
+	}
+	if err := query.Find(&list).Error; err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// UpdateActivityWaitlistByID 更新候补记录
+func (r *Repository) UpdateActivityWaitlistByID(db *gorm.DB, id int64, updates map[string]any) error {
+	return db.WithContext(r.ctx).
+		Model(&model.ActivityWaitlist{}).
+		Where("id = ?", id).
+		Updates(updates).Error
+}
@@ -59,3 +59,16 @@ func (r *Repository) ListWorkHourLogs(db *gorm.DB, queryMap map[string]any, limi
 
 	return logs, total, nil
 }
+
+// CountVoidLogsByRefLogIDs 统计作废了指定流水的作废流水数量
+func (r *Repository) CountVoidLogsByRefLogIDs(db *gorm.DB, refLogIDs []int64) (int64, error) {
+	if len(refLogIDs) == 0 {
+		return 0, nil
+	}
+	var count int64
+	err := db.WithContext(r.ctx).
+		Model(&model.WorkHourLog{}).
+		Where("operation_type = ? AND ref_log_id IN ?", model.WorkHourOperationVoid, refLogIDs).
+		Count(&count).Error
+	return count, err
+}
@@ -2,6 +2,8 @@ package router
 
 import (
 	"volunteer-system/internal/handler"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
 
 	"github.com/cloudwego/hertz/pkg/route"
 )
@@ -12,19 +14,32 @@ func RegisterActivityRouter(r *route.RouterGroup) {
 	r.POST("/activities", handler.ActivityList)
 	r.POST("/activities/signup", handler.ActivitySignup)
 	r.POST("/activities/cancel", handler.ActivityCancel)
+	r.GET("/activities/waitlist/:id", handler.ActivityWaitlistStatus)
+	r.POST("/activities/waitlist/leave", handler.ActivityWaitlistLeave)
+	r.GET("/activities/series/:id", handler.ActivitySeriesDetail)
+	r.POST("/activities/series/signup", handler.ActivitySeriesSignup)
+	r.GET("/activities/slots/:id", handler.ActivitySlotList)
 	r.GET("/activities/:id", handler.ActivityDetail)
 	r.POST("/activities/my", handler.MyActivities)
 	r.POST("/activities/checkin", handler.ActivityCheckIn)
 	r.POST("/activities/checkout", handler.ActivityCheckOut)
 
-	// 组织端 - 活动管理
-	r.POST("/activities/create", handler.CreateActivity)
-	r.PUT("/activities/:id", handler.UpdateActivity)
-	r.DELETE("/activities/:id", handler.DeleteActivity)
-	r.POST("/activities/cancel/:id", handler.CancelActivity)
-	r.POST("/activities/finish/:id", handler.FinishActivity)
-	r.POST("/activities/attendance-codes/generate/:id", handler.GenerateAttendanceCodes)
-	r.POST("/activities/attendance-codes/reset/:id", handler.ResetAttendanceCode)
-	r.GET("/activities/attendance-codes/:id", handler.GetActivityAttendanceCodes)
-	r.POST("/activities/supplement-attendance", handler.ActivitySupplementAttendance)
+	// 组织端 - 活动管理（组织主账号或具备相应权限的组织成员）
+	r.POST("/activities/create", middleware.RequireOrgPermission(model.PermActivityCreate), handler.CreateActivity)
+	r.PUT("/activities/:id", middleware.RequireOrgPermission(model.PermActivityUpdate), handler.UpdateActivity)
+	r.DELETE("/activities/:id", middleware.RequireOrgPermission(model.PermActivityDelete), handler.DeleteActivity)
+	r.POST("/activities/cancel/:id", middleware.RequireOrgPermission(model.PermActivityUpdate), handler.CancelActivity)
+	r.POST("/activities/finish/:id", middleware.RequireOrgPermission(model.PermActivityUpdate), handler.FinishActivity)
+	r.POST("/activities/series/create", middleware.RequireOrgPermission(model.PermActivityCreate), handler.CreateActivitySeries)
+	r.POST("/activities/slots/create", middleware.RequireOrgPermission(model.PermActivityUpdate), handler.CreateActivitySlot)
+	r.POST("/activities/slots/update/:id", middleware.RequireOrgPermission(model.PermActivityUpdate), handler.UpdateActivitySlot)
+	r.POST("/activities/slots/delete/:id", middleware.RequireOrgPermission(model.PermActivityUpdate), handler.DeleteActivitySlot)
+	r.POST("/activities/attendance-codes/generate/:id", middleware.RequireOrgPermission(model.PermActivityAttendance), handler.GenerateAttendanceCodes)
+	r.POST("/activities/attendance-codes/reset/:id", middleware.RequireOrgPermission(model.PermActivityAttendance), handler.ResetAttendanceCode)
+	r.GET("/activities/attendance-codes/:id", middleware.RequireOrgPermission(model.PermActivityAttendance), handler.GetActivityAttendanceCodes)
+	r.GET("/activities/attendance-codes/qrcode/:id", middleware.RequireOrgPermission(model.PermActivityAttendance), handler.AttendanceQRCode)
+	r.POST("/activities/supplement-attendance", middleware.RequireOrgPermission(model.PermActivityAttendance), handler.ActivitySupplementAttendance)
+	r.POST("/activities/batch-attendance", middleware.RequireOrgPermission(model.PermActivityAttendance), handler.ActivityBatchAttendance)
+	r.GET("/activities/pending-settlements/:id", middleware.RequireOrgPermission(model.PermActivityAttendance), handler.PendingSettlementList)
+	r.POST("/activities/pending-settlements/confirm", middleware.RequireOrgPermission(model.PermActivityAttendance), handler.ConfirmPendingSettlement)
 }
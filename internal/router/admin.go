@@ -0,0 +1,25 @@
+package router
+
+import (
+	"volunteer-system/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/route"
+)
+
+// RegisterAdminRouter 注册平台管理后台路由（需在平台管理员路由组下注册）
+func RegisterAdminRouter(r *route.RouterGroup) {
+	r.POST("/accounts/list", handler.AdminAccountList)
+	r.POST("/accounts/status", handler.AdminUpdateAccountStatus)
+	r.POST("/organizations/list", handler.AdminOrganizationList)
+	r.POST("/audits/list", handler.AdminAuditList)
+	r.POST("/audits/inbox", handler.AuditInbox)
+	// 审核处理复用审核接口，操作权限由审核服务按审核类型区分
+	r.POST("/audits/approval", handler.AuditApproval)
+	r.POST("/audits/rejection", handler.AuditRejection)
+	r.POST("/audits/batch-approval", handler.BatchAuditApproval)
+	r.POST("/audits/batch-rejection", handler.BatchAuditRejection)
+	r.GET("/audits/records/:id", handler.AuditRecordDetail)
+	r.GET("/audit-chains", handler.ListAuditApprovalChains)
+	r.POST("/audit-chains/save", handler.SaveAuditApprovalChain)
+	r.GET("/org-qualifications/documents/:id", handler.DownloadQualificationDocument)
+}
@@ -2,14 +2,24 @@ package router
 
 import (
 	"volunteer-system/internal/handler"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
 
 	"github.com/cloudwego/hertz/pkg/route"
 )
 
 // RegisterAuditRouter registers audit related routes.
 func RegisterAuditRouter(r *route.RouterGroup) {
-	r.POST("/audits/volunteer-join-org/pending", handler.PendingVolunteerJoinOrgAuditList)
-	r.POST("/audits/approval", handler.AuditApproval)
-	r.POST("/audits/rejection", handler.AuditRejection)
-	r.GET("/audits/records/:id", handler.AuditRecordDetail)
+	r.POST("/audits/volunteer-join-org/pending", middleware.RequireOrgPermission(model.PermAuditReview), handler.PendingVolunteerJoinOrgAuditList)
+	r.POST("/audits/inbox", middleware.RequireOrgPermission(model.PermAuditReview), handler.AuditInbox)
+	r.POST("/audits/approval", middleware.RequireOrgPermission(model.PermAuditReview), handler.AuditApproval)
+	r.POST("/audits/rejection", middleware.RequireOrgPermission(model.PermAuditReview), handler.AuditRejection)
+	r.POST("/audits/batch-approval", middleware.RequireOrgPermission(model.PermAuditReview), handler.BatchAuditApproval)
+	r.POST("/audits/batch-rejection", middleware.RequireOrgPermission(model.PermAuditReview), handler.BatchAuditRejection)
+	r.GET("/audits/records/:id", middleware.RequireOrgPermission(model.PermAuditReview), handler.AuditRecordDetail)
+	r.GET("/audits/my-records/:id", handler.AuditRecordDetail)
+	r.GET("/audit-chains", middleware.RequireOrgPermission(model.PermAuditChain), handler.ListAuditApprovalChains)
+	r.POST("/audit-chains/save", middleware.RequireOrgPermission(model.PermAuditChain), handler.SaveAuditApprovalChain)
+	r.POST("/audit-reminders/list", handler.ListAuditReminders)
+	r.POST("/audit-reminders/read", handler.ReadAuditReminders)
 }
@@ -0,0 +1,32 @@
+package router
+
+import (
+	"volunteer-system/internal/handler"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+
+	"github.com/cloudwego/hertz/pkg/route"
+)
+
+// RegisterCertificateRouter 注册证书相关路由
+func RegisterCertificateRouter(r *route.RouterGroup) {
+	// 志愿者查看、申请本人证书；组织侧按证书管理权限操作本组织证书
+	shared := middleware.OptionalOrgPermission(model.PermCertificateManage)
+	r.POST("/certificates/issue", shared, handler.IssueCertificate)
+	r.POST("/certificates/list", shared, handler.CertificateList)
+	r.GET("/certificates/download/:id", shared, handler.DownloadCertificate)
+
+	// 证书模板（组织侧）
+	perm := middleware.RequireOrgPermission(model.PermCertificateManage)
+	r.POST("/certificate-templates/create", perm, handler.CreateCertificateTemplate)
+	r.POST("/certificate-templates/update", perm, handler.UpdateCertificateTemplate)
+	r.POST("/certificate-templates/delete", perm, handler.DeleteCertificateTemplate)
+	r.POST("/certificate-templates/list", perm, handler.CertificateTemplateList)
+	r.GET("/certificate-templates/detail/:id", perm, handler.CertificateTemplateDetail)
+	r.POST("/certificate-templates/preview", perm, handler.PreviewCertificateTemplate)
+}
+
+// RegisterCertificatePublicRouter 注册证书公开核验路由（无需认证）
+func RegisterCertificatePublicRouter(r *route.RouterGroup) {
+	r.GET("/certificates/verify", handler.VerifyCertificate)
+}
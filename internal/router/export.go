@@ -0,0 +1,17 @@
+package router
+
+import (
+	"volunteer-system/internal/handler"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+
+	"github.com/cloudwego/hertz/pkg/route"
+)
+
+// RegisterExportRouter 注册数据导出相关路由（导出文件通过异步任务结果下载）
+func RegisterExportRouter(r *route.RouterGroup) {
+	perm := middleware.RequireOrgPermission(model.PermDataExport)
+	r.POST("/exports/activities", perm, handler.ExportActivities)
+	r.POST("/exports/activity-signups", perm, handler.ExportActivitySignups)
+	r.POST("/exports/work-hour-logs", perm, handler.ExportWorkHourLogs)
+}
@@ -0,0 +1,15 @@
+package router
+
+import (
+	"volunteer-system/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/route"
+)
+
+// RegisterJobRouter 注册异步任务相关路由
+func RegisterJobRouter(r *route.RouterGroup) {
+	r.POST("/jobs/list", handler.JobList)
+	r.GET("/jobs/detail/:id", handler.JobDetail)
+	r.POST("/jobs/cancel", handler.CancelJob)
+	r.GET("/jobs/result/:id", handler.DownloadJobResult)
+}
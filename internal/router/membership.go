@@ -2,6 +2,8 @@ package router
 
 import (
 	"volunteer-system/internal/handler"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
 
 	"github.com/cloudwego/hertz/pkg/route"
 )
@@ -12,6 +14,6 @@ func RegisterMembershipRouter(r *route.RouterGroup) {
 	r.POST("/memberships/leave", handler.VolunteerLeaveOrganization)
 	r.GET("/organizations/:organizationId/members", handler.GetOrganizationMembers)
 	r.GET("/volunteers/:volunteerId/organizations", handler.GetVolunteerOrganizations)
-	r.POST("/memberships/status/update", handler.UpdateMemberStatus)
+	r.POST("/memberships/status/update", middleware.RequireOrgPermission(model.PermMemberApprove), handler.UpdateMemberStatus)
 	r.GET("/memberships/stats", handler.MembershipStats)
 }
@@ -0,0 +1,19 @@
+package router
+
+import (
+	"volunteer-system/internal/handler"
+
+	"github.com/cloudwego/hertz/pkg/route"
+)
+
+// RegisterOrgAdminRouter 注册组织协作管理员相关路由
+func RegisterOrgAdminRouter(r *route.RouterGroup) {
+	r.POST("/org-admins/invite", handler.InviteOrgAdmin)
+	r.POST("/org-admins/list", handler.OrgAdminList)
+	r.POST("/org-admins/role", handler.UpdateOrgAdminRole)
+	r.POST("/org-admins/revoke", handler.RevokeOrgAdmin)
+	r.POST("/org-admins/invitations", handler.MyOrgAdminInvitations)
+	r.POST("/org-admins/accept", handler.AcceptOrgAdminInvitation)
+	r.POST("/org-admins/decline", handler.DeclineOrgAdminInvitation)
+	r.POST("/org-admins/transfer-ownership", handler.TransferOrgOwnership)
+}
@@ -0,0 +1,19 @@
+package router
+
+import (
+	"volunteer-system/internal/handler"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+
+	"github.com/cloudwego/hertz/pkg/route"
+)
+
+// RegisterOrgQualificationRouter 注册组织资质认证相关路由
+func RegisterOrgQualificationRouter(r *route.RouterGroup) {
+	perm := middleware.RequireOrgPermission(model.PermOrgQualification)
+	r.POST("/org-qualifications/documents/upload", perm, handler.UploadQualificationDocument)
+	r.POST("/org-qualifications/documents/delete", perm, handler.DeleteQualificationDocument)
+	r.GET("/org-qualifications/documents/:id", perm, handler.DownloadQualificationDocument)
+	r.POST("/org-qualifications/submit", perm, handler.SubmitQualification)
+	r.GET("/org-qualifications/status", perm, handler.QualificationStatus)
+}
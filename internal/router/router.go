@@ -20,6 +20,9 @@ func RegisterRouter(r *server.Hertz) {
 
 	// 用户注册路由
 	RegisterRegisterRouter(api)
+
+	// 证书公开核验路由（无需认证）
+	RegisterCertificatePublicRouter(api)
 	// 创建需要认证的路由组
 	authApi := api.Group("", middleware.Auth())
 
@@ -28,10 +31,22 @@ func RegisterRouter(r *server.Hertz) {
 	// 注册组织管理员功能路由（需要认证）
 	RegisterOrganizationRouter(authApi)
 	RegisterMembershipRouter(authApi)
+	RegisterOrgAdminRouter(authApi)
+	RegisterOrgQualificationRouter(authApi)
 	RegisterAuditRouter(authApi)
 	// 注册活动功能路由（需要认证）
 	RegisterActivityRouter(authApi)
 	// 注册工时功能路由（需要认证）
 	RegisterWorkHourRouter(authApi)
+	// 注册证书功能路由（需要认证）
+	RegisterCertificateRouter(authApi)
+	// 注册异步任务路由（需要认证）
+	RegisterJobRouter(authApi)
+	// 注册数据导出路由（需要认证）
+	RegisterExportRouter(authApi)
+
+	// 平台管理后台路由（需要认证且为平台管理员）
+	adminApi := api.Group("/admin", middleware.Auth(), middleware.AdminAuth())
+	RegisterAdminRouter(adminApi)
 
 }
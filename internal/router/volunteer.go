@@ -2,6 +2,8 @@ package router
 
 import (
 	"volunteer-system/internal/handler"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
 
 	"github.com/cloudwego/hertz/pkg/route"
 )
@@ -11,4 +13,10 @@ func RegisterVolunteerRouter(r *route.RouterGroup) {
 	r.GET("/volunteers/detail/:id", handler.VolunteerDetail)
 	r.GET("/volunteers/my/profile/:id", handler.MyProfile)
 	r.PUT("/volunteers/:id", handler.VolunteerUpdate)
+	r.POST("/volunteers/verification/submit", handler.SubmitVerification)
+	r.GET("/volunteers/verification/my", handler.MyVerification)
+	r.POST("/volunteers/credit-logs", middleware.OptionalOrgPermission(model.PermWorkHourView), handler.CreditScoreLogList)
+	r.POST("/volunteers/import", middleware.RequireOrgPermission(model.PermVolunteerImport), handler.ImportVolunteers)
+	r.POST("/volunteers/import/batches", middleware.RequireOrgPermission(model.PermVolunteerImport), handler.VolunteerImportBatchList)
+	r.GET("/volunteers/import/receipt/:id", middleware.RequireOrgPermission(model.PermVolunteerImport), handler.DownloadVolunteerImportReceipt)
 }
@@ -2,13 +2,15 @@ package router
 
 import (
 	"volunteer-system/internal/handler"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
 
 	"github.com/cloudwego/hertz/pkg/route"
 )
 
 // RegisterWorkHourRouter 注册工时相关路由
 func RegisterWorkHourRouter(r *route.RouterGroup) {
-	r.POST("/work-hours/list", handler.WorkHourLogList)
-	r.POST("/work-hours/void", handler.VoidWorkHour)
-	r.POST("/work-hours/recalculate", handler.RecalculateWorkHour)
+	r.POST("/work-hours/list", middleware.OptionalOrgPermission(model.PermWorkHourView), handler.WorkHourLogList)
+	r.POST("/work-hours/void", middleware.RequireOrgPermission(model.PermWorkHourVoid), handler.VoidWorkHour)
+	r.POST("/work-hours/recalculate", middleware.RequireOrgPermission(model.PermWorkHourRecalculate), handler.RecalculateWorkHour)
 }
@@ -0,0 +1,74 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	goredis "github.com/redis/go-redis/v9"
+)
+
+// renewScript 仅当锁仍由当前实例持有时续期
+var renewScript = goredis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("PEXPIRE", KEYS[1], ARGV[2])
+end
+return 0
+`)
+
+// releaseScript 仅当锁仍由当前实例持有时释放
+var releaseScript = goredis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// leaderLock 基于 Redis 的主节点锁，保证多实例部署时只有一个实例执行调度任务。
+type leaderLock struct {
+	client *goredis.Client
+	key    string
+	token  string
+	ttl    time.Duration
+	held   bool
+}
+
+func newLeaderLock(client *goredis.Client, key, token string, ttl time.Duration) *leaderLock {
+	return &leaderLock{
+		client: client,
+		key:    key,
+		token:  token,
+		ttl:    ttl,
+	}
+}
+
+// Acquire 获取或续期主节点锁，返回当前实例是否为主节点。
+func (l *leaderLock) Acquire(ctx context.Context) (bool, error) {
+	if l.held {
+		renewed, err := renewScript.Run(ctx, l.client, []string{l.key}, l.token, l.ttl.Milliseconds()).Int64()
+		if err != nil {
+			l.held = false
+			return false, err
+		}
+		if renewed == 1 {
+			return true, nil
+		}
+		// 锁已过期并被其他实例抢占
+		l.held = false
+	}
+
+	ok, err := l.client.SetNX(ctx, l.key, l.token, l.ttl).Result()
+	if err != nil {
+		return false, err
+	}
+	l.held = ok
+	return ok, nil
+}
+
+// Release 释放主节点锁（仅释放自己持有的锁）。
+func (l *leaderLock) Release(ctx context.Context) error {
+	if !l.held {
+		return nil
+	}
+	l.held = false
+	return releaseScript.Run(ctx, l.client, []string{l.key}, l.token).Err()
+}
@@ -0,0 +1,191 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"volunteer-system/config"
+	"volunteer-system/internal/service"
+	"volunteer-system/pkg/database/redis"
+	"volunteer-system/pkg/logger"
+)
+
+const (
+	defaultIntervalSeconds = 60
+	defaultLockTTLSeconds  = 90
+	leaderLockKey          = "scheduler:leader"
+	jobTimeout             = 5 * time.Minute
+)
+
+// job 调度任务，返回本次处理的记录数
+type job struct {
+	name string
+	run  func(svc *service.SchedulerService, now time.Time) (int64, error)
+}
+
+// jobs 按顺序执行：先截止报名、完结活动，再处理签退与出勤结算，清理签到码，最后处理超时审核。
+var jobs = []job{
+	{name: "截止活动报名", run: (*service.SchedulerService).CloseSignups},
+	{name: "自动完结活动", run: (*service.SchedulerService).AutoFinishActivities},
+	{name: "自动签退结算", run: (*service.SchedulerService).AutoCheckoutSignups},
+	{name: "补偿出勤结算", run: (*service.SchedulerService).SettlePendingAttendance},
+	{name: "过期签到签退码", run: (*service.SchedulerService).ExpireAttendanceCodes},
+	{name: "审核超时自动处理", run: (*service.SchedulerService).EscalateOverdueAudits},
+	{name: "审核超时提醒", run: (*service.SchedulerService).RemindOverdueAudits},
+}
+
+// Scheduler 后台调度器，按固定间隔执行活动生命周期任务
+type Scheduler struct {
+	interval time.Duration
+	lock     *leaderLock
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+}
+
+// New 根据配置创建调度器；未启用时返回 nil。
+func New(cfg *config.Config) *Scheduler {
+	if cfg.Scheduler == nil || !cfg.Scheduler.Enabled {
+		return nil
+	}
+
+	intervalSeconds := cfg.Scheduler.IntervalSeconds
+	if intervalSeconds <= 0 {
+		intervalSeconds = defaultIntervalSeconds
+	}
+	lockTTLSeconds := cfg.Scheduler.LockTTLSeconds
+	if lockTTLSeconds <= 0 {
+		lockTTLSeconds = defaultLockTTLSeconds
+	}
+
+	s := &Scheduler{
+		interval: time.Duration(intervalSeconds) * time.Second,
+	}
+	if client := redis.GetRedis(); client != nil {
+		keyPrefix := ""
+		if cfg.Redis != nil {
+			keyPrefix = cfg.Redis.KeyPrefix
+		}
+		s.lock = newLeaderLock(client, keyPrefix+leaderLockKey, instanceToken(), time.Duration(lockTTLSeconds)*time.Second)
+	}
+	return s
+}
+
+// Start 启动调度循环
+func (s *Scheduler) Start() {
+	if s == nil {
+		return
+	}
+	appLog := logger.GetLogger()
+	if s.lock == nil {
+		appLog.Warn("Redis不可用，调度器以单实例模式运行（多实例部署时可能重复执行任务）")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s.cancel = cancel
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		ticker := time.NewTicker(s.interval)
+		defer ticker.Stop()
+		for {
+			s.tick(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	appLog.Info("调度器已启动: interval=%s", s.interval)
+}
+
+// Stop 停止调度循环并释放主节点锁
+func (s *Scheduler) Stop() {
+	if s == nil || s.cancel == nil {
+		return
+	}
+	s.cancel()
+	s.wg.Wait()
+	if s.lock != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+		defer cancel()
+		if err := s.lock.Release(ctx); err != nil {
+			logger.GetLogger().Error("释放调度主节点锁失败: %v", err)
+		}
+	}
+	logger.GetLogger().Info("调度器已停止")
+}
+
+// tick 执行一轮调度任务（仅主节点执行）
+func (s *Scheduler) tick(ctx context.Context) {
+	appLog := logger.GetLogger()
+	if s.lock != nil {
+		isLeader, err := s.lock.Acquire(ctx)
+		if err != nil {
+			appLog.Error("获取调度主节点锁失败: %v", err)
+			return
+		}
+		if !isLeader {
+			return
+		}
+	}
+
+	jobCtx, cancel := context.WithTimeout(ctx, jobTimeout)
+	defer cancel()
+	svc := service.NewSchedulerService(jobCtx)
+	for i, j := range jobs {
+		if jobCtx.Err() != nil {
+			return
+		}
+		// 一轮任务可能超过锁有效期，每个任务执行前续期，失去主节点身份时停止本轮，避免与新主节点重复执行
+		if i > 0 && !s.renewLeadership(jobCtx) {
+			return
+		}
+		s.runJob(svc, j)
+	}
+}
+
+// renewLeadership 续期主节点锁，返回当前实例是否仍为主节点；单实例模式始终为主节点
+func (s *Scheduler) renewLeadership(ctx context.Context) bool {
+	if s.lock == nil {
+		return true
+	}
+	isLeader, err := s.lock.Acquire(ctx)
+	if err != nil {
+		logger.GetLogger().Error("续期调度主节点锁失败: %v", err)
+		return false
+	}
+	if !isLeader {
+		logger.GetLogger().Warn("调度主节点锁已被其他实例持有，停止本轮调度")
+	}
+	return isLeader
+}
+
+// runJob 执行单个任务，panic 不影响后续任务
+func (s *Scheduler) runJob(svc *service.SchedulerService, j job) {
+	appLog := logger.GetLogger()
+	defer func() {
+		if r := recover(); r != nil {
+			appLog.Error("调度任务异常: job=%s panic=%v", j.name, r)
+		}
+	}()
+
+	start := time.Now()
+	count, err := j.run(svc, start)
+	if err != nil {
+		appLog.Error("调度任务失败: job=%s err=%v", j.name, err)
+		return
+	}
+	if count > 0 {
+		appLog.Info("调度任务完成: job=%s count=%d cost=%s", j.name, count, time.Since(start))
+	}
+}
+
+// instanceToken 当前实例标识，用于区分主节点锁的持有者
+func instanceToken() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s:%d:%d", hostname, os.Getpid(), time.Now().UnixNano())
+}
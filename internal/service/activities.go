@@ -85,6 +85,7 @@ func (s *ActivityService) ActivityList(req *api.ActivityListRequest) (*api.Activ
 			CurrentPeople: act.CurrentPeople,
 			Status:        act.Status,
 			IsFull:        act.MaxPeople > 0 && act.CurrentPeople >= act.MaxPeople,
+			SeriesId:      act.SeriesID,
 		}
 		resp.List = append(resp.List, item)
 	}
@@ -106,13 +107,19 @@ func (s *ActivityService) ActivitySignup(req *api.ActivitySignupRequest) (*api.A
 		return nil, err
 	}
 
+	return s.submitActivitySignup(req.ActivityId, req.SlotId, userID, volunteerID)
+}
+
+// submitActivitySignup 提交单场活动报名：名额充足时生成待审核报名申请，否则进入候补队列。
+// 活动设置了班次岗位时 slotID 必填，报名快照与候补记录均携带所选班次。
+func (s *ActivityService) submitActivitySignup(activityID, slotID, userID, volunteerID int64) (*api.ActivitySignupResponse, error) {
 	// 查询活动信息
-	activity, err := s.repo.GetActivityByID(s.repo.DB, req.ActivityId)
+	activity, err := s.repo.GetActivityByID(s.repo.DB, activityID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("活动不存在")
 		}
-		log.Error("活动报名失败: 查询活动异常: %v, activity_id=%d user_id=%d", err, req.ActivityId, userID)
+		log.Error("活动报名失败: 查询活动异常: %v, activity_id=%d user_id=%d", err, activityID, userID)
 		return nil, err
 	}
 
@@ -120,16 +127,27 @@ func (s *ActivityService) ActivitySignup(req *api.ActivitySignupRequest) (*api.A
 	if activity.Status != model.ActivityStatusRecruiting {
 		return nil, errors.New("活动已结束或已取消")
 	}
+	// 调度任务可能尚未置位截止标记，这里同时按截止时间判断
+	if activity.SignupClosed == 1 || !time.Now().Before(activitySignupDeadline(activity)) {
+		return nil, errors.New("活动报名已截止")
+	}
 
-	// 校验名额
-	if activity.MaxPeople > 0 && activity.CurrentPeople >= activity.MaxPeople {
-		return nil, errors.New("名额已满")
+	// 信用分过低的志愿者不允许报名
+	if err := s.ensureVolunteerCreditAllowsSignup(volunteerID); err != nil {
+		return nil, err
+	}
+
+	// 活动要求实名认证时，仅认证通过的志愿者可报名
+	if activity.RequireVerified == 1 {
+		if err := s.ensureVolunteerVerified(volunteerID); err != nil {
+			return nil, err
+		}
 	}
 
 	// 第一层去重：检查报名表（activity_signups）里是否已有有效报名记录（已落库）
-	existing, signupErr := s.repo.GetSignup(s.repo.DB, req.ActivityId, volunteerID)
+	existing, signupErr := s.repo.GetSignup(s.repo.DB, activityID, volunteerID)
 	if signupErr != nil {
-		log.Error("活动报名前检查失败: 查询报名记录异常: %v, activity_id=%d user_id=%d volunteer_id=%d", signupErr, req.ActivityId, userID, volunteerID)
+		log.Error("活动报名前检查失败: 查询报名记录异常: %v, activity_id=%d user_id=%d volunteer_id=%d", signupErr, activityID, userID, volunteerID)
 		return nil, signupErr
 	}
 	if existing != nil && (existing.Status == model.ActivitySignupStatusPending || existing.Status == model.ActivitySignupStatusSuccess) {
@@ -137,29 +155,48 @@ func (s *ActivityService) ActivitySignup(req *api.ActivitySignupRequest) (*api.A
 	}
 
 	// 第二层去重：检查审核表（audit_records）里是否已有待审核的创建申请（未落库）
-	hasPendingAudit, err := s.hasPendingSignupCreateAudit(req.ActivityId, volunteerID, userID)
+	hasPendingAudit, err := s.hasPendingSignupCreateAudit(activityID, volunteerID, userID)
 	if err != nil {
-		log.Error("活动报名失败: 查询待审核报名异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
+		log.Error("活动报名失败: 查询待审核报名异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, activityID, userID, volunteerID)
 		return nil, err
 	}
 	if hasPendingAudit {
 		return nil, errors.New("请勿重复报名")
 	}
 
+	// 第三层去重：检查候补队列（activity_waitlists）里是否已在排队
+	waitingEntry, err := s.repo.GetWaitingWaitlistEntry(s.repo.DB, activityID, volunteerID)
+	if err != nil {
+		log.Error("活动报名失败: 查询候补记录异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, activityID, userID, volunteerID)
+		return nil, err
+	}
+	if waitingEntry != nil {
+		return nil, errors.New("已在候补队列中，请勿重复报名")
+	}
+
+	// 校验所选班次岗位（名额、技能要求）
+	slot, err := s.validateSignupSlot(s.repo.DB, activityID, slotID, volunteerID)
+	if err != nil {
+		return nil, err
+	}
+
 	signupSnapshot := &model.ActivitySignup{
-		ActivityID:  req.ActivityId,
+		ActivityID:  activityID,
 		VolunteerID: volunteerID,
+		SlotID:      slotID,
 		Status:      model.ActivitySignupStatusPending,
 	}
 	newContent, err := json.Marshal(signupSnapshot)
 	if err != nil {
-		log.Error("活动报名失败: 序列化报名快照异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
+		log.Error("活动报名失败: 序列化报名快照异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, activityID, userID, volunteerID)
 		return nil, err
 	}
 
 	record := &model.AuditRecord{
 		TargetType:    model.AuditTargetSignup,
 		TargetID:      0,
+		OrgID:         activity.OrgID,
+		ActivityID:    activityID,
 		CreatorID:     userID,
 		AuditorID:     0,
 		OldContent:    "{}",
@@ -170,12 +207,59 @@ func (s *ActivityService) ActivitySignup(req *api.ActivitySignupRequest) (*api.A
 		OperationType: model.OperationTypeCreate,
 		Status:        model.AuditStatusPending,
 	}
-	if err := s.repo.CreateAuditRecord(s.repo.DB, record); err != nil {
-		log.Error("活动报名失败: 创建审核记录异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
+
+	var waitlistEntry *model.ActivityWaitlist
+	var waitlistPosition int64
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		// 锁定活动行，与候补递补串行，保证名额判断与入队/提交申请的原子性。
+		lockedActivity, err := s.repo.GetActivityByIDForUpdate(tx, activityID)
+		if err != nil {
+			return err
+		}
+		if lockedActivity.Status != model.ActivityStatusRecruiting {
+			return errors.New("活动已结束或已取消")
+		}
+		if lockedActivity.SignupClosed == 1 {
+			return errors.New("活动报名已截止")
+		}
+
+		// 活动或班次名额已满、或已有人排队时进入候补队列，由名额释放时按先后顺序递补。
+		shouldWait, err := s.shouldJoinWaitlist(tx, lockedActivity, slot)
+		if err != nil {
+			return err
+		}
+		if shouldWait {
+			waitlistEntry = &model.ActivityWaitlist{
+				ActivityID:  activityID,
+				VolunteerID: volunteerID,
+				SlotID:      slotID,
+				AccountID:   userID,
+				Status:      model.ActivityWaitlistStatusWaiting,
+			}
+			if err := s.repo.CreateActivityWaitlist(tx, waitlistEntry); err != nil {
+				return err
+			}
+			waitlistPosition, err = s.repo.CountWaitlistPosition(tx, activityID, waitlistEntry.ID)
+			return err
+		}
+
+		return s.repo.CreateAuditRecord(tx, record)
+	})
+	if err != nil {
+		log.Error("活动报名失败: 提交报名异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, activityID, userID, volunteerID)
 		return nil, err
 	}
 
-	log.Info("活动报名申请已提交: activity_id=%d user_id=%d volunteer_id=%d record_id=%d", req.ActivityId, userID, volunteerID, record.ID)
+	if waitlistEntry != nil {
+		log.Info("活动名额已满，已加入候补队列: activity_id=%d user_id=%d volunteer_id=%d waitlist_id=%d position=%d", activityID, userID, volunteerID, waitlistEntry.ID, waitlistPosition)
+		return &api.ActivitySignupResponse{
+			Success:          true,
+			Waitlisted:       true,
+			WaitlistPosition: int32(waitlistPosition),
+		}, nil
+	}
+
+	log.Info("活动报名申请已提交: activity_id=%d user_id=%d volunteer_id=%d record_id=%d", activityID, userID, volunteerID, record.ID)
 	return &api.ActivitySignupResponse{Success: true}, nil
 }
 
@@ -242,6 +326,7 @@ func (s *ActivityService) ActivityCancel(req *api.ActivityCancelRequest) (*api.A
 	}
 
 	// 事务处理
+	previousStatus := signup.Status
 	err = s.repo.DB.Transaction(func(tx *gorm.DB) error {
 		// 更新报名状态为已取消
 		signup.Status = model.ActivitySignupStatusCanceled
@@ -256,6 +341,20 @@ func (s *ActivityService) ActivityCancel(req *api.ActivityCancelRequest) (*api.A
 			return err
 		}
 
+		// 已占用班次名额的报名同步释放班次名额
+		if signup.SlotID > 0 && previousStatus == model.ActivitySignupStatusSuccess {
+			if err := s.repo.DecrementActivitySlotPeople(tx, signup.SlotID); err != nil {
+				log.Error("取消报名失败: 减少班次人数异常: %v, activity_id=%d slot_id=%d user_id=%d", err, req.ActivityId, signup.SlotID, userID)
+				return err
+			}
+		}
+
+		// 名额释放后按先后顺序递补候补志愿者
+		if _, err := s.promoteActivityWaitlist(tx, req.ActivityId); err != nil {
+			log.Error("取消报名失败: 候补递补异常: %v, activity_id=%d user_id=%d", err, req.ActivityId, userID)
+			return err
+		}
+
 		return nil
 	})
 
@@ -290,28 +389,34 @@ func (s *ActivityService) ActivityDetail(req *api.ActivityDetailRequest) (*api.A
 	// 组装返回数据
 	resp := &api.ActivityDetailResponse{
 		Activity: &api.ActivityInfo{
-			Id:             activity.ID,
-			OrgId:          activity.OrgID,
-			OrgName:        orgName,
-			Title:          activity.Title,
-			Description:    activity.Description,
-			CoverUrl:       activity.CoverURL,
-			StartTime:      util.FormatDateTimeOrEmpty(activity.StartTime),
-			EndTime:        util.FormatDateTimeOrEmpty(activity.EndTime),
-			Location:       activity.Location,
-			Address:        activity.Address,
-			Duration:       activity.Duration,
-			MaxPeople:      activity.MaxPeople,
-			CurrentPeople:  activity.CurrentPeople,
-			Status:         activity.Status,
-			IsRegistered:   false,
-			CreatedAt:      util.FormatDateTimeOrEmpty(activity.CreatedAt),
-			CheckInStatus:  model.ActivityCheckInPending,
-			CheckInTime:    util.FormatDateTimePtr(nil),
-			CheckOutStatus: model.ActivityCheckOutPending,
-			CheckOutTime:   util.FormatDateTimePtr(nil),
-			WorkHourStatus: model.WorkHourStatusPending,
-			GrantedHours:   0,
+			Id:               activity.ID,
+			OrgId:            activity.OrgID,
+			OrgName:          orgName,
+			Title:            activity.Title,
+			Description:      activity.Description,
+			CoverUrl:         activity.CoverURL,
+			StartTime:        util.FormatDateTimeOrEmpty(activity.StartTime),
+			EndTime:          util.FormatDateTimeOrEmpty(activity.EndTime),
+			Location:         activity.Location,
+			Address:          activity.Address,
+			Duration:         activity.Duration,
+			MaxPeople:        activity.MaxPeople,
+			CurrentPeople:    activity.CurrentPeople,
+			Status:           activity.Status,
+			IsRegistered:     false,
+			CreatedAt:        util.FormatDateTimeOrEmpty(activity.CreatedAt),
+			CheckInStatus:    model.ActivityCheckInPending,
+			CheckInTime:      util.FormatDateTimePtr(nil),
+			CheckOutStatus:   model.ActivityCheckOutPending,
+			CheckOutTime:     util.FormatDateTimePtr(nil),
+			WorkHourStatus:   model.WorkHourStatusPending,
+			GrantedHours:     0,
+			SeriesId:         activity.SeriesID,
+			Latitude:         activity.Latitude,
+			Longitude:        activity.Longitude,
+			GeofenceRadius:   activity.GeofenceRadius,
+			AutoSettlePolicy: activity.AutoSettlePolicy,
+			RequireVerified:  activity.RequireVerified == 1,
 		},
 	}
 
@@ -418,6 +523,7 @@ func (s *ActivityService) MyActivities(req *api.MyActivitiesRequest) (*api.MyAct
 			CheckOutTime:   checkOutTime,
 			WorkHourStatus: signup.WorkHourStatus,
 			GrantedHours:   signup.GrantedHours,
+			SlotId:         signup.SlotID,
 		}
 		resp.List = append(resp.List, item)
 	}
@@ -451,10 +557,13 @@ func (s *ActivityService) CreateActivity(req *api.CreateActivityRequest) (*api.C
 		return nil, err
 	}
 
-	// 校验组织是否属于当前登录的管理者
-	if org.AccountID != userID {
+	// 校验当前账号可代表该组织操作
+	if !s.canActForOrg(org, userID) {
 		return nil, errors.New("无权为该组织创建活动")
 	}
+	if err := ensureOrganizationVerified(org); err != nil {
+		return nil, err
+	}
 
 	// 解析时间
 	startTime, err := time.Parse("2006-01-02 15:04:05", req.StartTime)
@@ -476,20 +585,44 @@ func (s *ActivityService) CreateActivity(req *api.CreateActivityRequest) (*api.C
 		return nil, errors.New("开始时间不能早于当前时间")
 	}
 
+	// 校验签到围栏
+	geofenceMode := req.GeofenceMode
+	if geofenceMode == 0 {
+		geofenceMode = model.GeofenceModeReject
+	}
+	if err := validateActivityGeofence(req.Latitude, req.Longitude, req.GeofenceRadius, geofenceMode); err != nil {
+		return nil, err
+	}
+	autoSettlePolicy := req.AutoSettlePolicy
+	if autoSettlePolicy == 0 {
+		autoSettlePolicy = model.AutoSettlePolicyEndTime
+	}
+	if !model.IsValidAutoSettlePolicy(autoSettlePolicy) {
+		return nil, errors.New("未签退结算策略不合法")
+	}
+
 	// 创建活动
 	activity := &model.Activity{
-		OrgID:         req.OrgId,
-		Title:         req.Title,
-		Description:   req.Description,
-		CoverURL:      req.CoverUrl,
-		StartTime:     startTime,
-		EndTime:       endTime,
-		Location:      req.Location,
-		Address:       req.Address,
-		Duration:      req.Duration,
-		MaxPeople:     req.MaxPeople,
-		CurrentPeople: 0,
-		Status:        model.ActivityStatusRecruiting,
+		OrgID:            req.OrgId,
+		Title:            req.Title,
+		Description:      req.Description,
+		CoverURL:         req.CoverUrl,
+		StartTime:        startTime,
+		EndTime:          endTime,
+		Location:         req.Location,
+		Address:          req.Address,
+		Duration:         req.Duration,
+		MaxPeople:        req.MaxPeople,
+		CurrentPeople:    0,
+		Status:           model.ActivityStatusRecruiting,
+		Latitude:         req.Latitude,
+		Longitude:        req.Longitude,
+		GeofenceRadius:   req.GeofenceRadius,
+		GeofenceMode:     geofenceMode,
+		AutoSettlePolicy: autoSettlePolicy,
+	}
+	if req.RequireVerified {
+		activity.RequireVerified = 1
 	}
 
 	if err := s.repo.CreateActivity(s.repo.DB, activity); err != nil {
@@ -523,15 +656,15 @@ func (s *ActivityService) UpdateActivity(req *api.UpdateActivityRequest) (*api.U
 		return nil, err
 	}
 
-	// 查询组织信息
-	org, err := s.repo.GetOrganizationByAccountID(s.repo.DB, userID)
+	// 查询当前操作的组织
+	orgID, err := s.currentOrgID(s.repo.DB, userID)
 	if err != nil {
 		log.Error("更新活动失败: 查询组织信息异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
 		return nil, errors.New("组织信息不存在")
 	}
 
 	// 校验活动归属
-	if activity.OrgID != org.ID {
+	if activity.OrgID != orgID {
 		return nil, errors.New("无权操作此活动")
 	}
 
@@ -540,6 +673,11 @@ func (s *ActivityService) UpdateActivity(req *api.UpdateActivityRequest) (*api.U
 		return nil, errors.New("已结束或已取消的活动不能修改")
 	}
 
+	// 系列活动支持“本场及之后”批量修改
+	if activity.SeriesID > 0 && req.Scope == model.ActivityEditScopeFollowing {
+		return s.updateSeriesFollowingActivities(activity, req, userID)
+	}
+
 	// 解析时间
 	if req.StartTime != "" {
 		startTime, err := time.Parse("2006-01-02 15:04:05", req.StartTime)
@@ -564,38 +702,34 @@ func (s *ActivityService) UpdateActivity(req *api.UpdateActivityRequest) (*api.U
 	}
 
 	// 更新字段
-	if req.Title != "" {
-		activity.Title = req.Title
-	}
-	if req.Description != "" {
-		activity.Description = req.Description
-	}
-	if req.CoverUrl != "" {
-		activity.CoverURL = req.CoverUrl
-	}
-	if req.Location != "" {
-		activity.Location = req.Location
-	}
-	if req.Address != "" {
-		activity.Address = req.Address
-	}
-	if req.Duration > 0 {
-		activity.Duration = req.Duration
-	}
-	if req.MaxPeople >= 0 {
-		// 检查是否会导致报名人数超过新设定的最大人数
-		if req.MaxPeople > 0 && activity.CurrentPeople > req.MaxPeople {
-			return nil, errors.New("当前报名人数超过新设定的最大人数")
-		}
-		activity.MaxPeople = req.MaxPeople
+	oldMaxPeople := activity.MaxPeople
+	if err := applyActivityUpdateFields(activity, req); err != nil {
+		return nil, err
 	}
+	capacityRaised := isActivityCapacityRaised(oldMaxPeople, activity.MaxPeople)
 
-	if err := s.repo.UpdateActivity(s.repo.DB, activity); err != nil {
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		if err := s.repo.UpdateActivity(tx, activity); err != nil {
+			return err
+		}
+		if !capacityRaised {
+			return nil
+		}
+		promoted, err := s.promoteActivityWaitlist(tx, activity.ID)
+		if err != nil {
+			return err
+		}
+		if promoted > 0 {
+			log.Info("活动扩容递补候补: activity_id=%d old_max_people=%d max_people=%d promoted=%d", activity.ID, oldMaxPeople, activity.MaxPeople, promoted)
+		}
+		return nil
+	})
+	if err != nil {
 		log.Error("更新活动失败: 更新活动异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
 		return nil, err
 	}
 
-	log.Info("更新活动成功: activity_id=%d org_id=%d user_id=%d", activity.ID, org.ID, userID)
+	log.Info("更新活动成功: activity_id=%d org_id=%d user_id=%d", activity.ID, orgID, userID)
 	return &api.UpdateActivityResponse{
 		Message: "更新活动成功",
 	}, nil
@@ -620,15 +754,15 @@ func (s *ActivityService) DeleteActivity(req *api.DeleteActivityRequest) (*api.D
 		return nil, err
 	}
 
-	// 查询组织信息
-	org, err := s.repo.GetOrganizationByAccountID(s.repo.DB, userID)
+	// 查询当前操作的组织
+	orgID, err := s.currentOrgID(s.repo.DB, userID)
 	if err != nil {
 		log.Error("删除活动失败: 查询组织信息异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
 		return nil, errors.New("组织信息不存在")
 	}
 
 	// 校验活动归属
-	if activity.OrgID != org.ID {
+	if activity.OrgID != orgID {
 		return nil, errors.New("无权操作此活动")
 	}
 
@@ -648,7 +782,7 @@ func (s *ActivityService) DeleteActivity(req *api.DeleteActivityRequest) (*api.D
 		return nil, err
 	}
 
-	log.Info("删除活动成功: activity_id=%d org_id=%d user_id=%d", req.Id, org.ID, userID)
+	log.Info("删除活动成功: activity_id=%d org_id=%d user_id=%d", req.Id, orgID, userID)
 	return &api.DeleteActivityResponse{
 		Message: "删除活动成功",
 	}, nil
@@ -673,15 +807,15 @@ func (s *ActivityService) CancelActivity(req *api.CancelActivityRequest) (*api.C
 		return nil, err
 	}
 
-	// 查询组织信息
-	org, err := s.repo.GetOrganizationByAccountID(s.repo.DB, userID)
+	// 查询当前操作的组织
+	orgID, err := s.currentOrgID(s.repo.DB, userID)
 	if err != nil {
 		log.Error("取消活动失败: 查询组织信息异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
 		return nil, errors.New("组织信息不存在")
 	}
 
 	// 校验活动归属
-	if activity.OrgID != org.ID {
+	if activity.OrgID != orgID {
 		return nil, errors.New("无权操作此活动")
 	}
 
@@ -690,12 +824,17 @@ func (s *ActivityService) CancelActivity(req *api.CancelActivityRequest) (*api.C
 		return nil, errors.New("已结束或已取消的活动不能取消")
 	}
 
+	// 系列场次取消需同步维护系列的例外日期/截止日期
+	if activity.SeriesID > 0 {
+		return s.cancelSeriesActivities(activity, req, userID)
+	}
+
 	if err := s.repo.CancelActivity(s.repo.DB, req.Id); err != nil {
 		log.Error("取消活动失败: 更新活动状态异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
 		return nil, err
 	}
 
-	log.Info("取消活动成功: activity_id=%d org_id=%d user_id=%d", req.Id, org.ID, userID)
+	log.Info("取消活动成功: activity_id=%d org_id=%d user_id=%d", req.Id, orgID, userID)
 	return &api.CancelActivityResponse{
 		Message: "取消活动成功",
 	}, nil
@@ -726,8 +865,25 @@ func (s *ActivityService) FinishActivity(req *api.FinishActivityRequest) (*api.F
 		return nil, err
 	}
 
-	log.Info("完结活动成功: activity_id=%d user_id=%d", req.Id, userID)
-	return &api.FinishActivityResponse{Message: "完结活动成功"}, nil
+	// 按活动策略处理已签到未签退的报名，再判定爽约并结算信用分；
+	// 失败的记录保持原状，可由调度任务重试，不影响完结结果。
+	autoSettle, err := s.autoSettleActivityCheckouts(activity, time.Now())
+	if err != nil {
+		log.Error("完结活动: 未签退自动结算异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+	}
+	settlement, err := s.settleActivityAttendance(activity, userID)
+	if err != nil {
+		log.Error("完结活动: 出勤结算异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+	}
+
+	log.Info("完结活动成功: activity_id=%d user_id=%d auto_settled=%d flagged=%d attended=%d no_show=%d", req.Id, userID, autoSettle.Granted, autoSettle.Flagged, settlement.Attended, settlement.NoShow)
+	return &api.FinishActivityResponse{
+		Message:          "完结活动成功",
+		AttendedCount:    settlement.Attended,
+		NoShowCount:      settlement.NoShow,
+		AutoSettledCount: autoSettle.Granted,
+		FlaggedCount:     autoSettle.Flagged,
+	}, nil
 }
 
 // GenerateAttendanceCodes 生成签到码/签退码（组织侧，初次生成同时生成两个码）
@@ -756,15 +912,8 @@ func (s *ActivityService) GenerateAttendanceCodes(req *api.GenerateAttendanceCod
 	if activity.Status == model.ActivityStatusFinished {
 		return nil, errors.New("已结束活动不能生成签到签退码")
 	}
-
-	checkInCode, err := generateRandomAttendanceCode(attendanceCodeLength)
-	if err != nil {
-		log.Error("生成签到签退码失败: 生成签到码异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
-		return nil, err
-	}
-	checkOutCode, err := generateRandomAttendanceCode(attendanceCodeLength)
+	codeMode, rotationSeconds, err := resolveAttendanceCodeRotation(req.CodeMode, req.RotationSeconds)
 	if err != nil {
-		log.Error("生成签到签退码失败: 生成签退码异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
 		return nil, err
 	}
 
@@ -781,14 +930,18 @@ func (s *ActivityService) GenerateAttendanceCodes(req *api.GenerateAttendanceCod
 	}
 	// 初次生成会同时刷新两个码及对应过期时间，并统一推进版本号。
 	updates := map[string]any{
-		"check_in_code":              checkInCode,
-		"check_in_code_hash":         "",
 		"check_in_code_expire_at":    checkInExpireAt,
-		"check_out_code":             checkOutCode,
-		"check_out_code_hash":        "",
 		"check_out_code_expire_at":   checkOutExpireAt,
 		"attendance_code_version":    gorm.Expr("attendance_code_version + 1"),
 		"attendance_code_updated_at": now,
+		"attendance_code_mode":       codeMode,
+		"attendance_code_period":     rotationSeconds,
+	}
+	for _, codeType := range []int32{model.AttendanceCodeTypeCheckIn, model.AttendanceCodeTypeCheckOut} {
+		if err := fillAttendanceCodeUpdates(updates, codeMode, codeType); err != nil {
+			log.Error("生成签到签退码失败: 生成码异常: %v, activity_id=%d user_id=%d code_type=%d", err, req.Id, userID, codeType)
+			return nil, err
+		}
 	}
 	if err := s.repo.UpdateActivityAttendanceCodeByID(s.repo.DB, req.Id, updates); err != nil {
 		log.Error("生成签到签退码失败: 更新活动码字段异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
@@ -801,6 +954,17 @@ func (s *ActivityService) GenerateAttendanceCodes(req *api.GenerateAttendanceCod
 		return nil, err
 	}
 
+	checkInCode, err := currentAttendanceCode(codeInfo, model.AttendanceCodeTypeCheckIn, now)
+	if err != nil {
+		log.Error("生成签到签退码失败: 计算签到码异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+	checkOutCode, err := currentAttendanceCode(codeInfo, model.AttendanceCodeTypeCheckOut, now)
+	if err != nil {
+		log.Error("生成签到签退码失败: 计算签退码异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+
 	resp := &api.GenerateAttendanceCodesResponse{
 		Success:                 true,
 		CheckInCode:             checkInCode,
@@ -809,6 +973,9 @@ func (s *ActivityService) GenerateAttendanceCodes(req *api.GenerateAttendanceCod
 		AttendanceCodeUpdatedAt: util.FormatDateTimePtr(codeInfo.AttendanceCodeUpdatedAt),
 		CheckInExpireAt:         util.FormatDateTimePtr(codeInfo.CheckInCodeExpireAt),
 		CheckOutExpireAt:        util.FormatDateTimePtr(codeInfo.CheckOutCodeExpireAt),
+		CodeMode:                codeInfo.AttendanceCodeMode,
+		RotationSeconds:         codeInfo.AttendanceCodePeriod,
+		NextRotateAt:            attendanceCodeNextRotateAt(codeInfo, now),
 	}
 
 	log.Info("生成签到签退码成功: activity_id=%d user_id=%d version=%d", req.Id, userID, resp.AttendanceCodeVersion)
@@ -845,11 +1012,16 @@ func (s *ActivityService) ResetAttendanceCode(req *api.ResetAttendanceCodeReques
 		return nil, errors.New("已结束活动不能重置签到签退码")
 	}
 
-	code, err := generateRandomAttendanceCode(attendanceCodeLength)
+	// 沿用活动当前的码模式，静态码重新生成随机码，动态码重新生成密钥。
+	currentCodeInfo, err := s.repo.GetActivityAttendanceCodeByID(s.repo.DB, req.Id)
 	if err != nil {
-		log.Error("重置签到签退码失败: 生成随机码异常: %v, activity_id=%d user_id=%d code_type=%d", err, req.Id, userID, req.CodeType)
+		log.Error("重置签到签退码失败: 查询活动码字段异常: %v, activity_id=%d user_id=%d code_type=%d", err, req.Id, userID, req.CodeType)
 		return nil, err
 	}
+	codeMode := currentCodeInfo.AttendanceCodeMode
+	if !model.IsValidAttendanceCodeMode(codeMode) {
+		codeMode = model.AttendanceCodeModeStatic
+	}
 
 	now := time.Now()
 	var expireAt *time.Time
@@ -864,16 +1036,16 @@ func (s *ActivityService) ResetAttendanceCode(req *api.ResetAttendanceCodeReques
 	// 单次只更新一种码，避免误覆盖另一种码的当前值与过期时间。
 	switch req.CodeType {
 	case model.AttendanceCodeTypeCheckIn:
-		updates["check_in_code"] = code
-		updates["check_in_code_hash"] = ""
 		updates["check_in_code_expire_at"] = expireAt
 	case model.AttendanceCodeTypeCheckOut:
-		updates["check_out_code"] = code
-		updates["check_out_code_hash"] = ""
 		updates["check_out_code_expire_at"] = expireAt
 	default:
 		return nil, errors.New("重置码类型不合法")
 	}
+	if err := fillAttendanceCodeUpdates(updates, codeMode, req.CodeType); err != nil {
+		log.Error("重置签到签退码失败: 生成码异常: %v, activity_id=%d user_id=%d code_type=%d", err, req.Id, userID, req.CodeType)
+		return nil, err
+	}
 
 	if err := s.repo.UpdateActivityAttendanceCodeByID(s.repo.DB, req.Id, updates); err != nil {
 		log.Error("重置签到签退码失败: 更新活动码字段异常: %v, activity_id=%d user_id=%d code_type=%d", err, req.Id, userID, req.CodeType)
@@ -886,12 +1058,20 @@ func (s *ActivityService) ResetAttendanceCode(req *api.ResetAttendanceCodeReques
 		return nil, err
 	}
 
+	code, err := currentAttendanceCode(codeInfo, req.CodeType, now)
+	if err != nil {
+		log.Error("重置签到签退码失败: 计算当前码异常: %v, activity_id=%d user_id=%d code_type=%d", err, req.Id, userID, req.CodeType)
+		return nil, err
+	}
+
 	resp := &api.ResetAttendanceCodeResponse{
 		Success:                 true,
 		CodeType:                req.CodeType,
 		Code:                    code,
 		AttendanceCodeVersion:   codeInfo.AttendanceCodeVersion,
 		AttendanceCodeUpdatedAt: util.FormatDateTimePtr(codeInfo.AttendanceCodeUpdatedAt),
+		CodeMode:                codeInfo.AttendanceCodeMode,
+		NextRotateAt:            attendanceCodeNextRotateAt(codeInfo, now),
 	}
 	// 返回被重置的码对应过期时间，方便前端直接展示。
 	if req.CodeType == model.AttendanceCodeTypeCheckIn {
@@ -927,14 +1107,30 @@ func (s *ActivityService) GetActivityAttendanceCodes(req *api.GetActivityAttenda
 		return nil, err
 	}
 
+	// 动态码模式下按当前时间实时计算，组织端大屏按 nextRotateAt 轮询刷新。
+	now := time.Now()
+	checkInCode, err := currentAttendanceCode(codeInfo, model.AttendanceCodeTypeCheckIn, now)
+	if err != nil {
+		log.Error("查询活动签到签退码失败: 计算签到码异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+	checkOutCode, err := currentAttendanceCode(codeInfo, model.AttendanceCodeTypeCheckOut, now)
+	if err != nil {
+		log.Error("查询活动签到签退码失败: 计算签退码异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+
 	resp := &api.GetActivityAttendanceCodesResponse{
 		Success:                 true,
-		CheckInCode:             codeInfo.CheckInCode,
-		CheckOutCode:            codeInfo.CheckOutCode,
+		CheckInCode:             checkInCode,
+		CheckOutCode:            checkOutCode,
 		CheckInExpireAt:         util.FormatDateTimePtr(codeInfo.CheckInCodeExpireAt),
 		CheckOutExpireAt:        util.FormatDateTimePtr(codeInfo.CheckOutCodeExpireAt),
 		AttendanceCodeVersion:   codeInfo.AttendanceCodeVersion,
 		AttendanceCodeUpdatedAt: util.FormatDateTimePtr(codeInfo.AttendanceCodeUpdatedAt),
+		CodeMode:                codeInfo.AttendanceCodeMode,
+		RotationSeconds:         codeInfo.AttendanceCodePeriod,
+		NextRotateAt:            attendanceCodeNextRotateAt(codeInfo, now),
 	}
 	return resp, nil
 }
@@ -944,7 +1140,7 @@ func (s *ActivityService) ActivityCheckIn(req *api.ActivityCheckInRequest) (*api
 	if req.ActivityId <= 0 {
 		return nil, errors.New("活动ID不能为空")
 	}
-	if strings.TrimSpace(req.CheckInCode) == "" {
+	if strings.TrimSpace(req.CheckInCode) == "" && strings.TrimSpace(req.QrToken) == "" {
 		return nil, errors.New("签到码不能为空")
 	}
 
@@ -970,10 +1166,21 @@ func (s *ActivityService) ActivityCheckIn(req *api.ActivityCheckInRequest) (*api
 	if activity.Status == model.ActivityStatusCanceled {
 		return nil, errors.New("已取消活动不允许签到")
 	}
-	if err := s.validateCheckInCode(req.ActivityId, req.CheckInCode); err != nil {
+	if strings.TrimSpace(req.QrToken) != "" {
+		if err := s.validateAttendanceQRToken(req.ActivityId, model.AttendanceCodeTypeCheckIn, req.QrToken, "签到二维码无效或已过期"); err != nil {
+			log.Error("活动签到失败: 校验签到二维码异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
+			return nil, err
+		}
+	} else if err := s.validateCheckInCode(req.ActivityId, req.CheckInCode); err != nil {
 		log.Error("活动签到失败: 校验签到码异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
 		return nil, err
 	}
+	// 校验签到位置（电子围栏）
+	position, err := resolveAttendancePosition(activity, req.Latitude, req.Longitude, "签到")
+	if err != nil {
+		log.Warn("活动签到失败: 签到位置校验未通过: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
+		return nil, err
+	}
 
 	var checkInTime time.Time
 	err = s.withTransaction(func(tx *gorm.DB) error {
@@ -990,6 +1197,9 @@ func (s *ActivityService) ActivityCheckIn(req *api.ActivityCheckInRequest) (*api
 		if signup.CheckOutStatus == model.ActivityCheckOutDone {
 			return errors.New("已签退，无法再次签到")
 		}
+		if signup.AttendanceResult == model.AttendanceResultNoShow {
+			return errors.New("已判定为爽约，请联系组织补录签到")
+		}
 		if signup.CheckInStatus == model.ActivityCheckInDone {
 			if signup.CheckInTime != nil {
 				checkInTime = *signup.CheckInTime
@@ -998,21 +1208,35 @@ func (s *ActivityService) ActivityCheckIn(req *api.ActivityCheckInRequest) (*api
 		}
 
 		now := time.Now()
+		// 绑定班次的报名只能在班次结束前签到
+		if signup.SlotID > 0 {
+			slot, err := s.repo.GetActivitySlotByID(tx, signup.SlotID)
+			if err != nil {
+				return err
+			}
+			if now.After(slot.EndTime) {
+				return errors.New("所在班次已结束，无法签到")
+			}
+		}
 		checkInTime = now
-		return s.repo.UpdateActivitySignupByID(tx, signup.ID, map[string]any{
+		updates := map[string]any{
 			"check_in_status": model.ActivityCheckInDone,
 			"check_in_time":   now,
-		})
+		}
+		position.fillUpdates(updates, "check_in")
+		return s.repo.UpdateActivitySignupByID(tx, signup.ID, updates)
 	})
 	if err != nil {
 		log.Error("活动签到失败: %v, activity_id=%d volunteer_id=%d user_id=%d", err, req.ActivityId, volunteerID, userID)
 		return nil, err
 	}
 
-	log.Info("活动签到成功: activity_id=%d volunteer_id=%d user_id=%d", req.ActivityId, volunteerID, userID)
+	log.Info("活动签到成功: activity_id=%d volunteer_id=%d user_id=%d distance=%d out_of_fence=%t", req.ActivityId, volunteerID, userID, position.distanceOrUnknown(), position.OutOfFence)
 	return &api.ActivityCheckInResponse{
 		Success:     true,
 		CheckInTime: util.FormatDateTimeOrEmpty(checkInTime),
+		OutOfFence:  position.OutOfFence,
+		Distance:    position.distanceOrUnknown(),
 	}, nil
 }
 
@@ -1021,7 +1245,7 @@ func (s *ActivityService) ActivityCheckOut(req *api.ActivityCheckOutRequest) (*a
 	if req.ActivityId <= 0 {
 		return nil, errors.New("活动ID不能为空")
 	}
-	if strings.TrimSpace(req.CheckOutCode) == "" {
+	if strings.TrimSpace(req.CheckOutCode) == "" && strings.TrimSpace(req.QrToken) == "" {
 		return nil, errors.New("签退码不能为空")
 	}
 
@@ -1047,10 +1271,21 @@ func (s *ActivityService) ActivityCheckOut(req *api.ActivityCheckOutRequest) (*a
 	if activity.Status == model.ActivityStatusCanceled {
 		return nil, errors.New("已取消活动不允许签退")
 	}
-	if err := s.validateCheckOutCode(req.ActivityId, req.CheckOutCode); err != nil {
+	if strings.TrimSpace(req.QrToken) != "" {
+		if err := s.validateAttendanceQRToken(req.ActivityId, model.AttendanceCodeTypeCheckOut, req.QrToken, "签退二维码无效或已过期"); err != nil {
+			log.Error("活动签退失败: 校验签退二维码异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
+			return nil, err
+		}
+	} else if err := s.validateCheckOutCode(req.ActivityId, req.CheckOutCode); err != nil {
 		log.Error("活动签退失败: 校验签退码异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
 		return nil, err
 	}
+	// 校验签退位置（电子围栏）
+	position, err := resolveAttendancePosition(activity, req.Latitude, req.Longitude, "签退")
+	if err != nil {
+		log.Warn("活动签退失败: 签退位置校验未通过: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
+		return nil, err
+	}
 
 	var checkOutTime time.Time
 	var grantedHours float64
@@ -1071,9 +1306,18 @@ func (s *ActivityService) ActivityCheckOut(req *api.ActivityCheckOutRequest) (*a
 		return nil, errors.New("未签到，无法签退")
 	}
 
-	// 签退时间校验
+	// 签退时间校验：绑定班次的报名以班次结束时间为准
+	checkOutEndTime := activity.EndTime
+	if preSignup.SlotID > 0 {
+		slot, err := s.repo.GetActivitySlotByID(s.repo.DB, preSignup.SlotID)
+		if err != nil {
+			log.Error("活动签退失败: 查询班次异常: %v, activity_id=%d slot_id=%d user_id=%d", err, req.ActivityId, preSignup.SlotID, userID)
+			return nil, err
+		}
+		checkOutEndTime = slot.EndTime
+	}
 	now := time.Now()
-	if now.Before(activity.EndTime.Add(-volunteerCheckoutEarliestWindow)) {
+	if now.Before(checkOutEndTime.Add(-volunteerCheckoutEarliestWindow)) {
 		return nil, errors.New("未到签退开始时间，还不能签退")
 	}
 
@@ -1106,7 +1350,10 @@ func (s *ActivityService) ActivityCheckOut(req *api.ActivityCheckOutRequest) (*a
 			now = *signup.CheckInTime
 		}
 		checkOutTime = now
-		grantedHours = util.CalcGrantedHours(activity.Duration, *signup.CheckInTime, now)
+		grantedHours, err = s.calcSignupGrantedHours(tx, activity, signup, *signup.CheckInTime, now)
+		if err != nil {
+			return err
+		}
 
 		volunteer, err := s.repo.FindVolunteerByIDForUpdate(tx, signup.VolunteerID)
 		if err != nil {
@@ -1149,7 +1396,7 @@ func (s *ActivityService) ActivityCheckOut(req *api.ActivityCheckOutRequest) (*a
 			return err
 		}
 
-		return s.repo.UpdateActivitySignupByID(tx, signup.ID, map[string]any{
+		updates := map[string]any{
 			"check_out_status":      model.ActivityCheckOutDone,
 			"check_out_time":        now,
 			"work_hour_status":      model.WorkHourStatusGranted,
@@ -1157,7 +1404,9 @@ func (s *ActivityService) ActivityCheckOut(req *api.ActivityCheckOutRequest) (*a
 			"last_work_hour_log_id": workHourLog.ID,
 			"granted_hours":         grantedHours,
 			"granted_at":            now,
-		})
+		}
+		position.fillUpdates(updates, "check_out")
+		return s.repo.UpdateActivitySignupByID(tx, signup.ID, updates)
 	})
 	if err != nil {
 		log.Error("活动签退失败: %v, activity_id=%d volunteer_id=%d user_id=%d", err, req.ActivityId, volunteerID, userID)
@@ -1169,6 +1418,8 @@ func (s *ActivityService) ActivityCheckOut(req *api.ActivityCheckOutRequest) (*a
 		Success:      true,
 		CheckOutTime: util.FormatDateTimeOrEmpty(checkOutTime),
 		GrantedHours: grantedHours,
+		OutOfFence:   position.OutOfFence,
+		Distance:     position.distanceOrUnknown(),
 	}, nil
 }
 
@@ -1189,15 +1440,14 @@ func (s *ActivityService) ActivitySupplementAttendance(req *api.ActivitySuppleme
 	}
 
 	checkInText := strings.TrimSpace(req.CheckInTime)
-	var checkInAt time.Time
-	hasCheckInInput := false
+	var checkInAt *time.Time
 	if checkInText != "" {
-		checkInAt, err = util.ParseDateTime(checkInText)
+		parsed, err := util.ParseDateTime(checkInText)
 		if err != nil {
 			log.Error("活动补录失败: 解析签到时间异常: %v, activity_id=%d volunteer_id=%d check_in_time=%s", err, req.ActivityId, req.VolunteerId, checkInText)
 			return nil, errors.New("签到时间格式错误")
 		}
-		hasCheckInInput = true
+		checkInAt = &parsed
 	}
 
 	userID, err := middleware.GetUserIDInt(s.c)
@@ -1223,6 +1473,7 @@ func (s *ActivityService) ActivitySupplementAttendance(req *api.ActivitySuppleme
 	var finalCheckIn time.Time
 	var finalCheckOut time.Time
 	var grantedHours float64
+	var checkInPosition, checkOutPosition *api.AttendancePosition
 
 	err = s.withTransaction(func(tx *gorm.DB) error {
 		signup, err := s.repo.GetSignupForUpdate(tx, req.ActivityId, req.VolunteerId)
@@ -1230,97 +1481,21 @@ func (s *ActivityService) ActivitySupplementAttendance(req *api.ActivitySuppleme
 			return err
 		}
 		if signup == nil {
-			return errors.New("报名记录不存在")
+			return errSupplementSignupNotFound
 		}
-		if signup.Status != model.ActivitySignupStatusSuccess {
-			return errors.New("当前报名状态不允许补录")
-		}
-
-		// 已签退场景直接视为幂等成功，返回已有结果。
-		if signup.CheckOutStatus == model.ActivityCheckOutDone {
-			if signup.CheckInTime != nil {
-				finalCheckIn = *signup.CheckInTime
-			}
-			if signup.CheckOutTime != nil {
-				finalCheckOut = *signup.CheckOutTime
-			}
-			grantedHours = signup.GrantedHours
-			return nil
-		}
-
-		if signup.CheckInStatus == model.ActivityCheckInDone {
-			if signup.CheckInTime == nil {
-				return errors.New("签到数据异常")
-			}
-			finalCheckIn = *signup.CheckInTime
-			if hasCheckInInput && !checkInAt.Equal(finalCheckIn) {
-				return errors.New("已签到，不允许补录签到时间")
-			}
-		} else {
-			if !hasCheckInInput {
-				return errors.New("未签到时必须补录签到时间")
-			}
-			finalCheckIn = checkInAt
-		}
-
-		if checkOutAt.Before(finalCheckIn) {
-			return errors.New("签退时间不能早于签到时间")
-		}
-		finalCheckOut = checkOutAt
-		grantedHours = util.CalcGrantedHours(activity.Duration, finalCheckIn, finalCheckOut)
+		// 返回志愿者自助签到/签退时采集的位置，便于组织核对争议
+		checkInPosition = buildAttendancePosition(signup.CheckInLatitude, signup.CheckInLongitude, signup.CheckInDistance, signup.CheckInOutOfFence)
+		checkOutPosition = buildAttendancePosition(signup.CheckOutLatitude, signup.CheckOutLongitude, signup.CheckOutDistance, signup.CheckOutOutOfFence)
 
-		volunteer, err := s.repo.FindVolunteerByIDForUpdate(tx, signup.VolunteerID)
+		// 已签退场景在 applySupplementAttendance 中视为幂等成功，返回已有结果。
+		result, err := s.applySupplementAttendance(tx, activity, signup, checkInAt, &checkOutAt, reason, userID)
 		if err != nil {
 			return err
 		}
-		beforeHours := volunteer.TotalHours
-		beforeCount := int64(volunteer.ServiceCount)
-		afterHours := util.RoundHours(beforeHours + grantedHours)
-		afterCount := beforeCount + 1
-		if afterHours < 0 || afterCount < 0 {
-			return errors.New("志愿者统计字段异常")
-		}
-
-		newVersion := signup.WorkHourVersion + 1
-		workHourLog := &model.WorkHourLog{
-			VolunteerID:        signup.VolunteerID,
-			ActivityID:         signup.ActivityID,
-			SignupID:           signup.ID,
-			OperationType:      model.WorkHourOperationGrant,
-			HoursDelta:         grantedHours,
-			ServiceCountDelta:  1,
-			BeforeTotalHours:   beforeHours,
-			AfterTotalHours:    afterHours,
-			BeforeServiceCount: beforeCount,
-			AfterServiceCount:  afterCount,
-			WorkHourVersion:    newVersion,
-			IdempotencyKey:     fmt.Sprintf("org-supplement:%d:%d:%d", signup.ID, newVersion, finalCheckOut.Unix()),
-			RefLogID:           signup.LastWorkHourLogID,
-			Reason:             reason,
-			OperatorID:         userID,
-		}
-		if err := s.repo.CreateWorkHourLog(tx, workHourLog); err != nil {
-			return err
-		}
-
-		if err := s.repo.UpdateVolunteer(tx, volunteer.ID, map[string]interface{}{
-			"total_hours":   afterHours,
-			"service_count": int32(afterCount),
-		}); err != nil {
-			return err
-		}
-
-		return s.repo.UpdateActivitySignupByID(tx, signup.ID, map[string]any{
-			"check_in_status":       model.ActivityCheckInDone,
-			"check_in_time":         finalCheckIn,
-			"check_out_status":      model.ActivityCheckOutDone,
-			"check_out_time":        finalCheckOut,
-			"work_hour_status":      model.WorkHourStatusGranted,
-			"work_hour_version":     newVersion,
-			"last_work_hour_log_id": workHourLog.ID,
-			"granted_hours":         grantedHours,
-			"granted_at":            finalCheckOut,
-		})
+		finalCheckIn = result.CheckInTime
+		finalCheckOut = result.CheckOutTime
+		grantedHours = result.GrantedHours
+		return nil
 	})
 	if err != nil {
 		log.Error("活动补录失败: %v, activity_id=%d volunteer_id=%d user_id=%d", err, req.ActivityId, req.VolunteerId, userID)
@@ -1329,13 +1504,73 @@ func (s *ActivityService) ActivitySupplementAttendance(req *api.ActivitySuppleme
 
 	log.Info("活动补录成功: activity_id=%d volunteer_id=%d user_id=%d granted_hours=%.2f", req.ActivityId, req.VolunteerId, userID, grantedHours)
 	return &api.ActivitySupplementAttendanceResponse{
-		Success:      true,
-		CheckInTime:  util.FormatDateTimeOrEmpty(finalCheckIn),
-		CheckOutTime: util.FormatDateTimeOrEmpty(finalCheckOut),
-		GrantedHours: grantedHours,
+		Success:          true,
+		CheckInTime:      util.FormatDateTimeOrEmpty(finalCheckIn),
+		CheckOutTime:     util.FormatDateTimeOrEmpty(finalCheckOut),
+		GrantedHours:     grantedHours,
+		CheckInPosition:  checkInPosition,
+		CheckOutPosition: checkOutPosition,
 	}, nil
 }
 
+// applyActivityUpdateFields 将更新请求中的非时间字段写入活动（空值表示不修改）。
+func applyActivityUpdateFields(activity *model.Activity, req *api.UpdateActivityRequest) error {
+	if req.Title != "" {
+		activity.Title = req.Title
+	}
+	if req.Description != "" {
+		activity.Description = req.Description
+	}
+	if req.CoverUrl != "" {
+		activity.CoverURL = req.CoverUrl
+	}
+	if req.Location != "" {
+		activity.Location = req.Location
+	}
+	if req.Address != "" {
+		activity.Address = req.Address
+	}
+	if req.Duration > 0 {
+		activity.Duration = req.Duration
+	}
+	if req.MaxPeople >= 0 {
+		// 检查是否会导致报名人数超过新设定的最大人数
+		if req.MaxPeople > 0 && activity.CurrentPeople > req.MaxPeople {
+			return errors.New("当前报名人数超过新设定的最大人数")
+		}
+		activity.MaxPeople = req.MaxPeople
+	}
+	if req.Latitude != 0 || req.Longitude != 0 {
+		activity.Latitude = req.Latitude
+		activity.Longitude = req.Longitude
+	}
+	if req.GeofenceRadius > 0 {
+		activity.GeofenceRadius = req.GeofenceRadius
+	} else if req.GeofenceRadius < 0 {
+		activity.GeofenceRadius = 0
+	}
+	if req.GeofenceMode > 0 {
+		activity.GeofenceMode = req.GeofenceMode
+	}
+	if req.AutoSettlePolicy > 0 {
+		if !model.IsValidAutoSettlePolicy(req.AutoSettlePolicy) {
+			return errors.New("未签退结算策略不合法")
+		}
+		activity.AutoSettlePolicy = req.AutoSettlePolicy
+	}
+	if req.RequireVerified > 0 {
+		activity.RequireVerified = 1
+	} else if req.RequireVerified < 0 {
+		activity.RequireVerified = 0
+	}
+	return validateActivityGeofence(activity.Latitude, activity.Longitude, activity.GeofenceRadius, activity.GeofenceMode)
+}
+
+// isActivityCapacityRaised 判断是否扩容（含改为不限人数），扩容时需要递补候补队列。
+func isActivityCapacityRaised(oldMaxPeople, newMaxPeople int32) bool {
+	return oldMaxPeople > 0 && (newMaxPeople == 0 || newMaxPeople > oldMaxPeople)
+}
+
 func (s *ActivityService) getVolunteerIDByAccountID(accountID int64) (int64, error) {
 	volunteer, err := s.repo.FindVolunteerByAccountID(s.repo.DB, accountID)
 	if err != nil {
@@ -1361,7 +1596,7 @@ func (s *ActivityService) ensureActivityOperableByCurrentOrg(activityID, account
 		return nil, err
 	}
 
-	org, err := s.repo.GetOrganizationByAccountID(s.repo.DB, accountID)
+	orgID, err := s.currentOrgID(s.repo.DB, accountID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("组织信息不存在")
@@ -1370,7 +1605,7 @@ func (s *ActivityService) ensureActivityOperableByCurrentOrg(activityID, account
 		return nil, err
 	}
 
-	if activity.OrgID != org.ID {
+	if activity.OrgID != orgID {
 		return nil, errors.New("无权操作此活动")
 	}
 	return activity, nil
@@ -1387,12 +1622,7 @@ func (s *ActivityService) validateCheckInCode(activityID int64, inputCode string
 		return err
 	}
 
-	return validateAttendanceCodeValue(
-		inputCode,
-		codeInfo.CheckInCode,
-		codeInfo.CheckInCodeExpireAt,
-		"签到码错误或已过期",
-	)
+	return validateAttendanceCode(codeInfo, model.AttendanceCodeTypeCheckIn, inputCode, "签到码错误或已过期")
 }
 
 // validateCheckOutCode 查询并校验活动的签退码。
@@ -1406,12 +1636,7 @@ func (s *ActivityService) validateCheckOutCode(activityID int64, inputCode strin
 		return err
 	}
 
-	return validateAttendanceCodeValue(
-		inputCode,
-		codeInfo.CheckOutCode,
-		codeInfo.CheckOutCodeExpireAt,
-		"签退码错误或已过期",
-	)
+	return validateAttendanceCode(codeInfo, model.AttendanceCodeTypeCheckOut, inputCode, "签退码错误或已过期")
 }
 
 // validateAttendanceCodeValue 统一处理码存在性、过期性与值匹配校验。
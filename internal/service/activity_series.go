@@ -0,0 +1,495 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// maxActivitySeriesOccurrences 单个系列最多生成的场次数，避免规则过宽一次性写入过多活动。
+const maxActivitySeriesOccurrences = 200
+
+// CreateActivitySeries 创建系列活动，并按重复规则生成各场次活动
+func (s *ActivityService) CreateActivitySeries(req *api.CreateActivitySeriesRequest) (*api.CreateActivitySeriesResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("创建系列活动失败: 获取当前用户ID异常: %v, org_id=%d", err, req.OrgId)
+		return nil, err
+	}
+
+	if req.OrgId <= 0 {
+		return nil, errors.New("组织ID不能为空")
+	}
+
+	org, err := s.repo.GetOrganizationByID(s.repo.DB, req.OrgId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("组织不存在")
+		}
+		log.Error("创建系列活动失败: 查询组织异常: %v, org_id=%d user_id=%d", err, req.OrgId, userID)
+		return nil, err
+	}
+	if !s.canActForOrg(org, userID) {
+		return nil, errors.New("无权为该组织创建活动")
+	}
+	if err := ensureOrganizationVerified(org); err != nil {
+		return nil, err
+	}
+
+	startTime, err := util.ParseDateTime(req.StartTime)
+	if err != nil {
+		log.Error("创建系列活动失败: 解析开始时间异常: %v, org_id=%d user_id=%d start_time=%s", err, req.OrgId, userID, req.StartTime)
+		return nil, errors.New("开始时间格式错误")
+	}
+	endTime, err := util.ParseDateTime(req.EndTime)
+	if err != nil {
+		log.Error("创建系列活动失败: 解析结束时间异常: %v, org_id=%d user_id=%d end_time=%s", err, req.OrgId, userID, req.EndTime)
+		return nil, errors.New("结束时间格式错误")
+	}
+	if endTime.Before(startTime) {
+		return nil, errors.New("结束时间不能早于开始时间")
+	}
+	if startTime.Before(time.Now()) {
+		return nil, errors.New("开始时间不能早于当前时间")
+	}
+
+	rule, err := util.ParseRecurrenceRule(req.Rrule)
+	if err != nil {
+		return nil, err
+	}
+
+	var untilDate *time.Time
+	if strings.TrimSpace(req.UntilDate) != "" {
+		until, err := util.ParseDate(strings.TrimSpace(req.UntilDate))
+		if err != nil {
+			return nil, errors.New("截止日期格式错误")
+		}
+		if until.Before(util.TruncateDate(startTime)) {
+			return nil, errors.New("截止日期不能早于首场日期")
+		}
+		untilDate = &until
+	}
+
+	exdates := make([]string, 0, len(req.Exdates))
+	for _, item := range req.Exdates {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		if _, err := util.ParseDate(item); err != nil {
+			return nil, errors.New("例外日期格式错误")
+		}
+		exdates = append(exdates, item)
+	}
+	exdates = normalizeSeriesExdates(exdates)
+
+	var until time.Time
+	if untilDate != nil {
+		until = *untilDate
+	}
+	starts, err := util.ExpandRecurrence(rule, startTime, until, seriesExdateSet(exdates), maxActivitySeriesOccurrences)
+	if err != nil {
+		return nil, err
+	}
+	if len(starts) == 0 {
+		return nil, errors.New("重复规则未生成任何场次")
+	}
+
+	series := &model.ActivitySeries{
+		OrgID:          req.OrgId,
+		Title:          req.Title,
+		Description:    req.Description,
+		CoverURL:       req.CoverUrl,
+		Location:       req.Location,
+		Address:        req.Address,
+		Duration:       req.Duration,
+		MaxPeople:      req.MaxPeople,
+		Rrule:          strings.TrimSpace(req.Rrule),
+		FirstStartTime: startTime,
+		FirstEndTime:   endTime,
+		UntilDate:      untilDate,
+		Exdates:        strings.Join(exdates, ","),
+		Status:         model.ActivitySeriesStatusActive,
+	}
+
+	occurrenceLength := endTime.Sub(startTime)
+	activityIDs := make([]int64, 0, len(starts))
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		activityIDs = activityIDs[:0]
+		if err := s.repo.CreateActivitySeries(tx, series); err != nil {
+			return err
+		}
+		for _, start := range starts {
+			activity := &model.Activity{
+				OrgID:         req.OrgId,
+				SeriesID:      series.ID,
+				Title:         req.Title,
+				Description:   req.Description,
+				CoverURL:      req.CoverUrl,
+				StartTime:     start,
+				EndTime:       start.Add(occurrenceLength),
+				Location:      req.Location,
+				Address:       req.Address,
+				Duration:      req.Duration,
+				MaxPeople:     req.MaxPeople,
+				CurrentPeople: 0,
+				Status:        model.ActivityStatusRecruiting,
+			}
+			if err := s.repo.CreateActivity(tx, activity); err != nil {
+				return err
+			}
+			activityIDs = append(activityIDs, activity.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("创建系列活动失败: 写入系列及场次异常: %v, org_id=%d user_id=%d", err, req.OrgId, userID)
+		return nil, err
+	}
+
+	log.Info("创建系列活动成功: series_id=%d org_id=%d user_id=%d occurrences=%d", series.ID, req.OrgId, userID, len(activityIDs))
+	return &api.CreateActivitySeriesResponse{
+		SeriesId:    series.ID,
+		ActivityIds: activityIDs,
+		Message:     "创建系列活动成功",
+	}, nil
+}
+
+// ActivitySeriesDetail 查询系列活动详情及场次
+func (s *ActivityService) ActivitySeriesDetail(req *api.ActivitySeriesDetailRequest) (*api.ActivitySeriesDetailResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("系列ID不能为空")
+	}
+
+	series, err := s.repo.GetActivitySeriesByID(s.repo.DB, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("系列活动不存在")
+		}
+		log.Error("系列活动详情查询失败: 查询系列异常: %v, series_id=%d", err, req.Id)
+		return nil, err
+	}
+
+	activities, err := s.repo.ListSeriesActivities(s.repo.DB, series.ID)
+	if err != nil {
+		log.Error("系列活动详情查询失败: 查询场次异常: %v, series_id=%d", err, series.ID)
+		return nil, err
+	}
+
+	untilDate := ""
+	if series.UntilDate != nil {
+		untilDate = util.FormatDate(*series.UntilDate)
+	}
+	resp := &api.ActivitySeriesDetailResponse{
+		Id:          series.ID,
+		OrgId:       series.OrgID,
+		Title:       series.Title,
+		Rrule:       series.Rrule,
+		UntilDate:   untilDate,
+		Exdates:     parseSeriesExdates(series.Exdates),
+		Status:      series.Status,
+		Occurrences: make([]*api.ActivityItem, 0, len(activities)),
+	}
+	for _, act := range activities {
+		resp.Occurrences = append(resp.Occurrences, &api.ActivityItem{
+			Id:            act.ID,
+			Title:         act.Title,
+			Description:   act.Description,
+			CoverUrl:      act.CoverURL,
+			StartTime:     util.FormatDateTimeOrEmpty(act.StartTime),
+			EndTime:       util.FormatDateTimeOrEmpty(act.EndTime),
+			Location:      act.Location,
+			Duration:      act.Duration,
+			MaxPeople:     act.MaxPeople,
+			CurrentPeople: act.CurrentPeople,
+			Status:        act.Status,
+			IsFull:        act.MaxPeople > 0 && act.CurrentPeople >= act.MaxPeople,
+			SeriesId:      act.SeriesID,
+		})
+	}
+
+	return resp, nil
+}
+
+// ActivitySeriesSignup 报名系列活动中所有尚未开始的场次
+func (s *ActivityService) ActivitySeriesSignup(req *api.ActivitySeriesSignupRequest) (*api.ActivitySeriesSignupResponse, error) {
+	if req.SeriesId <= 0 {
+		return nil, errors.New("系列ID不能为空")
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("系列活动报名失败: 获取当前用户ID异常: %v, series_id=%d", err, req.SeriesId)
+		return nil, err
+	}
+	volunteerID, err := s.getVolunteerIDByAccountID(userID)
+	if err != nil {
+		log.Error("系列活动报名失败: 查询志愿者身份异常: %v, user_id=%d", err, userID)
+		return nil, err
+	}
+
+	series, err := s.repo.GetActivitySeriesByID(s.repo.DB, req.SeriesId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("系列活动不存在")
+		}
+		log.Error("系列活动报名失败: 查询系列异常: %v, series_id=%d user_id=%d", err, req.SeriesId, userID)
+		return nil, err
+	}
+	if series.Status != model.ActivitySeriesStatusActive {
+		return nil, errors.New("系列活动已取消")
+	}
+
+	activities, err := s.repo.ListSeriesActivitiesFrom(s.repo.DB, series.ID, time.Now())
+	if err != nil {
+		log.Error("系列活动报名失败: 查询场次异常: %v, series_id=%d user_id=%d", err, series.ID, userID)
+		return nil, err
+	}
+
+	resp := &api.ActivitySeriesSignupResponse{
+		Results: make([]*api.ActivitySeriesSignupResult, 0, len(activities)),
+	}
+	for _, act := range activities {
+		if act.Status != model.ActivityStatusRecruiting {
+			continue
+		}
+
+		// 各场次独立提交，单场失败（如重复报名）不影响其他场次。
+		result := &api.ActivitySeriesSignupResult{
+			ActivityId: act.ID,
+			StartTime:  util.FormatDateTimeOrEmpty(act.StartTime),
+		}
+		signupResp, err := s.submitActivitySignup(act.ID, 0, userID, volunteerID)
+		if err != nil {
+			result.Message = err.Error()
+		} else {
+			result.Success = true
+			result.Waitlisted = signupResp.Waitlisted
+			result.WaitlistPosition = signupResp.WaitlistPosition
+			resp.SuccessCount++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	if len(resp.Results) == 0 {
+		return nil, errors.New("系列暂无可报名场次")
+	}
+
+	log.Info("系列活动报名完成: series_id=%d user_id=%d volunteer_id=%d success=%d total=%d", series.ID, userID, volunteerID, resp.SuccessCount, len(resp.Results))
+	return resp, nil
+}
+
+// updateSeriesFollowingActivities 修改系列中本场及之后的全部场次。
+// 时间按本场的变化量整体平移，保持各场次之间原有的日期间隔。
+func (s *ActivityService) updateSeriesFollowingActivities(base *model.Activity, req *api.UpdateActivityRequest, userID int64) (*api.UpdateActivityResponse, error) {
+	var startShift, endShift time.Duration
+	if req.StartTime != "" {
+		startTime, err := util.ParseDateTime(req.StartTime)
+		if err != nil {
+			log.Error("更新系列活动失败: 解析开始时间异常: %v, activity_id=%d user_id=%d start_time=%s", err, base.ID, userID, req.StartTime)
+			return nil, errors.New("开始时间格式错误")
+		}
+		startShift = startTime.Sub(base.StartTime)
+	}
+	if req.EndTime != "" {
+		endTime, err := util.ParseDateTime(req.EndTime)
+		if err != nil {
+			log.Error("更新系列活动失败: 解析结束时间异常: %v, activity_id=%d user_id=%d end_time=%s", err, base.ID, userID, req.EndTime)
+			return nil, errors.New("结束时间格式错误")
+		}
+		endShift = endTime.Sub(base.EndTime)
+	}
+
+	activities, err := s.repo.ListSeriesActivitiesFrom(s.repo.DB, base.SeriesID, base.StartTime)
+	if err != nil {
+		log.Error("更新系列活动失败: 查询后续场次异常: %v, series_id=%d activity_id=%d user_id=%d", err, base.SeriesID, base.ID, userID)
+		return nil, err
+	}
+
+	seriesUpdates := map[string]any{}
+	if req.Title != "" {
+		seriesUpdates["title"] = req.Title
+	}
+	if req.Description != "" {
+		seriesUpdates["description"] = req.Description
+	}
+	if req.CoverUrl != "" {
+		seriesUpdates["cover_url"] = req.CoverUrl
+	}
+	if req.Location != "" {
+		seriesUpdates["location"] = req.Location
+	}
+	if req.Address != "" {
+		seriesUpdates["address"] = req.Address
+	}
+	if req.Duration > 0 {
+		seriesUpdates["duration"] = req.Duration
+	}
+	if req.MaxPeople >= 0 {
+		seriesUpdates["max_people"] = req.MaxPeople
+	}
+
+	// 事务内逐场加锁重新读取后按列更新，避免覆盖并发写入的报名人数、状态等字段；任一场次不合法则整体回滚。
+	updated := 0
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		updated = 0
+		for _, item := range activities {
+			act, err := s.repo.GetActivityByIDForUpdate(tx, item.ID)
+			if err != nil {
+				return err
+			}
+			if act.Status != model.ActivityStatusRecruiting {
+				continue
+			}
+			act.StartTime = act.StartTime.Add(startShift)
+			act.EndTime = act.EndTime.Add(endShift)
+			if act.EndTime.Before(act.StartTime) {
+				return errors.New("结束时间不能早于开始时间")
+			}
+			oldMaxPeople := act.MaxPeople
+			if err := applyActivityUpdateFields(act, req); err != nil {
+				return err
+			}
+			if err := s.repo.UpdateActivityByID(tx, act.ID, activityEditableColumns(act)); err != nil {
+				return err
+			}
+			updated++
+			if isActivityCapacityRaised(oldMaxPeople, act.MaxPeople) {
+				if _, err := s.promoteActivityWaitlist(tx, act.ID); err != nil {
+					return err
+				}
+			}
+		}
+		if updated == 0 {
+			return errors.New("没有可修改的场次")
+		}
+		if len(seriesUpdates) == 0 {
+			return nil
+		}
+		return s.repo.UpdateActivitySeriesByID(tx, base.SeriesID, seriesUpdates)
+	})
+	if err != nil {
+		log.Error("更新系列活动失败: 事务执行异常: %v, series_id=%d activity_id=%d user_id=%d", err, base.SeriesID, base.ID, userID)
+		return nil, err
+	}
+
+	log.Info("更新系列活动成功: series_id=%d from_activity_id=%d user_id=%d updated=%d", base.SeriesID, base.ID, userID, updated)
+	return &api.UpdateActivityResponse{
+		Message: "更新活动成功",
+	}, nil
+}
+
+// activityEditableColumns 活动编辑可修改的列，不含报名人数、状态等由其他流程维护的字段
+func activityEditableColumns(act *model.Activity) map[string]any {
+	return map[string]any{
+		"title":              act.Title,
+		"description":        act.Description,
+		"cover_url":          act.CoverURL,
+		"start_time":         act.StartTime,
+		"end_time":           act.EndTime,
+		"location":           act.Location,
+		"address":            act.Address,
+		"latitude":           act.Latitude,
+		"longitude":          act.Longitude,
+		"geofence_radius":    act.GeofenceRadius,
+		"geofence_mode":      act.GeofenceMode,
+		"auto_settle_policy": act.AutoSettlePolicy,
+		"require_verified":   act.RequireVerified,
+		"duration":           act.Duration,
+		"max_people":         act.MaxPeople,
+	}
+}
+
+// cancelSeriesActivities 取消系列中的场次。
+// 仅本场：记录为系列例外日期；本场及之后：取消后续场次并将系列截止日期提前到本场之前。
+func (s *ActivityService) cancelSeriesActivities(base *model.Activity, req *api.CancelActivityRequest, userID int64) (*api.CancelActivityResponse, error) {
+	canceled := 0
+	err := s.withTransaction(func(tx *gorm.DB) error {
+		canceled = 0
+		series, err := s.repo.GetActivitySeriesByIDForUpdate(tx, base.SeriesID)
+		if err != nil {
+			return err
+		}
+
+		if req.Scope != model.ActivityEditScopeFollowing {
+			if err := s.repo.CancelActivity(tx, base.ID); err != nil {
+				return err
+			}
+			canceled = 1
+			exdates := normalizeSeriesExdates(append(parseSeriesExdates(series.Exdates), util.FormatDate(base.StartTime)))
+			return s.repo.UpdateActivitySeriesByID(tx, series.ID, map[string]any{
+				"exdates": strings.Join(exdates, ","),
+			})
+		}
+
+		activities, err := s.repo.ListSeriesActivitiesFrom(tx, series.ID, base.StartTime)
+		if err != nil {
+			return err
+		}
+		for _, act := range activities {
+			if act.Status != model.ActivityStatusRecruiting {
+				continue
+			}
+			if err := s.repo.CancelActivity(tx, act.ID); err != nil {
+				return err
+			}
+			canceled++
+		}
+
+		untilDate := util.TruncateDate(base.StartTime).AddDate(0, 0, -1)
+		updates := map[string]any{
+			"until_date": untilDate,
+		}
+		// 从首场起全部取消时，整个系列视为取消。
+		if !base.StartTime.After(series.FirstStartTime) {
+			updates["status"] = model.ActivitySeriesStatusCanceled
+		}
+		return s.repo.UpdateActivitySeriesByID(tx, series.ID, updates)
+	})
+	if err != nil {
+		log.Error("取消系列活动失败: 事务执行异常: %v, series_id=%d activity_id=%d user_id=%d scope=%d", err, base.SeriesID, base.ID, userID, req.Scope)
+		return nil, err
+	}
+
+	log.Info("取消系列活动成功: series_id=%d activity_id=%d user_id=%d scope=%d canceled=%d", base.SeriesID, base.ID, userID, req.Scope, canceled)
+	return &api.CancelActivityResponse{
+		Message: "取消活动成功",
+	}, nil
+}
+
+// parseSeriesExdates 将逗号分隔的例外日期解析为列表。
+func parseSeriesExdates(raw string) []string {
+	result := make([]string, 0)
+	for _, item := range strings.Split(raw, ",") {
+		item = strings.TrimSpace(item)
+		if item != "" {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// normalizeSeriesExdates 对例外日期去重并排序。
+func normalizeSeriesExdates(exdates []string) []string {
+	set := seriesExdateSet(exdates)
+	result := make([]string, 0, len(set))
+	for item := range set {
+		result = append(result, item)
+	}
+	sort.Strings(result)
+	return result
+}
+
+func seriesExdateSet(exdates []string) map[string]struct{} {
+	set := make(map[string]struct{}, len(exdates))
+	for _, item := range exdates {
+		set[item] = struct{}{}
+	}
+	return set
+}
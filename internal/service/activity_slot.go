@@ -0,0 +1,300 @@
+package service
+
+import (
+	"errors"
+	"strings"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// ActivitySlotList 查询活动班次岗位列表
+func (s *ActivityService) ActivitySlotList(req *api.ActivitySlotListRequest) (*api.ActivitySlotListResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("活动ID不能为空")
+	}
+
+	slots, err := s.repo.ListActivitySlots(s.repo.DB, req.Id)
+	if err != nil {
+		log.Error("查询班次岗位失败: 查询班次列表异常: %v, activity_id=%d", err, req.Id)
+		return nil, err
+	}
+
+	resp := &api.ActivitySlotListResponse{List: make([]*api.ActivitySlotItem, 0, len(slots))}
+	for _, slot := range slots {
+		resp.List = append(resp.List, buildActivitySlotItem(slot))
+	}
+	return resp, nil
+}
+
+// CreateActivitySlot 创建活动班次岗位（组织侧）
+func (s *ActivityService) CreateActivitySlot(req *api.CreateActivitySlotRequest) (*api.CreateActivitySlotResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("创建班次岗位失败: 获取当前用户ID异常: %v, activity_id=%d", err, req.ActivityId)
+		return nil, err
+	}
+
+	activity, err := s.ensureActivityOperableByCurrentOrg(req.ActivityId, userID)
+	if err != nil {
+		return nil, err
+	}
+	if activity.Status != model.ActivityStatusRecruiting {
+		return nil, errors.New("仅报名中的活动可设置班次岗位")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, errors.New("班次名称不能为空")
+	}
+	startTime, endTime, err := parseActivitySlotWindow(activity, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	if req.MaxPeople < 0 {
+		return nil, errors.New("班次最大人数不能小于0")
+	}
+	requiredSkills := util.JoinSkills(req.RequiredSkills)
+	if len(requiredSkills) > 500 {
+		return nil, errors.New("所需技能总长度不能超过500个字符")
+	}
+
+	slot := &model.ActivitySlot{
+		ActivityID:     activity.ID,
+		Name:           name,
+		RoleName:       strings.TrimSpace(req.RoleName),
+		StartTime:      startTime,
+		EndTime:        endTime,
+		MaxPeople:      req.MaxPeople,
+		RequiredSkills: requiredSkills,
+	}
+
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		// 首次设置班次前，活动已有的报名未绑定班次，无法按班次核算工时，禁止拆分。
+		count, err := s.repo.CountActivitySlots(tx, activity.ID)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			lockedActivity, err := s.repo.GetActivityByIDForUpdate(tx, activity.ID)
+			if err != nil {
+				return err
+			}
+			if lockedActivity.CurrentPeople > 0 {
+				return errors.New("活动已有报名志愿者，无法再拆分班次岗位")
+			}
+		}
+		return s.repo.CreateActivitySlot(tx, slot)
+	})
+	if err != nil {
+		log.Error("创建班次岗位失败: %v, activity_id=%d user_id=%d", err, activity.ID, userID)
+		return nil, err
+	}
+
+	log.Info("创建班次岗位成功: activity_id=%d slot_id=%d user_id=%d", activity.ID, slot.ID, userID)
+	return &api.CreateActivitySlotResponse{Id: slot.ID, Message: "创建成功"}, nil
+}
+
+// UpdateActivitySlot 更新活动班次岗位（组织侧）
+func (s *ActivityService) UpdateActivitySlot(req *api.UpdateActivitySlotRequest) (*api.UpdateActivitySlotResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("更新班次岗位失败: 获取当前用户ID异常: %v, slot_id=%d", err, req.Id)
+		return nil, err
+	}
+
+	slot, err := s.repo.GetActivitySlotByID(s.repo.DB, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("班次岗位不存在")
+		}
+		log.Error("更新班次岗位失败: 查询班次异常: %v, slot_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+
+	activity, err := s.ensureActivityOperableByCurrentOrg(slot.ActivityID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if activity.Status == model.ActivityStatusFinished || activity.Status == model.ActivityStatusCanceled {
+		return nil, errors.New("已结束或已取消的活动不能修改")
+	}
+	if req.MaxPeople < 0 {
+		return nil, errors.New("班次最大人数不能小于0")
+	}
+
+	startStr, endStr := req.StartTime, req.EndTime
+	if startStr == "" {
+		startStr = util.FormatDateTime(slot.StartTime)
+	}
+	if endStr == "" {
+		endStr = util.FormatDateTime(slot.EndTime)
+	}
+	startTime, endTime, err := parseActivitySlotWindow(activity, startStr, endStr)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]any{
+		"start_time": startTime,
+		"end_time":   endTime,
+		"max_people": req.MaxPeople,
+	}
+	if name := strings.TrimSpace(req.Name); name != "" {
+		updates["name"] = name
+	}
+	if req.RoleName != "" {
+		updates["role_name"] = strings.TrimSpace(req.RoleName)
+	}
+	if len(req.RequiredSkills) > 0 {
+		requiredSkills := util.JoinSkills(req.RequiredSkills)
+		if len(requiredSkills) > 500 {
+			return nil, errors.New("所需技能总长度不能超过500个字符")
+		}
+		updates["required_skills"] = requiredSkills
+	}
+
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		lockedSlot, err := s.repo.GetActivitySlotByIDForUpdate(tx, slot.ID)
+		if err != nil {
+			return err
+		}
+		if req.MaxPeople > 0 && req.MaxPeople < lockedSlot.CurrentPeople {
+			return errors.New("班次最大人数不能小于当前已报名人数")
+		}
+		return s.repo.UpdateActivitySlotByID(tx, slot.ID, updates)
+	})
+	if err != nil {
+		log.Error("更新班次岗位失败: %v, slot_id=%d activity_id=%d user_id=%d", err, slot.ID, slot.ActivityID, userID)
+		return nil, err
+	}
+
+	log.Info("更新班次岗位成功: slot_id=%d activity_id=%d user_id=%d", slot.ID, slot.ActivityID, userID)
+	return &api.UpdateActivitySlotResponse{Message: "更新成功"}, nil
+}
+
+// DeleteActivitySlot 删除活动班次岗位（组织侧）
+func (s *ActivityService) DeleteActivitySlot(req *api.DeleteActivitySlotRequest) (*api.DeleteActivitySlotResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("删除班次岗位失败: 获取当前用户ID异常: %v, slot_id=%d", err, req.Id)
+		return nil, err
+	}
+
+	slot, err := s.repo.GetActivitySlotByID(s.repo.DB, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("班次岗位不存在")
+		}
+		log.Error("删除班次岗位失败: 查询班次异常: %v, slot_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+
+	if _, err := s.ensureActivityOperableByCurrentOrg(slot.ActivityID, userID); err != nil {
+		return nil, err
+	}
+
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		lockedSlot, err := s.repo.GetActivitySlotByIDForUpdate(tx, slot.ID)
+		if err != nil {
+			return err
+		}
+		if lockedSlot.CurrentPeople > 0 {
+			return errors.New("该班次已有报名志愿者，无法删除")
+		}
+		return s.repo.DeleteActivitySlot(tx, slot.ID)
+	})
+	if err != nil {
+		log.Error("删除班次岗位失败: %v, slot_id=%d activity_id=%d user_id=%d", err, slot.ID, slot.ActivityID, userID)
+		return nil, err
+	}
+
+	log.Info("删除班次岗位成功: slot_id=%d activity_id=%d user_id=%d", slot.ID, slot.ActivityID, userID)
+	return &api.DeleteActivitySlotResponse{Message: "删除成功"}, nil
+}
+
+// validateSignupSlot 校验报名所选班次：活动设置了班次时必须选择，且志愿者需具备所需技能。
+// 班次名额已满时不拒绝报名，由候补队列按班次排队递补。
+func (s *Service) validateSignupSlot(db *gorm.DB, activityID, slotID, volunteerID int64) (*model.ActivitySlot, error) {
+	if slotID <= 0 {
+		count, err := s.repo.CountActivitySlots(db, activityID)
+		if err != nil {
+			return nil, err
+		}
+		if count > 0 {
+			return nil, errors.New("该活动已设置班次岗位，请选择报名班次")
+		}
+		return nil, nil
+	}
+
+	slot, err := s.repo.GetActivitySlotByID(db, slotID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("班次岗位不存在")
+		}
+		return nil, err
+	}
+	if slot.ActivityID != activityID {
+		return nil, errors.New("班次岗位不属于该活动")
+	}
+	requiredSkills := util.SplitSkills(slot.RequiredSkills)
+	if len(requiredSkills) > 0 {
+		volunteer, err := s.repo.FindVolunteerByID(db, volunteerID)
+		if err != nil {
+			return nil, err
+		}
+		if missing := util.MissingSkills(requiredSkills, util.SplitSkills(volunteer.Skills)); len(missing) > 0 {
+			return nil, errors.New("不满足班次技能要求: " + strings.Join(missing, ","))
+		}
+	}
+	return slot, nil
+}
+
+// calcSignupGrantedHours 计算报名应发工时：绑定班次时按班次时间窗口截取，否则按活动预估工时封顶。
+func (s *Service) calcSignupGrantedHours(db *gorm.DB, activity *model.Activity, signup *model.ActivitySignup, checkIn, checkOut time.Time) (float64, error) {
+	if signup.SlotID <= 0 {
+		return util.CalcGrantedHours(activity.Duration, checkIn, checkOut), nil
+	}
+	slot, err := s.repo.GetActivitySlotByID(db, signup.SlotID)
+	if err != nil {
+		return 0, err
+	}
+	return util.CalcGrantedHoursInWindow(slot.StartTime, slot.EndTime, checkIn, checkOut), nil
+}
+
+// parseActivitySlotWindow 解析班次时间窗口，并校验其落在活动时间范围内。
+func parseActivitySlotWindow(activity *model.Activity, startStr, endStr string) (time.Time, time.Time, error) {
+	startTime, err := util.ParseDateTime(startStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("班次开始时间格式错误")
+	}
+	endTime, err := util.ParseDateTime(endStr)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("班次结束时间格式错误")
+	}
+	if !endTime.After(startTime) {
+		return time.Time{}, time.Time{}, errors.New("班次结束时间必须晚于开始时间")
+	}
+	if startTime.Before(activity.StartTime) || endTime.After(activity.EndTime) {
+		return time.Time{}, time.Time{}, errors.New("班次时间必须在活动时间范围内")
+	}
+	return startTime, endTime, nil
+}
+
+func buildActivitySlotItem(slot *model.ActivitySlot) *api.ActivitySlotItem {
+	return &api.ActivitySlotItem{
+		Id:             slot.ID,
+		ActivityId:     slot.ActivityID,
+		Name:           slot.Name,
+		RoleName:       slot.RoleName,
+		StartTime:      util.FormatDateTime(slot.StartTime),
+		EndTime:        util.FormatDateTime(slot.EndTime),
+		MaxPeople:      slot.MaxPeople,
+		CurrentPeople:  slot.CurrentPeople,
+		RequiredSkills: util.SplitSkills(slot.RequiredSkills),
+	}
+}
@@ -0,0 +1,328 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/internal/repository"
+	"volunteer-system/pkg/util"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultAdminPageSize = 20
+	maxAdminPageSize     = 100
+)
+
+type AdminService struct {
+	Service
+}
+
+func NewAdminService(ctx context.Context, c *app.RequestContext) *AdminService {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &AdminService{
+		Service{
+			ctx:  ctx,
+			c:    c,
+			repo: repository.NewRepository(ctx, c),
+		},
+	}
+}
+
+// CreateAdmin 创建平台管理员账号（命令行初始化使用，不开放接口注册）
+func (s *AdminService) CreateAdmin(username, email, phone, password string) (*model.SysAccount, error) {
+	username = strings.TrimSpace(username)
+	email = strings.TrimSpace(email)
+	phone = strings.TrimSpace(phone)
+
+	register := &RegisterService{Service: s.Service}
+	if username == "" {
+		return nil, errors.New("用户名不能为空")
+	}
+	if !register.isValidEmail(email) {
+		return nil, errors.New("邮箱格式不正确")
+	}
+	if !register.isValidMobile(phone) {
+		return nil, errors.New("手机号格式不正确")
+	}
+	if err := util.ValidatePasswordStrength(password); err != nil {
+		return nil, errors.New("密码不符合要求: " + err.Error())
+	}
+
+	mobilePair, err := util.ProcessSensitiveField(phone)
+	if err != nil {
+		log.Error("创建平台管理员失败: 手机号处理异常: %v", err)
+		return nil, errors.New("手机号处理失败")
+	}
+	exists, err := s.repo.CheckMobileExists(s.repo.DB, mobilePair.Hash)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("手机号已存在")
+	}
+	exists, err = s.repo.CheckEmailExists(s.repo.DB, email)
+	if err != nil {
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("邮箱已存在")
+	}
+
+	hashedPassword, err := util.HashPassword(password)
+	if err != nil {
+		log.Error("创建平台管理员失败: 密码加密异常: %v", err)
+		return nil, errors.New("密码加密失败")
+	}
+
+	account := &model.SysAccount{
+		Username:     username,
+		Mobile:       mobilePair.Encrypted,
+		MobileHash:   mobilePair.Hash,
+		Email:        email,
+		Password:     hashedPassword,
+		IdentityType: model.RegisterTypeAdminCode,
+		Status:       model.SysAccountNormal,
+		CreatedAt:    time.Now(),
+	}
+	if err := s.repo.CreateAccount(s.repo.DB, account); err != nil {
+		log.Error("创建平台管理员失败: 保存账号异常: %v, email=%s", err, email)
+		return nil, err
+	}
+
+	log.Info("平台管理员创建成功: 账号ID=%d, 邮箱=%s, 手机号=%s", account.ID, email, util.GetMobileMask(phone))
+	return account, nil
+}
+
+// AdminAccountList 账号列表（跨组织）
+func (s *AdminService) AdminAccountList(req *api.AdminAccountListRequest) (*api.AdminAccountListResponse, error) {
+	resp := &api.AdminAccountListResponse{
+		Total: 0,
+		List:  []*api.AdminAccountItem{},
+	}
+	page, pageSize := normalizeAdminPage(req.Page, req.PageSize)
+
+	queryMap := make(map[string]any)
+	if req.IdentityType > 0 {
+		queryMap["identity_type = ?"] = req.IdentityType
+	}
+	if len(req.Status) > 0 {
+		queryMap["status IN ?"] = req.Status
+	}
+	if keyword := strings.TrimSpace(req.Keyword); keyword != "" {
+		queryMap["(username LIKE ? OR email LIKE ?)"] = []any{"%" + keyword + "%", "%" + keyword + "%"}
+	}
+
+	accounts, total, err := s.repo.ListAccounts(s.repo.DB, queryMap, pageSize, (page-1)*pageSize)
+	if err != nil {
+		log.Error("平台账号列表查询失败: %v", err)
+		return nil, err
+	}
+
+	resp.Total = int32(total)
+	for _, account := range accounts {
+		mobile := ""
+		if account.Mobile != "" {
+			if decrypted, err := util.DecryptSensitiveField(account.Mobile); err == nil {
+				mobile = util.GetMobileMask(decrypted)
+			}
+		}
+		resp.List = append(resp.List, &api.AdminAccountItem{
+			Id:            account.ID,
+			Username:      account.Username,
+			Email:         account.Email,
+			Mobile:        mobile,
+			IdentityType:  account.IdentityType,
+			Status:        account.Status,
+			LastLoginTime: util.FormatDateTimePtr(account.LastLoginTime),
+			CreatedAt:     util.FormatDateTimeOrEmpty(account.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// AdminUpdateAccountStatus 启用/禁用账号，禁用时同时撤销账号的刷新令牌
+func (s *AdminService) AdminUpdateAccountStatus(req *api.AdminUpdateAccountStatusRequest) (*api.AdminUpdateAccountStatusResponse, error) {
+	if req.AccountId <= 0 {
+		return nil, errors.New("账号ID不能为空")
+	}
+	if req.Status != model.SysAccountNormal && req.Status != model.SysAccountNotNormal {
+		return nil, errors.New("账号状态无效")
+	}
+
+	operatorID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	if req.AccountId == operatorID && req.Status == model.SysAccountNotNormal {
+		return nil, errors.New("不能禁用当前登录的账号")
+	}
+
+	account, err := s.repo.FindByID(s.repo.DB, req.AccountId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("账号不存在")
+		}
+		log.Error("更新账号状态失败: 查询账号异常: %v, account_id=%d", err, req.AccountId)
+		return nil, err
+	}
+	if account.Status == req.Status {
+		return &api.AdminUpdateAccountStatusResponse{}, nil
+	}
+
+	if err := s.repo.UpdateAccountStatus(s.repo.DB, account.ID, req.Status); err != nil {
+		log.Error("更新账号状态失败: %v, account_id=%d", err, account.ID)
+		return nil, err
+	}
+	if req.Status == model.SysAccountNotNormal {
+		if err := util.GetJWTManager().RevokeUserTokens(s.ctx, strconv.FormatInt(account.ID, 10)); err != nil {
+			log.Warn("禁用账号: 撤销令牌失败: %v, account_id=%d", err, account.ID)
+		}
+	}
+
+	log.Info("平台管理员更新账号状态: operator_id=%d, account_id=%d, identity_type=%d, status=%d->%d",
+		operatorID, account.ID, account.IdentityType, account.Status, req.Status)
+	return &api.AdminUpdateAccountStatusResponse{}, nil
+}
+
+// AdminOrganizationList 组织列表（跨组织，附带组织账号状态）
+func (s *AdminService) AdminOrganizationList(req *api.AdminOrganizationListRequest) (*api.AdminOrganizationListResponse, error) {
+	resp := &api.AdminOrganizationListResponse{
+		Total: 0,
+		List:  []*api.AdminOrganizationItem{},
+	}
+	page, pageSize := normalizeAdminPage(req.Page, req.PageSize)
+
+	queryMap := make(map[string]any)
+	if keyword := strings.TrimSpace(req.Keyword); keyword != "" {
+		queryMap["(org.org_name LIKE ? OR org.contact_person LIKE ?)"] = []any{"%" + keyword + "%", "%" + keyword + "%"}
+	}
+	if len(req.Status) > 0 {
+		queryMap["org.status IN (?)"] = req.Status
+	}
+
+	organizations, total, err := s.repo.GetOrganizationList(s.repo.DB, queryMap, pageSize, (page-1)*pageSize)
+	if err != nil {
+		log.Error("平台组织列表查询失败: %v", err)
+		return nil, err
+	}
+	if total == 0 {
+		return resp, nil
+	}
+
+	accountIDs := make([]int64, 0, len(organizations))
+	for _, org := range organizations {
+		accountIDs = append(accountIDs, org.AccountID)
+	}
+	accounts, err := s.repo.GetAccountsByIDs(s.repo.DB, accountIDs)
+	if err != nil {
+		log.Error("平台组织列表查询失败: 查询组织账号异常: %v", err)
+		return nil, err
+	}
+
+	resp.Total = int32(total)
+	for _, org := range organizations {
+		item := &api.AdminOrganizationItem{
+			Id:            org.ID,
+			OrgName:       org.OrgName,
+			LicenseCode:   org.LicenseCode,
+			ContactPerson: org.ContactPerson,
+			Status:        org.Status,
+			AccountId:     org.AccountID,
+			CreatedAt:     util.FormatDateTimeOrEmpty(org.CreatedAt),
+			VerifyStatus:  org.VerifyStatus,
+		}
+		if account, ok := accounts[org.AccountID]; ok {
+			item.AccountEmail = account.Email
+			item.AccountStatus = account.Status
+		}
+		resp.List = append(resp.List, item)
+	}
+	return resp, nil
+}
+
+// AdminAuditList 平台审核列表（志愿者实名、组织资质）
+func (s *AdminService) AdminAuditList(req *api.AdminAuditListRequest) (*api.AdminAuditListResponse, error) {
+	resp := &api.AdminAuditListResponse{
+		Total: 0,
+		List:  []*api.AdminAuditItem{},
+	}
+	if req.TargetType > 0 && !model.IsPlatformAuditTarget(req.TargetType) {
+		return nil, errors.New("审核类型无效")
+	}
+	page, pageSize := normalizeAdminPage(req.Page, req.PageSize)
+
+	queryMap := map[string]any{
+		"target_type IN ?": []int32{model.AuditTargetVolunteer, model.AuditTargetOrg},
+	}
+	if req.TargetType > 0 {
+		queryMap = map[string]any{"target_type = ?": req.TargetType}
+	}
+	if len(req.Status) > 0 {
+		queryMap["status IN ?"] = req.Status
+	}
+
+	records, total, err := s.repo.GetAuditRecordsList(s.repo.DB, queryMap, int32(pageSize), int32((page-1)*pageSize))
+	if err != nil {
+		log.Error("平台审核列表查询失败: %v", err)
+		return nil, err
+	}
+
+	resp.Total = int32(total)
+	for _, record := range records {
+		item := &api.AdminAuditItem{
+			Id:           record.ID,
+			TargetType:   record.TargetType,
+			TargetId:     record.TargetID,
+			Status:       record.Status,
+			Title:        s.adminAuditTitle(record),
+			AuditorId:    record.AuditorID,
+			RejectReason: record.RejectReason,
+			CreatedAt:    util.FormatDateTimeOrEmpty(record.CreatedAt),
+		}
+		if record.Status != model.AuditStatusPending {
+			item.AuditTime = util.FormatDateTimeOrEmpty(record.AuditTime)
+		}
+		resp.List = append(resp.List, item)
+	}
+	return resp, nil
+}
+
+// adminAuditTitle 审核记录标题：志愿者姓名或组织名称，目标不存在时为空
+func (s *AdminService) adminAuditTitle(record *model.AuditRecord) string {
+	switch record.TargetType {
+	case model.AuditTargetVolunteer:
+		volunteer, err := s.repo.FindVolunteerByID(s.repo.DB, record.TargetID)
+		if err == nil && volunteer != nil {
+			return volunteer.RealName
+		}
+	case model.AuditTargetOrg:
+		org, err := s.repo.GetOrganizationByID(s.repo.DB, record.TargetID)
+		if err == nil && org != nil {
+			return org.OrgName
+		}
+	}
+	return ""
+}
+
+func normalizeAdminPage(page, pageSize int32) (int, int) {
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultAdminPageSize
+	}
+	if pageSize > maxAdminPageSize {
+		pageSize = maxAdminPageSize
+	}
+	return int(page), int(pageSize)
+}
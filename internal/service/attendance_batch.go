@@ -0,0 +1,322 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// maxBatchAttendanceRows 单次批量补录的最大行数
+const maxBatchAttendanceRows = 200
+
+// 补录签到签退的校验错误，批量补录据此归类每行结果。
+var (
+	errSupplementSignupNotFound   = errors.New("报名记录不存在")
+	errSupplementStatusInvalid    = errors.New("当前报名状态不允许补录")
+	errSupplementCheckInCorrupted = errors.New("签到数据异常")
+	errSupplementCheckInConflict  = errors.New("已签到，不允许补录签到时间")
+	errSupplementCheckInRequired  = errors.New("未签到时必须补录签到时间")
+	errSupplementCheckOutEarly    = errors.New("签退时间不能早于签到时间")
+)
+
+// supplementAttendanceResult 单条补录结果
+type supplementAttendanceResult struct {
+	CheckInTime      time.Time
+	CheckOutTime     time.Time
+	GrantedHours     float64
+	AlreadySettled   bool
+	AlreadyCheckedIn bool // 仅补录签到且已签到未签退，无变更
+}
+
+// ActivityBatchAttendance 批量补录签到签退（组织侧）
+// 每行在独立事务中处理，锁定与工时流水规则与单条补录一致，单行失败不影响其他行。
+func (s *ActivityService) ActivityBatchAttendance(req *api.ActivityBatchAttendanceRequest) (*api.ActivityBatchAttendanceResponse, error) {
+	if req.ActivityId <= 0 {
+		return nil, errors.New("活动ID不能为空")
+	}
+	if len(req.Rows) == 0 {
+		return nil, errors.New("补录名单不能为空")
+	}
+	if len(req.Rows) > maxBatchAttendanceRows {
+		return nil, fmt.Errorf("单次最多补录%d条", maxBatchAttendanceRows)
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("批量补录失败: 获取当前用户ID异常: %v, activity_id=%d", err, req.ActivityId)
+		return nil, err
+	}
+
+	activity, err := s.ensureActivityOperableByCurrentOrg(req.ActivityId, userID)
+	if err != nil {
+		log.Error("批量补录失败: 校验活动归属异常: %v, activity_id=%d user_id=%d", err, req.ActivityId, userID)
+		return nil, err
+	}
+	if activity.Status == model.ActivityStatusCanceled {
+		return nil, errors.New("已取消活动不允许补录")
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		reason = "组织批量补录签到签退"
+	}
+
+	resp := &api.ActivityBatchAttendanceResponse{
+		Results: make([]*api.ActivityBatchAttendanceResult, 0, len(req.Rows)),
+	}
+	for i, row := range req.Rows {
+		result := s.processBatchAttendanceRow(activity, row, reason, userID)
+		result.RowIndex = int32(i)
+		if result.Status == model.AttendanceBatchResultSuccess || result.Status == model.AttendanceBatchResultAlreadySettled ||
+			result.Status == model.AttendanceBatchResultCheckedIn {
+			resp.SuccessCount++
+		} else {
+			resp.FailedCount++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+
+	log.Info("批量补录完成: activity_id=%d user_id=%d total=%d success=%d failed=%d", req.ActivityId, userID, len(req.Rows), resp.SuccessCount, resp.FailedCount)
+	return resp, nil
+}
+
+// processBatchAttendanceRow 处理单行补录，错误转换为行结果而不向上返回。
+func (s *ActivityService) processBatchAttendanceRow(activity *model.Activity, row *api.ActivityBatchAttendanceRow, reason string, operatorID int64) *api.ActivityBatchAttendanceResult {
+	result := &api.ActivityBatchAttendanceResult{}
+	if row == nil || (row.VolunteerId <= 0 && row.SignupId <= 0) {
+		result.Status = model.AttendanceBatchResultNotSignedUp
+		result.Message = "志愿者ID与报名ID不能同时为空"
+		return result
+	}
+	result.VolunteerId = row.VolunteerId
+	result.SignupId = row.SignupId
+
+	var checkInAt *time.Time
+	if text := strings.TrimSpace(row.CheckInTime); text != "" {
+		t, err := util.ParseDateTime(text)
+		if err != nil {
+			result.Status = model.AttendanceBatchResultInvalidTime
+			result.Message = "签到时间格式错误"
+			return result
+		}
+		checkInAt = &t
+	}
+	var checkOutAt *time.Time
+	if text := strings.TrimSpace(row.CheckOutTime); text != "" {
+		t, err := util.ParseDateTime(text)
+		if err != nil {
+			result.Status = model.AttendanceBatchResultInvalidTime
+			result.Message = "签退时间格式错误"
+			return result
+		}
+		checkOutAt = &t
+	}
+	if checkInAt == nil && checkOutAt == nil {
+		result.Status = model.AttendanceBatchResultInvalidTime
+		result.Message = "签到时间与签退时间不能同时为空"
+		return result
+	}
+
+	var rowResult *supplementAttendanceResult
+	err := s.withTransaction(func(tx *gorm.DB) error {
+		var signup *model.ActivitySignup
+		var err error
+		if row.SignupId > 0 {
+			signup, err = s.repo.GetActivitySignupByIDForUpdate(tx, row.SignupId)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errSupplementSignupNotFound
+			}
+			if err == nil && (signup.ActivityID != activity.ID || (row.VolunteerId > 0 && signup.VolunteerID != row.VolunteerId)) {
+				return errSupplementSignupNotFound
+			}
+		} else {
+			signup, err = s.repo.GetSignupForUpdate(tx, activity.ID, row.VolunteerId)
+		}
+		if err != nil {
+			return err
+		}
+		if signup == nil {
+			return errSupplementSignupNotFound
+		}
+		result.VolunteerId = signup.VolunteerID
+		result.SignupId = signup.ID
+
+		rowResult, err = s.applySupplementAttendance(tx, activity, signup, checkInAt, checkOutAt, reason, operatorID)
+		return err
+	})
+	if err != nil {
+		result.Status, result.Message = classifyBatchAttendanceError(err)
+		if result.Status == model.AttendanceBatchResultFailed {
+			log.Error("批量补录单行失败: %v, activity_id=%d volunteer_id=%d signup_id=%d user_id=%d", err, activity.ID, row.VolunteerId, row.SignupId, operatorID)
+		}
+		return result
+	}
+
+	result.Status = model.AttendanceBatchResultSuccess
+	if rowResult.AlreadySettled {
+		result.Status = model.AttendanceBatchResultAlreadySettled
+	} else if rowResult.AlreadyCheckedIn {
+		result.Status = model.AttendanceBatchResultCheckedIn
+	}
+	result.CheckInTime = util.FormatDateTimeOrEmpty(rowResult.CheckInTime)
+	result.CheckOutTime = util.FormatDateTimeOrEmpty(rowResult.CheckOutTime)
+	result.GrantedHours = rowResult.GrantedHours
+	return result
+}
+
+// classifyBatchAttendanceError 将补录错误归类为行结果状态。
+func classifyBatchAttendanceError(err error) (int32, string) {
+	switch {
+	case errors.Is(err, errSupplementSignupNotFound), errors.Is(err, errSupplementStatusInvalid):
+		return model.AttendanceBatchResultNotSignedUp, err.Error()
+	case errors.Is(err, errSupplementCheckInConflict), errors.Is(err, errSupplementCheckInRequired), errors.Is(err, errSupplementCheckOutEarly):
+		return model.AttendanceBatchResultInvalidTime, err.Error()
+	default:
+		return model.AttendanceBatchResultFailed, "补录失败，请稍后重试"
+	}
+}
+
+// applySupplementAttendance 在事务内为已加锁的报名记录补录签到签退并结算工时。
+// checkInAt 为空表示沿用已有签到时间；checkOutAt 为空表示仅补录签到，不结算工时。
+// 已签退的记录视为幂等成功，直接返回已有结果。
+func (s *ActivityService) applySupplementAttendance(tx *gorm.DB, activity *model.Activity, signup *model.ActivitySignup, checkInAt, checkOutAt *time.Time, reason string, operatorID int64) (*supplementAttendanceResult, error) {
+	if signup.Status != model.ActivitySignupStatusSuccess {
+		return nil, errSupplementStatusInvalid
+	}
+
+	result := &supplementAttendanceResult{}
+	if signup.CheckOutStatus == model.ActivityCheckOutDone {
+		if signup.CheckInTime != nil {
+			result.CheckInTime = *signup.CheckInTime
+		}
+		if signup.CheckOutTime != nil {
+			result.CheckOutTime = *signup.CheckOutTime
+		}
+		result.GrantedHours = signup.GrantedHours
+		result.AlreadySettled = true
+		return result, nil
+	}
+
+	// 已判定爽约的记录补录后返还信用分
+	if signup.AttendanceResult == model.AttendanceResultNoShow {
+		if err := s.revokeNoShowCredit(tx, signup, reason, operatorID); err != nil {
+			return nil, err
+		}
+	}
+
+	if signup.CheckInStatus == model.ActivityCheckInDone {
+		if signup.CheckInTime == nil {
+			return nil, errSupplementCheckInCorrupted
+		}
+		result.CheckInTime = *signup.CheckInTime
+		if checkInAt != nil && !checkInAt.Equal(result.CheckInTime) {
+			return nil, errSupplementCheckInConflict
+		}
+	} else {
+		if checkInAt == nil {
+			return nil, errSupplementCheckInRequired
+		}
+		result.CheckInTime = *checkInAt
+	}
+
+	// 仅补录签到
+	if checkOutAt == nil {
+		if signup.CheckInStatus == model.ActivityCheckInDone {
+			result.AlreadyCheckedIn = true
+			return result, nil
+		}
+		return result, s.repo.UpdateActivitySignupByID(tx, signup.ID, map[string]any{
+			"check_in_status": model.ActivityCheckInDone,
+			"check_in_time":   result.CheckInTime,
+		})
+	}
+
+	if checkOutAt.Before(result.CheckInTime) {
+		return nil, errSupplementCheckOutEarly
+	}
+	result.CheckOutTime = *checkOutAt
+	grantedHours, err := s.calcSignupGrantedHours(tx, activity, signup, result.CheckInTime, result.CheckOutTime)
+	if err != nil {
+		return nil, err
+	}
+	result.GrantedHours = grantedHours
+
+	// 被自动结算标记待确认的报名，补录即视为组织确认发放
+	var extraUpdates map[string]any
+	if signup.SettleReviewStatus == model.SettleReviewPending {
+		extraUpdates = map[string]any{"settle_review_status": model.SettleReviewGranted}
+	}
+	idempotencyKey := fmt.Sprintf("org-supplement:%d:%d:%d", signup.ID, signup.WorkHourVersion+1, result.CheckOutTime.Unix())
+	if err := s.grantSignupWorkHours(tx, signup, result.CheckInTime, result.CheckOutTime, grantedHours, idempotencyKey, reason, operatorID, extraUpdates); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// grantSignupWorkHours 在事务内为报名记录发放工时：锁定志愿者、写入工时流水并同步志愿者统计与报名结算字段。
+// extraUpdates 为需要随报名记录一并更新的附加字段。
+func (s *Service) grantSignupWorkHours(tx *gorm.DB, signup *model.ActivitySignup, checkInAt, checkOutAt time.Time, grantedHours float64, idempotencyKey, reason string, operatorID int64, extraUpdates map[string]any) error {
+	volunteer, err := s.repo.FindVolunteerByIDForUpdate(tx, signup.VolunteerID)
+	if err != nil {
+		return err
+	}
+	beforeHours := volunteer.TotalHours
+	beforeCount := int64(volunteer.ServiceCount)
+	afterHours := util.RoundHours(beforeHours + grantedHours)
+	afterCount := beforeCount + 1
+	if afterHours < 0 || afterCount < 0 {
+		return errors.New("志愿者统计字段异常")
+	}
+
+	newVersion := signup.WorkHourVersion + 1
+	workHourLog := &model.WorkHourLog{
+		VolunteerID:        signup.VolunteerID,
+		ActivityID:         signup.ActivityID,
+		SignupID:           signup.ID,
+		OperationType:      model.WorkHourOperationGrant,
+		HoursDelta:         grantedHours,
+		ServiceCountDelta:  1,
+		BeforeTotalHours:   beforeHours,
+		AfterTotalHours:    afterHours,
+		BeforeServiceCount: beforeCount,
+		AfterServiceCount:  afterCount,
+		WorkHourVersion:    newVersion,
+		IdempotencyKey:     idempotencyKey,
+		RefLogID:           signup.LastWorkHourLogID,
+		Reason:             reason,
+		OperatorID:         operatorID,
+	}
+	if err := s.repo.CreateWorkHourLog(tx, workHourLog); err != nil {
+		return err
+	}
+
+	if err := s.repo.UpdateVolunteer(tx, volunteer.ID, map[string]interface{}{
+		"total_hours":   afterHours,
+		"service_count": int32(afterCount),
+	}); err != nil {
+		return err
+	}
+
+	updates := map[string]any{
+		"check_in_status":       model.ActivityCheckInDone,
+		"check_in_time":         checkInAt,
+		"check_out_status":      model.ActivityCheckOutDone,
+		"check_out_time":        checkOutAt,
+		"work_hour_status":      model.WorkHourStatusGranted,
+		"work_hour_version":     newVersion,
+		"last_work_hour_log_id": workHourLog.ID,
+		"granted_hours":         grantedHours,
+		"granted_at":            checkOutAt,
+	}
+	for key, value := range extraUpdates {
+		updates[key] = value
+	}
+	return s.repo.UpdateActivitySignupByID(tx, signup.ID, updates)
+}
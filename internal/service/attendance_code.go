@@ -0,0 +1,295 @@
+package service
+
+import (
+	"crypto/subtle"
+	"errors"
+	"strings"
+	"time"
+	"volunteer-system/config"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/internal/repository"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+const (
+	// 动态码轮换周期范围（秒）
+	attendanceCodeRotationDefault = 30
+	attendanceCodeRotationMin     = 30
+	attendanceCodeRotationMax     = 60
+	// attendanceCodeRotationSkew 动态码校验允许前后各 1 个周期的漂移，兼顾网络延迟与设备时钟误差。
+	attendanceCodeRotationSkew = 1
+
+	// 二维码令牌有效时长（秒）
+	attendanceQRCodeValidDefault = 60
+	attendanceQRCodeValidMax     = 600
+	attendanceQRCodeSizeMax      = 1024
+)
+
+// AttendanceQRCode 获取签到/签退二维码（组织侧）
+// 二维码内容为签名令牌（活动ID、码类型、码版本号、过期时间），重置签到签退码后版本号变化，旧二维码随即失效。
+func (s *ActivityService) AttendanceQRCode(req *api.AttendanceQRCodeRequest) (*api.AttendanceQRCodeResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("活动ID不能为空")
+	}
+	if !model.IsValidAttendanceCodeType(req.CodeType) {
+		return nil, errors.New("码类型不合法")
+	}
+	if req.ValidSeconds < 0 || req.ValidSeconds > attendanceQRCodeValidMax {
+		return nil, errors.New("二维码有效时长需在0~600秒之间")
+	}
+	if req.Size < 0 || req.Size > attendanceQRCodeSizeMax {
+		return nil, errors.New("二维码尺寸不能超过1024像素")
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("生成签到二维码失败: 获取当前用户ID异常: %v, activity_id=%d", err, req.Id)
+		return nil, err
+	}
+
+	activity, err := s.ensureActivityOperableByCurrentOrg(req.Id, userID)
+	if err != nil {
+		log.Error("生成签到二维码失败: 校验活动归属异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+	if activity.Status == model.ActivityStatusCanceled || activity.Status == model.ActivityStatusFinished {
+		return nil, errors.New("已结束或已取消的活动不能生成签到二维码")
+	}
+
+	codeInfo, err := s.repo.GetActivityAttendanceCodeByID(s.repo.DB, req.Id)
+	if err != nil {
+		log.Error("生成签到二维码失败: 查询活动码字段异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+	if codeInfo.AttendanceCodeVersion <= 0 {
+		return nil, errors.New("请先生成签到签退码")
+	}
+
+	validSeconds := req.ValidSeconds
+	if validSeconds == 0 {
+		validSeconds = attendanceQRCodeValidDefault
+	}
+	now := time.Now()
+	expireAt := now.Add(time.Duration(validSeconds) * time.Second)
+	// 二维码有效期不超过对应码本身的过期时间
+	codeExpireAt := codeInfo.CheckInCodeExpireAt
+	if req.CodeType == model.AttendanceCodeTypeCheckOut {
+		codeExpireAt = codeInfo.CheckOutCodeExpireAt
+	}
+	if codeExpireAt != nil {
+		if !codeExpireAt.After(now) {
+			return nil, errors.New("签到签退码已过期，请重新生成")
+		}
+		if codeExpireAt.Before(expireAt) {
+			expireAt = *codeExpireAt
+		}
+	}
+
+	token, err := util.SignAttendanceToken(appSigningSecret(), req.Id, req.CodeType, codeInfo.AttendanceCodeVersion, expireAt)
+	if err != nil {
+		log.Error("生成签到二维码失败: 签发令牌异常: %v, activity_id=%d user_id=%d code_type=%d", err, req.Id, userID, req.CodeType)
+		return nil, err
+	}
+	image, contentType, err := util.RenderQRCode(token, strings.ToLower(strings.TrimSpace(req.Format)), int(req.Size))
+	if err != nil {
+		log.Error("生成签到二维码失败: 渲染二维码异常: %v, activity_id=%d user_id=%d code_type=%d", err, req.Id, userID, req.CodeType)
+		return nil, err
+	}
+
+	return &api.AttendanceQRCodeResponse{
+		Image:                 image,
+		ContentType:           contentType,
+		ExpireAt:              util.FormatDateTime(expireAt),
+		AttendanceCodeVersion: codeInfo.AttendanceCodeVersion,
+	}, nil
+}
+
+// validateAttendanceQRToken 校验二维码令牌的签名、有效期、活动、码类型及码版本号。
+func (s *ActivityService) validateAttendanceQRToken(activityID int64, codeType int32, token, errMsg string) error {
+	claims, err := util.ParseAttendanceToken(appSigningSecret(), strings.TrimSpace(token))
+	if err != nil {
+		return errors.New(errMsg)
+	}
+	if claims.ActivityID != activityID || claims.CodeType != codeType {
+		return errors.New(errMsg)
+	}
+
+	codeInfo, err := s.repo.GetActivityAttendanceCodeByID(s.repo.DB, activityID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("活动不存在")
+		}
+		log.Error("校验签到二维码失败: 查询活动码字段异常: %v, activity_id=%d", err, activityID)
+		return err
+	}
+	// 码被重置后版本号已推进，旧二维码全部失效
+	if claims.Version != codeInfo.AttendanceCodeVersion {
+		return errors.New(errMsg)
+	}
+	return nil
+}
+
+// appSigningSecret 应用签名密钥（二维码令牌、证书签名），优先使用应用密钥，未配置时回退到 JWT 密钥。
+func appSigningSecret() string {
+	cfg := config.GetConfig()
+	if cfg == nil {
+		return ""
+	}
+	if cfg.App.SecretKey != "" {
+		return cfg.App.SecretKey
+	}
+	if cfg.Auth != nil {
+		return cfg.Auth.JWT.Secret
+	}
+	return ""
+}
+
+// resolveAttendanceCodeRotation 解析码模式与轮换周期，未指定时默认静态码。
+func resolveAttendanceCodeRotation(codeMode, rotationSeconds int32) (int32, int32, error) {
+	if codeMode == 0 {
+		codeMode = model.AttendanceCodeModeStatic
+	}
+	if !model.IsValidAttendanceCodeMode(codeMode) {
+		return 0, 0, errors.New("码模式不合法")
+	}
+	if codeMode == model.AttendanceCodeModeStatic {
+		return codeMode, 0, nil
+	}
+
+	if rotationSeconds == 0 {
+		rotationSeconds = attendanceCodeRotationDefault
+	}
+	if rotationSeconds < attendanceCodeRotationMin || rotationSeconds > attendanceCodeRotationMax {
+		return 0, 0, errors.New("动态码轮换周期需在30~60秒之间")
+	}
+	return codeMode, rotationSeconds, nil
+}
+
+// newEncryptedAttendanceSecret 生成动态码密钥并返回加密后的密文。
+func newEncryptedAttendanceSecret() (string, error) {
+	secret, err := util.GenerateTOTPSecret()
+	if err != nil {
+		return "", err
+	}
+	return util.EncryptSensitiveField(secret)
+}
+
+// hashAttendanceCode 计算静态码哈希，用于校验时比对。
+func hashAttendanceCode(code string) (string, error) {
+	return util.HashSensitiveField(strings.TrimSpace(code))
+}
+
+// currentAttendanceCode 返回当前可展示的码：静态码解密后返回（历史数据无密文时返回明文列），动态码由密钥按当前时间计算。
+func currentAttendanceCode(codeInfo *repository.ActivityAttendanceCode, codeType int32, now time.Time) (string, error) {
+	encrypted, legacyCode := codeInfo.CheckInCodeSecret, codeInfo.CheckInCode
+	if codeType == model.AttendanceCodeTypeCheckOut {
+		encrypted, legacyCode = codeInfo.CheckOutCodeSecret, codeInfo.CheckOutCode
+	}
+	if codeInfo.AttendanceCodeMode != model.AttendanceCodeModeRotating {
+		if encrypted == "" {
+			return legacyCode, nil
+		}
+		return util.DecryptSensitiveField(encrypted)
+	}
+
+	if encrypted == "" {
+		return "", nil
+	}
+	secret, err := util.DecryptSensitiveField(encrypted)
+	if err != nil {
+		return "", err
+	}
+	return util.TOTPCode(secret, now, int(codeInfo.AttendanceCodePeriod))
+}
+
+// attendanceCodeNextRotateAt 返回动态码下次轮换时间，静态码返回空串。
+func attendanceCodeNextRotateAt(codeInfo *repository.ActivityAttendanceCode, now time.Time) string {
+	if codeInfo.AttendanceCodeMode != model.AttendanceCodeModeRotating || codeInfo.AttendanceCodePeriod <= 0 {
+		return ""
+	}
+	return util.FormatDateTime(util.TOTPNextRotateAt(now, int(codeInfo.AttendanceCodePeriod)))
+}
+
+// validateAttendanceCode 按码模式校验输入的签到码/签退码。
+func validateAttendanceCode(codeInfo *repository.ActivityAttendanceCode, codeType int32, inputCode, errMsg string) error {
+	expectedCode, codeHash, secret, expireAt := codeInfo.CheckInCode, codeInfo.CheckInCodeHash, codeInfo.CheckInCodeSecret, codeInfo.CheckInCodeExpireAt
+	if codeType == model.AttendanceCodeTypeCheckOut {
+		expectedCode, codeHash, secret, expireAt = codeInfo.CheckOutCode, codeInfo.CheckOutCodeHash, codeInfo.CheckOutCodeSecret, codeInfo.CheckOutCodeExpireAt
+	}
+
+	now := time.Now()
+	if codeInfo.AttendanceCodeMode == model.AttendanceCodeModeRotating {
+		if secret == "" {
+			return errors.New(errMsg)
+		}
+		if expireAt != nil && now.After(*expireAt) {
+			return errors.New(errMsg)
+		}
+		plainSecret, err := util.DecryptSensitiveField(secret)
+		if err != nil {
+			log.Error("校验动态码失败: 解密密钥异常: %v, activity_id=%d code_type=%d", err, codeInfo.ID, codeType)
+			return errors.New(errMsg)
+		}
+		if !util.ValidateTOTPCode(plainSecret, inputCode, now, int(codeInfo.AttendanceCodePeriod), attendanceCodeRotationSkew) {
+			return errors.New(errMsg)
+		}
+		return nil
+	}
+
+	// 静态码按哈希比对，未写入哈希的历史数据回退为明文列比对。
+	if codeHash != "" {
+		if expireAt != nil && now.After(*expireAt) {
+			return errors.New(errMsg)
+		}
+		if strings.TrimSpace(inputCode) == "" {
+			return errors.New(errMsg)
+		}
+		inputHash, err := hashAttendanceCode(inputCode)
+		if err != nil || subtle.ConstantTimeCompare([]byte(inputHash), []byte(codeHash)) != 1 {
+			return errors.New(errMsg)
+		}
+		return nil
+	}
+	return validateAttendanceCodeValue(inputCode, expectedCode, expireAt, errMsg)
+}
+
+// fillAttendanceCodeUpdates 按码模式生成指定类型的新码并写入更新字段，均不落库明文：
+// 静态码保存哈希（用于校验）与加密后的码（供组织端展示）；动态码仅保存加密密钥，清空哈希。
+func fillAttendanceCodeUpdates(updates map[string]any, codeMode, codeType int32) error {
+	prefix := "check_in_code"
+	if codeType == model.AttendanceCodeTypeCheckOut {
+		prefix = "check_out_code"
+	}
+
+	if codeMode == model.AttendanceCodeModeRotating {
+		encrypted, err := newEncryptedAttendanceSecret()
+		if err != nil {
+			return err
+		}
+		updates[prefix] = ""
+		updates[prefix+"_hash"] = ""
+		updates[prefix+"_secret"] = encrypted
+		return nil
+	}
+
+	code, err := generateRandomAttendanceCode(attendanceCodeLength)
+	if err != nil {
+		return err
+	}
+	codeHash, err := hashAttendanceCode(code)
+	if err != nil {
+		return err
+	}
+	encrypted, err := util.EncryptSensitiveField(code)
+	if err != nil {
+		return err
+	}
+	updates[prefix] = ""
+	updates[prefix+"_hash"] = codeHash
+	updates[prefix+"_secret"] = encrypted
+	return nil
+}
@@ -35,93 +35,37 @@ func NewAuditService(ctx context.Context, c *app.RequestContext) *AuditService {
 
 type ApprovalHandler func(*gorm.DB, *model.AuditRecord) error
 
-// VolunteerJoinOrgAuditList returns pending audits for volunteer join organization requests.
+// VolunteerJoinOrgAuditList returns audits for volunteer join organization requests.
+// 保留兼容，基于统一审核收件箱实现，范围限定为当前组织。
 func (s *AuditService) VolunteerJoinOrgAuditList(req *api.PendingVolunteerJoinOrgAuditListRequest) (*api.PendingVolunteerJoinOrgAuditListResponse, error) {
 	if req == nil {
 		log.Warn("待审核列表查询失败: 请求为空")
 		return nil, errors.New("请求不能为空")
 	}
 
-	resp := &api.PendingVolunteerJoinOrgAuditListResponse{}
-
-	if req.Page <= 0 {
-		req.Page = 1
-	}
-	if req.PageSize <= 0 {
-		req.PageSize = 20
-	}
-
-	auditMap := map[string]any{
-		"target_type = ?": model.AuditTypeVolunteerJoinOrganization,
-	}
-	if len(req.Status) > 0 {
-		auditMap["status in (?)"] = req.Status
-	}
-
-	offset := req.PageSize * (req.Page - 1)
-	auditRecords, total, err := s.repo.GetAuditRecordsList(s.repo.DB, auditMap, req.PageSize, offset)
+	inbox, err := s.AuditInbox(&api.AuditInboxRequest{
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		TargetType: []int32{model.AuditTypeVolunteerJoinOrganization},
+		Status:     req.Status,
+	})
 	if err != nil {
-		log.Error("待审核列表查询失败: %v, page=%d, pageSize=%d, status=%v", err, req.Page, req.PageSize, req.Status)
 		return nil, err
 	}
-	if total == 0 {
-		return resp, nil
-	}
-
-	items := make([]*api.PendingVolunteerJoinOrgAuditItem, 0, len(auditRecords))
-	for _, record := range auditRecords {
-		if record == nil {
-			continue
-		}
-		if strings.TrimSpace(record.NewContent) == "" {
-			continue
-		}
-
-		var snapshot model.OrgMember
-		if err := json.Unmarshal([]byte(record.NewContent), &snapshot); err != nil {
-			log.Warn("待审核记录快照解析失败: record_id=%d", record.ID)
-			return nil, errors.New("成员关系快照无效")
-		}
-		if snapshot.VolunteerID <= 0 || snapshot.OrgID <= 0 {
-			log.Warn("待审核记录快照字段无效: record_id=%d volunteer_id=%d org_id=%d", record.ID, snapshot.VolunteerID, snapshot.OrgID)
-			return nil, errors.New("成员关系快照无效")
-		}
-
-		volunteer, err := s.repo.FindVolunteerByID(s.repo.DB, snapshot.VolunteerID)
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				log.Warn("待审核记录关联志愿者不存在: record_id=%d volunteer_id=%d", record.ID, snapshot.VolunteerID)
-				return nil, errors.New("志愿者不存在")
-			}
-			log.Error("查询待审核记录关联志愿者失败: %v, record_id=%d volunteer_id=%d", err, record.ID, snapshot.VolunteerID)
-			return nil, err
-		}
 
-		organization, err := s.repo.GetOrganizationByID(s.repo.DB, snapshot.OrgID)
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				log.Warn("待审核记录关联组织不存在: record_id=%d org_id=%d", record.ID, snapshot.OrgID)
-				return nil, errors.New("组织不存在")
-			}
-			log.Error("查询待审核记录关联组织失败: %v, record_id=%d org_id=%d", err, record.ID, snapshot.OrgID)
-			return nil, err
-		}
-
-		targetID := record.TargetID
-		if targetID <= 0 {
-			targetID = snapshot.ID
-		}
-		items = append(items, &api.PendingVolunteerJoinOrgAuditItem{
-			TargetId:  targetID,
-			Status:    record.Status,
-			Title:     volunteer.RealName,
-			SubTitle:  organization.OrgName,
-			CreatedAt: record.CreatedAt.Format(util.DateTimeLayout),
+	resp := &api.PendingVolunteerJoinOrgAuditListResponse{
+		Total: inbox.Total,
+		List:  make([]*api.PendingVolunteerJoinOrgAuditItem, 0, len(inbox.List)),
+	}
+	for _, item := range inbox.List {
+		resp.List = append(resp.List, &api.PendingVolunteerJoinOrgAuditItem{
+			TargetId:  item.TargetId,
+			Status:    item.Status,
+			Title:     item.Title,
+			SubTitle:  item.SubTitle,
+			CreatedAt: item.CreatedAt,
 		})
 	}
-
-	resp.Total = int32(total)
-	resp.List = items
 	return resp, nil
 }
 
@@ -151,52 +95,83 @@ func (s *AuditService) AuditApproval(req *api.AuditApprovalRequest) (*api.AuditA
 		return nil, err
 	}
 
-	auditorID, err := s.getAuditOperatorID()
+	approver, err := s.resolveAuditApprover(record.TargetType)
+	if err == nil {
+		err = s.ensureAuditRecordInActingOrg(record)
+	}
 	if err != nil {
 		log.Warn("审核通过失败: 获取审核人失败, record_id=%d err=%v", record.ID, err)
 		return nil, err
 	}
 
+	if err := s.approveAuditRecord(record, approver, strings.TrimSpace(req.Reason)); err != nil {
+		return nil, err
+	}
+	resp.Status = record.Status
+	resp.CurrentStage = record.CurrentStage
+	return &resp, nil
+}
+
+// approveAuditRecord 在独立事务中执行审核通过，死锁/锁等待超时时重试：配置审批链时先记录当前阶段的审批决定，
+// 最后一个阶段通过后才调用对应类型的 ApprovalHandler 并完成审核记录；record 的状态与当前阶段随之更新
+func (s *AuditService) approveAuditRecord(record *model.AuditRecord, approver *auditApprover, reason string) error {
 	auditHandlerMap := map[int32]ApprovalHandler{
 		model.AuditTargetVolunteer: s.applyVolunteerAuditApproval,
+		model.AuditTargetOrg:       s.applyOrgAuditApproval,
 		model.AuditTargetMember:    s.applyMemberAuditApproval,
 		model.AuditTargetSignup:    s.applySignupAuditApproval,
 	}
-	reason := strings.TrimSpace(req.Reason)
+	handler, ok := auditHandlerMap[record.TargetType]
+	if !ok {
+		return errors.New("不支持的审核目标类型")
+	}
 
-	err = s.repo.DB.Transaction(func(tx *gorm.DB) error {
-		handler, ok := auditHandlerMap[record.TargetType]
-		if !ok {
-			return errors.New("不支持的审核目标类型")
+	var approved model.AuditRecord
+	err := s.withTransaction(func(tx *gorm.DB) error {
+		// 基于加锁读取的记录执行，处理函数会回写 target_id，重试时重新读取
+		locked, err := s.lockPendingAuditRecord(tx, record.ID)
+		if err != nil {
+			return err
 		}
-
-		if err := handler(tx, record); err != nil {
+		approved = *locked
+		final, err := s.advanceAuditChain(tx, &approved, approver, reason)
+		if err != nil || !final {
+			return err
+		}
+		if err := handler(tx, &approved); err != nil {
 			return err
 		}
 
 		updates := map[string]any{
-			"auditor_id":    auditorID,
+			"auditor_id":    approver.id,
 			"audit_result":  model.ResolveAuditResult(model.AuditStatusApproved),
 			"reject_reason": reason,
 			"audit_time":    time.Now(),
 			"status":        model.AuditStatusApproved,
 		}
-		if record.TargetID > 0 {
-			updates["target_id"] = record.TargetID
+		if approved.TargetID > 0 {
+			updates["target_id"] = approved.TargetID
 		}
-		return s.repo.UpdateAuditRecordByID(tx, record.ID, updates)
+		approved.Status = model.AuditStatusApproved
+		return s.repo.UpdateAuditRecordByID(tx, approved.ID, updates)
 	})
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			log.Warn("审核通过失败: 审核目标不存在, record_id=%d", record.ID)
-			return nil, errors.New("审核目标不存在")
+			return errors.New("审核目标不存在")
 		}
 		log.Error("审核通过失败: 事务执行异常: %v, record_id=%d", err, record.ID)
-		return nil, err
+		return err
 	}
-	log.Info("审核通过成功: record_id=%d target_type=%d target_id=%d auditor_id=%d", record.ID, record.TargetType, record.TargetID, auditorID)
-
-	return &resp, nil
+	record.TargetID = approved.TargetID
+	record.Status = approved.Status
+	record.CurrentStage = approved.CurrentStage
+	if record.Status == model.AuditStatusPending {
+		log.Info("审批阶段通过: record_id=%d target_type=%d current_stage=%d auditor_id=%d role=%d", record.ID, record.TargetType, record.CurrentStage, approver.id, approver.role)
+		return nil
+	}
+	log.Info("审核通过成功: record_id=%d target_type=%d target_id=%d auditor_id=%d", record.ID, record.TargetType, record.TargetID, approver.id)
+	return nil
 }
 
 // AuditRejection rejects one audit target.
@@ -231,36 +206,142 @@ func (s *AuditService) AuditRejection(req *api.AuditRejectionRequest) (*api.Audi
 		return nil, err
 	}
 
-	auditorID, err := s.getAuditOperatorID()
+	approver, err := s.resolveAuditApprover(record.TargetType)
+	if err == nil {
+		err = s.ensureAuditRecordInActingOrg(record)
+	}
 	if err != nil {
 		log.Warn("审核驳回失败: 获取审核人失败, record_id=%d err=%v", record.ID, err)
 		return nil, err
 	}
 
+	if err := s.rejectAuditRecord(record, approver, reason); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// rejectAuditRecord 在独立事务中执行审核驳回并同步目标状态，死锁/锁等待超时时重试；
+// 配置审批链时仅当前阶段的审批角色可驳回，任一阶段驳回即整体驳回
+func (s *AuditService) rejectAuditRecord(record *model.AuditRecord, approver *auditApprover, reason string) error {
 	updates := map[string]any{
-		"auditor_id":    auditorID,
+		"auditor_id":    approver.id,
 		"audit_result":  model.ResolveAuditResult(model.AuditStatusRejected),
 		"reject_reason": reason,
 		"audit_time":    time.Now(),
 		"status":        model.AuditStatusRejected,
 	}
-	if err := s.repo.UpdateAuditRecordByID(s.repo.DB, record.ID, updates); err != nil {
+	var rejected model.AuditRecord
+	err := s.withTransaction(func(tx *gorm.DB) error {
+		locked, err := s.lockPendingAuditRecord(tx, record.ID)
+		if err != nil {
+			return err
+		}
+		rejected = *locked
+		if err := s.rejectAuditChainStage(tx, &rejected, approver, reason); err != nil {
+			return err
+		}
+		if err := s.repo.UpdateAuditRecordByID(tx, rejected.ID, updates); err != nil {
+			return err
+		}
+		if rejected.TargetType == model.AuditTargetVolunteer {
+			return s.repo.UpdateVolunteer(tx, rejected.TargetID, map[string]any{
+				"audit_status": model.VolunteerAuditStatusRejected,
+			})
+		}
+		if rejected.TargetType == model.AuditTargetOrg {
+			return s.repo.UpdateOrganization(tx, rejected.TargetID, map[string]any{
+				"verify_status": model.OrgVerifyStatusRejected,
+			})
+		}
+		if rejected.TargetType != model.AuditTargetSignup {
+			return nil
+		}
+
+		// 驳回报名申请会释放递补占用的名额，需继续递补候补队列。
+		activityID, err := s.resolveSignupAuditActivityID(tx, &rejected)
+		if err != nil {
+			return err
+		}
+		if activityID <= 0 {
+			return nil
+		}
+		_, err = s.promoteActivityWaitlist(tx, activityID)
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Warn("审核驳回失败: 审核记录不存在, record_id=%d", record.ID)
+			return errors.New("审核记录不存在")
+		}
 		log.Error("审核驳回失败: 更新审核记录异常: %v, record_id=%d", err, record.ID)
-		return nil, err
+		return err
 	}
-	log.Info("审核驳回成功: record_id=%d target_type=%d target_id=%d auditor_id=%d", record.ID, record.TargetType, record.TargetID, auditorID)
-
-	return &resp, nil
+	record.CurrentStage = rejected.CurrentStage
+	record.Status = model.AuditStatusRejected
+	log.Info("审核驳回成功: record_id=%d target_type=%d target_id=%d auditor_id=%d", record.ID, record.TargetType, record.TargetID, approver.id)
+	return nil
 }
 
+// applyVolunteerAuditApproval 实名认证通过：将提交快照中的姓名、身份证号（密文）、出生日期与性别写入志愿者档案
 func (s *AuditService) applyVolunteerAuditApproval(tx *gorm.DB, record *model.AuditRecord) error {
 	volunteer, err := s.repo.FindVolunteerByID(tx, record.TargetID)
 	if err != nil {
 		return err
 	}
-	return s.repo.UpdateVolunteer(tx, volunteer.ID, map[string]any{
+	updates := map[string]any{
 		"audit_status": model.VolunteerAuditStatusApproved,
-	})
+	}
+
+	var snapshot volunteerVerificationSnapshot
+	if strings.TrimSpace(record.NewContent) != "" {
+		if err := json.Unmarshal([]byte(record.NewContent), &snapshot); err != nil {
+			return errors.New("实名认证快照解析失败")
+		}
+	}
+	if snapshot.IDCard != "" {
+		exists, err := s.repo.ExistsVerifiedVolunteerByIDCardHash(tx, snapshot.IDCardHash, volunteer.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return errors.New("该身份证号已被其他志愿者认证")
+		}
+		birthday, err := util.ParseDate(snapshot.Birthday)
+		if err != nil {
+			return errors.New("实名认证快照出生日期无效")
+		}
+		updates["real_name"] = snapshot.RealName
+		updates["id_card"] = snapshot.IDCard
+		updates["id_card_hash"] = snapshot.IDCardHash
+		updates["birthday"] = &birthday
+		updates["gender"] = snapshot.Gender
+	}
+	return s.repo.UpdateVolunteer(tx, volunteer.ID, updates)
+}
+
+// applyOrgAuditApproval 组织资质审核通过后启用组织并置为已认证，统一社会信用代码以提交快照为准
+func (s *AuditService) applyOrgAuditApproval(tx *gorm.DB, record *model.AuditRecord) error {
+	org, err := s.repo.GetOrganizationByID(tx, record.TargetID)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	updates := map[string]any{
+		"status":        model.OrganizationNormal,
+		"verify_status": model.OrgVerifyStatusVerified,
+		"verified_at":   &now,
+	}
+	if strings.TrimSpace(record.NewContent) != "" {
+		var snapshot orgQualificationSnapshot
+		if err := json.Unmarshal([]byte(record.NewContent), &snapshot); err != nil {
+			return errors.New("资质审核快照解析失败")
+		}
+		if snapshot.LicenseCode != "" {
+			updates["license_code"] = snapshot.LicenseCode
+		}
+	}
+	return s.repo.UpdateOrganization(tx, org.ID, updates)
 }
 
 func (s *AuditService) applyMemberAuditApproval(tx *gorm.DB, record *model.AuditRecord) error {
@@ -375,6 +456,7 @@ func (s *AuditService) applySignupAuditApproval(tx *gorm.DB, record *model.Audit
 			signup = &model.ActivitySignup{
 				ActivityID:  signupSnapshot.ActivityID,
 				VolunteerID: signupSnapshot.VolunteerID,
+				SlotID:      signupSnapshot.SlotID,
 				Status:      model.ActivitySignupStatusSuccess,
 			}
 			if err := s.repo.CreateSignup(tx, signup); err != nil {
@@ -382,16 +464,32 @@ func (s *AuditService) applySignupAuditApproval(tx *gorm.DB, record *model.Audit
 			}
 			needIncrementPeople = true
 		} else if signup.Status != model.ActivitySignupStatusSuccess {
-			if err := s.repo.UpdateActivitySignupStatusByID(tx, signup.ID, model.ActivitySignupStatusSuccess); err != nil {
+			// 取消后重新报名时以本次申请所选班次为准
+			if err := s.repo.UpdateActivitySignupByID(tx, signup.ID, map[string]any{
+				"status":  model.ActivitySignupStatusSuccess,
+				"slot_id": signupSnapshot.SlotID,
+			}); err != nil {
 				return err
 			}
 			needIncrementPeople = true
 		}
 
 		if needIncrementPeople {
+			// 按 max_people 条件自增，名额已满时不更新任何行
 			if err := s.repo.IncrementActivityPeople(tx, signupSnapshot.ActivityID); err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return errors.New("活动名额已满")
+				}
 				return err
 			}
+			if signupSnapshot.SlotID > 0 {
+				if err := s.repo.IncrementActivitySlotPeople(tx, signupSnapshot.SlotID); err != nil {
+					if errors.Is(err, gorm.ErrRecordNotFound) {
+						return errors.New("该班次名额已满")
+					}
+					return err
+				}
+			}
 		}
 		record.TargetID = signup.ID
 		return nil
@@ -404,6 +502,30 @@ func (s *AuditService) applySignupAuditApproval(tx *gorm.DB, record *model.Audit
 	return s.repo.UpdateActivitySignupStatusByID(tx, signup.ID, model.ActivitySignupStatusSuccess)
 }
 
+// resolveSignupAuditActivityID 解析报名审核记录关联的活动ID，无法解析时返回 0。
+func (s *AuditService) resolveSignupAuditActivityID(db *gorm.DB, record *model.AuditRecord) (int64, error) {
+	if record.TargetID > 0 {
+		signup, err := s.repo.GetActivitySignupByID(db, record.TargetID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return 0, nil
+			}
+			return 0, err
+		}
+		return signup.ActivityID, nil
+	}
+
+	var signupSnapshot model.ActivitySignup
+	if strings.TrimSpace(record.NewContent) == "" {
+		return 0, nil
+	}
+	if err := json.Unmarshal([]byte(record.NewContent), &signupSnapshot); err != nil {
+		log.Warn("解析报名审核快照失败: record_id=%d err=%v", record.ID, err)
+		return 0, nil
+	}
+	return signupSnapshot.ActivityID, nil
+}
+
 // AuditRecordDetail returns one audit record.
 func (s *AuditService) AuditRecordDetail(req *api.AuditRecordDetailRequest) (*api.AuditRecordDetailResponse, error) {
 	if req == nil {
@@ -420,6 +542,9 @@ func (s *AuditService) AuditRecordDetail(req *api.AuditRecordDetailRequest) (*ap
 		}
 		return nil, err
 	}
+	if err := s.ensureAuditRecordReadable(record); err != nil {
+		return nil, err
+	}
 
 	auditTime := ""
 	if !record.AuditTime.IsZero() {
@@ -430,45 +555,159 @@ func (s *AuditService) AuditRecordDetail(req *api.AuditRecordDetailRequest) (*ap
 		createdAt = record.CreatedAt.Format(util.DateTimeLayout)
 	}
 
-	return &api.AuditRecordDetailResponse{
-		Record: &api.AuditRecordDetail{
-			Id:           record.ID,
-			TargetType:   record.TargetType,
-			TargetId:     record.TargetID,
-			AuditorId:    record.AuditorID,
-			Status:       record.Status,
-			OldContent:   record.OldContent,
-			NewContent:   record.NewContent,
-			AuditResult:  record.AuditResult,
-			RejectReason: record.RejectReason,
-			AuditTime:    auditTime,
-			CreatedAt:    createdAt,
-		},
-	}, nil
+	detail := &api.AuditRecordDetail{
+		Id:             record.ID,
+		TargetType:     record.TargetType,
+		TargetId:       record.TargetID,
+		AuditorId:      record.AuditorID,
+		Status:         record.Status,
+		OldContent:     record.OldContent,
+		NewContent:     record.NewContent,
+		AuditResult:    record.AuditResult,
+		RejectReason:   record.RejectReason,
+		AuditTime:      auditTime,
+		CreatedAt:      createdAt,
+		History:        []*api.AuditRecordHistoryItem{},
+		Documents:      []*api.AuditRecordDocument{},
+		CurrentStage:   record.CurrentStage,
+		StageDecisions: []*api.AuditStageDecisionItem{},
+	}
+
+	if record.TargetType == model.AuditTargetVolunteer {
+		detail.OldContent = maskVolunteerVerificationContent(record.OldContent)
+		detail.NewContent = maskVolunteerVerificationContent(record.NewContent)
+	}
+
+	// 同一目标的历次审核记录（驳回后重新提交会产生多条）
+	if record.TargetID > 0 {
+		history, err := s.repo.ListAuditRecordsByTarget(s.repo.DB, record.TargetType, record.TargetID)
+		if err != nil {
+			log.Error("查询审核记录详情失败: 查询历史审核记录异常: %v, record_id=%d", err, record.ID)
+			return nil, err
+		}
+		for _, item := range history {
+			historyItem := &api.AuditRecordHistoryItem{
+				Id:           item.ID,
+				Status:       item.Status,
+				AuditorId:    item.AuditorID,
+				RejectReason: item.RejectReason,
+				CreatedAt:    util.FormatDateTimeOrEmpty(item.CreatedAt),
+			}
+			if item.Status != model.AuditStatusPending {
+				historyItem.AuditTime = util.FormatDateTimeOrEmpty(item.AuditTime)
+			}
+			detail.History = append(detail.History, historyItem)
+		}
+	}
+
+	if record.TargetType == model.AuditTargetOrg {
+		docs, err := s.repo.ListOrgQualificationDocuments(s.repo.DB, record.TargetID, record.ID)
+		if err != nil {
+			log.Error("查询审核记录详情失败: 查询资质材料异常: %v, record_id=%d", err, record.ID)
+			return nil, err
+		}
+		for _, doc := range docs {
+			detail.Documents = append(detail.Documents, &api.AuditRecordDocument{
+				Id:        doc.ID,
+				DocType:   doc.DocType,
+				FileName:  doc.FileName,
+				FileSize:  doc.FileSize,
+				CreatedAt: util.FormatDateTimeOrEmpty(doc.CreatedAt),
+			})
+		}
+	}
+
+	if err := s.fillAuditChainDetail(record, detail); err != nil {
+		log.Error("查询审核记录详情失败: 查询审批链异常: %v, record_id=%d", err, record.ID)
+		return nil, err
+	}
+
+	return &api.AuditRecordDetailResponse{Record: detail}, nil
+}
+
+// auditApprover 审核人及其审批角色（组织内角色或平台管理员），用于匹配审批链阶段；
+// system 为超时自动处理，不受审批链约束直接完成审核
+type auditApprover struct {
+	id     int64
+	role   int32
+	system bool
 }
 
-func (s *AuditService) getAuditOperatorID() (int64, error) {
+// resolveAuditApprover 校验当前账号可处理该类审核并返回审核人：经组织权限中间件的请求代表组织处理组织内审核，
+// 角色取自中间件；否则须为平台管理员，处理志愿者实名与组织资质审核。
+func (s *AuditService) resolveAuditApprover(targetType int32) (*auditApprover, error) {
+	if _, ok := middleware.GetActingOrgID(s.c); ok {
+		if model.IsPlatformAuditTarget(targetType) {
+			return nil, errors.New("该审核需由平台管理员处理")
+		}
+		auditorID, err := middleware.GetUserIDInt(s.c)
+		if err != nil {
+			return nil, err
+		}
+		role, _ := middleware.GetOrgRole(s.c)
+		return &auditApprover{id: auditorID, role: role}, nil
+	}
+
 	auditorID, err := middleware.GetUserIDInt(s.c)
 	if err != nil {
 		log.Warn("获取审核人失败: 无法从上下文获取用户ID, err=%v", err)
-		return 0, err
+		return nil, err
 	}
 	if auditorID <= 0 {
 		log.Warn("获取审核人失败: 用户ID无效, user_id=%d", auditorID)
-		return 0, errors.New("审核人无效")
+		return nil, errors.New("审核人无效")
 	}
 
 	account, err := s.repo.FindByID(s.repo.DB, auditorID)
 	if err != nil {
 		log.Error("获取审核人失败: 查询账号异常, user_id=%d err=%v", auditorID, err)
-		return 0, err
+		return nil, err
 	}
-	if account.IdentityType != model.RegisterTypeOrganizationCode {
+	if account.IdentityType != model.RegisterTypeAdminCode {
 		log.Warn("获取审核人失败: 身份无权限, user_id=%d identity_type=%d", auditorID, account.IdentityType)
-		return 0, errors.New("无权限执行审核")
+		return nil, errors.New("无权限执行审核")
+	}
+	if !model.IsPlatformAuditTarget(targetType) {
+		log.Warn("获取审核人失败: 平台管理员不处理组织内审核, user_id=%d target_type=%d", auditorID, targetType)
+		return nil, errors.New("该审核由组织处理")
+	}
+	return &auditApprover{id: auditorID, role: model.ApproverRolePlatformAdmin}, nil
+}
+
+// ensureAuditRecordInActingOrg 组织端只能处理本组织的审核
+func (s *AuditService) ensureAuditRecordInActingOrg(record *model.AuditRecord) error {
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok && record.OrgID != orgID {
+		return errors.New("无权处理该审核")
+	}
+	return nil
+}
+
+// ensureAuditRecordReadable 校验审核记录查看权限：组织端仅可查看本组织的组织内审核，
+// 平台管理员可查看全部审核，其余账号仅可查看本人提交的审核
+func (s *AuditService) ensureAuditRecordReadable(record *model.AuditRecord) error {
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok {
+		if model.IsPlatformAuditTarget(record.TargetType) || record.OrgID != orgID {
+			return errors.New("无权查看该审核记录")
+		}
+		return nil
 	}
 
-	return auditorID, nil
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return err
+	}
+	if record.CreatorID == userID {
+		return nil
+	}
+	account, err := s.repo.FindByID(s.repo.DB, userID)
+	if err != nil {
+		return err
+	}
+	if account.IdentityType != model.RegisterTypeAdminCode {
+		log.Warn("查询审核记录详情失败: 无权查看, user_id=%d record_id=%d", userID, record.ID)
+		return errors.New("无权查看该审核记录")
+	}
+	return nil
 }
 
 func ensureAuditRecordPending(record *model.AuditRecord) error {
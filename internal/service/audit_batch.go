@@ -0,0 +1,154 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+
+	"gorm.io/gorm"
+)
+
+// maxBatchAuditSize 单次批量审核最多处理的记录数
+const maxBatchAuditSize = 200
+
+// BatchAuditApproval 批量审核通过，每条记录独立事务执行，单条失败不影响其余记录
+func (s *AuditService) BatchAuditApproval(req *api.BatchAuditRequest) (*api.BatchAuditResponse, error) {
+	if req == nil {
+		return nil, errors.New("请求不能为空")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	return s.batchAudit(req, func(record *model.AuditRecord, approver *auditApprover) error {
+		return s.approveAuditRecord(record, approver, reason)
+	})
+}
+
+// BatchAuditRejection 批量审核驳回，所有记录共用驳回原因
+func (s *AuditService) BatchAuditRejection(req *api.BatchAuditRequest) (*api.BatchAuditResponse, error) {
+	if req == nil {
+		return nil, errors.New("请求不能为空")
+	}
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		return nil, errors.New("驳回原因不能为空")
+	}
+	return s.batchAudit(req, func(record *model.AuditRecord, approver *auditApprover) error {
+		return s.rejectAuditRecord(record, approver, reason)
+	})
+}
+
+// batchAudit 解析待处理记录并逐条执行审核动作，返回逐条结果
+func (s *AuditService) batchAudit(req *api.BatchAuditRequest, apply func(record *model.AuditRecord, approver *auditApprover) error) (*api.BatchAuditResponse, error) {
+	records, err := s.resolveBatchAuditRecords(req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &api.BatchAuditResponse{
+		Results: make([]*api.BatchAuditResult, 0, len(records)),
+	}
+	auditors := make(map[int32]*auditApprover)
+	for _, item := range records {
+		result := &api.BatchAuditResult{Id: item.id}
+		if err := s.batchAuditOne(item, auditors, apply); err != nil {
+			result.Message = err.Error()
+			resp.Failed++
+		} else {
+			result.Success = true
+			result.Status = item.record.Status
+			resp.Succeeded++
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	resp.Total = int32(len(resp.Results))
+
+	userID, _ := middleware.GetUserIDInt(s.c)
+	log.Info("批量审核完成: operator_id=%d total=%d succeeded=%d failed=%d", userID, resp.Total, resp.Succeeded, resp.Failed)
+	return resp, nil
+}
+
+// batchAuditItem 待处理的审核记录，record 为 nil 时表示指定的ID不存在
+type batchAuditItem struct {
+	id     int64
+	record *model.AuditRecord
+}
+
+// resolveBatchAuditRecords 按ID列表（去重、保持顺序）或收件箱筛选条件（仅待审核、按提交先后）解析待处理记录
+func (s *AuditService) resolveBatchAuditRecords(req *api.BatchAuditRequest) ([]batchAuditItem, error) {
+	if len(req.Ids) > 0 && req.Filter != nil {
+		return nil, errors.New("审核记录ID与筛选条件只能选择一种")
+	}
+
+	if len(req.Ids) > 0 {
+		if len(req.Ids) > maxBatchAuditSize {
+			return nil, fmt.Errorf("单次最多处理%d条审核记录", maxBatchAuditSize)
+		}
+		items := make([]batchAuditItem, 0, len(req.Ids))
+		seen := make(map[int64]struct{}, len(req.Ids))
+		for _, id := range req.Ids {
+			if id <= 0 {
+				return nil, errors.New("审核记录ID无效")
+			}
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+
+			record, err := s.repo.GetAuditRecordByID(s.repo.DB, id)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error("批量审核失败: 查询审核记录异常: %v, record_id=%d", err, id)
+				return nil, err
+			}
+			items = append(items, batchAuditItem{id: id, record: record})
+		}
+		return items, nil
+	}
+
+	if req.Filter == nil {
+		return nil, errors.New("请指定审核记录ID或筛选条件")
+	}
+	queryMap, err := s.auditInboxQuery(req.Filter)
+	if err != nil {
+		return nil, err
+	}
+	queryMap["status IN ?"] = []int32{model.AuditStatusPending}
+
+	records, err := s.repo.ListAuditRecordsInSubmitOrder(s.repo.DB, queryMap, maxBatchAuditSize)
+	if err != nil {
+		log.Error("批量审核失败: 按条件查询审核记录异常: %v", err)
+		return nil, err
+	}
+	items := make([]batchAuditItem, 0, len(records))
+	for _, record := range records {
+		items = append(items, batchAuditItem{id: record.ID, record: record})
+	}
+	return items, nil
+}
+
+// batchAuditOne 校验单条记录可由当前账号处理后执行审核动作
+func (s *AuditService) batchAuditOne(item batchAuditItem, auditors map[int32]*auditApprover, apply func(record *model.AuditRecord, approver *auditApprover) error) error {
+	record := item.record
+	if record == nil {
+		return errors.New("审核记录不存在")
+	}
+	if err := ensureAuditRecordPending(record); err != nil {
+		return err
+	}
+
+	approver, ok := auditors[record.TargetType]
+	if !ok {
+		var err error
+		approver, err = s.resolveAuditApprover(record.TargetType)
+		if err != nil {
+			return err
+		}
+		auditors[record.TargetType] = approver
+	}
+
+	if err := s.ensureAuditRecordInActingOrg(record); err != nil {
+		return err
+	}
+	return apply(record, approver)
+}
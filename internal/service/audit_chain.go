@@ -0,0 +1,366 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// platformAuditTargets 平台管理员可处理的审核类型
+var platformAuditTargets = []int32{model.AuditTargetVolunteer, model.AuditTargetOrg}
+
+// ListAuditApprovalChains 查询审批链配置：组织端为本组织的加入组织、活动报名审核，平台管理员为志愿者实名、组织资质审核
+func (s *AuditService) ListAuditApprovalChains(req *api.ListAuditApprovalChainsRequest) (*api.ListAuditApprovalChainsResponse, error) {
+	orgID, targetTypes, err := s.auditChainScope()
+	if err != nil {
+		return nil, err
+	}
+
+	stages, err := s.repo.ListAuditApprovalStagesByOrg(s.repo.DB, orgID)
+	if err != nil {
+		log.Error("查询审批链失败: %v, org_id=%d", err, orgID)
+		return nil, err
+	}
+	stagesByType := make(map[int32][]*model.AuditApprovalStage, len(targetTypes))
+	for _, stage := range stages {
+		stagesByType[stage.TargetType] = append(stagesByType[stage.TargetType], stage)
+	}
+
+	resp := &api.ListAuditApprovalChainsResponse{
+		List: make([]*api.AuditApprovalChainItem, 0, len(targetTypes)),
+	}
+	for _, targetType := range targetTypes {
+		resp.List = append(resp.List, buildAuditApprovalChainItem(targetType, stagesByType[targetType]))
+	}
+	return resp, nil
+}
+
+// SaveAuditApprovalChain 整体替换审核类型的审批链，阶段为空时恢复单级审核
+func (s *AuditService) SaveAuditApprovalChain(req *api.SaveAuditApprovalChainRequest) (*api.AuditApprovalChainItem, error) {
+	if req == nil {
+		return nil, errors.New("请求不能为空")
+	}
+	orgID, targetTypes, err := s.auditChainScope()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.Contains(targetTypes, req.TargetType) {
+		return nil, errors.New("该审核类型不支持在此配置审批链")
+	}
+	if len(req.Stages) > model.MaxAuditApprovalStages {
+		return nil, fmt.Errorf("审批链最多%d个阶段", model.MaxAuditApprovalStages)
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	stages := make([]*model.AuditApprovalStage, 0, len(req.Stages))
+	for i, item := range req.Stages {
+		if item == nil || !model.IsValidApproverRole(req.TargetType, item.ApproverRole) {
+			return nil, fmt.Errorf("第%d级审批角色无效", i+1)
+		}
+		mode := item.ApproveMode
+		if mode == 0 {
+			mode = model.ApproveModeAnyOf
+		}
+		if !model.IsValidApproveMode(mode) {
+			return nil, fmt.Errorf("第%d级通过方式无效", i+1)
+		}
+		stages = append(stages, &model.AuditApprovalStage{
+			OrgID:        orgID,
+			TargetType:   req.TargetType,
+			StageNo:      int32(i + 1),
+			ApproverRole: item.ApproverRole,
+			ApproveMode:  mode,
+			UpdatedBy:    userID,
+		})
+	}
+
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		return s.repo.ReplaceAuditApprovalStages(tx, orgID, req.TargetType, stages)
+	})
+	if err != nil {
+		log.Error("保存审批链失败: %v, org_id=%d target_type=%d", err, orgID, req.TargetType)
+		return nil, err
+	}
+
+	log.Info("保存审批链: org_id=%d target_type=%d stages=%d operator_id=%d", orgID, req.TargetType, len(stages), userID)
+	return buildAuditApprovalChainItem(req.TargetType, stages), nil
+}
+
+// auditChainScope 按当前身份确定可配置的审批链范围：经组织权限中间件的请求为该组织的组织内审核，
+// 否则须为平台管理员，配置统一保存在 org_id = 0 下
+func (s *AuditService) auditChainScope() (int64, []int32, error) {
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok {
+		return orgID, orgAuditTargets, nil
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return 0, nil, err
+	}
+	account, err := s.repo.FindByID(s.repo.DB, userID)
+	if err != nil {
+		return 0, nil, err
+	}
+	if account.IdentityType != model.RegisterTypeAdminCode {
+		return 0, nil, errors.New("无权配置审批链")
+	}
+	return 0, platformAuditTargets, nil
+}
+
+func buildAuditApprovalChainItem(targetType int32, stages []*model.AuditApprovalStage) *api.AuditApprovalChainItem {
+	item := &api.AuditApprovalChainItem{
+		TargetType: targetType,
+		Stages:     make([]*api.AuditApprovalStageItem, 0, len(stages)),
+	}
+	for _, stage := range stages {
+		item.Stages = append(item.Stages, &api.AuditApprovalStageItem{
+			StageNo:      stage.StageNo,
+			ApproverRole: stage.ApproverRole,
+			ApproveMode:  stage.ApproveMode,
+		})
+	}
+	return item
+}
+
+// auditChainOrgID 审核记录适用的审批链所属组织，平台审核统一为 0
+func auditChainOrgID(record *model.AuditRecord) int64 {
+	if model.IsPlatformAuditTarget(record.TargetType) {
+		return 0
+	}
+	return record.OrgID
+}
+
+// auditChainState 审核记录在审批链中的当前状态
+type auditChainState struct {
+	stages    []*model.AuditApprovalStage
+	stage     *model.AuditApprovalStage
+	decisions []*model.AuditStageDecision
+}
+
+// loadAuditChain 读取审核记录适用的审批链，未配置时返回 nil。record 须为事务内加锁读取的审核记录，
+// 以串行化同一记录的多人审批；配置审批链时校验审批人角色与当前阶段一致且未审批过该记录。
+func (s *AuditService) loadAuditChain(tx *gorm.DB, record *model.AuditRecord, approver *auditApprover) (*auditChainState, error) {
+	stages, err := s.repo.ListAuditApprovalStages(tx, auditChainOrgID(record), record.TargetType)
+	if err != nil {
+		return nil, err
+	}
+	if len(stages) == 0 {
+		return nil, nil
+	}
+
+	stage := currentAuditStage(stages, record.CurrentStage)
+	decisions, err := s.repo.ListAuditStageDecisions(tx, record.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkAuditStageApprover(stage, decisions, approver); err != nil {
+		return nil, err
+	}
+
+	record.CurrentStage = stage.StageNo
+	return &auditChainState{stages: stages, stage: stage, decisions: decisions}, nil
+}
+
+// lockPendingAuditRecord 加锁读取审核记录并确认仍待审核，审核通过与驳回均须基于该记录处理，
+// 避免单条审核、批量审核与超时自动处理并发时重复执行处理函数或相互覆盖结果
+func (s *AuditService) lockPendingAuditRecord(tx *gorm.DB, id int64) (*model.AuditRecord, error) {
+	locked, err := s.repo.GetAuditRecordForUpdate(tx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := ensureAuditRecordPending(locked); err != nil {
+		return nil, err
+	}
+	return locked, nil
+}
+
+// currentAuditStage 审核记录当前所处阶段，审批链缩短后超出的阶段按最后一个阶段处理
+func currentAuditStage(stages []*model.AuditApprovalStage, currentStage int32) *model.AuditApprovalStage {
+	index := int(currentStage) - 1
+	if index < 0 {
+		index = 0
+	}
+	if index >= len(stages) {
+		index = len(stages) - 1
+	}
+	return stages[index]
+}
+
+// checkAuditStageApprover 校验审批人角色与当前阶段一致，且未在任一阶段审批过该记录
+func checkAuditStageApprover(stage *model.AuditApprovalStage, decisions []*model.AuditStageDecision, approver *auditApprover) error {
+	if approver.role != stage.ApproverRole {
+		return fmt.Errorf("当前为第%d级审批，需由%s处理", stage.StageNo, approverRoleLabel(stage.ApproverRole))
+	}
+	for _, decision := range decisions {
+		if decision.AuditorID == approver.id {
+			return errors.New("您已审批过该审核记录")
+		}
+	}
+	return nil
+}
+
+// advanceAuditChain 记录当前阶段的通过决定，返回审核是否已到达最终通过：未配置审批链或系统自动处理时直接视为最终通过；
+// 当前阶段完成且非最后一个阶段时推进到下一阶段，审核记录保持待审核。record 须为加锁读取的审核记录
+func (s *AuditService) advanceAuditChain(tx *gorm.DB, record *model.AuditRecord, approver *auditApprover, reason string) (bool, error) {
+	if approver.system {
+		return true, nil
+	}
+	state, err := s.loadAuditChain(tx, record, approver)
+	if err != nil {
+		return false, err
+	}
+	if state == nil {
+		return true, nil
+	}
+
+	decision := &model.AuditStageDecision{
+		AuditRecordID: record.ID,
+		StageNo:       state.stage.StageNo,
+		ApproverRole:  approver.role,
+		AuditorID:     approver.id,
+		Decision:      model.StageDecisionApproved,
+		Reason:        reason,
+	}
+	if err := s.repo.CreateAuditStageDecision(tx, decision); err != nil {
+		return false, err
+	}
+	state.decisions = append(state.decisions, decision)
+
+	completed, err := s.auditStageCompleted(tx, record, state)
+	if err != nil || !completed {
+		return false, err
+	}
+	if isFinalAuditStage(state.stages, state.stage) {
+		return true, nil
+	}
+
+	record.CurrentStage = state.stage.StageNo + 1
+	return false, s.repo.UpdateAuditRecordByID(tx, record.ID, map[string]any{
+		"current_stage": record.CurrentStage,
+	})
+}
+
+// rejectAuditChainStage 配置审批链时校验驳回人为当前阶段审批角色并记录驳回决定，未配置审批链或系统自动处理时不做处理。
+// record 须为加锁读取的审核记录
+func (s *AuditService) rejectAuditChainStage(tx *gorm.DB, record *model.AuditRecord, approver *auditApprover, reason string) error {
+	if approver.system {
+		return nil
+	}
+	state, err := s.loadAuditChain(tx, record, approver)
+	if err != nil || state == nil {
+		return err
+	}
+	return s.repo.CreateAuditStageDecision(tx, &model.AuditStageDecision{
+		AuditRecordID: record.ID,
+		StageNo:       state.stage.StageNo,
+		ApproverRole:  approver.role,
+		AuditorID:     approver.id,
+		Decision:      model.StageDecisionRejected,
+		Reason:        reason,
+	})
+}
+
+// auditStageCompleted 判断当前阶段是否完成，全部通过方式下查询该角色的全部正常账号后判定
+func (s *AuditService) auditStageCompleted(tx *gorm.DB, record *model.AuditRecord, state *auditChainState) (bool, error) {
+	if state.stage.ApproveMode != model.ApproveModeAllOf {
+		return auditStageApproved(state.stage, state.decisions, nil), nil
+	}
+	required, err := s.auditStageApproverIDs(tx, record, state.stage.ApproverRole)
+	if err != nil {
+		return false, err
+	}
+	return auditStageApproved(state.stage, state.decisions, required), nil
+}
+
+// auditStageApproved 判断阶段是否已通过：任一通过方式下本阶段已有通过决定即通过；
+// 全部通过方式下需 required 中的账号均已通过，角色下已无正常账号时以本阶段已有的通过决定为准
+func auditStageApproved(stage *model.AuditApprovalStage, decisions []*model.AuditStageDecision, required []int64) bool {
+	approved := make(map[int64]struct{}, len(decisions))
+	for _, decision := range decisions {
+		if decision.StageNo == stage.StageNo && decision.Decision == model.StageDecisionApproved {
+			approved[decision.AuditorID] = struct{}{}
+		}
+	}
+	if len(approved) == 0 {
+		return false
+	}
+	if stage.ApproveMode != model.ApproveModeAllOf {
+		return true
+	}
+	for _, accountID := range required {
+		if _, ok := approved[accountID]; !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// isFinalAuditStage 是否为审批链的最后一个阶段（审批链缩短后超出的阶段同样视为最后一个阶段）
+func isFinalAuditStage(stages []*model.AuditApprovalStage, stage *model.AuditApprovalStage) bool {
+	return int(stage.StageNo) >= len(stages)
+}
+
+// auditStageApproverIDs 查询持有审批角色的全部正常账号
+func (s *AuditService) auditStageApproverIDs(tx *gorm.DB, record *model.AuditRecord, role int32) ([]int64, error) {
+	switch role {
+	case model.ApproverRolePlatformAdmin:
+		return s.repo.ListActiveAccountIDsByIdentity(tx, model.RegisterTypeAdminCode)
+	case model.OrgRoleOwner:
+		org, err := s.repo.GetOrganizationByID(tx, record.OrgID)
+		if err != nil {
+			return nil, err
+		}
+		return []int64{org.AccountID}, nil
+	default:
+		return s.repo.ListOrgRoleAccountIDs(tx, record.OrgID, role)
+	}
+}
+
+// fillAuditChainDetail 填充审核记录详情中的审批链阶段数与各阶段决定
+func (s *AuditService) fillAuditChainDetail(record *model.AuditRecord, detail *api.AuditRecordDetail) error {
+	stages, err := s.repo.ListAuditApprovalStages(s.repo.DB, auditChainOrgID(record), record.TargetType)
+	if err != nil {
+		return err
+	}
+	detail.StageCount = int32(len(stages))
+
+	decisions, err := s.repo.ListAuditStageDecisions(s.repo.DB, record.ID)
+	if err != nil {
+		return err
+	}
+	for _, decision := range decisions {
+		detail.StageDecisions = append(detail.StageDecisions, &api.AuditStageDecisionItem{
+			StageNo:      decision.StageNo,
+			ApproverRole: decision.ApproverRole,
+			AuditorId:    decision.AuditorID,
+			Decision:     decision.Decision,
+			Reason:       decision.Reason,
+			CreatedAt:    util.FormatDateTimeOrEmpty(decision.CreatedAt),
+		})
+	}
+	return nil
+}
+
+func approverRoleLabel(role int32) string {
+	switch role {
+	case model.MemberRoleManager:
+		return "管理员"
+	case model.MemberRoleLeader:
+		return "负责人"
+	case model.OrgRoleOwner:
+		return "组织主账号"
+	case model.ApproverRolePlatformAdmin:
+		return "平台管理员"
+	default:
+		return "指定角色"
+	}
+}
@@ -0,0 +1,144 @@
+package service
+
+import (
+	"testing"
+	"volunteer-system/internal/model"
+)
+
+func testAuditStages(roles ...int32) []*model.AuditApprovalStage {
+	stages := make([]*model.AuditApprovalStage, 0, len(roles))
+	for i, role := range roles {
+		stages = append(stages, &model.AuditApprovalStage{
+			StageNo:      int32(i + 1),
+			ApproverRole: role,
+			ApproveMode:  model.ApproveModeAnyOf,
+		})
+	}
+	return stages
+}
+
+func approvedDecision(stageNo int32, auditorID int64) *model.AuditStageDecision {
+	return &model.AuditStageDecision{StageNo: stageNo, AuditorID: auditorID, Decision: model.StageDecisionApproved}
+}
+
+func TestCurrentAuditStage(t *testing.T) {
+	stages := testAuditStages(model.MemberRoleManager, model.MemberRoleLeader, model.OrgRoleOwner)
+	cases := []struct {
+		name         string
+		stages       []*model.AuditApprovalStage
+		currentStage int32
+		wantStageNo  int32
+		wantFinal    bool
+	}{
+		{name: "not started", stages: stages, currentStage: 0, wantStageNo: 1},
+		{name: "first stage", stages: stages, currentStage: 1, wantStageNo: 1},
+		{name: "middle stage", stages: stages, currentStage: 2, wantStageNo: 2},
+		{name: "last stage", stages: stages, currentStage: 3, wantStageNo: 3, wantFinal: true},
+		{name: "chain shortened mid-flight", stages: stages[:2], currentStage: 3, wantStageNo: 2, wantFinal: true},
+		{name: "chain shortened to one stage", stages: stages[:1], currentStage: 2, wantStageNo: 1, wantFinal: true},
+	}
+	for _, tc := range cases {
+		stage := currentAuditStage(tc.stages, tc.currentStage)
+		if stage.StageNo != tc.wantStageNo {
+			t.Fatalf("%s: StageNo = %d, want %d", tc.name, stage.StageNo, tc.wantStageNo)
+		}
+		if got := isFinalAuditStage(tc.stages, stage); got != tc.wantFinal {
+			t.Fatalf("%s: isFinalAuditStage() = %v, want %v", tc.name, got, tc.wantFinal)
+		}
+	}
+}
+
+func TestCheckAuditStageApprover(t *testing.T) {
+	stages := testAuditStages(model.MemberRoleManager, model.MemberRoleLeader)
+	cases := []struct {
+		name      string
+		stage     *model.AuditApprovalStage
+		decisions []*model.AuditStageDecision
+		approver  *auditApprover
+		wantErr   bool
+	}{
+		{name: "stage role matches", stage: stages[0], approver: &auditApprover{id: 1, role: model.MemberRoleManager}},
+		{name: "stage role mismatch", stage: stages[0], approver: &auditApprover{id: 1, role: model.MemberRoleLeader}, wantErr: true},
+		{
+			name:      "same auditor at same stage",
+			stage:     stages[0],
+			decisions: []*model.AuditStageDecision{approvedDecision(1, 1)},
+			approver:  &auditApprover{id: 1, role: model.MemberRoleManager},
+			wantErr:   true,
+		},
+		{
+			name:      "same auditor across stages",
+			stage:     stages[1],
+			decisions: []*model.AuditStageDecision{approvedDecision(1, 5)},
+			approver:  &auditApprover{id: 5, role: model.MemberRoleLeader},
+			wantErr:   true,
+		},
+		{
+			name:      "different auditor at next stage",
+			stage:     stages[1],
+			decisions: []*model.AuditStageDecision{approvedDecision(1, 5)},
+			approver:  &auditApprover{id: 6, role: model.MemberRoleLeader},
+		},
+	}
+	for _, tc := range cases {
+		err := checkAuditStageApprover(tc.stage, tc.decisions, tc.approver)
+		if (err != nil) != tc.wantErr {
+			t.Fatalf("%s: checkAuditStageApprover() error = %v, wantErr %v", tc.name, err, tc.wantErr)
+		}
+	}
+}
+
+func TestAuditStageApproved(t *testing.T) {
+	anyOf := &model.AuditApprovalStage{StageNo: 2, ApproverRole: model.MemberRoleManager, ApproveMode: model.ApproveModeAnyOf}
+	allOf := &model.AuditApprovalStage{StageNo: 2, ApproverRole: model.MemberRoleManager, ApproveMode: model.ApproveModeAllOf}
+	rejected := &model.AuditStageDecision{StageNo: 2, AuditorID: 2, Decision: model.StageDecisionRejected}
+
+	cases := []struct {
+		name      string
+		stage     *model.AuditApprovalStage
+		decisions []*model.AuditStageDecision
+		required  []int64
+		want      bool
+	}{
+		{name: "any-of without decisions", stage: anyOf},
+		{name: "any-of approved", stage: anyOf, decisions: []*model.AuditStageDecision{approvedDecision(2, 1)}, want: true},
+		{name: "any-of approved only at previous stage", stage: anyOf, decisions: []*model.AuditStageDecision{approvedDecision(1, 1)}},
+		{
+			name:      "all-of partially approved",
+			stage:     allOf,
+			decisions: []*model.AuditStageDecision{approvedDecision(2, 1)},
+			required:  []int64{1, 2},
+		},
+		{
+			name:      "all-of with rejection",
+			stage:     allOf,
+			decisions: []*model.AuditStageDecision{approvedDecision(2, 1), rejected},
+			required:  []int64{1, 2},
+		},
+		{
+			name:      "all-of fully approved",
+			stage:     allOf,
+			decisions: []*model.AuditStageDecision{approvedDecision(2, 1), approvedDecision(2, 2)},
+			required:  []int64{1, 2},
+			want:      true,
+		},
+		{
+			name:      "all-of approvals at previous stage do not count",
+			stage:     allOf,
+			decisions: []*model.AuditStageDecision{approvedDecision(1, 1), approvedDecision(2, 2)},
+			required:  []int64{1, 2},
+		},
+		{name: "all-of with zero holders and no decisions", stage: allOf},
+		{
+			name:      "all-of with zero holders after approval",
+			stage:     allOf,
+			decisions: []*model.AuditStageDecision{approvedDecision(2, 1)},
+			want:      true,
+		},
+	}
+	for _, tc := range cases {
+		if got := auditStageApproved(tc.stage, tc.decisions, tc.required); got != tc.want {
+			t.Fatalf("%s: auditStageApproved() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,339 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// orgAuditTargets 组织端可处理的审核类型
+var orgAuditTargets = []int32{model.AuditTargetMember, model.AuditTargetSignup}
+
+// auditRenderer 按审核类型解析快照，填充收件箱条目的标题、副标题与摘要字段。
+// 快照无法解析时仅记录日志并保留空标题，不影响列表其余条目；返回的错误仅来自数据查询。
+type auditRenderer func(rc *auditRenderContext, record *model.AuditRecord, item *api.AuditInboxItem) error
+
+// auditRenderers 审核类型 -> 快照渲染器
+var auditRenderers = map[int32]auditRenderer{
+	model.AuditTargetVolunteer: renderVolunteerAudit,
+	model.AuditTargetOrg:       renderOrgAudit,
+	model.AuditTargetMember:    renderMemberAudit,
+	model.AuditTargetSignup:    renderSignupAudit,
+}
+
+// AuditInbox 统一审核收件箱：组织端仅可查看本组织的成员与活动报名审核，平台管理员可查看全部审核
+func (s *AuditService) AuditInbox(req *api.AuditInboxRequest) (*api.AuditInboxResponse, error) {
+	if req == nil {
+		return nil, errors.New("请求不能为空")
+	}
+	resp := &api.AuditInboxResponse{
+		Total: 0,
+		List:  []*api.AuditInboxItem{},
+	}
+
+	queryMap, err := s.auditInboxQuery(req)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := normalizeAdminPage(req.Page, req.PageSize)
+	records, total, err := s.repo.GetAuditRecordsList(s.repo.DB, queryMap, int32(pageSize), int32((page-1)*pageSize))
+	if err != nil {
+		log.Error("审核收件箱查询失败: %v, page=%d pageSize=%d", err, page, pageSize)
+		return nil, err
+	}
+
+	rc := newAuditRenderContext(s)
+	policy, now := currentAuditSLAPolicy(), time.Now()
+	resp.Total = int32(total)
+	for _, record := range records {
+		item := &api.AuditInboxItem{
+			Id:            record.ID,
+			TargetType:    record.TargetType,
+			TargetId:      record.TargetID,
+			OperationType: record.OperationType,
+			Status:        record.Status,
+			Fields:        []*api.AuditInboxField{},
+			OrgId:         record.OrgID,
+			ActivityId:    record.ActivityID,
+			SubmitterId:   record.CreatorID,
+			AuditorId:     record.AuditorID,
+			RejectReason:  record.RejectReason,
+			CreatedAt:     util.FormatDateTimeOrEmpty(record.CreatedAt),
+			CurrentStage:  record.CurrentStage,
+		}
+		if record.Status != model.AuditStatusPending {
+			item.AuditTime = util.FormatDateTimeOrEmpty(record.AuditTime)
+		}
+		fillAuditInboxDeadline(policy, record, item, now)
+		if render, ok := auditRenderers[record.TargetType]; ok {
+			if err := render(rc, record, item); err != nil {
+				log.Error("审核收件箱查询失败: 解析审核记录异常: %v, record_id=%d", err, record.ID)
+				return nil, err
+			}
+		}
+		resp.List = append(resp.List, item)
+	}
+	return resp, nil
+}
+
+// auditInboxQuery 将收件箱筛选条件转换为查询条件（含组织范围限定）
+func (s *AuditService) auditInboxQuery(req *api.AuditInboxRequest) (map[string]any, error) {
+	queryMap, err := s.auditInboxScope(req)
+	if err != nil {
+		return nil, err
+	}
+	if len(req.Status) > 0 {
+		queryMap["status IN ?"] = req.Status
+	}
+	if req.ActivityId > 0 {
+		queryMap["activity_id = ?"] = req.ActivityId
+	}
+	if req.SubmitterId > 0 {
+		queryMap["creator_id = ?"] = req.SubmitterId
+	}
+	if startDate := strings.TrimSpace(req.StartDate); startDate != "" {
+		start, err := time.ParseInLocation(util.DateLayout, startDate, time.Local)
+		if err != nil {
+			return nil, errors.New("开始日期格式错误，应为yyyy-MM-dd")
+		}
+		queryMap["created_at >= ?"] = start
+	}
+	if endDate := strings.TrimSpace(req.EndDate); endDate != "" {
+		end, err := time.ParseInLocation(util.DateLayout, endDate, time.Local)
+		if err != nil {
+			return nil, errors.New("结束日期格式错误，应为yyyy-MM-dd")
+		}
+		queryMap["created_at < ?"] = end.AddDate(0, 0, 1)
+	}
+	if req.Overdue {
+		applyAuditOverdueFilter(queryMap, time.Now())
+	}
+	return queryMap, nil
+}
+
+// auditInboxScope 按当前身份限定收件箱范围：经组织权限中间件的请求限定为该组织的组织内审核，
+// 否则须为平台管理员
+func (s *AuditService) auditInboxScope(req *api.AuditInboxRequest) (map[string]any, error) {
+	for _, targetType := range req.TargetType {
+		if !model.IsValidAuditTargetType(targetType) {
+			return nil, errors.New("审核类型无效")
+		}
+	}
+
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok {
+		targetTypes := orgAuditTargets
+		if len(req.TargetType) > 0 {
+			for _, targetType := range req.TargetType {
+				if model.IsPlatformAuditTarget(targetType) {
+					return nil, errors.New("该审核需由平台管理员处理")
+				}
+			}
+			targetTypes = req.TargetType
+		}
+		return map[string]any{
+			"org_id = ?":       orgID,
+			"target_type IN ?": targetTypes,
+		}, nil
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	account, err := s.repo.FindByID(s.repo.DB, userID)
+	if err != nil {
+		return nil, err
+	}
+	if account.IdentityType != model.RegisterTypeAdminCode {
+		return nil, errors.New("无权查看审核")
+	}
+	queryMap := map[string]any{}
+	if len(req.TargetType) > 0 {
+		queryMap["target_type IN ?"] = req.TargetType
+	}
+	if req.OrgId > 0 {
+		queryMap["org_id = ?"] = req.OrgId
+	}
+	return queryMap, nil
+}
+
+// auditRenderContext 渲染一页审核记录时复用的志愿者、组织、活动查询结果
+type auditRenderContext struct {
+	s             *AuditService
+	volunteers    map[int64]*model.Volunteer
+	organizations map[int64]*model.Organization
+	activities    map[int64]*model.Activity
+}
+
+func newAuditRenderContext(s *AuditService) *auditRenderContext {
+	return &auditRenderContext{
+		s:             s,
+		volunteers:    map[int64]*model.Volunteer{},
+		organizations: map[int64]*model.Organization{},
+		activities:    map[int64]*model.Activity{},
+	}
+}
+
+// volunteer 查询志愿者，不存在时返回 nil
+func (rc *auditRenderContext) volunteer(id int64) (*model.Volunteer, error) {
+	if volunteer, ok := rc.volunteers[id]; ok {
+		return volunteer, nil
+	}
+	volunteer, err := rc.s.repo.FindVolunteerByID(rc.s.repo.DB, id)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	rc.volunteers[id] = volunteer
+	return volunteer, nil
+}
+
+// organization 查询组织，不存在时返回 nil
+func (rc *auditRenderContext) organization(id int64) (*model.Organization, error) {
+	if org, ok := rc.organizations[id]; ok {
+		return org, nil
+	}
+	org, err := rc.s.repo.GetOrganizationByID(rc.s.repo.DB, id)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	rc.organizations[id] = org
+	return org, nil
+}
+
+// activity 查询活动，不存在时返回 nil
+func (rc *auditRenderContext) activity(id int64) (*model.Activity, error) {
+	if activity, ok := rc.activities[id]; ok {
+		return activity, nil
+	}
+	activity, err := rc.s.repo.GetActivityByID(rc.s.repo.DB, id)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, err
+	}
+	rc.activities[id] = activity
+	return activity, nil
+}
+
+// decodeAuditSnapshot 解析审核快照，失败时记录日志并返回 false
+func decodeAuditSnapshot(record *model.AuditRecord, snapshot any) bool {
+	if strings.TrimSpace(record.NewContent) == "" {
+		return false
+	}
+	if err := json.Unmarshal([]byte(record.NewContent), snapshot); err != nil {
+		log.Warn("审核记录快照解析失败: record_id=%d target_type=%d err=%v", record.ID, record.TargetType, err)
+		return false
+	}
+	return true
+}
+
+func appendAuditField(item *api.AuditInboxItem, label, value string) {
+	if value == "" {
+		return
+	}
+	item.Fields = append(item.Fields, &api.AuditInboxField{Label: label, Value: value})
+}
+
+func renderVolunteerAudit(rc *auditRenderContext, record *model.AuditRecord, item *api.AuditInboxItem) error {
+	item.SubTitle = "实名认证"
+	var snapshot volunteerVerificationSnapshot
+	if !decodeAuditSnapshot(record, &snapshot) {
+		return nil
+	}
+	item.Title = snapshot.RealName
+	appendAuditField(item, "身份证号", maskStoredIDCard(snapshot.IDCard))
+	appendAuditField(item, "出生日期", snapshot.Birthday)
+	appendAuditField(item, "性别", genderLabel(snapshot.Gender))
+	return nil
+}
+
+func renderOrgAudit(rc *auditRenderContext, record *model.AuditRecord, item *api.AuditInboxItem) error {
+	item.SubTitle = "组织资质认证"
+	var snapshot orgQualificationSnapshot
+	if !decodeAuditSnapshot(record, &snapshot) {
+		return nil
+	}
+	item.Title = snapshot.OrgName
+	appendAuditField(item, "统一社会信用代码", snapshot.LicenseCode)
+	appendAuditField(item, "负责人", snapshot.ContactPerson)
+	appendAuditField(item, "材料数量", strconv.Itoa(len(snapshot.Documents)))
+	return nil
+}
+
+func renderMemberAudit(rc *auditRenderContext, record *model.AuditRecord, item *api.AuditInboxItem) error {
+	switch record.OperationType {
+	case model.OperationTypeCreate:
+		appendAuditField(item, "申请类型", "加入组织")
+	case model.OperationTypeDelete:
+		appendAuditField(item, "申请类型", "退出组织")
+	}
+	var snapshot model.OrgMember
+	if !decodeAuditSnapshot(record, &snapshot) {
+		return nil
+	}
+	if item.OrgId <= 0 {
+		item.OrgId = snapshot.OrgID
+	}
+	volunteer, err := rc.volunteer(snapshot.VolunteerID)
+	if err != nil {
+		return err
+	}
+	if volunteer != nil {
+		item.Title = volunteer.RealName
+	}
+	org, err := rc.organization(snapshot.OrgID)
+	if err != nil {
+		return err
+	}
+	if org != nil {
+		item.SubTitle = org.OrgName
+	}
+	return nil
+}
+
+func renderSignupAudit(rc *auditRenderContext, record *model.AuditRecord, item *api.AuditInboxItem) error {
+	var snapshot model.ActivitySignup
+	if !decodeAuditSnapshot(record, &snapshot) {
+		return nil
+	}
+	if item.ActivityId <= 0 {
+		item.ActivityId = snapshot.ActivityID
+	}
+	volunteer, err := rc.volunteer(snapshot.VolunteerID)
+	if err != nil {
+		return err
+	}
+	if volunteer != nil {
+		item.Title = volunteer.RealName
+	}
+	activity, err := rc.activity(snapshot.ActivityID)
+	if err != nil {
+		return err
+	}
+	if activity != nil {
+		item.SubTitle = activity.Title
+		if item.OrgId <= 0 {
+			item.OrgId = activity.OrgID
+		}
+		appendAuditField(item, "活动时间", util.FormatDateTimeOrEmpty(activity.StartTime))
+	}
+	return nil
+}
+
+func genderLabel(gender int32) string {
+	switch gender {
+	case 1:
+		return "男"
+	case 2:
+		return "女"
+	default:
+		return "未知"
+	}
+}
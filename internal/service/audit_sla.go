@@ -0,0 +1,409 @@
+package service
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"strings"
+	"time"
+	"volunteer-system/config"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+const defaultAuditRemindIntervalHours = 24
+
+// auditSLARule 单个审核类型的处理时限
+type auditSLARule struct {
+	Deadline   time.Duration // 提交后应处理的时限
+	AutoAction string        // 超时自动处理方式
+	AutoAfter  time.Duration // 提交后自动处理的时限，不早于 Deadline
+}
+
+// auditSLAPolicy 审核时限策略，未启用或未配置时 Rules 为空
+type auditSLAPolicy struct {
+	RemindInterval time.Duration
+	Rules          map[int32]auditSLARule
+}
+
+// currentAuditSLAPolicy 读取审核时限策略，忽略审核类型或时限无效的规则，未知的自动处理方式视为不处理，
+// 自动通过仅限组织内审核（成员申请、活动报名）。
+func currentAuditSLAPolicy() auditSLAPolicy {
+	policy := auditSLAPolicy{
+		RemindInterval: defaultAuditRemindIntervalHours * time.Hour,
+		Rules:          map[int32]auditSLARule{},
+	}
+	cfg := config.GetConfig()
+	if cfg == nil || cfg.AuditSLA == nil || !cfg.AuditSLA.Enabled {
+		return policy
+	}
+	if cfg.AuditSLA.RemindIntervalHours > 0 {
+		policy.RemindInterval = time.Duration(cfg.AuditSLA.RemindIntervalHours) * time.Hour
+	}
+	for _, item := range cfg.AuditSLA.Rules {
+		if !model.IsValidAuditTargetType(item.TargetType) || item.DeadlineHours <= 0 {
+			continue
+		}
+		rule := auditSLARule{
+			Deadline:   time.Duration(item.DeadlineHours) * time.Hour,
+			AutoAction: strings.ToLower(strings.TrimSpace(item.AutoAction)),
+		}
+		if !model.IsValidAuditAutoAction(rule.AutoAction) {
+			rule.AutoAction = model.AuditAutoActionNone
+		}
+		// 实名认证、组织资质审核须人工核验，不允许超时自动通过
+		if rule.AutoAction == model.AuditAutoActionApprove && !slices.Contains(orgAuditTargets, item.TargetType) {
+			log.Warn("审核时限配置: 审核类型不支持自动通过，已忽略, target_type=%d", item.TargetType)
+			rule.AutoAction = model.AuditAutoActionNone
+		}
+		rule.AutoAfter = rule.Deadline
+		if after := time.Duration(item.AutoAfterHours) * time.Hour; after > rule.AutoAfter {
+			rule.AutoAfter = after
+		}
+		policy.Rules[item.TargetType] = rule
+	}
+	return policy
+}
+
+// targetTypes 配置了处理时限的审核类型（升序）
+func (p auditSLAPolicy) targetTypes() []int32 {
+	types := make([]int32, 0, len(p.Rules))
+	for targetType := range p.Rules {
+		types = append(types, targetType)
+	}
+	slices.Sort(types)
+	return types
+}
+
+// auditDeadline 审核记录的处理时限，该审核类型未配置时限时返回 false
+func (p auditSLAPolicy) auditDeadline(record *model.AuditRecord) (time.Time, bool) {
+	rule, ok := p.Rules[record.TargetType]
+	if !ok {
+		return time.Time{}, false
+	}
+	return record.CreatedAt.Add(rule.Deadline), true
+}
+
+// applyAuditOverdueFilter 将收件箱范围限定为已超过处理时限的待审核记录：
+// 审核类型取范围内配置了时限的类型，提交时长按各类型时限分别比较
+func applyAuditOverdueFilter(queryMap map[string]any, now time.Time) {
+	policy := currentAuditSLAPolicy()
+	scoped, hasScope := queryMap["target_type IN ?"].([]int32)
+	targetTypes := make([]int32, 0, len(policy.Rules))
+	for _, targetType := range policy.targetTypes() {
+		if !hasScope || slices.Contains(scoped, targetType) {
+			targetTypes = append(targetTypes, targetType)
+		}
+	}
+	queryMap["target_type IN ?"] = targetTypes
+	queryMap["status IN ?"] = []int32{model.AuditStatusPending}
+	if len(targetTypes) == 0 {
+		return
+	}
+
+	// 时限来自服务端配置（整数分钟），直接拼入 CASE 表达式
+	var cases strings.Builder
+	for _, targetType := range targetTypes {
+		fmt.Fprintf(&cases, " WHEN %d THEN %d", targetType, int64(policy.Rules[targetType].Deadline/time.Minute))
+	}
+	queryMap["TIMESTAMPDIFF(MINUTE, created_at, ?) >= CASE target_type"+cases.String()+" END"] = now
+}
+
+// fillAuditInboxDeadline 填充收件箱条目的处理时限与是否超时（仅待审核记录）
+func fillAuditInboxDeadline(policy auditSLAPolicy, record *model.AuditRecord, item *api.AuditInboxItem, now time.Time) {
+	if record.Status != model.AuditStatusPending {
+		return
+	}
+	deadline, ok := policy.auditDeadline(record)
+	if !ok {
+		return
+	}
+	item.Deadline = util.FormatDateTimeOrEmpty(deadline)
+	item.Overdue = now.After(deadline)
+}
+
+// RemindOverdueAudits 为超过处理时限的待审核记录提醒负责审核的账号，同一记录按提醒间隔重复提醒
+func (s *SchedulerService) RemindOverdueAudits(now time.Time) (int64, error) {
+	return NewAuditService(s.ctx, nil).remindOverdueAudits(now, currentSchedulerPolicy().BatchSize)
+}
+
+// EscalateOverdueAudits 按配置对超过自动处理时限的待审核记录自动通过或驳回，审核人记为系统
+func (s *SchedulerService) EscalateOverdueAudits(now time.Time) (int64, error) {
+	return NewAuditService(s.ctx, nil).escalateOverdueAudits(now, currentSchedulerPolicy().BatchSize)
+}
+
+func (s *AuditService) remindOverdueAudits(now time.Time, limit int) (int64, error) {
+	policy := currentAuditSLAPolicy()
+	var reminded int64
+	for _, targetType := range policy.targetTypes() {
+		rule := policy.Rules[targetType]
+		records, err := s.repo.ListAuditRecordsToRemind(s.repo.DB, targetType, now.Add(-rule.Deadline), now.Add(-policy.RemindInterval), limit)
+		if err != nil {
+			log.Error("调度任务失败: 查询超时待审核记录异常: %v, target_type=%d", err, targetType)
+			return reminded, err
+		}
+		for _, record := range records {
+			count, err := s.remindAuditReviewers(record, rule, now)
+			if err != nil {
+				log.Error("调度任务失败: 审核超时提醒异常: %v, record_id=%d", err, record.ID)
+				continue
+			}
+			reminded += int64(count)
+		}
+	}
+	return reminded, nil
+}
+
+// remindAuditReviewers 为审核记录当前负责审核的账号生成超时提醒并记录提醒时间，返回提醒人数
+func (s *AuditService) remindAuditReviewers(record *model.AuditRecord, rule auditSLARule, now time.Time) (int, error) {
+	var count int
+	err := s.withTransaction(func(tx *gorm.DB) error {
+		reviewers, stageNo, err := s.auditReviewerIDs(tx, record)
+		if err != nil {
+			return err
+		}
+		content := fmt.Sprintf("%s已超过审核时限（%d小时）未处理，请尽快审核", auditTargetLabel(record.TargetType), int64(rule.Deadline/time.Hour))
+		reminders := make([]*model.AuditReminder, 0, len(reviewers))
+		for _, accountID := range reviewers {
+			reminders = append(reminders, &model.AuditReminder{
+				AuditRecordID: record.ID,
+				AccountID:     accountID,
+				TargetType:    record.TargetType,
+				OrgID:         record.OrgID,
+				StageNo:       stageNo,
+				Content:       content,
+			})
+		}
+		if err := s.repo.CreateAuditReminders(tx, reminders); err != nil {
+			return err
+		}
+		count = len(reminders)
+		return s.repo.UpdateAuditRecordByID(tx, record.ID, map[string]any{
+			"last_reminded_at": now,
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+	if count == 0 {
+		log.Warn("审核超时提醒: 未找到负责审核的账号, record_id=%d target_type=%d org_id=%d", record.ID, record.TargetType, record.OrgID)
+	}
+	return count, nil
+}
+
+// auditReviewerIDs 查询负责处理审核记录的账号及所处阶段：配置审批链时为当前阶段尚未审批的审批人；
+// 否则平台审核为全部平台管理员，组织内审核为组织主账号及具备审核权限的管理员、负责人
+func (s *AuditService) auditReviewerIDs(db *gorm.DB, record *model.AuditRecord) ([]int64, int32, error) {
+	stages, err := s.repo.ListAuditApprovalStages(db, auditChainOrgID(record), record.TargetType)
+	if err != nil {
+		return nil, 0, err
+	}
+	if len(stages) > 0 {
+		stage := currentAuditStage(stages, record.CurrentStage)
+		ids, err := s.auditStageApproverIDs(db, record, stage.ApproverRole)
+		if err != nil {
+			return nil, 0, err
+		}
+		decisions, err := s.repo.ListAuditStageDecisions(db, record.ID)
+		if err != nil {
+			return nil, 0, err
+		}
+		decided := make(map[int64]struct{}, len(decisions))
+		for _, decision := range decisions {
+			decided[decision.AuditorID] = struct{}{}
+		}
+		pending := make([]int64, 0, len(ids))
+		for _, id := range ids {
+			if _, ok := decided[id]; !ok {
+				pending = append(pending, id)
+			}
+		}
+		return pending, stage.StageNo, nil
+	}
+
+	if model.IsPlatformAuditTarget(record.TargetType) {
+		ids, err := s.auditStageApproverIDs(db, record, model.ApproverRolePlatformAdmin)
+		return ids, record.CurrentStage, err
+	}
+	ids, err := s.auditStageApproverIDs(db, record, model.OrgRoleOwner)
+	if err != nil {
+		return nil, 0, err
+	}
+	seen := make(map[int64]struct{}, len(ids))
+	for _, id := range ids {
+		seen[id] = struct{}{}
+	}
+	for _, role := range []int32{model.MemberRoleManager, model.MemberRoleLeader} {
+		if !model.OrgRoleHasPermission(role, model.PermAuditReview) {
+			continue
+		}
+		roleIDs, err := s.auditStageApproverIDs(db, record, role)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, id := range roleIDs {
+			if _, ok := seen[id]; ok {
+				continue
+			}
+			seen[id] = struct{}{}
+			ids = append(ids, id)
+		}
+	}
+	return ids, record.CurrentStage, nil
+}
+
+func (s *AuditService) escalateOverdueAudits(now time.Time, limit int) (int64, error) {
+	policy := currentAuditSLAPolicy()
+	var escalated int64
+	for _, targetType := range policy.targetTypes() {
+		rule := policy.Rules[targetType]
+		if rule.AutoAction == model.AuditAutoActionNone {
+			continue
+		}
+		records, err := s.repo.ListAuditRecordsToEscalate(s.repo.DB, targetType, now.Add(-rule.AutoAfter), limit)
+		if err != nil {
+			log.Error("调度任务失败: 查询待自动处理审核记录异常: %v, target_type=%d", err, targetType)
+			return escalated, err
+		}
+		for _, record := range records {
+			if err := s.escalateAuditRecord(record, rule, now); err != nil {
+				log.Warn("审核超时自动处理失败: %v, record_id=%d target_type=%d action=%s", err, record.ID, record.TargetType, rule.AutoAction)
+				continue
+			}
+			escalated++
+		}
+	}
+	return escalated, nil
+}
+
+// escalateAuditRecord 以系统身份自动通过或驳回审核记录并记录处理时间。因业务规则失败（如活动名额已满）的记录
+// 保持待审核，同样记录处理时间不再重复自动处理，仍由审核人处理并继续提醒；数据库等暂时性异常不记录，下次调度重试
+func (s *AuditService) escalateAuditRecord(record *model.AuditRecord, rule auditSLARule, now time.Time) error {
+	approver := &auditApprover{id: model.SystemAuditorID, system: true}
+	hours := int64(rule.AutoAfter / time.Hour)
+
+	var err error
+	if err = ensureAuditRecordPending(record); err == nil {
+		if rule.AutoAction == model.AuditAutoActionApprove {
+			err = s.approveAuditRecord(record, approver, fmt.Sprintf("超过审核时限（%d小时）未处理，系统自动通过", hours))
+		} else {
+			err = s.rejectAuditRecord(record, approver, fmt.Sprintf("超过审核时限（%d小时）未处理，系统自动驳回", hours))
+		}
+	}
+	if isTransientAuditError(err) {
+		return err
+	}
+	if markErr := s.repo.UpdateAuditRecordByID(s.repo.DB, record.ID, map[string]any{
+		"escalated_at": now,
+	}); markErr != nil {
+		log.Error("审核超时自动处理: 记录处理时间异常: %v, record_id=%d", markErr, record.ID)
+	}
+	return err
+}
+
+// isTransientAuditError 判断审核处理失败是否为死锁、锁等待超时或数据库连接异常等暂时性异常。
+// 唯一键冲突、外键约束等其他 MySQL 错误重试也不会成功，按处理失败记录，避免每轮调度重复处理同一记录。
+func isTransientAuditError(err error) bool {
+	if err == nil {
+		return false
+	}
+	if isRetryableTxError(err) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) ||
+		errors.Is(err, driver.ErrBadConn) ||
+		errors.Is(err, mysqlDriver.ErrInvalidConn) ||
+		errors.Is(err, sql.ErrConnDone) ||
+		errors.Is(err, context.DeadlineExceeded) ||
+		errors.Is(err, context.Canceled)
+}
+
+// ListAuditReminders 查询当前账号收到的审核超时提醒
+func (s *AuditService) ListAuditReminders(req *api.ListAuditRemindersRequest) (*api.ListAuditRemindersResponse, error) {
+	if req == nil {
+		return nil, errors.New("请求不能为空")
+	}
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+
+	page, pageSize := normalizeAdminPage(req.Page, req.PageSize)
+	reminders, total, err := s.repo.ListAuditReminders(s.repo.DB, userID, req.UnreadOnly, pageSize, (page-1)*pageSize)
+	if err != nil {
+		log.Error("查询审核提醒失败: %v, account_id=%d", err, userID)
+		return nil, err
+	}
+	unread, err := s.repo.CountUnreadAuditReminders(s.repo.DB, userID)
+	if err != nil {
+		log.Error("查询审核提醒失败: 统计未读异常: %v, account_id=%d", err, userID)
+		return nil, err
+	}
+
+	resp := &api.ListAuditRemindersResponse{
+		Total:  int32(total),
+		Unread: int32(unread),
+		List:   make([]*api.AuditReminderItem, 0, len(reminders)),
+	}
+	for _, reminder := range reminders {
+		resp.List = append(resp.List, &api.AuditReminderItem{
+			Id:            reminder.ID,
+			AuditRecordId: reminder.AuditRecordID,
+			TargetType:    reminder.TargetType,
+			OrgId:         reminder.OrgID,
+			StageNo:       reminder.StageNo,
+			Content:       reminder.Content,
+			Read:          reminder.ReadAt != nil,
+			CreatedAt:     util.FormatDateTimeOrEmpty(reminder.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// ReadAuditReminders 将当前账号的提醒标记为已读，未指定ID时全部标记
+func (s *AuditService) ReadAuditReminders(req *api.ReadAuditRemindersRequest) (*api.ReadAuditRemindersResponse, error) {
+	if req == nil {
+		return nil, errors.New("请求不能为空")
+	}
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range req.Ids {
+		if id <= 0 {
+			return nil, errors.New("提醒ID无效")
+		}
+	}
+
+	updated, err := s.repo.MarkAuditRemindersRead(s.repo.DB, userID, req.Ids, time.Now())
+	if err != nil {
+		log.Error("标记审核提醒已读失败: %v, account_id=%d", err, userID)
+		return nil, err
+	}
+	return &api.ReadAuditRemindersResponse{Updated: int32(updated)}, nil
+}
+
+func auditTargetLabel(targetType int32) string {
+	switch targetType {
+	case model.AuditTargetVolunteer:
+		return "志愿者实名认证"
+	case model.AuditTargetOrg:
+		return "组织资质认证"
+	case model.AuditTargetMember:
+		return "加入/退出组织申请"
+	case model.AuditTargetSignup:
+		return "活动报名申请"
+	default:
+		return "审核申请"
+	}
+}
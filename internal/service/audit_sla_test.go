@@ -0,0 +1,196 @@
+package service
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+	"volunteer-system/config"
+	"volunteer-system/internal/model"
+
+	mysqlDriver "github.com/go-sql-driver/mysql"
+)
+
+func withAuditSLAConfig(t *testing.T, sla *config.AuditSLAConfig) {
+	t.Helper()
+	cfg := config.GetConfig()
+	old := cfg.AuditSLA
+	cfg.AuditSLA = sla
+	t.Cleanup(func() { cfg.AuditSLA = old })
+}
+
+func TestCurrentAuditSLAPolicy(t *testing.T) {
+	cases := []struct {
+		name       string
+		sla        *config.AuditSLAConfig
+		wantRemind time.Duration
+		wantRules  map[int32]auditSLARule
+	}{
+		{name: "not configured", wantRemind: 24 * time.Hour, wantRules: map[int32]auditSLARule{}},
+		{
+			name: "disabled",
+			sla: &config.AuditSLAConfig{
+				RemindIntervalHours: 6,
+				Rules:               []config.AuditSLARule{{TargetType: model.AuditTargetSignup, DeadlineHours: 24}},
+			},
+			wantRemind: 24 * time.Hour,
+			wantRules:  map[int32]auditSLARule{},
+		},
+		{
+			name: "invalid rules skipped",
+			sla: &config.AuditSLAConfig{
+				Enabled:             true,
+				RemindIntervalHours: 6,
+				Rules: []config.AuditSLARule{
+					{TargetType: 0, DeadlineHours: 24},
+					{TargetType: 9, DeadlineHours: 24},
+					{TargetType: model.AuditTargetMember, DeadlineHours: 0},
+					{TargetType: model.AuditTargetSignup, DeadlineHours: -1},
+				},
+			},
+			wantRemind: 6 * time.Hour,
+			wantRules:  map[int32]auditSLARule{},
+		},
+		{
+			name: "auto action normalized",
+			sla: &config.AuditSLAConfig{
+				Enabled: true,
+				Rules: []config.AuditSLARule{
+					{TargetType: model.AuditTargetMember, DeadlineHours: 48, AutoAction: " Approve "},
+					{TargetType: model.AuditTargetSignup, DeadlineHours: 24, AutoAction: "escalate"},
+				},
+			},
+			wantRemind: 24 * time.Hour,
+			wantRules: map[int32]auditSLARule{
+				model.AuditTargetMember: {Deadline: 48 * time.Hour, AutoAction: model.AuditAutoActionApprove, AutoAfter: 48 * time.Hour},
+				model.AuditTargetSignup: {Deadline: 24 * time.Hour, AutoAction: model.AuditAutoActionNone, AutoAfter: 24 * time.Hour},
+			},
+		},
+		{
+			name: "auto approve limited to org audits",
+			sla: &config.AuditSLAConfig{
+				Enabled: true,
+				Rules: []config.AuditSLARule{
+					{TargetType: model.AuditTargetVolunteer, DeadlineHours: 72, AutoAction: model.AuditAutoActionApprove},
+					{TargetType: model.AuditTargetOrg, DeadlineHours: 72, AutoAction: model.AuditAutoActionReject},
+				},
+			},
+			wantRemind: 24 * time.Hour,
+			wantRules: map[int32]auditSLARule{
+				model.AuditTargetVolunteer: {Deadline: 72 * time.Hour, AutoAction: model.AuditAutoActionNone, AutoAfter: 72 * time.Hour},
+				model.AuditTargetOrg:       {Deadline: 72 * time.Hour, AutoAction: model.AuditAutoActionReject, AutoAfter: 72 * time.Hour},
+			},
+		},
+		{
+			name: "auto after clamped to deadline",
+			sla: &config.AuditSLAConfig{
+				Enabled: true,
+				Rules: []config.AuditSLARule{
+					{TargetType: model.AuditTargetMember, DeadlineHours: 48, AutoAction: model.AuditAutoActionReject, AutoAfterHours: 12},
+					{TargetType: model.AuditTargetSignup, DeadlineHours: 24, AutoAction: model.AuditAutoActionReject, AutoAfterHours: 72},
+				},
+			},
+			wantRemind: 24 * time.Hour,
+			wantRules: map[int32]auditSLARule{
+				model.AuditTargetMember: {Deadline: 48 * time.Hour, AutoAction: model.AuditAutoActionReject, AutoAfter: 48 * time.Hour},
+				model.AuditTargetSignup: {Deadline: 24 * time.Hour, AutoAction: model.AuditAutoActionReject, AutoAfter: 72 * time.Hour},
+			},
+		},
+	}
+	for _, tc := range cases {
+		withAuditSLAConfig(t, tc.sla)
+		policy := currentAuditSLAPolicy()
+		if policy.RemindInterval != tc.wantRemind {
+			t.Fatalf("%s: RemindInterval = %s, want %s", tc.name, policy.RemindInterval, tc.wantRemind)
+		}
+		if !reflect.DeepEqual(policy.Rules, tc.wantRules) {
+			t.Fatalf("%s: Rules = %+v, want %+v", tc.name, policy.Rules, tc.wantRules)
+		}
+	}
+}
+
+func TestApplyAuditOverdueFilter(t *testing.T) {
+	withAuditSLAConfig(t, &config.AuditSLAConfig{
+		Enabled: true,
+		Rules: []config.AuditSLARule{
+			{TargetType: model.AuditTargetSignup, DeadlineHours: 24},
+			{TargetType: model.AuditTargetMember, DeadlineHours: 72},
+		},
+	})
+	now := time.Date(2026, 1, 2, 3, 4, 5, 0, time.Local)
+
+	cases := []struct {
+		name      string
+		scope     []int32
+		wantTypes []int32
+		wantCase  string
+	}{
+		{
+			name:      "all configured types",
+			wantTypes: []int32{model.AuditTargetMember, model.AuditTargetSignup},
+			wantCase:  "TIMESTAMPDIFF(MINUTE, created_at, ?) >= CASE target_type WHEN 3 THEN 4320 WHEN 4 THEN 1440 END",
+		},
+		{
+			name:      "intersect with scope",
+			scope:     []int32{model.AuditTargetMember, model.AuditTargetVolunteer},
+			wantTypes: []int32{model.AuditTargetMember},
+			wantCase:  "TIMESTAMPDIFF(MINUTE, created_at, ?) >= CASE target_type WHEN 3 THEN 4320 END",
+		},
+		{
+			name:      "no configured type in scope",
+			scope:     []int32{model.AuditTargetOrg},
+			wantTypes: []int32{},
+		},
+	}
+	for _, tc := range cases {
+		queryMap := map[string]any{}
+		if tc.scope != nil {
+			queryMap["target_type IN ?"] = tc.scope
+		}
+		applyAuditOverdueFilter(queryMap, now)
+
+		if got := queryMap["target_type IN ?"]; !reflect.DeepEqual(got, tc.wantTypes) {
+			t.Fatalf("%s: target_type IN = %v, want %v", tc.name, got, tc.wantTypes)
+		}
+		if got := queryMap["status IN ?"]; !reflect.DeepEqual(got, []int32{model.AuditStatusPending}) {
+			t.Fatalf("%s: status IN = %v, want pending only", tc.name, got)
+		}
+		wantLen := 2
+		if tc.wantCase != "" {
+			wantLen = 3
+			if got, ok := queryMap[tc.wantCase]; !ok || got != now {
+				t.Fatalf("%s: missing overdue condition %q, queryMap = %v", tc.name, tc.wantCase, queryMap)
+			}
+		}
+		if len(queryMap) != wantLen {
+			t.Fatalf("%s: queryMap = %v, want %d conditions", tc.name, queryMap, wantLen)
+		}
+	}
+}
+
+func TestIsTransientAuditError(t *testing.T) {
+	cases := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "nil", err: nil},
+		{name: "business error", err: errors.New("审核记录已处理")},
+		{name: "deadlock", err: &mysqlDriver.MySQLError{Number: 1213}, want: true},
+		{name: "lock wait timeout", err: fmt.Errorf("approve: %w", &mysqlDriver.MySQLError{Number: 1205}), want: true},
+		{name: "duplicate key", err: &mysqlDriver.MySQLError{Number: 1062, Message: "Duplicate entry"}},
+		{name: "foreign key", err: fmt.Errorf("approve: %w", &mysqlDriver.MySQLError{Number: 1452})},
+		{name: "data too long", err: &mysqlDriver.MySQLError{Number: 1406}},
+		{name: "bad connection", err: driver.ErrBadConn, want: true},
+		{name: "invalid connection", err: mysqlDriver.ErrInvalidConn, want: true},
+		{name: "deadline exceeded", err: fmt.Errorf("approve: %w", context.DeadlineExceeded), want: true},
+	}
+	for _, tc := range cases {
+		if got := isTransientAuditError(tc.err); got != tc.want {
+			t.Fatalf("%s: isTransientAuditError(%v) = %v, want %v", tc.name, tc.err, got, tc.want)
+		}
+	}
+}
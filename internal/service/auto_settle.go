@@ -0,0 +1,254 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// autoSettleResult 未签退报名自动结算结果
+type autoSettleResult struct {
+	Granted int32
+	Flagged int32
+}
+
+// autoSettleActivityCheckouts 活动完结时按活动策略处理已签到未签退的报名。
+// 每条报名在独立事务中处理，单条失败只记录日志，留待调度任务重试。
+func (s *ActivityService) autoSettleActivityCheckouts(activity *model.Activity, now time.Time) (*autoSettleResult, error) {
+	result := &autoSettleResult{}
+	signupIDs, err := s.repo.ListUncheckedOutSignupIDs(s.repo.DB, activity.ID)
+	if err != nil {
+		return result, err
+	}
+	for _, signupID := range signupIDs {
+		granted, flagged, err := s.autoSettleSignupByID(activity, signupID, now)
+		if err != nil {
+			log.Error("自动结算失败: 处理报名记录异常: %v, activity_id=%d signup_id=%d", err, activity.ID, signupID)
+			continue
+		}
+		if granted {
+			result.Granted++
+		}
+		if flagged {
+			result.Flagged++
+		}
+	}
+	return result, nil
+}
+
+// autoSettleSignupByID 按活动的未签退结算策略处理单条报名：
+// 按计划时长或结束时间发放工时（写入独立原因与幂等键的工时流水），或不发放并标记待组织确认。
+func (s *ActivityService) autoSettleSignupByID(activity *model.Activity, signupID int64, now time.Time) (granted bool, flagged bool, err error) {
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		signup, err := s.repo.GetActivitySignupByIDForUpdate(tx, signupID)
+		if err != nil {
+			return err
+		}
+		// 事务内二次校验，已签退或已进入复核的记录直接跳过
+		if signup.Status != model.ActivitySignupStatusSuccess ||
+			signup.CheckInStatus != model.ActivityCheckInDone ||
+			signup.CheckInTime == nil ||
+			signup.CheckOutStatus == model.ActivityCheckOutDone ||
+			signup.SettleReviewStatus != model.SettleReviewNone {
+			return nil
+		}
+
+		policy := activity.AutoSettlePolicy
+		if !model.IsValidAutoSettlePolicy(policy) {
+			policy = model.AutoSettlePolicyEndTime
+		}
+		if policy == model.AutoSettlePolicyReview {
+			flagged = true
+			return s.repo.UpdateActivitySignupByID(tx, signup.ID, map[string]any{
+				"settle_review_status": model.SettleReviewPending,
+			})
+		}
+
+		// 签退时间取活动（班次）结束时间，活动被提前完结时取当前时间
+		windowStart, windowEnd := activity.StartTime, activity.EndTime
+		if signup.SlotID > 0 {
+			slot, err := s.repo.GetActivitySlotByID(tx, signup.SlotID)
+			if err != nil {
+				return err
+			}
+			windowStart, windowEnd = slot.StartTime, slot.EndTime
+		}
+		checkInAt := *signup.CheckInTime
+		checkOutAt := windowEnd
+		if now.Before(checkOutAt) {
+			checkOutAt = now
+		}
+		if checkOutAt.Before(checkInAt) {
+			checkOutAt = checkInAt
+		}
+
+		var grantedHours float64
+		var reason string
+		switch policy {
+		case model.AutoSettlePolicyDuration:
+			// 按计划时长发放：绑定班次的报名以班次时长为准
+			grantedHours = activity.Duration
+			if signup.SlotID > 0 {
+				grantedHours = util.RoundHours(windowEnd.Sub(windowStart).Hours())
+			}
+			reason = "未签退自动结算：按计划时长发放"
+		default:
+			grantedHours, err = s.calcSignupGrantedHours(tx, activity, signup, checkInAt, checkOutAt)
+			if err != nil {
+				return err
+			}
+			reason = "未签退自动结算：按结束时间发放"
+		}
+		if grantedHours < 0 {
+			grantedHours = 0
+		}
+
+		idempotencyKey := fmt.Sprintf("auto-settle:%d:%d", signup.ID, signup.WorkHourVersion+1)
+		if err := s.grantSignupWorkHours(tx, signup, checkInAt, checkOutAt, grantedHours, idempotencyKey, reason, 0, nil); err != nil {
+			return err
+		}
+		granted = true
+		return nil
+	})
+	return granted, flagged, err
+}
+
+// PendingSettlementList 待确认结算的报名列表（组织侧，自动结算策略为“不发放并标记”时产生）
+func (s *ActivityService) PendingSettlementList(req *api.PendingSettlementListRequest) (*api.PendingSettlementListResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("活动ID不能为空")
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("查询待确认结算失败: 获取当前用户ID异常: %v, activity_id=%d", err, req.Id)
+		return nil, err
+	}
+	if _, err := s.ensureActivityOperableByCurrentOrg(req.Id, userID); err != nil {
+		log.Error("查询待确认结算失败: 校验活动归属异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+
+	signups, err := s.repo.ListPendingSettlementSignups(s.repo.DB, req.Id)
+	if err != nil {
+		log.Error("查询待确认结算失败: 查询报名记录异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+
+	resp := &api.PendingSettlementListResponse{
+		List: make([]*api.PendingSettlementItem, 0, len(signups)),
+	}
+	if len(signups) == 0 {
+		return resp, nil
+	}
+	volunteerIDs := make([]int64, 0, len(signups))
+	for _, signup := range signups {
+		volunteerIDs = append(volunteerIDs, signup.VolunteerID)
+	}
+	volunteers, err := s.repo.GetVolunteersByIDs(s.repo.DB, volunteerIDs)
+	if err != nil {
+		log.Error("查询待确认结算失败: 查询志愿者信息异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+	nameMap := make(map[int64]string, len(volunteers))
+	for _, volunteer := range volunteers {
+		nameMap[volunteer.ID] = volunteer.RealName
+	}
+
+	for _, signup := range signups {
+		resp.List = append(resp.List, &api.PendingSettlementItem{
+			SignupId:        signup.ID,
+			VolunteerId:     signup.VolunteerID,
+			VolunteerName:   nameMap[signup.VolunteerID],
+			SlotId:          signup.SlotID,
+			CheckInTime:     util.FormatDateTimePtr(signup.CheckInTime),
+			CheckInPosition: buildAttendancePosition(signup.CheckInLatitude, signup.CheckInLongitude, signup.CheckInDistance, signup.CheckInOutOfFence),
+		})
+	}
+	resp.Total = int32(len(resp.List))
+	return resp, nil
+}
+
+// ConfirmPendingSettlement 确认待结算报名（组织侧）：补录签退时间发放工时，或确认不发放
+func (s *ActivityService) ConfirmPendingSettlement(req *api.ConfirmPendingSettlementRequest) (*api.ConfirmPendingSettlementResponse, error) {
+	if req.ActivityId <= 0 || req.SignupId <= 0 {
+		return nil, errors.New("活动ID和报名ID不能为空")
+	}
+	if req.Action != model.SettleConfirmActionGrant && req.Action != model.SettleConfirmActionDismiss {
+		return nil, errors.New("处理方式不合法")
+	}
+
+	var checkOutAt time.Time
+	if req.Action == model.SettleConfirmActionGrant {
+		checkOutText := strings.TrimSpace(req.CheckOutTime)
+		if checkOutText == "" {
+			return nil, errors.New("签退时间不能为空")
+		}
+		parsed, err := util.ParseDateTime(checkOutText)
+		if err != nil {
+			return nil, errors.New("签退时间格式错误")
+		}
+		checkOutAt = parsed
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("确认待结算失败: 获取当前用户ID异常: %v, activity_id=%d signup_id=%d", err, req.ActivityId, req.SignupId)
+		return nil, err
+	}
+	activity, err := s.ensureActivityOperableByCurrentOrg(req.ActivityId, userID)
+	if err != nil {
+		log.Error("确认待结算失败: 校验活动归属异常: %v, activity_id=%d signup_id=%d user_id=%d", err, req.ActivityId, req.SignupId, userID)
+		return nil, err
+	}
+
+	reason := strings.TrimSpace(req.Reason)
+	if reason == "" {
+		reason = "组织确认未签退结算"
+	}
+
+	resp := &api.ConfirmPendingSettlementResponse{Success: true}
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		signup, err := s.repo.GetActivitySignupByIDForUpdate(tx, req.SignupId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("报名记录不存在")
+			}
+			return err
+		}
+		if signup.ActivityID != activity.ID {
+			return errors.New("报名记录不存在")
+		}
+		if signup.SettleReviewStatus != model.SettleReviewPending {
+			return errors.New("该报名无需确认结算")
+		}
+
+		if req.Action == model.SettleConfirmActionDismiss {
+			return s.repo.UpdateActivitySignupByID(tx, signup.ID, map[string]any{
+				"settle_review_status": model.SettleReviewDismissed,
+			})
+		}
+
+		result, err := s.applySupplementAttendance(tx, activity, signup, nil, &checkOutAt, reason, userID)
+		if err != nil {
+			return err
+		}
+		resp.CheckOutTime = util.FormatDateTimeOrEmpty(result.CheckOutTime)
+		resp.GrantedHours = result.GrantedHours
+		return nil
+	})
+	if err != nil {
+		log.Error("确认待结算失败: %v, activity_id=%d signup_id=%d user_id=%d", err, req.ActivityId, req.SignupId, userID)
+		return nil, err
+	}
+
+	log.Info("确认待结算成功: activity_id=%d signup_id=%d user_id=%d action=%d granted_hours=%.2f", req.ActivityId, req.SignupId, userID, req.Action, resp.GrantedHours)
+	return resp, nil
+}
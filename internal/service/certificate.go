@@ -0,0 +1,517 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/internal/repository"
+	"volunteer-system/pkg/util"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+)
+
+type CertificateService struct {
+	Service
+}
+
+const (
+	defaultCertificatePageSize = 20
+	maxCertificatePageSize     = 100
+	// maxCertificatePeriodDays 累计证书最长统计区间（天）
+	maxCertificatePeriodDays = 366 * 5
+	// certificateQRCodeImageSize 证书二维码图片边长（像素）
+	certificateQRCodeImageSize = 256
+
+	certificateRevokeReasonWorkHourVoided = "证书涵盖的志愿工时已作废"
+)
+
+func NewCertificateService(ctx context.Context, c *app.RequestContext) *CertificateService {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &CertificateService{
+		Service{
+			ctx:  ctx,
+			c:    c,
+			repo: repository.NewRepository(ctx, c),
+		},
+	}
+}
+
+// certificateActor 当前操作证书的账号身份（志愿者或组织二选一）
+type certificateActor struct {
+	accountID int64
+	volunteer *model.Volunteer
+	org       *model.Organization
+}
+
+// IssueCertificate 签发证书
+// 志愿者只能为本人签发；组织只能为本组织活动中已发放工时的志愿者签发。
+// 同一批工时流水已签发过有效证书时直接返回原证书，不重复生成编号。
+func (s *CertificateService) IssueCertificate(req *api.IssueCertificateRequest) (*api.IssueCertificateResponse, error) {
+	if req.CertType != model.CertificateTypeActivity && req.CertType != model.CertificateTypeCumulative {
+		return nil, errors.New("证书类型不合法")
+	}
+
+	actor, err := s.currentCertificateActor()
+	if err != nil {
+		return nil, err
+	}
+
+	volunteerID := req.VolunteerId
+	if actor.volunteer != nil {
+		if volunteerID > 0 && volunteerID != actor.volunteer.ID {
+			return nil, errors.New("无权为其他志愿者签发证书")
+		}
+		volunteerID = actor.volunteer.ID
+	} else if volunteerID <= 0 {
+		return nil, errors.New("志愿者ID不能为空")
+	}
+
+	volunteer := actor.volunteer
+	if volunteer == nil {
+		volunteer, err = s.repo.FindVolunteerByID(s.repo.DB, volunteerID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("志愿者不存在")
+			}
+			log.Error("签发证书失败: 查询志愿者异常: %v, volunteer_id=%d", err, volunteerID)
+			return nil, err
+		}
+	}
+
+	cert := &model.Certificate{
+		CertType:      req.CertType,
+		VolunteerID:   volunteer.ID,
+		VolunteerName: volunteer.RealName,
+	}
+
+	switch req.CertType {
+	case model.CertificateTypeActivity:
+		if req.ActivityId <= 0 {
+			return nil, errors.New("活动ID不能为空")
+		}
+		activity, err := s.repo.GetActivityByID(s.repo.DB, req.ActivityId)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("活动不存在")
+			}
+			log.Error("签发证书失败: 查询活动异常: %v, activity_id=%d", err, req.ActivityId)
+			return nil, err
+		}
+		if actor.org != nil && activity.OrgID != actor.org.ID {
+			return nil, errors.New("无权为该活动签发证书")
+		}
+		cert.OrgID = activity.OrgID
+		cert.ActivityID = activity.ID
+		cert.ActivityTitle = activity.Title
+		cert.PeriodStart = activity.StartTime
+		cert.PeriodEnd = activity.EndTime
+
+	case model.CertificateTypeCumulative:
+		periodStart, periodEnd, err := parseCertificatePeriod(req.PeriodStart, req.PeriodEnd)
+		if err != nil {
+			return nil, err
+		}
+		if actor.org != nil {
+			if req.OrgId > 0 && req.OrgId != actor.org.ID {
+				return nil, errors.New("无权以其他组织名义签发证书")
+			}
+			cert.OrgID = actor.org.ID
+		} else {
+			if req.OrgId <= 0 {
+				return nil, errors.New("签发组织ID不能为空")
+			}
+			cert.OrgID = req.OrgId
+		}
+		cert.PeriodStart = periodStart
+		cert.PeriodEnd = periodEnd
+	}
+
+	org := actor.org
+	if org == nil {
+		org, err = s.repo.GetOrganizationByID(s.repo.DB, cert.OrgID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("组织不存在")
+			}
+			log.Error("签发证书失败: 查询组织异常: %v, org_id=%d", err, cert.OrgID)
+			return nil, err
+		}
+	}
+	cert.OrgName = org.OrgName
+
+	// 志愿者申请时使用组织默认模板，组织签发时可指定本组织模板
+	if req.TemplateId > 0 && actor.org == nil {
+		return nil, errors.New("仅组织签发时可以指定证书模板")
+	}
+	cert.TemplateVersionID, err = s.resolveCertificateTemplateVersion(cert.OrgID, req.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+
+	signups, err := s.repo.ListGrantedSignupsForCertificate(s.repo.DB, cert.VolunteerID, cert.OrgID, cert.ActivityID, cert.PeriodStart, cert.PeriodEnd)
+	if err != nil {
+		log.Error("签发证书失败: 查询已发放工时异常: %v, volunteer_id=%d org_id=%d activity_id=%d", err, cert.VolunteerID, cert.OrgID, cert.ActivityID)
+		return nil, err
+	}
+	if len(signups) == 0 {
+		if cert.CertType == model.CertificateTypeActivity {
+			return nil, errors.New("该活动暂无已发放的志愿工时，无法签发证书")
+		}
+		return nil, errors.New("所选时间段内暂无已发放的志愿工时，无法签发证书")
+	}
+
+	logIDs := make([]string, 0, len(signups))
+	var totalHours float64
+	for _, signup := range signups {
+		totalHours += signup.GrantedHours
+		logIDs = append(logIDs, strconv.FormatInt(signup.LastWorkHourLogID, 10))
+	}
+	cert.TotalHours = util.RoundHours(totalHours)
+	cert.ServiceCount = int32(len(signups))
+	cert.WorkHourLogIDs = strings.Join(logIDs, ",")
+
+	existing, err := s.repo.FindValidCertificate(s.repo.DB, cert)
+	if err != nil {
+		log.Error("签发证书失败: 查询已签发证书异常: %v, volunteer_id=%d org_id=%d activity_id=%d", err, cert.VolunteerID, cert.OrgID, cert.ActivityID)
+		return nil, err
+	}
+	if existing != nil {
+		return &api.IssueCertificateResponse{Certificate: toCertificateItem(existing), Reused: true}, nil
+	}
+
+	// 签名使用秒级时间戳，与数据库 DATETIME 精度保持一致
+	issuedAt := time.Now().Truncate(time.Second)
+	serialNo, err := util.GenerateCertificateSerial(issuedAt)
+	if err != nil {
+		log.Error("签发证书失败: 生成证书编号异常: %v, volunteer_id=%d", err, cert.VolunteerID)
+		return nil, err
+	}
+	cert.SerialNo = serialNo
+	cert.IssuedAt = issuedAt
+	cert.IssuerID = actor.accountID
+	cert.Status = model.CertificateStatusValid
+	cert.Signature = util.SignCertificate(appSigningSecret(), certificatePayload(cert))
+
+	if err := s.repo.CreateCertificate(s.repo.DB, cert); err != nil {
+		log.Error("签发证书失败: 保存证书异常: %v, volunteer_id=%d org_id=%d activity_id=%d", err, cert.VolunteerID, cert.OrgID, cert.ActivityID)
+		return nil, err
+	}
+
+	return &api.IssueCertificateResponse{Certificate: toCertificateItem(cert)}, nil
+}
+
+// CertificateList 证书签发记录查询（志愿者查本人证书；组织查本组织签发的证书）
+func (s *CertificateService) CertificateList(req *api.CertificateListRequest) (*api.CertificateListResponse, error) {
+	resp := &api.CertificateListResponse{
+		Total: 0,
+		List:  []*api.CertificateItem{},
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = defaultCertificatePageSize
+	}
+	if req.PageSize > maxCertificatePageSize {
+		req.PageSize = maxCertificatePageSize
+	}
+
+	actor, err := s.currentCertificateActor()
+	if err != nil {
+		return nil, err
+	}
+
+	queryMap := make(map[string]any)
+	if actor.volunteer != nil {
+		queryMap["volunteer_id = ?"] = actor.volunteer.ID
+	} else {
+		queryMap["org_id = ?"] = actor.org.ID
+		if req.VolunteerId > 0 {
+			queryMap["volunteer_id = ?"] = req.VolunteerId
+		}
+	}
+	if req.CertType > 0 {
+		queryMap["cert_type = ?"] = req.CertType
+	}
+	if req.ActivityId > 0 {
+		queryMap["activity_id = ?"] = req.ActivityId
+	}
+
+	offset := int((req.Page - 1) * req.PageSize)
+	certs, total, err := s.repo.ListCertificates(s.repo.DB, queryMap, int(req.PageSize), offset)
+	if err != nil {
+		log.Error("证书查询失败: 查询证书列表异常: %v, user_id=%d", err, actor.accountID)
+		return nil, err
+	}
+
+	resp.Total = int32(total)
+	for _, cert := range certs {
+		resp.List = append(resp.List, toCertificateItem(cert))
+	}
+	return resp, nil
+}
+
+// VerifyCertificate 证书公开核验（无需登录）
+// 支持输入证书编号或扫描证书二维码；证书涵盖的工时被作废后返回撤销状态，志愿者姓名脱敏展示。
+func (s *CertificateService) VerifyCertificate(req *api.VerifyCertificateRequest) (*api.VerifyCertificateResponse, error) {
+	input := strings.TrimSpace(req.Code)
+	if input == "" {
+		input = strings.TrimSpace(req.SerialNo)
+	}
+	if input == "" {
+		return nil, errors.New("请输入证书编号或核验码")
+	}
+	serialNo, signPrefix, err := util.ParseCertificateVerifyCode(input)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := s.repo.GetCertificateBySerialNo(s.repo.DB, serialNo)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("未查询到该证书")
+		}
+		log.Error("证书核验失败: 查询证书异常: %v, serial_no=%s", err, serialNo)
+		return nil, err
+	}
+	// 核验码中的签名前缀不匹配时按不存在处理，避免泄露证书信息
+	if signPrefix != "" && !strings.HasPrefix(strings.ToUpper(cert.Signature), signPrefix) {
+		return nil, errors.New("未查询到该证书")
+	}
+
+	if cert.Status == model.CertificateStatusValid {
+		if err := s.revokeCertificateIfWorkHourVoided(cert); err != nil {
+			log.Error("证书核验失败: 校验工时作废状态异常: %v, certificate_id=%d", err, cert.ID)
+			return nil, err
+		}
+	}
+
+	resp := &api.VerifyCertificateResponse{
+		Status:        cert.Status,
+		SerialNo:      cert.SerialNo,
+		CertType:      cert.CertType,
+		OrgName:       cert.OrgName,
+		VolunteerName: util.GetNameMask(cert.VolunteerName),
+		ActivityTitle: cert.ActivityTitle,
+		PeriodStart:   cert.PeriodStart.Format("2006-01-02 15:04:05"),
+		PeriodEnd:     cert.PeriodEnd.Format("2006-01-02 15:04:05"),
+		TotalHours:    cert.TotalHours,
+		ServiceCount:  cert.ServiceCount,
+		IssuedAt:      cert.IssuedAt.Format("2006-01-02 15:04:05"),
+		RevokeReason:  cert.RevokeReason,
+	}
+	if cert.RevokedAt != nil {
+		resp.RevokedAt = cert.RevokedAt.Format("2006-01-02 15:04:05")
+	}
+
+	switch {
+	case !util.VerifyCertificateSignature(appSigningSecret(), certificatePayload(cert), cert.Signature):
+		log.Error("证书核验失败: 证书签名校验不通过, certificate_id=%d serial_no=%s", cert.ID, cert.SerialNo)
+		resp.Message = "证书签名校验不通过，请联系签发组织"
+	case cert.Status != model.CertificateStatusValid:
+		resp.Message = "证书已撤销"
+	default:
+		resp.Valid = true
+		resp.Message = "证书真实有效"
+	}
+	return resp, nil
+}
+
+// DownloadCertificate 下载证书 PDF（志愿者本人或签发组织），按签发快照重新渲染
+func (s *CertificateService) DownloadCertificate(req *api.DownloadCertificateRequest) (*api.DownloadCertificateResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("证书ID不能为空")
+	}
+
+	actor, err := s.currentCertificateActor()
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := s.repo.GetCertificateByID(s.repo.DB, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("证书不存在")
+		}
+		log.Error("下载证书失败: 查询证书异常: %v, certificate_id=%d", err, req.Id)
+		return nil, err
+	}
+	if actor.volunteer != nil && cert.VolunteerID != actor.volunteer.ID {
+		return nil, errors.New("无权下载该证书")
+	}
+	if actor.org != nil && cert.OrgID != actor.org.ID {
+		return nil, errors.New("无权下载该证书")
+	}
+	if cert.Status != model.CertificateStatusValid {
+		return nil, errors.New("证书已撤销，无法下载")
+	}
+	if !util.VerifyCertificateSignature(appSigningSecret(), certificatePayload(cert), cert.Signature) {
+		log.Error("下载证书失败: 证书签名校验不通过, certificate_id=%d serial_no=%s", cert.ID, cert.SerialNo)
+		return nil, errors.New("证书签名校验失败")
+	}
+
+	tpl, images, err := s.loadCertificateRenderTemplate(cert.TemplateVersionID)
+	if err != nil {
+		log.Error("下载证书失败: 查询证书模板版本异常: %v, certificate_id=%d template_version_id=%d", err, cert.ID, cert.TemplateVersionID)
+		return nil, err
+	}
+	content, err := renderCertificatePDF(cert, tpl, images)
+	if err != nil {
+		log.Error("下载证书失败: 生成PDF异常: %v, certificate_id=%d", err, cert.ID)
+		return nil, err
+	}
+	return &api.DownloadCertificateResponse{
+		Content:  content,
+		FileName: cert.SerialNo + ".pdf",
+	}, nil
+}
+
+// revokeCertificateIfWorkHourVoided 证书涵盖的工时流水已被作废时撤销证书，并同步更新入参
+func (s *CertificateService) revokeCertificateIfWorkHourVoided(cert *model.Certificate) error {
+	logIDs := parseCertificateWorkHourLogIDs(cert.WorkHourLogIDs)
+	voided, err := s.repo.CountVoidLogsByRefLogIDs(s.repo.DB, logIDs)
+	if err != nil {
+		return err
+	}
+	if voided == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	if err := s.repo.RevokeCertificate(s.repo.DB, cert.ID, certificateRevokeReasonWorkHourVoided, now); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		// 并发撤销，以库中记录为准
+		latest, err := s.repo.GetCertificateByID(s.repo.DB, cert.ID)
+		if err != nil {
+			return err
+		}
+		*cert = *latest
+		return nil
+	}
+	cert.Status = model.CertificateStatusRevoked
+	cert.RevokedAt = &now
+	cert.RevokeReason = certificateRevokeReasonWorkHourVoided
+	return nil
+}
+
+// currentCertificateActor 解析当前操作的组织（经组织权限中间件授权）或当前登录账号对应的志愿者
+func (s *CertificateService) currentCertificateActor() (*certificateActor, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.repo.FindByID(s.repo.DB, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("账号不存在")
+		}
+		return nil, err
+	}
+
+	actor := &certificateActor{accountID: userID}
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok {
+		// 组织（含协作管理员）
+		org, err := s.repo.GetOrganizationByID(s.repo.DB, orgID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("组织信息不存在")
+			}
+			log.Error("查询证书操作人失败: 查询组织信息异常: %v, org_id=%d", err, orgID)
+			return nil, err
+		}
+		actor.org = org
+		return actor, nil
+	}
+
+	if account.IdentityType != model.RegisterTypeVolunteerCode {
+		return nil, errors.New("账号身份无效")
+	}
+	volunteer, err := s.repo.FindVolunteerByAccountID(s.repo.DB, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("志愿者信息不存在")
+		}
+		log.Error("查询证书操作人失败: 查询志愿者信息异常: %v, user_id=%d", err, userID)
+		return nil, err
+	}
+	if volunteer == nil {
+		return nil, errors.New("志愿者信息不存在")
+	}
+	actor.volunteer = volunteer
+	return actor, nil
+}
+
+// parseCertificatePeriod 解析累计证书的统计区间（按自然日，结束日期包含当天）
+func parseCertificatePeriod(startDate, endDate string) (time.Time, time.Time, error) {
+	if strings.TrimSpace(startDate) == "" || strings.TrimSpace(endDate) == "" {
+		return time.Time{}, time.Time{}, errors.New("累计证书需指定开始和结束日期")
+	}
+	start, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(startDate), time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("开始日期格式错误，应为yyyy-MM-dd")
+	}
+	end, err := time.ParseInLocation("2006-01-02", strings.TrimSpace(endDate), time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("结束日期格式错误，应为yyyy-MM-dd")
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, errors.New("结束日期不能早于开始日期")
+	}
+	if end.Sub(start) > maxCertificatePeriodDays*24*time.Hour {
+		return time.Time{}, time.Time{}, errors.New("累计证书统计区间不能超过5年")
+	}
+	return start, end.AddDate(0, 0, 1).Add(-time.Second), nil
+}
+
+// parseCertificateWorkHourLogIDs 解析证书涵盖的工时流水ID
+func parseCertificateWorkHourLogIDs(raw string) []int64 {
+	var ids []int64
+	for _, part := range strings.Split(raw, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(part), 10, 64)
+		if err == nil && id > 0 {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// certificatePayload 证书签名原文
+func certificatePayload(cert *model.Certificate) string {
+	return util.CertificatePayload(cert.SerialNo, cert.VolunteerID, cert.OrgID, cert.ActivityID, cert.TotalHours, cert.IssuedAt)
+}
+
+func toCertificateItem(cert *model.Certificate) *api.CertificateItem {
+	item := &api.CertificateItem{
+		Id:            cert.ID,
+		SerialNo:      cert.SerialNo,
+		CertType:      cert.CertType,
+		VolunteerId:   cert.VolunteerID,
+		VolunteerName: cert.VolunteerName,
+		OrgId:         cert.OrgID,
+		OrgName:       cert.OrgName,
+		ActivityId:    cert.ActivityID,
+		ActivityTitle: cert.ActivityTitle,
+		PeriodStart:   cert.PeriodStart.Format("2006-01-02 15:04:05"),
+		PeriodEnd:     cert.PeriodEnd.Format("2006-01-02 15:04:05"),
+		TotalHours:    cert.TotalHours,
+		ServiceCount:  cert.ServiceCount,
+		Status:        cert.Status,
+		IssuedAt:      cert.IssuedAt.Format("2006-01-02 15:04:05"),
+	}
+	if cert.RevokedAt != nil {
+		item.RevokedAt = cert.RevokedAt.Format("2006-01-02 15:04:05")
+	}
+	item.RevokeReason = cert.RevokeReason
+	return item
+}
@@ -0,0 +1,199 @@
+package service
+
+import (
+	"image"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"volunteer-system/config"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+)
+
+// 系统默认证书样式（组织未配置模板时使用）
+const (
+	defaultCertificateTitle          = "志愿服务证书"
+	defaultCertificateActivityBody   = "{{volunteerName}} 同志：\n　　于{{startDate}}至{{endDate}}参加由{{orgName}}组织的“{{activityTitle}}”志愿服务活动，累计服务{{hours}}小时。感谢您的无私奉献，特发此证，以资鼓励。"
+	defaultCertificateCumulativeBody = "{{volunteerName}} 同志：\n　　于{{startDate}}至{{endDate}}期间参加由{{orgName}}组织的志愿服务活动{{serviceCount}}次，累计服务{{hours}}小时。感谢您的无私奉献，特发此证，以资鼓励。"
+	defaultCertificateThemeColor     = "#A82020"
+)
+
+// certificateImages 证书渲染用到的图片，未配置时为 nil
+type certificateImages struct {
+	logo image.Image
+	seal image.Image
+}
+
+// defaultCertificateTemplateVersion 系统默认证书样式
+func defaultCertificateTemplateVersion() *model.CertificateTemplateVersion {
+	return &model.CertificateTemplateVersion{
+		Title:          defaultCertificateTitle,
+		ActivityBody:   defaultCertificateActivityBody,
+		CumulativeBody: defaultCertificateCumulativeBody,
+		Layout:         model.CertificateLayoutLandscape,
+		ThemeColor:     defaultCertificateThemeColor,
+	}
+}
+
+// renderCertificatePDF 按证书快照与模板版本渲染 PDF，tpl 为 nil 时使用系统默认样式
+func renderCertificatePDF(cert *model.Certificate, tpl *model.CertificateTemplateVersion, images *certificateImages) ([]byte, error) {
+	if tpl == nil {
+		tpl = defaultCertificateTemplateVersion()
+	}
+	if images == nil {
+		images = &certificateImages{}
+	}
+
+	// 横版与竖版的版面参数
+	width, height := util.PDFPageA4LandscapeWidth, util.PDFPageA4LandscapeHeight
+	marginX, titleY, bodyY, signBottom := 96.0, 120.0, 210.0, 112.0
+	if tpl.Layout == model.CertificateLayoutPortrait {
+		width, height = util.PDFPageA4LandscapeHeight, util.PDFPageA4LandscapeWidth
+		marginX, titleY, bodyY, signBottom = 72.0, 170.0, 280.0, 230.0
+	}
+	doc := util.NewPDFDocument(width, height)
+	doc.AddPage()
+
+	r, g, b, err := util.ParseHexColor(tpl.ThemeColor)
+	if err != nil {
+		r, g, b, _ = util.ParseHexColor(defaultCertificateThemeColor)
+	}
+
+	// 双线边框
+	doc.SetColor(r, g, b)
+	doc.Rect(24, 24, width-48, height-48, 3)
+	doc.Rect(34, 34, width-68, height-68, 0.8)
+
+	if images.logo != nil {
+		drawCertificateImage(doc, images.logo, 56, 56, 140, 56)
+	}
+
+	title := tpl.Title
+	if title == "" {
+		title = defaultCertificateTitle
+	}
+	doc.TextCenter(titleY, 36, title)
+	doc.SetColor(120, 120, 120)
+	doc.TextCenter(titleY+28, 12, "CERTIFICATE OF VOLUNTEER SERVICE")
+
+	body := tpl.ActivityBody
+	if cert.CertType == model.CertificateTypeCumulative {
+		body = tpl.CumulativeBody
+	}
+	body = util.RenderCertificateTemplateText(body, certificateTemplateValues(cert))
+
+	doc.SetColor(33, 33, 33)
+	y := bodyY
+	for _, line := range doc.WrapText(16, width-marginX*2, body) {
+		doc.Text(marginX, y, 16, line)
+		y += 30
+	}
+
+	// 右下角落款：署名、组织、日期，印章盖在组织名称上
+	right := width - marginX
+	orgY := height - signBottom - 28
+	if tpl.Signatory != "" {
+		doc.TextRight(right, orgY-28, 16, tpl.Signatory)
+	}
+	doc.TextRight(right, orgY, 16, cert.OrgName)
+	doc.TextRight(right, height-signBottom, 14, cert.IssuedAt.Format("2006年01月02日"))
+	if images.seal != nil {
+		centerX := right - doc.TextWidth(16, cert.OrgName)/2
+		drawCertificateImage(doc, images.seal, centerX-55, orgY-60, 110, 110)
+	}
+
+	// 左下角核验二维码与核验码
+	qrImage, err := util.RenderQRCodeImage(certificateVerifyContent(cert), certificateQRCodeImageSize)
+	if err != nil {
+		return nil, err
+	}
+	doc.Image(qrImage, marginX, height-196, 72, 72)
+	doc.SetColor(120, 120, 120)
+	doc.Text(marginX+84, height-170, 10, "扫码或凭核验码查验证书真伪")
+	doc.Text(marginX+84, height-150, 10, "证书编号："+cert.SerialNo)
+	doc.Text(marginX+84, height-132, 10, "核验码："+util.CertificateVerifyCode(cert.SerialNo, cert.Signature))
+
+	return doc.Bytes()
+}
+
+// drawCertificateImage 在 maxW×maxH 的区域内按原比例绘制图片，(x, y) 为区域左上角
+func drawCertificateImage(doc *util.PDFDocument, img image.Image, x, y, maxW, maxH float64) {
+	bounds := img.Bounds()
+	if bounds.Dx() == 0 || bounds.Dy() == 0 {
+		return
+	}
+	w, h := maxW, maxW*float64(bounds.Dy())/float64(bounds.Dx())
+	if h > maxH {
+		w, h = maxH*float64(bounds.Dx())/float64(bounds.Dy()), maxH
+	}
+	doc.Image(img, x+(maxW-w)/2, y+(maxH-h)/2, w, h)
+}
+
+// certificateTemplateValues 证书模板占位符取值
+func certificateTemplateValues(cert *model.Certificate) map[string]string {
+	return map[string]string{
+		util.CertificatePlaceholderVolunteerName: cert.VolunteerName,
+		util.CertificatePlaceholderOrgName:       cert.OrgName,
+		util.CertificatePlaceholderActivityTitle: cert.ActivityTitle,
+		util.CertificatePlaceholderHours:         strconv.FormatFloat(cert.TotalHours, 'f', -1, 64),
+		util.CertificatePlaceholderServiceCount:  strconv.Itoa(int(cert.ServiceCount)),
+		util.CertificatePlaceholderStartDate:     cert.PeriodStart.Format("2006年01月02日"),
+		util.CertificatePlaceholderEndDate:       cert.PeriodEnd.Format("2006年01月02日"),
+		util.CertificatePlaceholderIssueDate:     cert.IssuedAt.Format("2006年01月02日"),
+		util.CertificatePlaceholderSerialNo:      cert.SerialNo,
+	}
+}
+
+// certificateVerifyContent 证书二维码内容：配置了核验页地址时为核验页链接，否则为核验码
+func certificateVerifyContent(cert *model.Certificate) string {
+	code := util.CertificateVerifyCode(cert.SerialNo, cert.Signature)
+	cfg := config.GetConfig()
+	if cfg == nil || cfg.Certificate == nil || strings.TrimSpace(cfg.Certificate.VerifyURL) == "" {
+		return code
+	}
+	verifyURL := strings.TrimSpace(cfg.Certificate.VerifyURL)
+	sep := "?"
+	if strings.Contains(verifyURL, "?") {
+		sep = "&"
+	}
+	return verifyURL + sep + "code=" + url.QueryEscape(code)
+}
+
+// uploadDir 上传文件根目录
+func uploadDir() string {
+	cfg := config.GetConfig()
+	if cfg == nil || cfg.Upload == nil || cfg.Upload.Dir == "" {
+		return "./uploads"
+	}
+	return cfg.Upload.Dir
+}
+
+// loadCertificateTemplateImages 读取模板版本保存在本地的 Logo 与印章图片，文件缺失时忽略该图片
+func loadCertificateTemplateImages(tpl *model.CertificateTemplateVersion) *certificateImages {
+	images := &certificateImages{}
+	if tpl == nil {
+		return images
+	}
+	images.logo = loadCertificateTemplateImage(tpl.LogoPath)
+	images.seal = loadCertificateTemplateImage(tpl.SealPath)
+	return images
+}
+
+func loadCertificateTemplateImage(relPath string) image.Image {
+	if relPath == "" {
+		return nil
+	}
+	data, err := os.ReadFile(filepath.Join(uploadDir(), filepath.FromSlash(relPath)))
+	if err != nil {
+		log.Warn("读取证书模板图片失败: %v, path=%s", err, relPath)
+		return nil
+	}
+	img, err := util.DecodeImage(data)
+	if err != nil {
+		log.Warn("解码证书模板图片失败: %v, path=%s", err, relPath)
+		return nil
+	}
+	return img
+}
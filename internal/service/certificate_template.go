@@ -0,0 +1,561 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"image"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultCertificateTemplatePageSize    = 20
+	maxCertificateTemplatePageSize        = 100
+	maxCertificateTemplateNameLength      = 64
+	maxCertificateTemplateTitleLength     = 32
+	maxCertificateTemplateBodyLength      = 1000
+	maxCertificateTemplateSignatoryLength = 64
+	// maxCertificateTemplateImageBytes Logo、印章图片大小上限
+	maxCertificateTemplateImageBytes = 2 << 20
+	// certificateTemplateImageDir 模板图片在上传目录下的存储子目录
+	certificateTemplateImageDir = "certificate-templates"
+)
+
+// CreateCertificateTemplate 创建证书模板（组织侧）
+func (s *CertificateService) CreateCertificateTemplate(req *api.CreateCertificateTemplateRequest) (*api.CreateCertificateTemplateResponse, error) {
+	name, err := normalizeCertificateTemplateName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := s.currentCertificateOrgActor()
+	if err != nil {
+		return nil, err
+	}
+
+	version, _, err := s.prepareCertificateTemplateVersion(actor.org, req.Content, nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	tpl := &model.CertificateTemplate{
+		OrgID:     actor.org.ID,
+		Name:      name,
+		Version:   1,
+		IsDefault: certificateTemplateDefaultFlag(req.IsDefault),
+		Status:    model.CertificateTemplateStatusEnabled,
+		CreatedBy: actor.accountID,
+	}
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		if err := s.repo.CreateCertificateTemplate(tx, tpl); err != nil {
+			return err
+		}
+		version.TemplateID = tpl.ID
+		version.OrgID = tpl.OrgID
+		version.Version = tpl.Version
+		version.CreatedBy = actor.accountID
+		if err := s.repo.CreateCertificateTemplateVersion(tx, version); err != nil {
+			return err
+		}
+		if err := s.repo.UpdateCertificateTemplateByID(tx, tpl.ID, map[string]any{"current_version_id": version.ID}); err != nil {
+			return err
+		}
+		if req.IsDefault {
+			return s.repo.ClearDefaultCertificateTemplates(tx, tpl.OrgID, tpl.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("创建证书模板失败: 保存模板异常: %v, org_id=%d", err, actor.org.ID)
+		return nil, err
+	}
+
+	return &api.CreateCertificateTemplateResponse{Id: tpl.ID, Version: tpl.Version}, nil
+}
+
+// UpdateCertificateTemplate 修改证书模板（组织侧）
+// 每次修改生成新版本，已签发的证书仍按签发时的版本渲染。
+func (s *CertificateService) UpdateCertificateTemplate(req *api.UpdateCertificateTemplateRequest) (*api.UpdateCertificateTemplateResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("模板ID不能为空")
+	}
+	name, err := normalizeCertificateTemplateName(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	actor, err := s.currentCertificateOrgActor()
+	if err != nil {
+		return nil, err
+	}
+
+	tpl, err := s.getOwnedCertificateTemplate(req.Id, actor.org.ID)
+	if err != nil {
+		return nil, err
+	}
+	current, err := s.repo.GetCertificateTemplateVersionByID(s.repo.DB, tpl.CurrentVersionID)
+	if err != nil {
+		log.Error("修改证书模板失败: 查询当前版本异常: %v, template_id=%d version_id=%d", err, tpl.ID, tpl.CurrentVersionID)
+		return nil, err
+	}
+
+	version, _, err := s.prepareCertificateTemplateVersion(actor.org, req.Content, current, true)
+	if err != nil {
+		return nil, err
+	}
+
+	var newVersion int32
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		locked, err := s.repo.GetCertificateTemplateByIDForUpdate(tx, tpl.ID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return errors.New("证书模板不存在")
+			}
+			return err
+		}
+		newVersion = locked.Version + 1
+		version.TemplateID = locked.ID
+		version.OrgID = locked.OrgID
+		version.Version = newVersion
+		version.CreatedBy = actor.accountID
+		if err := s.repo.CreateCertificateTemplateVersion(tx, version); err != nil {
+			return err
+		}
+		if err := s.repo.UpdateCertificateTemplateByID(tx, locked.ID, map[string]any{
+			"name":               name,
+			"version":            newVersion,
+			"current_version_id": version.ID,
+			"is_default":         certificateTemplateDefaultFlag(req.IsDefault),
+		}); err != nil {
+			return err
+		}
+		if req.IsDefault {
+			return s.repo.ClearDefaultCertificateTemplates(tx, locked.OrgID, locked.ID)
+		}
+		return nil
+	})
+	if err != nil {
+		log.Error("修改证书模板失败: 保存模板异常: %v, template_id=%d", err, tpl.ID)
+		return nil, err
+	}
+
+	return &api.UpdateCertificateTemplateResponse{Id: tpl.ID, Version: newVersion}, nil
+}
+
+// DeleteCertificateTemplate 删除证书模板（组织侧，仅标记删除，历史版本保留供已签发证书使用）
+func (s *CertificateService) DeleteCertificateTemplate(req *api.DeleteCertificateTemplateRequest) (*api.DeleteCertificateTemplateResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("模板ID不能为空")
+	}
+
+	actor, err := s.currentCertificateOrgActor()
+	if err != nil {
+		return nil, err
+	}
+
+	tpl, err := s.getOwnedCertificateTemplate(req.Id, actor.org.ID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateCertificateTemplateByID(s.repo.DB, tpl.ID, map[string]any{
+		"status":     model.CertificateTemplateStatusDeleted,
+		"is_default": 0,
+	}); err != nil {
+		log.Error("删除证书模板失败: 更新模板状态异常: %v, template_id=%d", err, tpl.ID)
+		return nil, err
+	}
+
+	return &api.DeleteCertificateTemplateResponse{Success: true}, nil
+}
+
+// CertificateTemplateList 证书模板列表（组织侧）
+func (s *CertificateService) CertificateTemplateList(req *api.CertificateTemplateListRequest) (*api.CertificateTemplateListResponse, error) {
+	resp := &api.CertificateTemplateListResponse{
+		Total: 0,
+		List:  []*api.CertificateTemplateItem{},
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = defaultCertificateTemplatePageSize
+	}
+	if req.PageSize > maxCertificateTemplatePageSize {
+		req.PageSize = maxCertificateTemplatePageSize
+	}
+
+	actor, err := s.currentCertificateOrgActor()
+	if err != nil {
+		return nil, err
+	}
+
+	offset := int((req.Page - 1) * req.PageSize)
+	templates, total, err := s.repo.ListCertificateTemplates(s.repo.DB, actor.org.ID, int(req.PageSize), offset)
+	if err != nil {
+		log.Error("证书模板查询失败: 查询模板列表异常: %v, org_id=%d", err, actor.org.ID)
+		return nil, err
+	}
+
+	versionIDs := make([]int64, 0, len(templates))
+	for _, tpl := range templates {
+		versionIDs = append(versionIDs, tpl.CurrentVersionID)
+	}
+	versions, err := s.repo.GetCertificateTemplateVersionsByIDs(s.repo.DB, versionIDs)
+	if err != nil {
+		log.Error("证书模板查询失败: 查询模板版本异常: %v, org_id=%d", err, actor.org.ID)
+		return nil, err
+	}
+
+	resp.Total = int32(total)
+	for _, tpl := range templates {
+		resp.List = append(resp.List, toCertificateTemplateItem(tpl, versions[tpl.CurrentVersionID]))
+	}
+	return resp, nil
+}
+
+// CertificateTemplateDetail 证书模板详情（组织侧，含历史版本）
+func (s *CertificateService) CertificateTemplateDetail(req *api.CertificateTemplateDetailRequest) (*api.CertificateTemplateDetailResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("模板ID不能为空")
+	}
+
+	actor, err := s.currentCertificateOrgActor()
+	if err != nil {
+		return nil, err
+	}
+
+	tpl, err := s.getOwnedCertificateTemplate(req.Id, actor.org.ID)
+	if err != nil {
+		return nil, err
+	}
+	versions, err := s.repo.ListCertificateTemplateVersions(s.repo.DB, tpl.ID)
+	if err != nil {
+		log.Error("证书模板详情查询失败: 查询模板版本异常: %v, template_id=%d", err, tpl.ID)
+		return nil, err
+	}
+
+	resp := &api.CertificateTemplateDetailResponse{
+		Versions: make([]*api.CertificateTemplateVersionItem, 0, len(versions)),
+	}
+	for _, version := range versions {
+		if version.ID == tpl.CurrentVersionID {
+			resp.Template = toCertificateTemplateItem(tpl, version)
+		}
+		resp.Versions = append(resp.Versions, &api.CertificateTemplateVersionItem{
+			Id:        version.ID,
+			Version:   version.Version,
+			Content:   toCertificateTemplateContent(version),
+			CreatedBy: version.CreatedBy,
+			CreatedAt: version.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	if resp.Template == nil {
+		resp.Template = toCertificateTemplateItem(tpl, nil)
+	}
+	return resp, nil
+}
+
+// PreviewCertificateTemplate 预览证书模板（组织侧），使用示例数据渲染 PDF，不生成证书记录
+func (s *CertificateService) PreviewCertificateTemplate(req *api.PreviewCertificateTemplateRequest) (*api.DownloadCertificateResponse, error) {
+	certType := req.CertType
+	if certType == 0 {
+		certType = model.CertificateTypeActivity
+	}
+	if certType != model.CertificateTypeActivity && certType != model.CertificateTypeCumulative {
+		return nil, errors.New("证书类型不合法")
+	}
+
+	actor, err := s.currentCertificateOrgActor()
+	if err != nil {
+		return nil, err
+	}
+
+	var version *model.CertificateTemplateVersion
+	var images *certificateImages
+	if req.TemplateId > 0 {
+		tpl, err := s.getOwnedCertificateTemplate(req.TemplateId, actor.org.ID)
+		if err != nil {
+			return nil, err
+		}
+		version, err = s.repo.GetCertificateTemplateVersionByID(s.repo.DB, tpl.CurrentVersionID)
+		if err != nil {
+			log.Error("预览证书模板失败: 查询当前版本异常: %v, template_id=%d version_id=%d", err, tpl.ID, tpl.CurrentVersionID)
+			return nil, err
+		}
+		images = loadCertificateTemplateImages(version)
+	} else {
+		version, images, err = s.prepareCertificateTemplateVersion(actor.org, req.Content, nil, false)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	sample := &model.Certificate{
+		SerialNo:      "VC" + now.Format("20060102") + "PREVIEW000",
+		CertType:      certType,
+		VolunteerName: "张三",
+		OrgName:       actor.org.OrgName,
+		ActivityTitle: "社区环保志愿服务",
+		PeriodStart:   now.Add(-4 * time.Hour),
+		PeriodEnd:     now.Add(-30 * time.Minute),
+		TotalHours:    3.5,
+		ServiceCount:  1,
+		IssuedAt:      now,
+	}
+	if certType == model.CertificateTypeCumulative {
+		sample.ActivityTitle = ""
+		sample.PeriodStart = now.AddDate(-1, 0, 0)
+		sample.PeriodEnd = now
+		sample.TotalHours = 48
+		sample.ServiceCount = 12
+	}
+
+	content, err := renderCertificatePDF(sample, version, images)
+	if err != nil {
+		log.Error("预览证书模板失败: 生成PDF异常: %v, org_id=%d", err, actor.org.ID)
+		return nil, err
+	}
+	return &api.DownloadCertificateResponse{
+		Content:  content,
+		FileName: "certificate-preview.pdf",
+	}, nil
+}
+
+// resolveCertificateTemplateVersion 确定签发证书使用的模板版本：指定模板优先，其次组织默认模板，均无时返回 0（系统默认样式）
+func (s *CertificateService) resolveCertificateTemplateVersion(orgID, templateID int64) (int64, error) {
+	if templateID > 0 {
+		tpl, err := s.getOwnedCertificateTemplate(templateID, orgID)
+		if err != nil {
+			return 0, err
+		}
+		return tpl.CurrentVersionID, nil
+	}
+	tpl, err := s.repo.GetDefaultCertificateTemplate(s.repo.DB, orgID)
+	if err != nil {
+		log.Error("查询组织默认证书模板失败: %v, org_id=%d", err, orgID)
+		return 0, err
+	}
+	if tpl == nil {
+		return 0, nil
+	}
+	return tpl.CurrentVersionID, nil
+}
+
+// loadCertificateRenderTemplate 读取证书签发时使用的模板版本及图片，versionID 为 0 时使用系统默认样式
+func (s *CertificateService) loadCertificateRenderTemplate(versionID int64) (*model.CertificateTemplateVersion, *certificateImages, error) {
+	if versionID <= 0 {
+		return nil, nil, nil
+	}
+	version, err := s.repo.GetCertificateTemplateVersionByID(s.repo.DB, versionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return version, loadCertificateTemplateImages(version), nil
+}
+
+// currentCertificateOrgActor 当前账号须为组织
+func (s *CertificateService) currentCertificateOrgActor() (*certificateActor, error) {
+	actor, err := s.currentCertificateActor()
+	if err != nil {
+		return nil, err
+	}
+	if actor.org == nil {
+		return nil, errors.New("仅组织账号可以管理证书模板")
+	}
+	return actor, nil
+}
+
+// getOwnedCertificateTemplate 获取本组织未删除的证书模板
+func (s *CertificateService) getOwnedCertificateTemplate(templateID, orgID int64) (*model.CertificateTemplate, error) {
+	tpl, err := s.repo.GetCertificateTemplateByID(s.repo.DB, templateID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("证书模板不存在")
+		}
+		log.Error("查询证书模板失败: %v, template_id=%d", err, templateID)
+		return nil, err
+	}
+	if tpl.OrgID != orgID {
+		return nil, errors.New("无权使用该证书模板")
+	}
+	return tpl, nil
+}
+
+// prepareCertificateTemplateVersion 校验模板内容并生成版本快照（未填写的项使用系统默认值）。
+// Logo 取组织当前 logo_url，印章取模板 seal_url；persist 为 true 时图片保存到上传目录，
+// 与上一版本地址相同时复用已保存的图片。
+func (s *CertificateService) prepareCertificateTemplateVersion(org *model.Organization, content *api.CertificateTemplateContent, previous *model.CertificateTemplateVersion, persist bool) (*model.CertificateTemplateVersion, *certificateImages, error) {
+	if content == nil {
+		content = &api.CertificateTemplateContent{}
+	}
+
+	version := defaultCertificateTemplateVersion()
+	if title := strings.TrimSpace(content.Title); title != "" {
+		if utf8.RuneCountInString(title) > maxCertificateTemplateTitleLength {
+			return nil, nil, errors.New("证书标题不能超过32个字符")
+		}
+		version.Title = title
+	}
+	if body := strings.TrimSpace(content.ActivityBody); body != "" {
+		version.ActivityBody = body
+	}
+	if body := strings.TrimSpace(content.CumulativeBody); body != "" {
+		version.CumulativeBody = body
+	}
+	for _, body := range []string{version.ActivityBody, version.CumulativeBody} {
+		if utf8.RuneCountInString(body) > maxCertificateTemplateBodyLength {
+			return nil, nil, errors.New("证书正文不能超过1000个字符")
+		}
+		if err := util.ValidateCertificateTemplateText(body); err != nil {
+			return nil, nil, err
+		}
+	}
+	version.Signatory = strings.TrimSpace(content.Signatory)
+	if utf8.RuneCountInString(version.Signatory) > maxCertificateTemplateSignatoryLength {
+		return nil, nil, errors.New("落款署名不能超过64个字符")
+	}
+	if content.Layout != 0 {
+		if !model.IsValidCertificateLayout(content.Layout) {
+			return nil, nil, errors.New("证书版式不合法")
+		}
+		version.Layout = content.Layout
+	}
+	if color := strings.TrimSpace(content.ThemeColor); color != "" {
+		if _, _, _, err := util.ParseHexColor(color); err != nil {
+			return nil, nil, errors.New("主题色格式应为#RRGGBB")
+		}
+		version.ThemeColor = strings.ToUpper(color)
+	}
+
+	images := &certificateImages{}
+	var err error
+	if content.ShowLogo {
+		version.ShowLogo = 1
+		if org.LogoURL != "" {
+			version.LogoURL = org.LogoURL
+			var previousURL, previousPath string
+			if previous != nil {
+				previousURL, previousPath = previous.LogoURL, previous.LogoPath
+			}
+			version.LogoPath, images.logo, err = s.prepareCertificateTemplateImage(org.ID, version.LogoURL, previousURL, previousPath, persist)
+			if err != nil {
+				return nil, nil, errors.New("组织Logo无法使用: " + err.Error())
+			}
+		}
+	}
+	if sealURL := strings.TrimSpace(content.SealUrl); sealURL != "" {
+		version.SealURL = sealURL
+		var previousURL, previousPath string
+		if previous != nil {
+			previousURL, previousPath = previous.SealURL, previous.SealPath
+		}
+		version.SealPath, images.seal, err = s.prepareCertificateTemplateImage(org.ID, sealURL, previousURL, previousPath, persist)
+		if err != nil {
+			return nil, nil, errors.New("印章图片无法使用: " + err.Error())
+		}
+	}
+	return version, images, nil
+}
+
+// prepareCertificateTemplateImage 获取模板图片：地址未变化时复用上一版本已保存的文件，否则重新下载
+func (s *CertificateService) prepareCertificateTemplateImage(orgID int64, imageURL, previousURL, previousPath string, persist bool) (string, image.Image, error) {
+	if imageURL == previousURL && previousPath != "" {
+		if img := loadCertificateTemplateImage(previousPath); img != nil {
+			return previousPath, img, nil
+		}
+	}
+
+	data, ext, err := util.DownloadImage(s.ctx, imageURL, maxCertificateTemplateImageBytes)
+	if err != nil {
+		return "", nil, err
+	}
+	img, err := util.DecodeImage(data)
+	if err != nil {
+		return "", nil, errors.New("图片解码失败")
+	}
+	if !persist {
+		return "", img, nil
+	}
+	relPath, err := saveCertificateTemplateImage(orgID, data, ext)
+	if err != nil {
+		log.Error("保存证书模板图片失败: %v, org_id=%d", err, orgID)
+		return "", nil, errors.New("图片保存失败")
+	}
+	return relPath, img, nil
+}
+
+// saveCertificateTemplateImage 将模板图片按内容哈希保存到上传目录，返回相对路径
+func saveCertificateTemplateImage(orgID int64, data []byte, ext string) (string, error) {
+	sum := sha256.Sum256(data)
+	relPath := path.Join(certificateTemplateImageDir, strconv.FormatInt(orgID, 10), hex.EncodeToString(sum[:16])+ext)
+	fullPath := filepath.Join(uploadDir(), filepath.FromSlash(relPath))
+	if _, err := os.Stat(fullPath); err == nil {
+		return relPath, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(fullPath, data, 0o644); err != nil {
+		return "", err
+	}
+	return relPath, nil
+}
+
+func toCertificateTemplateItem(tpl *model.CertificateTemplate, version *model.CertificateTemplateVersion) *api.CertificateTemplateItem {
+	return &api.CertificateTemplateItem{
+		Id:        tpl.ID,
+		Name:      tpl.Name,
+		Version:   tpl.Version,
+		IsDefault: tpl.IsDefault == 1,
+		Content:   toCertificateTemplateContent(version),
+		CreatedAt: tpl.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt: tpl.UpdatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func toCertificateTemplateContent(version *model.CertificateTemplateVersion) *api.CertificateTemplateContent {
+	if version == nil {
+		return nil
+	}
+	return &api.CertificateTemplateContent{
+		Title:          version.Title,
+		ActivityBody:   version.ActivityBody,
+		CumulativeBody: version.CumulativeBody,
+		Signatory:      version.Signatory,
+		ShowLogo:       version.ShowLogo == 1,
+		SealUrl:        version.SealURL,
+		Layout:         version.Layout,
+		ThemeColor:     version.ThemeColor,
+	}
+}
+
+func certificateTemplateDefaultFlag(isDefault bool) int32 {
+	if isDefault {
+		return 1
+	}
+	return 0
+}
+
+// normalizeCertificateTemplateName 校验模板名称
+func normalizeCertificateTemplateName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", errors.New("模板名称不能为空")
+	}
+	if utf8.RuneCountInString(name) > maxCertificateTemplateNameLength {
+		return "", errors.New("模板名称不能超过64个字符")
+	}
+	return name, nil
+}
@@ -0,0 +1,336 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"volunteer-system/config"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultCreditInitialScore      int32 = 100
+	defaultCreditNoShowPenalty     int32 = 10
+	defaultCreditStreakLength      int32 = 5
+	defaultCreditStreakBonus       int32 = 2
+	defaultCreditSignupRejectBelow int32 = 60
+
+	defaultCreditLogPageSize = 50
+	maxCreditLogPageSize     = 100
+)
+
+// creditPolicy 信用分策略
+type creditPolicy struct {
+	InitialScore      int32 // 信用分上限（即初始分）
+	NoShowPenalty     int32 // 每次爽约扣分
+	StreakLength      int32 // 连续出勤多少次奖励一次
+	StreakBonus       int32 // 连续出勤奖励分
+	SignupRejectBelow int32 // 低于该分数拒绝报名（<=0 表示不限制）
+}
+
+// attendanceSettlement 活动出勤结算结果
+type attendanceSettlement struct {
+	Attended int32
+	NoShow   int32
+}
+
+// currentCreditPolicy 读取信用分策略，未配置（为0）的项使用默认值，扣分与奖励项配置为负数时同样使用默认值。
+func currentCreditPolicy() creditPolicy {
+	policy := creditPolicy{
+		InitialScore:      defaultCreditInitialScore,
+		NoShowPenalty:     defaultCreditNoShowPenalty,
+		StreakLength:      defaultCreditStreakLength,
+		StreakBonus:       defaultCreditStreakBonus,
+		SignupRejectBelow: defaultCreditSignupRejectBelow,
+	}
+	cfg := config.GetConfig()
+	if cfg == nil || cfg.Credit == nil {
+		return policy
+	}
+	if cfg.Credit.InitialScore > 0 {
+		policy.InitialScore = int32(cfg.Credit.InitialScore)
+	}
+	// 扣分、奖励为负数会使爽约加分、连续出勤扣分，视为配置错误并沿用默认值
+	if cfg.Credit.NoShowPenalty > 0 {
+		policy.NoShowPenalty = int32(cfg.Credit.NoShowPenalty)
+	} else if cfg.Credit.NoShowPenalty < 0 {
+		log.Warn("信用分配置: 爽约扣分不能为负数，使用默认值, no_show_penalty=%d", cfg.Credit.NoShowPenalty)
+	}
+	if cfg.Credit.StreakLength > 0 {
+		policy.StreakLength = int32(cfg.Credit.StreakLength)
+	} else if cfg.Credit.StreakLength < 0 {
+		log.Warn("信用分配置: 连续出勤次数不能为负数，使用默认值, streak_length=%d", cfg.Credit.StreakLength)
+	}
+	if cfg.Credit.StreakBonus > 0 {
+		policy.StreakBonus = int32(cfg.Credit.StreakBonus)
+	} else if cfg.Credit.StreakBonus < 0 {
+		log.Warn("信用分配置: 连续出勤奖励分不能为负数，使用默认值, streak_bonus=%d", cfg.Credit.StreakBonus)
+	}
+	// 报名门槛配置为负数（如 -1）表示不限制
+	if cfg.Credit.SignupRejectBelow > 0 {
+		policy.SignupRejectBelow = int32(cfg.Credit.SignupRejectBelow)
+	} else if cfg.Credit.SignupRejectBelow < 0 {
+		policy.SignupRejectBelow = 0
+	}
+	return policy
+}
+
+// settleActivityAttendance 活动结束后判定出勤结果并结算信用分。
+// 报名成功但未签到的记录判定为爽约并扣分，已签到的记录累计连续出勤次数并按策略奖励。
+// 每条报名在独立事务中处理，已判定的记录会被跳过，可重复调用。
+func (s *Service) settleActivityAttendance(activity *model.Activity, operatorID int64) (*attendanceSettlement, error) {
+	result := &attendanceSettlement{}
+	signupIDs, err := s.repo.ListUnsettledAttendanceSignupIDs(s.repo.DB, activity.ID)
+	if err != nil {
+		return result, err
+	}
+
+	policy := currentCreditPolicy()
+	for _, signupID := range signupIDs {
+		var attendanceResult int32
+		err := s.withTransaction(func(tx *gorm.DB) error {
+			signup, err := s.repo.GetActivitySignupByIDForUpdate(tx, signupID)
+			if err != nil {
+				return err
+			}
+			if signup.Status != model.ActivitySignupStatusSuccess || signup.AttendanceResult != model.AttendanceResultPending {
+				return nil
+			}
+			attendanceResult, err = s.settleSignupAttendance(tx, activity, signup, policy, operatorID)
+			return err
+		})
+		if err != nil {
+			log.Error("出勤结算失败: 处理报名记录异常: %v, activity_id=%d signup_id=%d", err, activity.ID, signupID)
+			return result, err
+		}
+		switch attendanceResult {
+		case model.AttendanceResultAttended:
+			result.Attended++
+		case model.AttendanceResultNoShow:
+			result.NoShow++
+		}
+	}
+	return result, nil
+}
+
+// settleSignupAttendance 在事务内判定单条报名的出勤结果并写入信用分流水。
+func (s *Service) settleSignupAttendance(tx *gorm.DB, activity *model.Activity, signup *model.ActivitySignup, policy creditPolicy, operatorID int64) (int32, error) {
+	volunteer, err := s.repo.FindVolunteerByIDForUpdate(tx, signup.VolunteerID)
+	if err != nil {
+		return 0, err
+	}
+
+	attendanceResult := model.AttendanceResultAttended
+	streak := volunteer.AttendanceStreak + 1
+	var logItem *model.CreditScoreLog
+	if signup.CheckInStatus != model.ActivityCheckInDone {
+		attendanceResult = model.AttendanceResultNoShow
+		streak = 0
+		logItem = &model.CreditScoreLog{
+			ChangeType:     model.CreditChangeNoShow,
+			ScoreDelta:     -min(policy.NoShowPenalty, volunteer.CreditScore),
+			IdempotencyKey: fmt.Sprintf("credit-no-show:%d", signup.ID),
+			Reason:         fmt.Sprintf("活动爽约扣分：%s", activity.Title),
+		}
+	} else if policy.StreakLength > 0 && policy.StreakBonus > 0 && streak%policy.StreakLength == 0 && volunteer.CreditScore < policy.InitialScore {
+		logItem = &model.CreditScoreLog{
+			ChangeType:     model.CreditChangeStreakReward,
+			ScoreDelta:     min(policy.StreakBonus, policy.InitialScore-volunteer.CreditScore),
+			IdempotencyKey: fmt.Sprintf("credit-streak:%d", signup.ID),
+			Reason:         fmt.Sprintf("连续出勤%d次奖励", streak),
+		}
+	}
+
+	volunteerUpdates := map[string]interface{}{
+		"attendance_streak": streak,
+	}
+	if logItem != nil && logItem.ScoreDelta != 0 {
+		logItem.VolunteerID = volunteer.ID
+		logItem.ActivityID = signup.ActivityID
+		logItem.SignupID = signup.ID
+		logItem.BeforeScore = volunteer.CreditScore
+		logItem.AfterScore = volunteer.CreditScore + logItem.ScoreDelta
+		logItem.OperatorID = operatorID
+		if err := s.repo.CreateCreditScoreLog(tx, logItem); err != nil {
+			return 0, err
+		}
+		volunteerUpdates["credit_score"] = logItem.AfterScore
+	}
+	if err := s.repo.UpdateVolunteer(tx, volunteer.ID, volunteerUpdates); err != nil {
+		return 0, err
+	}
+
+	if err := s.repo.UpdateActivitySignupByID(tx, signup.ID, map[string]any{
+		"attendance_result": attendanceResult,
+	}); err != nil {
+		return 0, err
+	}
+	return attendanceResult, nil
+}
+
+// revokeNoShowCredit 爽约记录被组织补录签到后，返还当初扣除的信用分并改判为已出勤。
+func (s *Service) revokeNoShowCredit(tx *gorm.DB, signup *model.ActivitySignup, reason string, operatorID int64) error {
+	noShowLog, err := s.repo.GetCreditScoreLogByIdempotencyKey(tx, fmt.Sprintf("credit-no-show:%d", signup.ID))
+	if err != nil {
+		return err
+	}
+	if noShowLog != nil && noShowLog.ScoreDelta < 0 {
+		volunteer, err := s.repo.FindVolunteerByIDForUpdate(tx, signup.VolunteerID)
+		if err != nil {
+			return err
+		}
+		afterScore := min(volunteer.CreditScore-noShowLog.ScoreDelta, currentCreditPolicy().InitialScore)
+		revokeLog := &model.CreditScoreLog{
+			VolunteerID:    volunteer.ID,
+			ActivityID:     signup.ActivityID,
+			SignupID:       signup.ID,
+			ChangeType:     model.CreditChangeNoShowRevoke,
+			ScoreDelta:     afterScore - volunteer.CreditScore,
+			BeforeScore:    volunteer.CreditScore,
+			AfterScore:     afterScore,
+			IdempotencyKey: fmt.Sprintf("credit-no-show-revoke:%d", signup.ID),
+			RefLogID:       noShowLog.ID,
+			Reason:         reason,
+			OperatorID:     operatorID,
+		}
+		if err := s.repo.CreateCreditScoreLog(tx, revokeLog); err != nil {
+			return err
+		}
+		if err := s.repo.UpdateVolunteer(tx, volunteer.ID, map[string]interface{}{
+			"credit_score": afterScore,
+		}); err != nil {
+			return err
+		}
+	}
+	return s.repo.UpdateActivitySignupByID(tx, signup.ID, map[string]any{
+		"attendance_result": model.AttendanceResultAttended,
+	})
+}
+
+// ensureVolunteerCreditAllowsSignup 信用分低于策略阈值时拒绝报名。
+// 高于阈值的报名仍按原流程提交组织审核。
+func (s *Service) ensureVolunteerCreditAllowsSignup(volunteerID int64) error {
+	policy := currentCreditPolicy()
+	if policy.SignupRejectBelow <= 0 {
+		return nil
+	}
+	volunteer, err := s.repo.FindVolunteerByID(s.repo.DB, volunteerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("志愿者信息不存在")
+		}
+		return err
+	}
+	if volunteer.CreditScore < policy.SignupRejectBelow {
+		return fmt.Errorf("信用分低于%d，暂无法报名活动", policy.SignupRejectBelow)
+	}
+	return nil
+}
+
+// CreditScoreLogList 信用分流水查询（志愿者查自己的流水；组织查本组织活动的流水）
+func (s *VolunteerService) CreditScoreLogList(req *api.CreditScoreLogListRequest) (*api.CreditScoreLogListResponse, error) {
+	resp := &api.CreditScoreLogListResponse{
+		Total: 0,
+		List:  []*api.CreditScoreLogItem{},
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = defaultCreditLogPageSize
+	}
+	if req.PageSize > maxCreditLogPageSize {
+		req.PageSize = maxCreditLogPageSize
+	}
+	if req.ChangeType > 0 &&
+		req.ChangeType != model.CreditChangeNoShow &&
+		req.ChangeType != model.CreditChangeStreakReward &&
+		req.ChangeType != model.CreditChangeNoShowRevoke {
+		return nil, errors.New("信用分变动类型无效")
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	account, err := s.repo.FindByID(s.repo.DB, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("账号不存在")
+		}
+		return nil, err
+	}
+
+	queryMap := make(map[string]any)
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok {
+		// 组织（含协作管理员）：可查看本组织活动产生的流水
+		if req.ActivityId > 0 {
+			activity, err := s.repo.GetActivityByID(s.repo.DB, req.ActivityId)
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return nil, errors.New("活动不存在")
+				}
+				return nil, err
+			}
+			if activity.OrgID != orgID {
+				return nil, errors.New("无权查看该活动信用分流水")
+			}
+			queryMap["activity_id = ?"] = req.ActivityId
+		} else {
+			queryMap["activity_id IN (SELECT id FROM activities WHERE org_id = ?)"] = orgID
+		}
+		if req.VolunteerId > 0 {
+			queryMap["volunteer_id = ?"] = req.VolunteerId
+		}
+	} else {
+		// 志愿者：只能看自己的流水
+		if account.IdentityType != model.RegisterTypeVolunteerCode {
+			return nil, errors.New("账号身份无效")
+		}
+		volunteer, err := s.repo.FindVolunteerByAccountID(s.repo.DB, userID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("志愿者信息不存在")
+			}
+			log.Error("信用分流水查询失败: 查询志愿者信息异常: %v, user_id=%d", err, userID)
+			return nil, err
+		}
+		queryMap["volunteer_id = ?"] = volunteer.ID
+		if req.ActivityId > 0 {
+			queryMap["activity_id = ?"] = req.ActivityId
+		}
+	}
+	if req.ChangeType > 0 {
+		queryMap["change_type = ?"] = req.ChangeType
+	}
+
+	pageSize := int(req.PageSize)
+	offset := (int(req.Page) - 1) * pageSize
+	logs, total, err := s.repo.ListCreditScoreLogs(s.repo.DB, queryMap, pageSize, offset)
+	if err != nil {
+		log.Error("信用分流水查询失败: %v, user_id=%d", err, userID)
+		return nil, err
+	}
+	for _, item := range logs {
+		resp.List = append(resp.List, &api.CreditScoreLogItem{
+			Id:          item.ID,
+			VolunteerId: item.VolunteerID,
+			ActivityId:  item.ActivityID,
+			SignupId:    item.SignupID,
+			ChangeType:  item.ChangeType,
+			ScoreDelta:  item.ScoreDelta,
+			BeforeScore: item.BeforeScore,
+			AfterScore:  item.AfterScore,
+			RefLogId:    item.RefLogID,
+			Reason:      item.Reason,
+			OperatorId:  item.OperatorID,
+			CreatedAt:   util.FormatDateTimeOrEmpty(item.CreatedAt),
+		})
+	}
+	resp.Total = int32(total)
+	return resp, nil
+}
@@ -0,0 +1,527 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/internal/repository"
+	"volunteer-system/pkg/util"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+)
+
+// exportBatchSize 导出时每批从数据库读取的记录数，写出后即释放
+const exportBatchSize = 500
+
+var (
+	exportActivityStatusLabels = map[int32]string{
+		model.ActivityStatusRecruiting: "报名中",
+		model.ActivityStatusFinished:   "已结束",
+		model.ActivityStatusCanceled:   "已取消",
+	}
+	exportSignupStatusLabels = map[int32]string{
+		model.ActivitySignupStatusPending:  "待审核",
+		model.ActivitySignupStatusSuccess:  "报名成功",
+		model.ActivitySignupStatusRejected: "报名驳回",
+		model.ActivitySignupStatusCanceled: "已取消",
+	}
+	exportAttendanceResultLabels = map[int32]string{
+		model.AttendanceResultPending:  "未判定",
+		model.AttendanceResultAttended: "已出勤",
+		model.AttendanceResultNoShow:   "爽约",
+	}
+	exportWorkHourStatusLabels = map[int32]string{
+		model.WorkHourStatusPending: "未结算",
+		model.WorkHourStatusGranted: "已发放",
+		model.WorkHourStatusVoided:  "已作废",
+	}
+	exportWorkHourOperationLabels = map[int32]string{
+		model.WorkHourOperationGrant:   "发放",
+		model.WorkHourOperationVoid:    "作废",
+		model.WorkHourOperationRegrant: "重发",
+	}
+)
+
+// exportPayload 导出任务参数
+type exportPayload struct {
+	Format     string `json:"format"` // util.SpreadsheetFormatCSV / util.SpreadsheetFormatXLSX
+	ActivityID int64  `json:"activityId,omitempty"`
+	StartDate  string `json:"startDate,omitempty"`
+	EndDate    string `json:"endDate,omitempty"`
+	// UnmaskPII 是否输出完整姓名与手机号，提交时已按调用人权限确定
+	UnmaskPII bool `json:"unmaskPii,omitempty"`
+}
+
+// exportResult 导出任务结果摘要
+type exportResult struct {
+	Rows int `json:"rows"`
+}
+
+func init() {
+	RegisterJobHandler(exportJobHandler{model.JobTypeActivityExport, (*ExportService).runActivityExport})
+	RegisterJobHandler(exportJobHandler{model.JobTypeActivitySignupExport, (*ExportService).runActivitySignupExport})
+	RegisterJobHandler(exportJobHandler{model.JobTypeWorkHourLogExport, (*ExportService).runWorkHourLogExport})
+}
+
+// exportJobHandler 导出任务：只读数据并重写结果文件，失败后可安全重试
+type exportJobHandler struct {
+	jobType string
+	run     func(s *ExportService, jc *JobContext, payload *exportPayload) (any, error)
+}
+
+func (h exportJobHandler) Type() string {
+	return h.jobType
+}
+
+func (h exportJobHandler) MaxAttempts() int {
+	return 0
+}
+
+func (h exportJobHandler) Run(jc *JobContext) (any, error) {
+	var payload exportPayload
+	if err := jc.DecodePayload(&payload); err != nil {
+		return nil, err
+	}
+	s := &ExportService{newJobBackgroundService(jc.Context())}
+	return h.run(s, jc, &payload)
+}
+
+type ExportService struct {
+	Service
+}
+
+func NewExportService(ctx context.Context, c *app.RequestContext) *ExportService {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &ExportService{
+		Service{
+			ctx:  ctx,
+			c:    c,
+			repo: repository.NewRepository(ctx, c),
+		},
+	}
+}
+
+// ExportActivities 提交活动列表导出任务（组织端）
+func (s *ExportService) ExportActivities(req *api.ExportActivitiesRequest) (*api.ExportJobResponse, error) {
+	format, err := parseExportFormat(req.Format)
+	if err != nil {
+		return nil, err
+	}
+	userID, org, err := s.currentExportOrganization()
+	if err != nil {
+		return nil, err
+	}
+
+	_, total, err := s.repo.GetOrganizationActivities(s.repo.DB, org.ID, 1, 0)
+	if err != nil {
+		log.Error("提交活动导出失败: 查询活动数量异常: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+
+	return s.submitExportJob(model.JobTypeActivityExport, userID, org.ID, total, &exportPayload{Format: format})
+}
+
+// ExportActivitySignups 提交活动报名名单导出任务（组织端）
+func (s *ExportService) ExportActivitySignups(req *api.ExportActivitySignupsRequest) (*api.ExportJobResponse, error) {
+	if req.ActivityId <= 0 {
+		return nil, errors.New("活动ID不能为空")
+	}
+	format, err := parseExportFormat(req.Format)
+	if err != nil {
+		return nil, err
+	}
+	userID, org, err := s.currentExportOrganization()
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ensureExportActivity(req.ActivityId, org.ID); err != nil {
+		return nil, err
+	}
+	if req.UnmaskPii && !canExportFullPII(userID, org) {
+		return nil, errors.New("无权导出完整个人信息")
+	}
+
+	total, err := s.repo.CountActivitySignupExportRows(s.repo.DB, req.ActivityId)
+	if err != nil {
+		log.Error("提交报名名单导出失败: 查询报名数量异常: %v, activity_id=%d", err, req.ActivityId)
+		return nil, err
+	}
+
+	return s.submitExportJob(model.JobTypeActivitySignupExport, userID, org.ID, total, &exportPayload{
+		Format:     format,
+		ActivityID: req.ActivityId,
+		UnmaskPII:  req.UnmaskPii,
+	})
+}
+
+// ExportWorkHourLogs 提交工时流水导出任务（组织端），按流水产生日期过滤
+func (s *ExportService) ExportWorkHourLogs(req *api.ExportWorkHourLogsRequest) (*api.ExportJobResponse, error) {
+	start, end, err := parseExportPeriod(req.StartDate, req.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	format, err := parseExportFormat(req.Format)
+	if err != nil {
+		return nil, err
+	}
+	userID, org, err := s.currentExportOrganization()
+	if err != nil {
+		return nil, err
+	}
+	if req.ActivityId > 0 {
+		if err := s.ensureExportActivity(req.ActivityId, org.ID); err != nil {
+			return nil, err
+		}
+	}
+	if req.UnmaskPii && !canExportFullPII(userID, org) {
+		return nil, errors.New("无权导出完整个人信息")
+	}
+
+	total, err := s.repo.CountWorkHourLogExportRows(s.repo.DB, org.ID, req.ActivityId, start, end)
+	if err != nil {
+		log.Error("提交工时流水导出失败: 查询流水数量异常: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+
+	return s.submitExportJob(model.JobTypeWorkHourLogExport, userID, org.ID, total, &exportPayload{
+		Format:     format,
+		ActivityID: req.ActivityId,
+		StartDate:  strings.TrimSpace(req.StartDate),
+		EndDate:    strings.TrimSpace(req.EndDate),
+		UnmaskPII:  req.UnmaskPii,
+	})
+}
+
+func (s *ExportService) submitExportJob(jobType string, userID, orgID, total int64, payload *exportPayload) (*api.ExportJobResponse, error) {
+	job, err := s.submitJob(jobType, userID, orgID, payload)
+	if err != nil {
+		return nil, err
+	}
+	log.Info("导出任务已提交: org_id=%d, job_id=%d, job_type=%s, 记录数=%d, 完整个人信息=%t",
+		orgID, job.ID, jobType, total, payload.UnmaskPII)
+	return &api.ExportJobResponse{
+		JobId:     job.ID,
+		TotalRows: int32(total),
+	}, nil
+}
+
+// runActivityExport 导出组织活动列表
+func (s *ExportService) runActivityExport(jc *JobContext, payload *exportPayload) (any, error) {
+	job := jc.Job()
+	header := []string{"活动ID", "活动标题", "开始时间", "结束时间", "地点", "详细地址",
+		"预估工时(小时)", "招募人数", "已报名人数", "活动状态", "创建时间"}
+
+	offset := 0
+	rows, err := writeExportFile(jc, fmt.Sprintf("activities-%s%s", time.Now().Format("20060102"), payload.Format), payload.Format, header,
+		func() ([][]string, error) {
+			activities, total, err := s.repo.GetOrganizationActivities(s.repo.DB, job.OrgID, exportBatchSize, offset)
+			if err != nil {
+				return nil, err
+			}
+			offset += len(activities)
+			jc.SetProgress(offset, int(total))
+
+			batch := make([][]string, 0, len(activities))
+			for _, activity := range activities {
+				maxPeople := "不限"
+				if activity.MaxPeople > 0 {
+					maxPeople = strconv.Itoa(int(activity.MaxPeople))
+				}
+				batch = append(batch, []string{
+					strconv.FormatInt(activity.ID, 10),
+					activity.Title,
+					util.FormatDateTimeOrEmpty(activity.StartTime),
+					util.FormatDateTimeOrEmpty(activity.EndTime),
+					activity.Location,
+					activity.Address,
+					formatExportHours(activity.Duration),
+					maxPeople,
+					strconv.Itoa(int(activity.CurrentPeople)),
+					exportLabel(exportActivityStatusLabels, activity.Status),
+					util.FormatDateTimeOrEmpty(activity.CreatedAt),
+				})
+			}
+			return batch, nil
+		})
+	if err != nil {
+		log.Error("活动导出失败: %v, job_id=%d, org_id=%d", err, job.ID, job.OrgID)
+		return nil, err
+	}
+	log.Info("活动导出完成: job_id=%d, org_id=%d, 行数=%d", job.ID, job.OrgID, rows)
+	return &exportResult{Rows: rows}, nil
+}
+
+// runActivitySignupExport 导出活动报名名单（含签到签退时间与发放工时）
+func (s *ExportService) runActivitySignupExport(jc *JobContext, payload *exportPayload) (any, error) {
+	job := jc.Job()
+	total, err := s.repo.CountActivitySignupExportRows(s.repo.DB, payload.ActivityID)
+	if err != nil {
+		log.Error("报名名单导出失败: 查询报名数量异常: %v, job_id=%d", err, job.ID)
+		return nil, err
+	}
+	header := []string{"报名ID", "志愿者ID", "姓名", "手机号", "班次", "报名时间", "报名状态",
+		"签到时间", "签到在围栏外", "签退时间", "签退在围栏外", "出勤结果", "工时状态", "发放工时(小时)", "发放时间"}
+
+	var afterID int64
+	done := 0
+	rows, err := writeExportFile(jc, fmt.Sprintf("activity-%d-signups%s", payload.ActivityID, payload.Format), payload.Format, header,
+		func() ([][]string, error) {
+			signups, err := s.repo.ListActivitySignupExportRows(s.repo.DB, payload.ActivityID, afterID, exportBatchSize)
+			if err != nil {
+				return nil, err
+			}
+			done += len(signups)
+			jc.SetProgress(done, int(total))
+
+			batch := make([][]string, 0, len(signups))
+			for _, signup := range signups {
+				afterID = signup.ID
+				name, mobile := exportPersonalInfo(signup.RealName, signup.Mobile, payload.UnmaskPII)
+				batch = append(batch, []string{
+					strconv.FormatInt(signup.ID, 10),
+					strconv.FormatInt(signup.VolunteerID, 10),
+					name,
+					mobile,
+					signup.SlotName,
+					util.FormatDateTimeOrEmpty(signup.SignupTime),
+					exportLabel(exportSignupStatusLabels, signup.Status),
+					util.FormatDateTimePtr(signup.CheckInTime),
+					exportYesNo(signup.CheckInTime != nil, signup.CheckInOutOfFence),
+					util.FormatDateTimePtr(signup.CheckOutTime),
+					exportYesNo(signup.CheckOutTime != nil, signup.CheckOutOutOfFence),
+					exportLabel(exportAttendanceResultLabels, signup.AttendanceResult),
+					exportLabel(exportWorkHourStatusLabels, signup.WorkHourStatus),
+					formatExportHours(signup.GrantedHours),
+					util.FormatDateTimePtr(signup.GrantedAt),
+				})
+			}
+			return batch, nil
+		})
+	if err != nil {
+		log.Error("报名名单导出失败: %v, job_id=%d, activity_id=%d", err, job.ID, payload.ActivityID)
+		return nil, err
+	}
+	log.Info("报名名单导出完成: job_id=%d, activity_id=%d, 行数=%d", job.ID, payload.ActivityID, rows)
+	return &exportResult{Rows: rows}, nil
+}
+
+// runWorkHourLogExport 导出日期区间内的工时流水
+func (s *ExportService) runWorkHourLogExport(jc *JobContext, payload *exportPayload) (any, error) {
+	job := jc.Job()
+	start, end, err := parseExportPeriod(payload.StartDate, payload.EndDate)
+	if err != nil {
+		return nil, NewPermanentJobError(err)
+	}
+	total, err := s.repo.CountWorkHourLogExportRows(s.repo.DB, job.OrgID, payload.ActivityID, start, end)
+	if err != nil {
+		log.Error("工时流水导出失败: 查询流水数量异常: %v, job_id=%d", err, job.ID)
+		return nil, err
+	}
+	header := []string{"流水ID", "时间", "活动ID", "活动标题", "报名ID", "志愿者ID", "姓名", "手机号",
+		"操作类型", "工时增量(小时)", "服务次数增量", "变更后累计工时(小时)", "原因", "操作人ID"}
+
+	var afterID int64
+	done := 0
+	fileName := fmt.Sprintf("work-hour-logs-%s-%s%s",
+		strings.ReplaceAll(payload.StartDate, "-", ""), strings.ReplaceAll(payload.EndDate, "-", ""), payload.Format)
+	rows, err := writeExportFile(jc, fileName, payload.Format, header,
+		func() ([][]string, error) {
+			logs, err := s.repo.ListWorkHourLogExportRows(s.repo.DB, job.OrgID, payload.ActivityID, start, end, afterID, exportBatchSize)
+			if err != nil {
+				return nil, err
+			}
+			done += len(logs)
+			jc.SetProgress(done, int(total))
+
+			batch := make([][]string, 0, len(logs))
+			for _, item := range logs {
+				afterID = item.ID
+				name, mobile := exportPersonalInfo(item.RealName, item.Mobile, payload.UnmaskPII)
+				batch = append(batch, []string{
+					strconv.FormatInt(item.ID, 10),
+					util.FormatDateTimeOrEmpty(item.CreatedAt),
+					strconv.FormatInt(item.ActivityID, 10),
+					item.ActivityTitle,
+					strconv.FormatInt(item.SignupID, 10),
+					strconv.FormatInt(item.VolunteerID, 10),
+					name,
+					mobile,
+					exportLabel(exportWorkHourOperationLabels, item.OperationType),
+					formatExportHours(item.HoursDelta),
+					strconv.FormatInt(item.ServiceCountDelta, 10),
+					formatExportHours(item.AfterTotalHours),
+					item.Reason,
+					strconv.FormatInt(item.OperatorID, 10),
+				})
+			}
+			return batch, nil
+		})
+	if err != nil {
+		log.Error("工时流水导出失败: %v, job_id=%d, org_id=%d", err, job.ID, job.OrgID)
+		return nil, err
+	}
+	log.Info("工时流水导出完成: job_id=%d, org_id=%d, 区间=%s~%s, 行数=%d",
+		job.ID, job.OrgID, payload.StartDate, payload.EndDate, rows)
+	return &exportResult{Rows: rows}, nil
+}
+
+// writeExportFile 创建任务结果文件并逐批写出表格，next 返回空批次时结束，返回写出的数据行数
+func writeExportFile(jc *JobContext, fileName, format string, header []string, next func() ([][]string, error)) (int, error) {
+	f, err := jc.CreateResultFile(fileName)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	w, err := util.NewSpreadsheetWriter(f, format)
+	if err != nil {
+		return 0, NewPermanentJobError(err)
+	}
+	if err := w.WriteRow(header); err != nil {
+		return 0, err
+	}
+
+	rows := 0
+	for {
+		if err := jc.Context().Err(); err != nil {
+			return rows, err
+		}
+		batch, err := next()
+		if err != nil {
+			return rows, err
+		}
+		if len(batch) == 0 {
+			break
+		}
+		for _, row := range batch {
+			if err := w.WriteRow(row); err != nil {
+				return rows, err
+			}
+		}
+		rows += len(batch)
+	}
+
+	if err := w.Close(); err != nil {
+		return rows, err
+	}
+	return rows, f.Close()
+}
+
+// currentExportOrganization 当前操作的组织（经组织权限中间件授权），返回账号ID与组织信息
+func (s *ExportService) currentExportOrganization() (int64, *model.Organization, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return 0, nil, err
+	}
+	orgID, ok := middleware.GetActingOrgID(s.c)
+	if !ok {
+		return 0, nil, errors.New("无权导出数据")
+	}
+
+	org, err := s.repo.GetOrganizationByID(s.repo.DB, orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil, errors.New("组织信息不存在")
+		}
+		log.Error("查询导出组织失败: %v, org_id=%d", err, orgID)
+		return 0, nil, err
+	}
+	return userID, org, nil
+}
+
+// ensureExportActivity 校验活动存在且属于当前组织
+func (s *ExportService) ensureExportActivity(activityID, orgID int64) error {
+	activity, err := s.repo.GetActivityByID(s.repo.DB, activityID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("活动不存在")
+		}
+		log.Error("校验导出活动失败: %v, activity_id=%d", err, activityID)
+		return err
+	}
+	if activity.OrgID != orgID {
+		return errors.New("无权导出该活动数据")
+	}
+	return nil
+}
+
+// canExportFullPII 是否允许导出完整姓名与手机号：仅组织主账号
+func canExportFullPII(userID int64, org *model.Organization) bool {
+	return org != nil && org.AccountID == userID
+}
+
+// exportPersonalInfo 解密手机号，未授权导出完整信息时对姓名与手机号脱敏
+func exportPersonalInfo(realName, encryptedMobile string, unmask bool) (string, string) {
+	mobile := ""
+	if encryptedMobile != "" {
+		decrypted, err := util.DecryptSensitiveField(encryptedMobile)
+		if err != nil {
+			log.Warn("导出 - 手机号解密失败: %v", err)
+		} else {
+			mobile = decrypted
+		}
+	}
+	if unmask {
+		return realName, mobile
+	}
+	return util.GetNameMask(realName), util.GetMobileMask(mobile)
+}
+
+// parseExportFormat 解析导出格式，默认 xlsx
+func parseExportFormat(format string) (string, error) {
+	switch strings.ToLower(strings.TrimSpace(format)) {
+	case "", "xlsx":
+		return util.SpreadsheetFormatXLSX, nil
+	case "csv":
+		return util.SpreadsheetFormatCSV, nil
+	default:
+		return "", errors.New("导出格式仅支持 csv 或 xlsx")
+	}
+}
+
+// parseExportPeriod 解析导出日期区间（结束日期包含当天），返回 [start, end)
+func parseExportPeriod(startDate, endDate string) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation(util.DateLayout, strings.TrimSpace(startDate), time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("开始日期格式错误，应为yyyy-MM-dd")
+	}
+	end, err := time.ParseInLocation(util.DateLayout, strings.TrimSpace(endDate), time.Local)
+	if err != nil {
+		return time.Time{}, time.Time{}, errors.New("结束日期格式错误，应为yyyy-MM-dd")
+	}
+	if end.Before(start) {
+		return time.Time{}, time.Time{}, errors.New("结束日期不能早于开始日期")
+	}
+	return start, end.AddDate(0, 0, 1), nil
+}
+
+func exportLabel(labels map[int32]string, value int32) string {
+	if label, ok := labels[value]; ok {
+		return label
+	}
+	return strconv.Itoa(int(value))
+}
+
+// exportYesNo 围栏标记仅在已签到/签退时输出
+func exportYesNo(present bool, flag int32) string {
+	if !present {
+		return ""
+	}
+	if flag == 1 {
+		return "是"
+	}
+	return "否"
+}
+
+func formatExportHours(hours float64) string {
+	return strconv.FormatFloat(hours, 'f', 2, 64)
+}
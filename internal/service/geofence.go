@@ -0,0 +1,112 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+)
+
+// activityGeofenceRadiusMax 签到围栏半径上限（米）
+const activityGeofenceRadiusMax = 5000
+
+// attendancePosition 签到/签退时采集的位置及围栏判定结果
+type attendancePosition struct {
+	Latitude   *float64
+	Longitude  *float64
+	Distance   *int32
+	OutOfFence bool
+}
+
+// resolveAttendancePosition 校验设备坐标并计算与活动地点的距离。
+// 活动启用围栏时坐标必填，围栏外按活动配置拒绝或放行并标记；未启用围栏时仅记录上报的坐标。
+func resolveAttendancePosition(activity *model.Activity, latitude, longitude float64, action string) (*attendancePosition, error) {
+	hasCoordinate := latitude != 0 || longitude != 0
+	if hasCoordinate && !util.IsValidCoordinate(latitude, longitude) {
+		return nil, errors.New("定位坐标不合法")
+	}
+
+	position := &attendancePosition{}
+	if hasCoordinate {
+		position.Latitude = &latitude
+		position.Longitude = &longitude
+		if activity.Latitude != 0 || activity.Longitude != 0 {
+			distance := int32(math.Round(util.HaversineDistance(activity.Latitude, activity.Longitude, latitude, longitude)))
+			position.Distance = &distance
+		}
+	}
+
+	if activity.GeofenceRadius <= 0 {
+		return position, nil
+	}
+	if position.Distance == nil {
+		return nil, fmt.Errorf("该活动已启用签到范围限制，请开启定位后%s", action)
+	}
+	if *position.Distance > activity.GeofenceRadius {
+		if activity.GeofenceMode == model.GeofenceModeFlag {
+			position.OutOfFence = true
+			return position, nil
+		}
+		return nil, fmt.Errorf("当前位置不在活动%s范围内（距离约%d米）", action, *position.Distance)
+	}
+	return position, nil
+}
+
+// fillUpdates 将采集的位置写入报名记录更新字段，prefix 为 check_in 或 check_out。
+func (p *attendancePosition) fillUpdates(updates map[string]any, prefix string) {
+	if p.Latitude == nil {
+		return
+	}
+	updates[prefix+"_latitude"] = *p.Latitude
+	updates[prefix+"_longitude"] = *p.Longitude
+	updates[prefix+"_distance"] = p.Distance
+	outOfFence := 0
+	if p.OutOfFence {
+		outOfFence = 1
+	}
+	updates[prefix+"_out_of_fence"] = outOfFence
+}
+
+// distanceOrUnknown 返回距离（米），未计算时返回 -1。
+func (p *attendancePosition) distanceOrUnknown() int32 {
+	if p == nil || p.Distance == nil {
+		return -1
+	}
+	return *p.Distance
+}
+
+// validateActivityGeofence 校验活动坐标与围栏配置。
+func validateActivityGeofence(latitude, longitude float64, radius, mode int32) error {
+	if !util.IsValidCoordinate(latitude, longitude) {
+		return errors.New("活动坐标不合法")
+	}
+	if radius < 0 || radius > activityGeofenceRadiusMax {
+		return errors.New("签到围栏半径需在0~5000米之间")
+	}
+	if radius > 0 && latitude == 0 && longitude == 0 {
+		return errors.New("启用签到围栏时必须填写活动坐标")
+	}
+	if mode != model.GeofenceModeReject && mode != model.GeofenceModeFlag {
+		return errors.New("围栏外处理方式不合法")
+	}
+	return nil
+}
+
+// buildAttendancePosition 组装报名记录中采集的位置，未采集时返回 nil。
+func buildAttendancePosition(latitude, longitude *float64, distance *int32, outOfFence int32) *api.AttendancePosition {
+	if latitude == nil || longitude == nil {
+		return nil
+	}
+	position := &api.AttendancePosition{
+		Latitude:   *latitude,
+		Longitude:  *longitude,
+		Distance:   -1,
+		OutOfFence: outOfFence == 1,
+	}
+	if distance != nil {
+		position.Distance = *distance
+	}
+	return position
+}
@@ -0,0 +1,648 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+	"volunteer-system/config"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/internal/repository"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+)
+
+const (
+	defaultJobPageSize            = 20
+	maxJobPageSize                = 100
+	defaultJobMaxAttempts         = 3
+	defaultJobRetryBackoffSeconds = 10
+	defaultJobMaxBackoffSeconds   = 600
+	defaultJobStaleSeconds        = 120
+	// jobHeartbeatInterval 执行中任务刷新心跳、进度并检查取消请求的间隔
+	jobHeartbeatInterval = 3 * time.Second
+	// jobRecoverBatchSize 单次补偿扫描处理的任务数
+	jobRecoverBatchSize = 100
+	// jobResultDir 任务结果文件在上传目录下的存储子目录
+	jobResultDir = "jobs"
+	// maxJobErrorMessageLength 失败原因保存长度上限（jobs.error_message）
+	maxJobErrorMessageLength = 500
+)
+
+// JobHandler 异步任务类型。
+// 新的批量操作实现该接口，并在 init 中调用 RegisterJobHandler 注册后即可通过 submitJob 提交。
+type JobHandler interface {
+	// Type 任务类型，对应 jobs.job_type
+	Type() string
+	// MaxAttempts 最大执行次数（含首次），返回 0 时使用配置的默认值；
+	// 逐条提交、无法安全重跑的任务应返回 1。
+	MaxAttempts() int
+	// Run 执行任务，返回的结果摘要序列化为 JSON 保存。
+	// 返回 NewPermanentJobError 包装的错误时不再重试；需响应 jc.Context() 的取消。
+	Run(jc *JobContext) (any, error)
+}
+
+var (
+	jobHandlersMu sync.RWMutex
+	jobHandlers   = make(map[string]JobHandler)
+)
+
+// RegisterJobHandler 注册任务类型，重复注册同一类型会 panic
+func RegisterJobHandler(handler JobHandler) {
+	jobHandlersMu.Lock()
+	defer jobHandlersMu.Unlock()
+	if _, ok := jobHandlers[handler.Type()]; ok {
+		panic("重复注册任务类型: " + handler.Type())
+	}
+	jobHandlers[handler.Type()] = handler
+}
+
+func getJobHandler(jobType string) JobHandler {
+	jobHandlersMu.RLock()
+	defer jobHandlersMu.RUnlock()
+	return jobHandlers[jobType]
+}
+
+// permanentJobError 不可重试的任务错误（参数错误、数据不存在等）
+type permanentJobError struct {
+	err error
+}
+
+func (e *permanentJobError) Error() string { return e.err.Error() }
+func (e *permanentJobError) Unwrap() error { return e.err }
+
+// NewPermanentJobError 包装不可重试的任务错误
+func NewPermanentJobError(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &permanentJobError{err: err}
+}
+
+// JobContext 任务执行上下文，提供参数解析、进度上报与结果文件
+type JobContext struct {
+	ctx   context.Context
+	job   *model.Job
+	done  atomic.Int32
+	total atomic.Int32
+
+	resultFile     string
+	resultFileName string
+}
+
+// Context 任务上下文，任务被取消或 worker 停止时结束
+func (jc *JobContext) Context() context.Context {
+	return jc.ctx
+}
+
+// Job 当前任务记录（执行期间只读）
+func (jc *JobContext) Job() *model.Job {
+	return jc.job
+}
+
+// DecodePayload 解析任务参数
+func (jc *JobContext) DecodePayload(v any) error {
+	if err := json.Unmarshal([]byte(jc.job.Payload), v); err != nil {
+		return NewPermanentJobError(errors.New("任务参数解析失败"))
+	}
+	return nil
+}
+
+// SetProgress 上报进度，随心跳写入数据库
+func (jc *JobContext) SetProgress(done, total int) {
+	jc.done.Store(int32(done))
+	jc.total.Store(int32(total))
+}
+
+// CreateResultFile 创建任务结果文件（保存在上传目录下），fileName 为下载时的文件名。
+// 调用方负责写入并关闭文件；重复调用会覆盖之前的结果文件。
+func (jc *JobContext) CreateResultFile(fileName string) (*os.File, error) {
+	relPath := path.Join(jobResultDir, strconv.FormatInt(jc.job.ID, 10), "result"+filepath.Ext(fileName))
+	fullPath := filepath.Join(uploadDir(), filepath.FromSlash(relPath))
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.Create(fullPath)
+	if err != nil {
+		return nil, err
+	}
+	jc.resultFile = relPath
+	jc.resultFileName = fileName
+	return f, nil
+}
+
+// newJobBackgroundService 无请求上下文的服务实例，供任务执行使用（操作人取自任务记录）
+func newJobBackgroundService(ctx context.Context) Service {
+	return Service{
+		ctx:  ctx,
+		c:    nil,
+		repo: repository.NewRepository(ctx, nil),
+	}
+}
+
+type JobService struct {
+	Service
+}
+
+func NewJobService(ctx context.Context, c *app.RequestContext) *JobService {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &JobService{
+		Service{
+			ctx:  ctx,
+			c:    c,
+			repo: repository.NewRepository(ctx, c),
+		},
+	}
+}
+
+// NewJobWorkerService 供 worker 使用的任务服务（无请求上下文）
+func NewJobWorkerService(ctx context.Context) *JobService {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &JobService{newJobBackgroundService(ctx)}
+}
+
+// jobPolicy 任务重试与中断判定策略
+type jobPolicy struct {
+	MaxAttempts  int
+	RetryBackoff time.Duration
+	MaxBackoff   time.Duration
+	Stale        time.Duration
+}
+
+// currentJobPolicy 读取任务策略，未配置的项使用默认值
+func currentJobPolicy() jobPolicy {
+	policy := jobPolicy{
+		MaxAttempts:  defaultJobMaxAttempts,
+		RetryBackoff: defaultJobRetryBackoffSeconds * time.Second,
+		MaxBackoff:   defaultJobMaxBackoffSeconds * time.Second,
+		Stale:        defaultJobStaleSeconds * time.Second,
+	}
+	cfg := config.GetConfig()
+	if cfg == nil || cfg.Job == nil {
+		return policy
+	}
+	if cfg.Job.MaxAttempts > 0 {
+		policy.MaxAttempts = cfg.Job.MaxAttempts
+	}
+	if cfg.Job.RetryBackoffSeconds > 0 {
+		policy.RetryBackoff = time.Duration(cfg.Job.RetryBackoffSeconds) * time.Second
+	}
+	if cfg.Job.MaxBackoffSeconds > 0 {
+		policy.MaxBackoff = time.Duration(cfg.Job.MaxBackoffSeconds) * time.Second
+	}
+	if cfg.Job.StaleSeconds > 0 {
+		policy.Stale = time.Duration(cfg.Job.StaleSeconds) * time.Second
+	}
+	return policy
+}
+
+// retryDelay 第 attempts 次执行失败后的重试间隔：RetryBackoff * 2^(attempts-1)，不超过 MaxBackoff
+func (p jobPolicy) retryDelay(attempts int32) time.Duration {
+	delay := p.RetryBackoff
+	for i := int32(1); i < attempts && delay < p.MaxBackoff; i++ {
+		delay *= 2
+	}
+	if delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	return delay
+}
+
+// submitJob 提交异步任务，入队失败时由 worker 补偿扫描数据库执行
+func (s *Service) submitJob(jobType string, accountID, orgID int64, payload any) (*model.Job, error) {
+	handler := getJobHandler(jobType)
+	if handler == nil {
+		return nil, fmt.Errorf("未注册的任务类型: %s", jobType)
+	}
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	maxAttempts := handler.MaxAttempts()
+	if maxAttempts <= 0 {
+		maxAttempts = currentJobPolicy().MaxAttempts
+	}
+
+	job := &model.Job{
+		JobType:     jobType,
+		AccountID:   accountID,
+		OrgID:       orgID,
+		Status:      model.JobStatusPending,
+		Payload:     string(data),
+		MaxAttempts: int32(maxAttempts),
+		NextRunAt:   time.Now(),
+	}
+	if err := s.repo.CreateJob(s.repo.DB, job); err != nil {
+		log.Error("提交任务失败: 创建任务异常: %v, job_type=%s account_id=%d", err, jobType, accountID)
+		return nil, err
+	}
+	if err := s.repo.EnqueueJob(job.ID); err != nil && !errors.Is(err, repository.ErrJobQueueUnavailable) {
+		log.Warn("提交任务: 入队失败，等待补偿扫描: %v, job_id=%d", err, job.ID)
+	}
+	return job, nil
+}
+
+// JobList 我提交的任务列表
+func (s *JobService) JobList(req *api.JobListRequest) (*api.JobListResponse, error) {
+	resp := &api.JobListResponse{
+		Total: 0,
+		List:  []*api.JobItem{},
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = defaultJobPageSize
+	}
+	if req.PageSize > maxJobPageSize {
+		req.PageSize = maxJobPageSize
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := int((req.Page - 1) * req.PageSize)
+	jobs, total, err := s.repo.ListJobs(s.repo.DB, userID, req.JobType, req.Status, int(req.PageSize), offset)
+	if err != nil {
+		log.Error("任务列表查询失败: %v, account_id=%d", err, userID)
+		return nil, err
+	}
+
+	resp.Total = int32(total)
+	for _, job := range jobs {
+		resp.List = append(resp.List, toJobItem(job))
+	}
+	return resp, nil
+}
+
+// JobDetail 任务详情
+func (s *JobService) JobDetail(req *api.JobDetailRequest) (*api.JobDetailResponse, error) {
+	job, err := s.getOwnedJob(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &api.JobDetailResponse{Job: toJobItem(job)}, nil
+}
+
+// CancelJob 取消任务：排队中的任务直接取消，执行中的任务标记取消后由 worker 停止
+func (s *JobService) CancelJob(req *api.CancelJobRequest) (*api.CancelJobResponse, error) {
+	job, err := s.getOwnedJob(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch job.Status {
+	case model.JobStatusPending:
+		err = s.repo.CancelPendingJob(s.repo.DB, job.ID, time.Now())
+	case model.JobStatusRunning:
+		err = s.repo.RequestJobCancel(s.repo.DB, job.ID)
+	default:
+		return nil, errors.New("任务已结束，无法取消")
+	}
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Error("取消任务失败: %v, job_id=%d", err, job.ID)
+		return nil, err
+	}
+
+	// 状态可能已被 worker 改变，以最新状态为准
+	latest, err := s.repo.GetJobByID(s.repo.DB, job.ID)
+	if err != nil {
+		log.Error("取消任务失败: 查询任务异常: %v, job_id=%d", err, job.ID)
+		return nil, err
+	}
+	if latest.CancelRequested != 1 && latest.Status != model.JobStatusCancelled {
+		return nil, errors.New("任务已结束，无法取消")
+	}
+	return &api.CancelJobResponse{Status: latest.Status}, nil
+}
+
+// DownloadJobResult 下载任务结果文件
+func (s *JobService) DownloadJobResult(req *api.DownloadJobResultRequest) (*api.DownloadJobResultResponse, error) {
+	job, err := s.getOwnedJob(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if job.Status != model.JobStatusSucceeded || job.ResultFile == "" {
+		return nil, errors.New("任务暂无结果文件")
+	}
+	fullPath := filepath.Join(uploadDir(), filepath.FromSlash(job.ResultFile))
+	if _, err := os.Stat(fullPath); err != nil {
+		log.Warn("下载任务结果失败: 结果文件不存在: %v, job_id=%d", err, job.ID)
+		return nil, errors.New("结果文件不存在或已过期")
+	}
+	return &api.DownloadJobResultResponse{
+		FilePath: fullPath,
+		FileName: job.ResultFileName,
+	}, nil
+}
+
+// getOwnedJob 获取当前账号提交的任务
+func (s *JobService) getOwnedJob(id int64) (*model.Job, error) {
+	if id <= 0 {
+		return nil, errors.New("任务ID不能为空")
+	}
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	job, err := s.repo.GetJobByID(s.repo.DB, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("任务不存在")
+		}
+		log.Error("查询任务失败: %v, job_id=%d", err, id)
+		return nil, err
+	}
+	if job.AccountID != userID {
+		return nil, errors.New("无权查看该任务")
+	}
+	return job, nil
+}
+
+// HasJobQueue Redis 任务队列是否可用，不可用时 worker 轮询数据库
+func (s *JobService) HasJobQueue() bool {
+	return s.repo.HasJobQueue()
+}
+
+// NextJobID 获取下一个待执行的任务ID：优先阻塞读取 Redis 队列，不可用时查询数据库；无任务返回 0
+func (s *JobService) NextJobID(wait time.Duration) (int64, error) {
+	if s.repo.HasJobQueue() {
+		return s.repo.DequeueJob(wait)
+	}
+	ids, err := s.repo.ListDueJobIDs(s.repo.DB, time.Now(), 1)
+	if err != nil || len(ids) == 0 {
+		return 0, err
+	}
+	return ids[0], nil
+}
+
+// RecoverJobs 补偿扫描：到期的重试任务转入队列、心跳超时的任务重新排队、入队丢失的任务重新入队。
+// orphanAfter 为排队任务超过多久未被领取视为入队丢失。
+func (s *JobService) RecoverJobs(now time.Time, orphanAfter time.Duration) error {
+	policy := currentJobPolicy()
+
+	if s.repo.HasJobQueue() {
+		if _, err := s.repo.PromoteDueJobs(now, jobRecoverBatchSize); err != nil {
+			log.Error("任务补偿失败: 转移延迟任务异常: %v", err)
+		}
+	}
+
+	staleBefore := now.Add(-policy.Stale)
+	staleJobs, err := s.repo.ListStaleRunningJobs(s.repo.DB, staleBefore, jobRecoverBatchSize)
+	if err != nil {
+		log.Error("任务补偿失败: 查询中断任务异常: %v", err)
+		return err
+	}
+	for _, job := range staleJobs {
+		updates := map[string]any{
+			"status":        model.JobStatusPending,
+			"next_run_at":   now,
+			"error_message": "任务执行中断",
+		}
+		if job.Attempts >= job.MaxAttempts {
+			updates["status"] = model.JobStatusFailed
+			updates["finished_at"] = now
+		}
+		if err := s.repo.UpdateStaleRunningJob(s.repo.DB, job.ID, staleBefore, updates); err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Error("任务补偿失败: 重置中断任务异常: %v, job_id=%d", err, job.ID)
+			}
+			continue
+		}
+		log.Warn("任务执行中断已重置: job_id=%d, job_type=%s, attempts=%d", job.ID, job.JobType, job.Attempts)
+		if updates["status"] == model.JobStatusPending {
+			s.enqueueRecoveredJob(job.ID)
+		}
+	}
+
+	if !s.repo.HasJobQueue() {
+		return nil
+	}
+	ids, err := s.repo.ListDueJobIDs(s.repo.DB, now.Add(-orphanAfter), jobRecoverBatchSize)
+	if err != nil {
+		log.Error("任务补偿失败: 查询排队任务异常: %v", err)
+		return err
+	}
+	for _, id := range ids {
+		s.enqueueRecoveredJob(id)
+	}
+	return nil
+}
+
+func (s *JobService) enqueueRecoveredJob(id int64) {
+	if err := s.repo.EnqueueJob(id); err != nil && !errors.Is(err, repository.ErrJobQueueUnavailable) {
+		log.Error("任务补偿失败: 重新入队异常: %v, job_id=%d", err, id)
+	}
+}
+
+// RunJob 领取并执行任务（worker 调用），任务已被其他 worker 领取或未到期时直接返回
+func (s *JobService) RunJob(id int64) {
+	if err := s.repo.ClaimJob(s.repo.DB, id, time.Now()); err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			log.Error("领取任务失败: %v, job_id=%d", err, id)
+		}
+		return
+	}
+	job, err := s.repo.GetJobByID(s.repo.DB, id)
+	if err != nil {
+		log.Error("执行任务失败: 查询任务异常: %v, job_id=%d", err, id)
+		return
+	}
+
+	// 任务结束后的状态写入不受 worker 停止影响
+	final := NewJobWorkerService(context.Background())
+
+	handler := getJobHandler(job.JobType)
+	if handler == nil {
+		final.finishJob(job, model.JobStatusFailed, map[string]any{"error_message": "未注册的任务类型: " + job.JobType})
+		return
+	}
+
+	jobCtx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+	jc := &JobContext{ctx: jobCtx, job: job}
+	jc.SetProgress(int(job.ProgressDone), int(job.ProgressTotal))
+
+	// 心跳：刷新进度、感知取消请求；任务已被重置或结束时停止执行
+	var cancelRequested, lost atomic.Bool
+	heartbeatDone := make(chan struct{})
+	go func() {
+		defer close(heartbeatDone)
+		ticker := time.NewTicker(jobHeartbeatInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-jobCtx.Done():
+				return
+			case <-ticker.C:
+			}
+			err := final.repo.UpdateRunningJob(final.repo.DB, job.ID, map[string]any{
+				"heartbeat_at":   time.Now(),
+				"progress_done":  jc.done.Load(),
+				"progress_total": jc.total.Load(),
+			})
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				lost.Store(true)
+				cancel()
+				return
+			}
+			if err != nil {
+				log.Warn("任务心跳失败: %v, job_id=%d", err, job.ID)
+				continue
+			}
+			latest, err := final.repo.GetJobByID(final.repo.DB, job.ID)
+			if err == nil && latest.CancelRequested == 1 {
+				cancelRequested.Store(true)
+				cancel()
+				return
+			}
+		}
+	}()
+
+	log.Info("开始执行任务: job_id=%d, job_type=%s, attempt=%d/%d", job.ID, job.JobType, job.Attempts, job.MaxAttempts)
+	result, runErr := runJobHandler(handler, jc)
+	cancel()
+	<-heartbeatDone
+
+	if lost.Load() {
+		log.Warn("任务已被重置，放弃本次执行结果: job_id=%d", job.ID)
+		return
+	}
+	if runErr != nil && !cancelRequested.Load() {
+		// 心跳间隔内收到的取消请求
+		if latest, err := final.repo.GetJobByID(final.repo.DB, job.ID); err == nil && latest.CancelRequested == 1 {
+			cancelRequested.Store(true)
+		}
+	}
+
+	updates := map[string]any{
+		"progress_done":  jc.done.Load(),
+		"progress_total": jc.total.Load(),
+	}
+	switch {
+	case runErr == nil:
+		if result != nil {
+			data, err := json.Marshal(result)
+			if err != nil {
+				log.Error("执行任务: 结果序列化失败: %v, job_id=%d", err, job.ID)
+			} else {
+				updates["result"] = string(data)
+			}
+		}
+		updates["result_file"] = jc.resultFile
+		updates["result_file_name"] = jc.resultFileName
+		updates["error_message"] = ""
+		final.finishJob(job, model.JobStatusSucceeded, updates)
+
+	case cancelRequested.Load():
+		updates["error_message"] = "任务已取消"
+		final.finishJob(job, model.JobStatusCancelled, updates)
+
+	case s.ctx.Err() != nil && job.MaxAttempts <= 1:
+		// 不可重跑的任务被 worker 停止中断，直接置为失败
+		updates["error_message"] = "服务停止，任务中断"
+		final.finishJob(job, model.JobStatusFailed, updates)
+
+	case s.ctx.Err() != nil:
+		// worker 停止导致中断：不计入执行次数，重新排队
+		updates["status"] = model.JobStatusPending
+		updates["attempts"] = gorm.Expr("attempts - 1")
+		updates["next_run_at"] = time.Now()
+		if err := final.repo.UpdateRunningJob(final.repo.DB, job.ID, updates); err != nil {
+			log.Error("任务重新排队失败: %v, job_id=%d", err, job.ID)
+			return
+		}
+		final.enqueueRecoveredJob(job.ID)
+		log.Warn("worker 停止，任务已重新排队: job_id=%d", job.ID)
+
+	default:
+		updates["error_message"] = truncateRunes(runErr.Error(), maxJobErrorMessageLength)
+		var permanent *permanentJobError
+		if errors.As(runErr, &permanent) || job.Attempts >= job.MaxAttempts {
+			log.Error("任务执行失败: %v, job_id=%d, job_type=%s, attempts=%d", runErr, job.ID, job.JobType, job.Attempts)
+			final.finishJob(job, model.JobStatusFailed, updates)
+			return
+		}
+
+		nextRunAt := time.Now().Add(currentJobPolicy().retryDelay(job.Attempts))
+		updates["status"] = model.JobStatusPending
+		updates["next_run_at"] = nextRunAt
+		if err := final.repo.UpdateRunningJob(final.repo.DB, job.ID, updates); err != nil {
+			log.Error("任务重试排队失败: %v, job_id=%d", err, job.ID)
+			return
+		}
+		if err := final.repo.EnqueueDelayedJob(job.ID, nextRunAt); err != nil && !errors.Is(err, repository.ErrJobQueueUnavailable) {
+			log.Warn("任务重试入队失败，等待补偿扫描: %v, job_id=%d", err, job.ID)
+		}
+		log.Warn("任务执行失败，将于 %s 重试: %v, job_id=%d, attempts=%d/%d",
+			nextRunAt.Format("2006-01-02 15:04:05"), runErr, job.ID, job.Attempts, job.MaxAttempts)
+	}
+}
+
+// finishJob 将执行中的任务置为结束状态
+func (s *JobService) finishJob(job *model.Job, status int32, updates map[string]any) {
+	updates["status"] = status
+	updates["finished_at"] = time.Now()
+	if err := s.repo.UpdateRunningJob(s.repo.DB, job.ID, updates); err != nil {
+		log.Error("更新任务结束状态失败: %v, job_id=%d, status=%d", err, job.ID, status)
+		return
+	}
+	log.Info("任务执行结束: job_id=%d, job_type=%s, status=%d", job.ID, job.JobType, status)
+}
+
+// runJobHandler 执行任务，panic 视为执行失败
+func runJobHandler(handler JobHandler, jc *JobContext) (result any, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Error("任务执行异常: job_id=%d, job_type=%s, panic=%v", jc.job.ID, jc.job.JobType, r)
+			err = fmt.Errorf("任务执行异常: %v", r)
+		}
+	}()
+	return handler.Run(jc)
+}
+
+func toJobItem(job *model.Job) *api.JobItem {
+	item := &api.JobItem{
+		Id:              job.ID,
+		JobType:         job.JobType,
+		Status:          job.Status,
+		ProgressDone:    job.ProgressDone,
+		ProgressTotal:   job.ProgressTotal,
+		Attempts:        job.Attempts,
+		MaxAttempts:     job.MaxAttempts,
+		CancelRequested: job.CancelRequested == 1,
+		HasResultFile:   job.Status == model.JobStatusSucceeded && job.ResultFile != "",
+		ResultFileName:  job.ResultFileName,
+		ErrorMessage:    job.ErrorMessage,
+		CreatedAt:       job.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+	if job.Result != nil {
+		item.Result = *job.Result
+	}
+	if job.Status == model.JobStatusPending && job.Attempts > 0 {
+		item.NextRunAt = job.NextRunAt.Format("2006-01-02 15:04:05")
+	}
+	if job.StartedAt != nil {
+		item.StartedAt = job.StartedAt.Format("2006-01-02 15:04:05")
+	}
+	if job.FinishedAt != nil {
+		item.FinishedAt = job.FinishedAt.Format("2006-01-02 15:04:05")
+	}
+	return item
+}
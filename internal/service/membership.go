@@ -136,6 +136,8 @@ func (s *MembershipService) VolunteerJoinOrganization(req *api.VolunteerJoinRequ
 	record := &model.AuditRecord{
 		TargetType:    model.AuditTargetMember,
 		TargetID:      0,
+		OrgID:         orgID,
+		CreatorID:     userID,
 		AuditorID:     0,
 		OldContent:    "{}",
 		NewContent:    string(newContent),
@@ -261,6 +263,8 @@ func (s *MembershipService) VolunteerLeaveOrganization(req *api.VolunteerLeaveRe
 	record := &model.AuditRecord{
 		TargetType:    model.AuditTargetMember,
 		TargetID:      member.ID,
+		OrgID:         member.OrgID,
+		CreatorID:     userID,
 		AuditorID:     0,
 		OldContent:    string(oldContent),
 		NewContent:    string(newContent),
@@ -293,13 +297,13 @@ func (s *MembershipService) GetOrganizationMembers(req *api.OrganizationMembersR
 		req.PageSize = 20
 	}
 
-	// Permission: only organization owner.
+	// Permission: organization owner or active co-admin.
 	userID, err := middleware.GetUserIDInt(s.c)
 	if err != nil {
 		log.Error("查询组织成员列表失败: 获取当前用户失败: %v, organization_id=%d", err, req.OrganizationId)
 		return nil, err
 	}
-	organizations, err := s.repo.FindOrganizationByAccountID(s.repo.DB, userID)
+	organizations, err := s.repo.FindManagedOrganizations(s.repo.DB, userID)
 	if err != nil {
 		log.Error("查询组织成员列表失败: 查询组织异常: %v, organization_id=%d user_id=%d", err, req.OrganizationId, userID)
 		return nil, err
@@ -466,10 +470,12 @@ func (s *MembershipService) GetVolunteerOrganizations(req *api.VolunteerOrganiza
 	return resp, nil
 }
 
-// UpdateMemberStatus updates membership status by organization owner.
+// UpdateMemberStatus updates membership status by organization owner or members with member.approve.
 func (s *MembershipService) UpdateMemberStatus(req *api.MemberStatusUpdateRequest) (*api.MemberStatusUpdateResponse, error) {
-	if req.AccountId <= 0 {
-		return nil, errors.New("组织管理者ID不能为空")
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("更新成员状态失败: 获取当前用户失败: %v", err)
+		return nil, err
 	}
 	if req.MembershipId <= 0 {
 		return nil, errors.New("成员关系ID不能为空")
@@ -494,19 +500,23 @@ func (s *MembershipService) UpdateMemberStatus(req *api.MemberStatusUpdateReques
 		return nil, err
 	}
 
-	// Permission: only organization owner for the membership.
+	// Permission: organization owner, or a member granted member.approve in this organization.
 	organization, err := s.repo.GetOrganizationByID(s.repo.DB, member.OrgID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			log.Error("更新成员状态失败: 组织不存在, membership_id=%d org_id=%d", req.MembershipId, member.OrgID)
 			return nil, errors.New("组织不存在")
 		}
-		log.Error("更新成员状态失败: 查询组织异常: %v, membership_id=%d org_id=%d account_id=%d", err, req.MembershipId, member.OrgID, req.AccountId)
+		log.Error("更新成员状态失败: 查询组织异常: %v, membership_id=%d org_id=%d account_id=%d", err, req.MembershipId, member.OrgID, userID)
 		return nil, err
 	}
-	if organization.AccountID != req.AccountId {
+	if !s.canActForOrg(organization, userID) {
 		return nil, errors.New("无权操作该组织")
 	}
+	// 成员管理员只能变更角色低于自己的成员
+	if role, ok := middleware.GetOrgRole(s.c); ok && role != model.OrgRoleOwner && member.Role >= role {
+		return nil, errors.New("无权变更同级或更高角色成员的状态")
+	}
 
 	updates := map[string]any{
 		"status": req.Status,
@@ -536,7 +546,7 @@ func (s *MembershipService) MembershipStats(req *api.MembershipStatsRequest) (*a
 			log.Error("查询成员统计失败: 获取当前用户失败: %v", err)
 			return nil, err
 		}
-		organizations, err := s.repo.FindOrganizationByAccountID(s.repo.DB, userID)
+		organizations, err := s.repo.FindManagedOrganizations(s.repo.DB, userID)
 		if err != nil {
 			log.Error("查询成员统计失败: 查询组织异常: %v, user_id=%d", err, userID)
 			return nil, err
@@ -551,7 +561,7 @@ func (s *MembershipService) MembershipStats(req *api.MembershipStatsRequest) (*a
 			log.Error("查询成员统计失败: 获取当前用户失败: %v, organization_id=%d", err, orgID)
 			return nil, err
 		}
-		organizations, err := s.repo.FindOrganizationByAccountID(s.repo.DB, userID)
+		organizations, err := s.repo.FindManagedOrganizations(s.repo.DB, userID)
 		if err != nil {
 			log.Error("查询成员统计失败: 查询组织异常: %v, organization_id=%d user_id=%d", err, orgID, userID)
 			return nil, err
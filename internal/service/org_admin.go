@@ -0,0 +1,460 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/internal/repository"
+	"volunteer-system/pkg/util"
+
+	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
+)
+
+type OrgAdminService struct {
+	Service
+}
+
+func NewOrgAdminService(ctx context.Context, c *app.RequestContext) *OrgAdminService {
+	return &OrgAdminService{
+		Service{
+			ctx:  ctx,
+			c:    c,
+			repo: repository.NewRepository(ctx, c),
+		},
+	}
+}
+
+// InviteOrgAdmin 邀请协作管理员（仅组织主账号）
+func (s *OrgAdminService) InviteOrgAdmin(req *api.InviteOrgAdminRequest) (*api.OrgAdminItem, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	role := req.Role
+	if role == 0 {
+		role = model.MemberRoleManager
+	}
+	if !model.IsValidOrgAdminRole(role) {
+		return nil, errors.New("角色无效")
+	}
+
+	org, err := s.getOwnedOrganization(req.OrgId, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitee, err := s.findAccountByEmailOrMobile(req.Account)
+	if err != nil {
+		return nil, err
+	}
+	if invitee.ID == userID {
+		return nil, errors.New("不能邀请自己")
+	}
+	if invitee.IdentityType != model.RegisterTypeVolunteerCode && invitee.IdentityType != model.RegisterTypeOrganizationCode {
+		return nil, errors.New("该账号不能被邀请为组织管理员")
+	}
+	if invitee.Status != model.SysAccountNormal {
+		return nil, errors.New("该账号已被禁用")
+	}
+
+	var grant *model.OrgAdmin
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		existing, err := s.repo.FindOrgAdmin(tx, org.ID, invitee.ID)
+		if err != nil {
+			return err
+		}
+		if existing == nil {
+			grant = &model.OrgAdmin{
+				OrgID:     org.ID,
+				AccountID: invitee.ID,
+				Role:      role,
+				Status:    model.OrgAdminStatusPending,
+				InvitedBy: userID,
+			}
+			return s.repo.CreateOrgAdmin(tx, grant)
+		}
+		switch existing.Status {
+		case model.OrgAdminStatusPending:
+			return errors.New("已邀请该账号，等待对方接受")
+		case model.OrgAdminStatusActive:
+			return errors.New("该账号已是本组织管理员")
+		}
+		// 已拒绝或已撤销的授权重新发起邀请
+		updates := map[string]any{
+			"role":        role,
+			"status":      model.OrgAdminStatusPending,
+			"invited_by":  userID,
+			"accepted_at": nil,
+			"revoked_at":  nil,
+		}
+		if err := s.repo.UpdateOrgAdminFields(tx, existing.ID, updates); err != nil {
+			return err
+		}
+		existing.Role = role
+		existing.Status = model.OrgAdminStatusPending
+		existing.InvitedBy = userID
+		existing.AcceptedAt = nil
+		existing.RevokedAt = nil
+		grant = existing
+		return nil
+	})
+	if err != nil {
+		log.Warn("邀请组织管理员失败: %v, org_id=%d invitee_id=%d", err, org.ID, invitee.ID)
+		return nil, err
+	}
+
+	log.Info("邀请组织管理员: org_id=%d invitee_id=%d role=%d operator_id=%d", org.ID, invitee.ID, role, userID)
+	return &api.OrgAdminItem{
+		Id:        grant.ID,
+		OrgId:     grant.OrgID,
+		AccountId: grant.AccountID,
+		Username:  invitee.Username,
+		Email:     invitee.Email,
+		Role:      grant.Role,
+		Status:    grant.Status,
+		CreatedAt: util.FormatDateTimeOrEmpty(grant.CreatedAt),
+	}, nil
+}
+
+// OrgAdminList 组织协作管理员列表（组织主账号与有效的协作管理员可查看）
+func (s *OrgAdminService) OrgAdminList(req *api.OrgAdminListRequest) (*api.OrgAdminListResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.repo.GetOrganizationByID(s.repo.DB, req.OrgId)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("组织不存在")
+		}
+		return nil, err
+	}
+	if org.AccountID != userID {
+		grant, err := s.repo.FindOrgAdmin(s.repo.DB, org.ID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if grant == nil || grant.Status != model.OrgAdminStatusActive {
+			return nil, errors.New("无权操作该组织")
+		}
+	}
+
+	rows, err := s.repo.ListOrgAdmins(s.repo.DB, org.ID)
+	if err != nil {
+		log.Error("查询组织管理员列表失败: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+	resp := &api.OrgAdminListResponse{
+		OwnerAccountId: org.AccountID,
+		List:           make([]*api.OrgAdminItem, 0, len(rows)),
+	}
+	for _, row := range rows {
+		resp.List = append(resp.List, &api.OrgAdminItem{
+			Id:         row.ID,
+			OrgId:      row.OrgID,
+			AccountId:  row.AccountID,
+			Username:   row.Username,
+			Email:      row.Email,
+			Role:       row.Role,
+			Status:     row.Status,
+			AcceptedAt: util.FormatDateTimePtr(row.AcceptedAt),
+			CreatedAt:  util.FormatDateTimeOrEmpty(row.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// UpdateOrgAdminRole 调整协作管理员角色（仅组织主账号）
+func (s *OrgAdminService) UpdateOrgAdminRole(req *api.UpdateOrgAdminRoleRequest) (*api.OrgAdminItem, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	if !model.IsValidOrgAdminRole(req.Role) {
+		return nil, errors.New("角色无效")
+	}
+	grant, err := s.getOrgAdmin(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.getOwnedOrganization(grant.OrgID, userID); err != nil {
+		return nil, err
+	}
+	if grant.Status != model.OrgAdminStatusPending && grant.Status != model.OrgAdminStatusActive {
+		return nil, errors.New("该授权已失效")
+	}
+
+	if err := s.repo.UpdateOrgAdminFields(s.repo.DB, grant.ID, map[string]any{"role": req.Role}); err != nil {
+		log.Error("调整组织管理员角色失败: %v, org_admin_id=%d", err, grant.ID)
+		return nil, err
+	}
+	log.Info("调整组织管理员角色: org_admin_id=%d org_id=%d role=%d->%d operator_id=%d", grant.ID, grant.OrgID, grant.Role, req.Role, userID)
+
+	grant.Role = req.Role
+	return &api.OrgAdminItem{
+		Id:         grant.ID,
+		OrgId:      grant.OrgID,
+		AccountId:  grant.AccountID,
+		Role:       grant.Role,
+		Status:     grant.Status,
+		AcceptedAt: util.FormatDateTimePtr(grant.AcceptedAt),
+		CreatedAt:  util.FormatDateTimeOrEmpty(grant.CreatedAt),
+	}, nil
+}
+
+// RevokeOrgAdmin 撤销协作管理员：组织主账号可撤销任意授权，协作管理员可撤销自己的授权（退出）
+func (s *OrgAdminService) RevokeOrgAdmin(req *api.OrgAdminIDRequest) (*api.OrgAdminActionResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	grant, err := s.getOrgAdmin(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if grant.AccountID != userID {
+		if _, err := s.getOwnedOrganization(grant.OrgID, userID); err != nil {
+			return nil, err
+		}
+	}
+	if grant.Status != model.OrgAdminStatusPending && grant.Status != model.OrgAdminStatusActive {
+		return nil, errors.New("该授权已失效")
+	}
+
+	now := time.Now()
+	updates := map[string]any{
+		"status":     model.OrgAdminStatusRevoked,
+		"revoked_at": &now,
+	}
+	if err := s.repo.UpdateOrgAdminFields(s.repo.DB, grant.ID, updates); err != nil {
+		log.Error("撤销组织管理员失败: %v, org_admin_id=%d", err, grant.ID)
+		return nil, err
+	}
+	log.Info("撤销组织管理员: org_admin_id=%d org_id=%d account_id=%d operator_id=%d", grant.ID, grant.OrgID, grant.AccountID, userID)
+	return &api.OrgAdminActionResponse{Message: "已撤销"}, nil
+}
+
+// MyOrgAdminInvitations 当前账号收到的邀请及已生效的授权
+func (s *OrgAdminService) MyOrgAdminInvitations(req *api.MyOrgAdminInvitationsRequest) (*api.MyOrgAdminInvitationsResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	rows, err := s.repo.ListOrgAdminInvitations(s.repo.DB, userID)
+	if err != nil {
+		log.Error("查询组织管理邀请失败: %v, account_id=%d", err, userID)
+		return nil, err
+	}
+	resp := &api.MyOrgAdminInvitationsResponse{
+		List: make([]*api.OrgAdminInvitationItem, 0, len(rows)),
+	}
+	for _, row := range rows {
+		resp.List = append(resp.List, &api.OrgAdminInvitationItem{
+			Id:        row.ID,
+			OrgId:     row.OrgID,
+			OrgName:   row.OrgName,
+			Role:      row.Role,
+			Status:    row.Status,
+			CreatedAt: util.FormatDateTimeOrEmpty(row.CreatedAt),
+		})
+	}
+	return resp, nil
+}
+
+// AcceptOrgAdminInvitation 接受协作管理邀请
+func (s *OrgAdminService) AcceptOrgAdminInvitation(req *api.OrgAdminIDRequest) (*api.OrgAdminActionResponse, error) {
+	grant, err := s.getPendingInvitation(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	updates := map[string]any{
+		"status":      model.OrgAdminStatusActive,
+		"accepted_at": &now,
+	}
+	if err := s.repo.UpdateOrgAdminFields(s.repo.DB, grant.ID, updates); err != nil {
+		log.Error("接受组织管理邀请失败: %v, org_admin_id=%d", err, grant.ID)
+		return nil, err
+	}
+	log.Info("接受组织管理邀请: org_admin_id=%d org_id=%d account_id=%d", grant.ID, grant.OrgID, grant.AccountID)
+	return &api.OrgAdminActionResponse{Message: "已接受邀请"}, nil
+}
+
+// DeclineOrgAdminInvitation 拒绝协作管理邀请
+func (s *OrgAdminService) DeclineOrgAdminInvitation(req *api.OrgAdminIDRequest) (*api.OrgAdminActionResponse, error) {
+	grant, err := s.getPendingInvitation(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.repo.UpdateOrgAdminFields(s.repo.DB, grant.ID, map[string]any{"status": model.OrgAdminStatusDeclined}); err != nil {
+		log.Error("拒绝组织管理邀请失败: %v, org_admin_id=%d", err, grant.ID)
+		return nil, err
+	}
+	return &api.OrgAdminActionResponse{Message: "已拒绝邀请"}, nil
+}
+
+// TransferOrgOwnership 转让组织主账号：受让人须为有效的协作管理员，原主账号转为负责人
+func (s *OrgAdminService) TransferOrgOwnership(req *api.TransferOrgOwnershipRequest) (*api.OrgAdminActionResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	org, err := s.getOwnedOrganization(req.OrgId, userID)
+	if err != nil {
+		return nil, err
+	}
+	grant, err := s.getOrgAdmin(req.OrgAdminId)
+	if err != nil {
+		return nil, err
+	}
+	if grant.OrgID != org.ID || grant.Status != model.OrgAdminStatusActive {
+		return nil, errors.New("受让人须为本组织有效的协作管理员")
+	}
+	newOwner, err := s.repo.FindByID(s.repo.DB, grant.AccountID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("受让账号不存在")
+		}
+		return nil, err
+	}
+	if newOwner.Status != model.SysAccountNormal {
+		return nil, errors.New("受让账号已被禁用")
+	}
+	// organizations.account_id 唯一，一个账号只能是一个组织的主账号
+	owned, err := s.repo.FindOrganizationByAccountID(s.repo.DB, newOwner.ID)
+	if err != nil {
+		return nil, err
+	}
+	if len(owned) > 0 {
+		return nil, errors.New("受让账号已是其他组织的主账号，无法受让")
+	}
+
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		if err := s.repo.UpdateOrganization(tx, org.ID, map[string]any{"account_id": newOwner.ID}); err != nil {
+			return err
+		}
+		// 受让人成为主账号后不再需要协作授权
+		now := time.Now()
+		if err := s.repo.UpdateOrgAdminFields(tx, grant.ID, map[string]any{
+			"status":     model.OrgAdminStatusRevoked,
+			"revoked_at": &now,
+		}); err != nil {
+			return err
+		}
+
+		// 原主账号转为负责人
+		previous, err := s.repo.FindOrgAdmin(tx, org.ID, userID)
+		if err != nil {
+			return err
+		}
+		if previous == nil {
+			return s.repo.CreateOrgAdmin(tx, &model.OrgAdmin{
+				OrgID:      org.ID,
+				AccountID:  userID,
+				Role:       model.MemberRoleLeader,
+				Status:     model.OrgAdminStatusActive,
+				InvitedBy:  newOwner.ID,
+				AcceptedAt: &now,
+			})
+		}
+		return s.repo.UpdateOrgAdminFields(tx, previous.ID, map[string]any{
+			"role":        model.MemberRoleLeader,
+			"status":      model.OrgAdminStatusActive,
+			"invited_by":  newOwner.ID,
+			"accepted_at": &now,
+			"revoked_at":  nil,
+		})
+	})
+	if err != nil {
+		log.Error("转让组织主账号失败: %v, org_id=%d from=%d to=%d", err, org.ID, userID, newOwner.ID)
+		return nil, err
+	}
+
+	log.Info("转让组织主账号: org_id=%d from=%d to=%d", org.ID, userID, newOwner.ID)
+	return &api.OrgAdminActionResponse{Message: "已转让"}, nil
+}
+
+// getOwnedOrganization 查询组织并校验当前账号为组织主账号
+func (s *OrgAdminService) getOwnedOrganization(orgID, accountID int64) (*model.Organization, error) {
+	if orgID <= 0 {
+		return nil, errors.New("组织ID不能为空")
+	}
+	org, err := s.repo.GetOrganizationByID(s.repo.DB, orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("组织不存在")
+		}
+		return nil, err
+	}
+	if org.AccountID != accountID {
+		return nil, errors.New("仅组织主账号可执行该操作")
+	}
+	return org, nil
+}
+
+func (s *OrgAdminService) getOrgAdmin(id int64) (*model.OrgAdmin, error) {
+	if id <= 0 {
+		return nil, errors.New("授权ID不能为空")
+	}
+	grant, err := s.repo.GetOrgAdminByID(s.repo.DB, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("授权记录不存在")
+		}
+		return nil, err
+	}
+	return grant, nil
+}
+
+// getPendingInvitation 查询当前账号待接受的邀请
+func (s *OrgAdminService) getPendingInvitation(id int64) (*model.OrgAdmin, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	grant, err := s.getOrgAdmin(id)
+	if err != nil {
+		return nil, err
+	}
+	if grant.AccountID != userID {
+		return nil, errors.New("授权记录不存在")
+	}
+	if grant.Status != model.OrgAdminStatusPending {
+		return nil, errors.New("邀请已处理")
+	}
+	return grant, nil
+}
+
+// findAccountByEmailOrMobile 按邮箱或手机号查询账号
+func (s *OrgAdminService) findAccountByEmailOrMobile(value string) (*model.SysAccount, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return nil, errors.New("请输入被邀请账号的邮箱或手机号")
+	}
+
+	var (
+		account *model.SysAccount
+		err     error
+	)
+	if strings.Contains(value, "@") {
+		account, err = s.repo.FindByEmail(s.repo.DB, value)
+	} else {
+		mobileHash, hashErr := util.HashSensitiveField(value)
+		if hashErr != nil {
+			return nil, errors.New("手机号格式不正确")
+		}
+		account, err = s.repo.FindByMobile(s.repo.DB, mobileHash)
+	}
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("被邀请账号不存在")
+		}
+		return nil, err
+	}
+	return account, nil
+}
@@ -0,0 +1,452 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"volunteer-system/config"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// orgQualificationFileDir 资质材料在上传目录下的子目录
+	orgQualificationFileDir = "org_qualifications"
+	// defaultOrgQualificationMaxFileSizeMB 未配置上传大小时的资质材料大小上限
+	defaultOrgQualificationMaxFileSizeMB = 10
+	// maxOrgQualificationDocuments 单次提交的材料数量上限
+	maxOrgQualificationDocuments = 10
+)
+
+// orgQualificationContentTypes 允许上传的资质材料格式
+var orgQualificationContentTypes = map[string]string{
+	".jpg":  "image/jpeg",
+	".jpeg": "image/jpeg",
+	".png":  "image/png",
+	".pdf":  "application/pdf",
+}
+
+// orgQualificationSnapshot 资质审核提交快照（audit_records.new_content）
+type orgQualificationSnapshot struct {
+	OrgName       string                        `json:"org_name"`
+	LicenseCode   string                        `json:"license_code"`
+	ContactPerson string                        `json:"contact_person"`
+	Documents     []orgQualificationSnapshotDoc `json:"documents,omitempty"`
+}
+
+type orgQualificationSnapshotDoc struct {
+	ID       int64  `json:"id"`
+	DocType  int32  `json:"doc_type"`
+	FileName string `json:"file_name"`
+}
+
+// UploadQualificationDocument 上传资质材料，审核中不可修改材料
+func (s *OrganizationService) UploadQualificationDocument(req *api.UploadQualificationDocumentRequest, file *multipart.FileHeader) (*api.OrgQualificationDocumentItem, error) {
+	if file == nil {
+		return nil, errors.New("请上传资质材料文件")
+	}
+	if !model.IsValidOrgQualificationDocType(req.DocType) {
+		return nil, errors.New("材料类型无效")
+	}
+	ext := strings.ToLower(filepath.Ext(file.Filename))
+	if _, ok := orgQualificationContentTypes[ext]; !ok {
+		return nil, errors.New("资质材料仅支持 jpg、png、pdf 格式")
+	}
+
+	userID, org, err := s.currentQualificationOrganization()
+	if err != nil {
+		return nil, err
+	}
+	if org.VerifyStatus == model.OrgVerifyStatusPending {
+		return nil, errors.New("资质审核中，暂不能修改材料")
+	}
+
+	drafts, err := s.repo.ListOrgQualificationDocuments(s.repo.DB, org.ID, 0)
+	if err != nil {
+		log.Error("上传资质材料失败: 查询待提交材料异常: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+	if len(drafts) >= maxOrgQualificationDocuments {
+		return nil, fmt.Errorf("资质材料最多上传%d份", maxOrgQualificationDocuments)
+	}
+
+	data, err := readOrgQualificationFile(file)
+	if err != nil {
+		return nil, err
+	}
+	relPath, err := saveOrgQualificationFile(org.ID, ext, data)
+	if err != nil {
+		log.Error("上传资质材料失败: 保存文件异常: %v, org_id=%d", err, org.ID)
+		return nil, errors.New("保存资质材料失败")
+	}
+
+	doc := &model.OrgQualificationDocument{
+		OrgID:      org.ID,
+		DocType:    req.DocType,
+		FileName:   truncateRunes(filepath.Base(file.Filename), 255),
+		FilePath:   relPath,
+		FileSize:   int64(len(data)),
+		UploadedBy: userID,
+	}
+	if err := s.repo.CreateOrgQualificationDocument(s.repo.DB, doc); err != nil {
+		removeUploadFile(relPath)
+		log.Error("上传资质材料失败: 保存材料记录异常: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+
+	log.Info("上传资质材料: org_id=%d doc_id=%d doc_type=%d size=%d operator_id=%d", org.ID, doc.ID, doc.DocType, doc.FileSize, userID)
+	return toOrgQualificationDocumentItem(doc), nil
+}
+
+// DeleteQualificationDocument 删除尚未提交的资质材料
+func (s *OrganizationService) DeleteQualificationDocument(req *api.DeleteQualificationDocumentRequest) (*api.OrgQualificationActionResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("材料ID不能为空")
+	}
+	userID, org, err := s.currentQualificationOrganization()
+	if err != nil {
+		return nil, err
+	}
+	doc, err := s.getOrgQualificationDocument(req.Id)
+	if err != nil {
+		return nil, err
+	}
+	if doc.OrgID != org.ID {
+		return nil, errors.New("资质材料不存在")
+	}
+	if doc.AuditRecordID > 0 {
+		return nil, errors.New("已提交审核的材料不可删除")
+	}
+
+	if err := s.repo.DeleteOrgQualificationDocument(s.repo.DB, doc.ID); err != nil {
+		log.Error("删除资质材料失败: %v, doc_id=%d", err, doc.ID)
+		return nil, err
+	}
+	removeUploadFile(doc.FilePath)
+
+	log.Info("删除资质材料: org_id=%d doc_id=%d operator_id=%d", org.ID, doc.ID, userID)
+	return &api.OrgQualificationActionResponse{Message: "已删除"}, nil
+}
+
+// DownloadQualificationDocument 下载资质材料：组织端仅可下载本组织材料，平台管理员可下载全部材料
+func (s *OrganizationService) DownloadQualificationDocument(req *api.DownloadQualificationDocumentRequest) (*api.DownloadQualificationDocumentResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("材料ID不能为空")
+	}
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := s.getOrgQualificationDocument(req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok {
+		if doc.OrgID != orgID {
+			return nil, errors.New("资质材料不存在")
+		}
+	} else {
+		account, err := s.repo.FindByID(s.repo.DB, userID)
+		if err != nil {
+			return nil, err
+		}
+		if account.IdentityType != model.RegisterTypeAdminCode {
+			return nil, errors.New("无权查看该资质材料")
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(uploadDir(), filepath.FromSlash(doc.FilePath)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, errors.New("资质材料文件不存在")
+		}
+		log.Error("下载资质材料失败: 读取文件异常: %v, doc_id=%d", err, doc.ID)
+		return nil, err
+	}
+
+	contentType, ok := orgQualificationContentTypes[strings.ToLower(filepath.Ext(doc.FilePath))]
+	if !ok {
+		contentType = "application/octet-stream"
+	}
+	return &api.DownloadQualificationDocumentResponse{
+		Content:     data,
+		FileName:    doc.FileName,
+		ContentType: contentType,
+	}, nil
+}
+
+// SubmitQualification 提交资质审核：将待提交材料绑定到新的审核记录，组织进入审核中状态
+func (s *OrganizationService) SubmitQualification(req *api.SubmitQualificationRequest) (*api.OrgQualificationStatusResponse, error) {
+	userID, org, err := s.currentQualificationOrganization()
+	if err != nil {
+		return nil, err
+	}
+	switch org.VerifyStatus {
+	case model.OrgVerifyStatusPending:
+		return nil, errors.New("资质审核中，请勿重复提交")
+	case model.OrgVerifyStatusVerified:
+		return nil, errors.New("组织资质已认证")
+	}
+
+	licenseCode := strings.TrimSpace(req.LicenseCode)
+	if licenseCode == "" {
+		licenseCode = strings.TrimSpace(org.LicenseCode)
+	}
+	if licenseCode == "" {
+		return nil, errors.New("统一社会信用代码不能为空")
+	}
+	if len(licenseCode) > 50 {
+		return nil, errors.New("统一社会信用代码长度不能超过50个字符")
+	}
+
+	drafts, err := s.repo.ListOrgQualificationDocuments(s.repo.DB, org.ID, 0)
+	if err != nil {
+		log.Error("提交资质审核失败: 查询待提交材料异常: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+	hasLicense := false
+	docs := make([]orgQualificationSnapshotDoc, 0, len(drafts))
+	for _, doc := range drafts {
+		if doc.DocType == model.OrgQualificationDocLicense {
+			hasLicense = true
+		}
+		docs = append(docs, orgQualificationSnapshotDoc{ID: doc.ID, DocType: doc.DocType, FileName: doc.FileName})
+	}
+	if !hasLicense {
+		return nil, errors.New("请先上传登记证书或营业执照")
+	}
+
+	oldContent, err := util.MarshalSnapshot(orgQualificationSnapshot{
+		OrgName:       org.OrgName,
+		LicenseCode:   org.LicenseCode,
+		ContactPerson: org.ContactPerson,
+	})
+	if err != nil {
+		return nil, err
+	}
+	newContent, err := util.MarshalSnapshot(orgQualificationSnapshot{
+		OrgName:       org.OrgName,
+		LicenseCode:   licenseCode,
+		ContactPerson: org.ContactPerson,
+		Documents:     docs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	record := &model.AuditRecord{
+		TargetType:    model.AuditTargetOrg,
+		TargetID:      org.ID,
+		OrgID:         org.ID,
+		CreatorID:     userID,
+		OldContent:    oldContent,
+		NewContent:    newContent,
+		AuditTime:     time.Now(),
+		OperationType: model.OperationTypeUpdate,
+		Status:        model.AuditStatusPending,
+	}
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		if err := s.repo.CreateAuditRecord(tx, record); err != nil {
+			return err
+		}
+		if err := s.repo.BindOrgQualificationDocuments(tx, org.ID, record.ID); err != nil {
+			return err
+		}
+		return s.repo.UpdateOrganization(tx, org.ID, map[string]any{
+			"verify_status": model.OrgVerifyStatusPending,
+		})
+	})
+	if err != nil {
+		log.Error("提交资质审核失败: 创建审核记录异常: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+
+	log.Info("提交资质审核: org_id=%d record_id=%d documents=%d operator_id=%d", org.ID, record.ID, len(docs), userID)
+	org.VerifyStatus = model.OrgVerifyStatusPending
+	return s.buildQualificationStatus(org)
+}
+
+// QualificationStatus 查询资质认证状态、待提交材料及历次提交记录
+func (s *OrganizationService) QualificationStatus(req *api.QualificationStatusRequest) (*api.OrgQualificationStatusResponse, error) {
+	_, org, err := s.currentQualificationOrganization()
+	if err != nil {
+		return nil, err
+	}
+	return s.buildQualificationStatus(org)
+}
+
+func (s *OrganizationService) buildQualificationStatus(org *model.Organization) (*api.OrgQualificationStatusResponse, error) {
+	resp := &api.OrgQualificationStatusResponse{
+		OrgId:        org.ID,
+		VerifyStatus: org.VerifyStatus,
+		VerifiedAt:   util.FormatDateTimePtr(org.VerifiedAt),
+		LicenseCode:  org.LicenseCode,
+		Documents:    []*api.OrgQualificationDocumentItem{},
+		History:      []*api.OrgQualificationSubmission{},
+	}
+
+	drafts, err := s.repo.ListOrgQualificationDocuments(s.repo.DB, org.ID, 0)
+	if err != nil {
+		log.Error("查询资质认证状态失败: 查询待提交材料异常: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+	for _, doc := range drafts {
+		resp.Documents = append(resp.Documents, toOrgQualificationDocumentItem(doc))
+	}
+
+	records, err := s.repo.ListAuditRecordsByTarget(s.repo.DB, model.AuditTargetOrg, org.ID)
+	if err != nil {
+		log.Error("查询资质认证状态失败: 查询审核记录异常: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+	recordIDs := make([]int64, 0, len(records))
+	for _, record := range records {
+		recordIDs = append(recordIDs, record.ID)
+	}
+	docs, err := s.repo.ListOrgQualificationDocumentsByRecordIDs(s.repo.DB, recordIDs)
+	if err != nil {
+		log.Error("查询资质认证状态失败: 查询已提交材料异常: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+	docsByRecord := make(map[int64][]*api.OrgQualificationDocumentItem, len(records))
+	for _, doc := range docs {
+		docsByRecord[doc.AuditRecordID] = append(docsByRecord[doc.AuditRecordID], toOrgQualificationDocumentItem(doc))
+	}
+
+	for _, record := range records {
+		item := &api.OrgQualificationSubmission{
+			AuditRecordId: record.ID,
+			Status:        record.Status,
+			RejectReason:  record.RejectReason,
+			CreatedAt:     util.FormatDateTimeOrEmpty(record.CreatedAt),
+			Documents:     docsByRecord[record.ID],
+		}
+		if record.Status != model.AuditStatusPending {
+			item.AuditTime = util.FormatDateTimeOrEmpty(record.AuditTime)
+		}
+		var snapshot orgQualificationSnapshot
+		if err := json.Unmarshal([]byte(record.NewContent), &snapshot); err == nil {
+			item.LicenseCode = snapshot.LicenseCode
+		}
+		if item.Documents == nil {
+			item.Documents = []*api.OrgQualificationDocumentItem{}
+		}
+		resp.History = append(resp.History, item)
+	}
+	return resp, nil
+}
+
+// currentQualificationOrganization 当前操作的组织（经权限中间件解析），返回账号ID与组织信息
+func (s *OrganizationService) currentQualificationOrganization() (int64, *model.Organization, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return 0, nil, err
+	}
+	orgID, err := s.currentOrgID(s.repo.DB, userID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil, errors.New("组织信息不存在")
+		}
+		return 0, nil, err
+	}
+	org, err := s.repo.GetOrganizationByID(s.repo.DB, orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil, errors.New("组织信息不存在")
+		}
+		return 0, nil, err
+	}
+	return userID, org, nil
+}
+
+func (s *OrganizationService) getOrgQualificationDocument(id int64) (*model.OrgQualificationDocument, error) {
+	doc, err := s.repo.GetOrgQualificationDocumentByID(s.repo.DB, id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("资质材料不存在")
+		}
+		return nil, err
+	}
+	return doc, nil
+}
+
+// ensureOrganizationVerified 组织资质认证通过后方可发布活动
+func ensureOrganizationVerified(org *model.Organization) error {
+	switch org.VerifyStatus {
+	case model.OrgVerifyStatusVerified:
+		return nil
+	case model.OrgVerifyStatusPending:
+		return errors.New("组织资质审核中，审核通过后方可发布活动")
+	default:
+		return errors.New("组织资质未认证，请先提交资质审核")
+	}
+}
+
+// readOrgQualificationFile 读取上传的资质材料，大小受上传配置限制
+func readOrgQualificationFile(file *multipart.FileHeader) ([]byte, error) {
+	maxSizeMB := defaultOrgQualificationMaxFileSizeMB
+	if cfg := config.GetConfig(); cfg != nil && cfg.Upload != nil && cfg.Upload.MaxFileSizeMB > 0 {
+		maxSizeMB = cfg.Upload.MaxFileSizeMB
+	}
+	maxSize := int64(maxSizeMB) << 20
+	if file.Size > maxSize {
+		return nil, fmt.Errorf("资质材料不能超过%dMB", maxSizeMB)
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		log.Error("读取资质材料失败: %v, file=%s", err, file.Filename)
+		return nil, errors.New("读取资质材料失败")
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, maxSize+1))
+	if err != nil {
+		log.Error("读取资质材料失败: %v, file=%s", err, file.Filename)
+		return nil, errors.New("读取资质材料失败")
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("资质材料不能超过%dMB", maxSizeMB)
+	}
+	if len(data) == 0 {
+		return nil, errors.New("资质材料文件为空")
+	}
+	return data, nil
+}
+
+// saveOrgQualificationFile 将资质材料保存到上传目录，返回相对路径
+func saveOrgQualificationFile(orgID int64, ext string, data []byte) (string, error) {
+	relPath := path.Join(orgQualificationFileDir, strconv.FormatInt(orgID, 10), uuid.NewString()+ext)
+	fullPath := filepath.Join(uploadDir(), filepath.FromSlash(relPath))
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(fullPath, data, 0o644); err != nil {
+		return "", err
+	}
+	return relPath, nil
+}
+
+func toOrgQualificationDocumentItem(doc *model.OrgQualificationDocument) *api.OrgQualificationDocumentItem {
+	return &api.OrgQualificationDocumentItem{
+		Id:        doc.ID,
+		DocType:   doc.DocType,
+		FileName:  doc.FileName,
+		FileSize:  doc.FileSize,
+		CreatedAt: util.FormatDateTimeOrEmpty(doc.CreatedAt),
+	}
+}
@@ -129,6 +129,7 @@ func (s *OrganizationService) OrganizationDetail(req *api.OrganizationDetailRequ
 			LogoUrl:          organization.LogoURL,
 			CreatedAt:        organization.CreatedAt.Format("2006-01-02 15:04:05"),
 			UpdatedAt:        organization.UpdatedAt.Format("2006-01-02 15:04:05"),
+			VerifyStatus:     organization.VerifyStatus,
 		},
 	}
 
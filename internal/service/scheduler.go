@@ -0,0 +1,188 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+	"volunteer-system/config"
+	"volunteer-system/internal/model"
+	"volunteer-system/internal/repository"
+
+	"gorm.io/gorm"
+)
+
+const (
+	defaultSchedulerBatchSize          = 100
+	defaultSchedulerFinishGraceMinutes = 30
+)
+
+// SchedulerService 后台调度任务（无请求上下文，操作人记为系统 0）
+type SchedulerService struct {
+	ActivityService
+}
+
+// schedulerPolicy 调度策略
+type schedulerPolicy struct {
+	BatchSize         int
+	SignupCloseBefore time.Duration
+	FinishGrace       time.Duration
+}
+
+func NewSchedulerService(ctx context.Context) *SchedulerService {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	return &SchedulerService{
+		ActivityService{
+			Service{
+				ctx:  ctx,
+				c:    nil,
+				repo: repository.NewRepository(ctx, nil),
+			},
+		},
+	}
+}
+
+// currentSchedulerPolicy 读取调度策略，未配置的项使用默认值。
+func currentSchedulerPolicy() schedulerPolicy {
+	policy := schedulerPolicy{
+		BatchSize:   defaultSchedulerBatchSize,
+		FinishGrace: defaultSchedulerFinishGraceMinutes * time.Minute,
+	}
+	cfg := config.GetConfig()
+	if cfg == nil || cfg.Scheduler == nil {
+		return policy
+	}
+	if cfg.Scheduler.BatchSize > 0 {
+		policy.BatchSize = cfg.Scheduler.BatchSize
+	}
+	if cfg.Scheduler.SignupCloseBeforeMinutes > 0 {
+		policy.SignupCloseBefore = time.Duration(cfg.Scheduler.SignupCloseBeforeMinutes) * time.Minute
+	}
+	if cfg.Scheduler.FinishGraceMinutes > 0 {
+		policy.FinishGrace = time.Duration(cfg.Scheduler.FinishGraceMinutes) * time.Minute
+	}
+	return policy
+}
+
+// activitySignupDeadline 活动报名截止时间（开始时间前 signup_close_before_minutes）
+func activitySignupDeadline(activity *model.Activity) time.Time {
+	return activity.StartTime.Add(-currentSchedulerPolicy().SignupCloseBefore)
+}
+
+// CloseSignups 截止已到报名截止时间的活动报名
+func (s *SchedulerService) CloseSignups(now time.Time) (int64, error) {
+	policy := currentSchedulerPolicy()
+	count, err := s.repo.CloseActivitySignups(s.repo.DB, now.Add(policy.SignupCloseBefore))
+	if err != nil {
+		log.Error("调度任务失败: 截止活动报名异常: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// AutoFinishActivities 自动完结已结束的活动，并判定爽约、结算信用分
+func (s *SchedulerService) AutoFinishActivities(now time.Time) (int64, error) {
+	policy := currentSchedulerPolicy()
+	activities, err := s.repo.ListEndedRecruitingActivities(s.repo.DB, now.Add(-policy.FinishGrace), policy.BatchSize)
+	if err != nil {
+		log.Error("调度任务失败: 查询待完结活动异常: %v", err)
+		return 0, err
+	}
+
+	var finished int64
+	for _, activity := range activities {
+		if err := s.repo.FinishRecruitingActivity(s.repo.DB, activity.ID); err != nil {
+			// 组织已手动完结或取消
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			log.Error("调度任务失败: 自动完结活动异常: %v, activity_id=%d", err, activity.ID)
+			continue
+		}
+		finished++
+		activity.Status = model.ActivityStatusFinished
+
+		autoSettle, err := s.autoSettleActivityCheckouts(activity, now)
+		if err != nil {
+			log.Error("调度任务: 自动完结后未签退结算异常: %v, activity_id=%d", err, activity.ID)
+		}
+		settlement, err := s.settleActivityAttendance(activity, 0)
+		if err != nil {
+			log.Error("调度任务: 自动完结后出勤结算异常: %v, activity_id=%d", err, activity.ID)
+			continue
+		}
+		log.Info("活动已自动完结: activity_id=%d auto_settled=%d flagged=%d attended=%d no_show=%d", activity.ID, autoSettle.Granted, autoSettle.Flagged, settlement.Attended, settlement.NoShow)
+	}
+	return finished, nil
+}
+
+// AutoCheckoutSignups 按活动的未签退结算策略处理已结束活动中已签到未签退的报名
+func (s *SchedulerService) AutoCheckoutSignups(now time.Time) (int64, error) {
+	policy := currentSchedulerPolicy()
+	signups, err := s.repo.ListFinishedUncheckedOutSignups(s.repo.DB, policy.BatchSize)
+	if err != nil {
+		log.Error("调度任务失败: 查询待自动签退报名异常: %v", err)
+		return 0, err
+	}
+	if len(signups) == 0 {
+		return 0, nil
+	}
+	activityIDs := make([]int64, 0, len(signups))
+	for _, signup := range signups {
+		activityIDs = append(activityIDs, signup.ActivityID)
+	}
+	activityMap, err := s.repo.GetActivitiesByIDs(s.repo.DB, activityIDs)
+	if err != nil {
+		log.Error("调度任务失败: 查询活动信息异常: %v", err)
+		return 0, err
+	}
+
+	var processed int64
+	for _, signup := range signups {
+		activity, ok := activityMap[signup.ActivityID]
+		if !ok {
+			continue
+		}
+		granted, flagged, err := s.autoSettleSignupByID(activity, signup.ID, now)
+		if err != nil {
+			log.Error("调度任务失败: 自动签退结算异常: %v, activity_id=%d signup_id=%d", err, signup.ActivityID, signup.ID)
+			continue
+		}
+		if granted || flagged {
+			processed++
+		}
+	}
+	return processed, nil
+}
+
+// SettlePendingAttendance 补偿结算已结束活动中尚未判定出勤结果的报名（如组织手动完结时结算失败）
+func (s *SchedulerService) SettlePendingAttendance(now time.Time) (int64, error) {
+	policy := currentSchedulerPolicy()
+	activities, err := s.repo.ListFinishedActivitiesWithUnsettledAttendance(s.repo.DB, policy.BatchSize)
+	if err != nil {
+		log.Error("调度任务失败: 查询待结算活动异常: %v", err)
+		return 0, err
+	}
+
+	var settled int64
+	for _, activity := range activities {
+		settlement, err := s.settleActivityAttendance(activity, 0)
+		if err != nil {
+			log.Error("调度任务: 补偿出勤结算异常: %v, activity_id=%d", err, activity.ID)
+			continue
+		}
+		settled += int64(settlement.Attended + settlement.NoShow)
+	}
+	return settled, nil
+}
+
+// ExpireAttendanceCodes 使已结束活动的签到/签退码过期
+func (s *SchedulerService) ExpireAttendanceCodes(now time.Time) (int64, error) {
+	count, err := s.repo.ExpireEndedActivityAttendanceCodes(s.repo.DB, now, now)
+	if err != nil {
+		log.Error("调度任务失败: 过期签到签退码异常: %v", err)
+		return 0, err
+	}
+	return count, nil
+}
@@ -2,9 +2,12 @@ package service
 
 import (
 	"context"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
 	"volunteer-system/internal/repository"
 
 	"github.com/cloudwego/hertz/pkg/app"
+	"gorm.io/gorm"
 )
 
 type Service struct {
@@ -21,3 +24,24 @@ func NewService(ctx context.Context, c *app.RequestContext) *Service {
 		repo: repository.NewRepository(ctx, c),
 	}
 }
+
+// currentOrgID 当前操作的组织ID：经过组织权限中间件的接口取中间件解析出的组织，
+// 其余接口按组织账号查询其名下组织。
+func (s *Service) currentOrgID(db *gorm.DB, accountID int64) (int64, error) {
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok {
+		return orgID, nil
+	}
+	org, err := s.repo.GetOrganizationByAccountID(db, accountID)
+	if err != nil {
+		return 0, err
+	}
+	return org.ID, nil
+}
+
+// canActForOrg 当前账号是否可代表该组织操作（组织主账号或经权限中间件授权的成员）
+func (s *Service) canActForOrg(org *model.Organization, accountID int64) bool {
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok && org.ID == orgID {
+		return true
+	}
+	return org.AccountID == accountID
+}
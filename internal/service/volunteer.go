@@ -6,6 +6,7 @@ import (
 	"time"
 	"volunteer-system/internal/api"
 	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
 	"volunteer-system/internal/repository"
 	"volunteer-system/pkg/util"
 
@@ -40,14 +41,14 @@ func (s *VolunteerService) VolunteerList(req *api.VolunteerListRequest) (*api.Vo
 		req.PageSize = 20
 	}
 
-	// 获取当前组织管理者所属组织列表
+	// 获取当前账号可管理的组织列表（名下组织及协作管理的组织）
 	userID, err := middleware.GetUserIDInt(s.c)
 	if err != nil {
 		log.Error("查询志愿者列表失败: 获取当前用户ID失败: %v", err)
 		return nil, err
 	}
 
-	organizations, err := s.repo.FindOrganizationByAccountID(s.repo.DB, userID)
+	organizations, err := s.repo.FindManagedOrganizations(s.repo.DB, userID)
 	if err != nil {
 		log.Error("查询志愿者列表失败: 查询组织异常: %v, user_id=%d", err, userID)
 		return nil, err
@@ -146,9 +147,10 @@ func (s *VolunteerService) VolunteerDetail(req *api.VolunteerDetailRequest) (*ap
 			RealName:     volunteer.RealName,
 			Gender:       volunteer.Gender,
 			Birthday:     birthday,
-			IdCard:       volunteer.IDCard,
+			IdCard:       maskStoredIDCard(volunteer.IDCard),
 			AvatarUrl:    volunteer.AvatarURL,
 			Introduction: volunteer.Introduction,
+			Skills:       util.SplitSkills(volunteer.Skills),
 			TotalHours:   volunteer.TotalHours,
 			ServiceCount: volunteer.ServiceCount,
 			CreditScore:  volunteer.CreditScore,
@@ -209,9 +211,10 @@ func (s *VolunteerService) MyProfile(req *api.MyProfileRequest) (*api.MyProfileR
 			RealName:     volunteer.RealName,
 			Gender:       volunteer.Gender,
 			Birthday:     birthday,
-			IdCard:       volunteer.IDCard,
+			IdCard:       maskStoredIDCard(volunteer.IDCard),
 			AvatarUrl:    volunteer.AvatarURL,
 			Introduction: volunteer.Introduction,
+			Skills:       util.SplitSkills(volunteer.Skills),
 			TotalHours:   volunteer.TotalHours,
 			ServiceCount: volunteer.ServiceCount,
 			CreditScore:  volunteer.CreditScore,
@@ -282,6 +285,16 @@ func (s *VolunteerService) VolunteerUpdate(req *api.VolunteerUpdateRequest) (*ap
 		updateQuery["introduction"] = req.Introduction
 	}
 
+	// 校验技能标签
+	if len(req.Skills) > 0 {
+		skills := util.JoinSkills(req.Skills)
+		if len(skills) > 500 {
+			log.Error("更新志愿者信息失败: 技能标签长度超限, volunteer_id=%d, length=%d", req.VolunteerId, len(skills))
+			return nil, errors.New("技能标签总长度不能超过500个字符")
+		}
+		updateQuery["skills"] = skills
+	}
+
 	if len(updateQuery) == 0 {
 		log.Error("更新志愿者信息失败: 没有需要更新的字段, volunteer_id=%d", req.VolunteerId)
 		return nil, errors.New("没有需要更新的字段")
@@ -299,6 +312,22 @@ func (s *VolunteerService) VolunteerUpdate(req *api.VolunteerUpdateRequest) (*ap
 		return nil, errors.New("志愿者不存在")
 	}
 
+	// 实名认证审核中或已通过时，姓名、出生日期、性别以认证信息为准
+	if volunteer.AuditStatus == model.VolunteerAuditStatusPending || volunteer.AuditStatus == model.VolunteerAuditStatusApproved {
+		if _, ok := updateQuery["real_name"]; ok && req.RealName != volunteer.RealName {
+			return nil, errors.New("实名认证审核中或已通过，姓名与出生日期不可修改")
+		}
+		if birthday != nil && (volunteer.Birthday == nil || util.FormatDate(*birthday) != util.FormatDate(*volunteer.Birthday)) {
+			return nil, errors.New("实名认证审核中或已通过，姓名与出生日期不可修改")
+		}
+		delete(updateQuery, "real_name")
+		delete(updateQuery, "birthday")
+		delete(updateQuery, "gender")
+		if len(updateQuery) == 0 {
+			return &api.VolunteerUpdateResponse{}, nil
+		}
+	}
+
 	// 调用 repository 层更新
 	err = s.repo.UpdateVolunteer(s.repo.DB, req.VolunteerId, updateQuery)
 	if err != nil {
@@ -0,0 +1,663 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"volunteer-system/config"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+const (
+	// maxVolunteerImportRows 单次导入的数据行数上限（不含表头）
+	maxVolunteerImportRows = 2000
+	// defaultVolunteerImportMaxFileSizeMB 未配置上传大小时的导入文件大小上限
+	defaultVolunteerImportMaxFileSizeMB = 10
+	defaultVolunteerImportPageSize      = 20
+	maxVolunteerImportPageSize          = 100
+	// volunteerImportFileDir 待导入文件在上传目录下的暂存子目录
+	volunteerImportFileDir = "volunteer-imports"
+)
+
+// 导入文件列名，表头同时支持中文与英文
+const (
+	volunteerImportColumnName     = "name"
+	volunteerImportColumnPhone    = "phone"
+	volunteerImportColumnEmail    = "email"
+	volunteerImportColumnAge      = "age"
+	volunteerImportColumnGender   = "gender"
+	volunteerImportColumnUsername = "username"
+	volunteerImportColumnPassword = "password"
+)
+
+var volunteerImportHeaderAliases = map[string]string{
+	"姓名":       volunteerImportColumnName,
+	"name":     volunteerImportColumnName,
+	"手机号":      volunteerImportColumnPhone,
+	"手机":       volunteerImportColumnPhone,
+	"phone":    volunteerImportColumnPhone,
+	"mobile":   volunteerImportColumnPhone,
+	"邮箱":       volunteerImportColumnEmail,
+	"email":    volunteerImportColumnEmail,
+	"年龄":       volunteerImportColumnAge,
+	"age":      volunteerImportColumnAge,
+	"性别":       volunteerImportColumnGender,
+	"gender":   volunteerImportColumnGender,
+	"用户名":      volunteerImportColumnUsername,
+	"username": volunteerImportColumnUsername,
+	"密码":       volunteerImportColumnPassword,
+	"password": volunteerImportColumnPassword,
+}
+
+// volunteerImportRow 导入文件中的一行数据
+type volunteerImportRow struct {
+	rowNo int32
+	req   *api.VolunteerRegisterRequest
+}
+
+// volunteerImportPayload 志愿者导入任务参数
+type volunteerImportPayload struct {
+	FilePath string `json:"filePath"` // 上传文件路径（相对上传目录），任务结束后删除
+	FileName string `json:"fileName"`
+	JoinOrg  bool   `json:"joinOrg"`
+	// DefaultPassword 默认密码密文（敏感字段加密），任务参数中不保存明文，导入时逐行单独计算哈希
+	DefaultPassword string `json:"defaultPassword"`
+}
+
+// volunteerImportResult 志愿者导入任务结果摘要
+type volunteerImportResult struct {
+	BatchID      int64 `json:"batchId"`
+	TotalRows    int32 `json:"totalRows"`
+	SuccessCount int32 `json:"successCount"`
+	FailedCount  int32 `json:"failedCount"`
+}
+
+func init() {
+	RegisterJobHandler(volunteerImportJobHandler{})
+}
+
+// volunteerImportJobHandler 志愿者批量导入任务
+type volunteerImportJobHandler struct{}
+
+func (volunteerImportJobHandler) Type() string {
+	return model.JobTypeVolunteerImport
+}
+
+// MaxAttempts 逐行独立入库，重跑会把已导入的行判为重复，因此不自动重试
+func (volunteerImportJobHandler) MaxAttempts() int {
+	return 1
+}
+
+func (volunteerImportJobHandler) Run(jc *JobContext) (any, error) {
+	var payload volunteerImportPayload
+	if err := jc.DecodePayload(&payload); err != nil {
+		return nil, err
+	}
+	// 单行入库不随取消中断，逐行之间检查 jc.Context()
+	s := &VolunteerService{newJobBackgroundService(context.Background())}
+	return s.runVolunteerImport(jc, &payload)
+}
+
+// ImportVolunteers 批量导入志愿者（组织端）
+// 上传时只校验文件格式与表头，逐行校验入库由异步任务执行，结果通过任务详情与导入回执查看。
+func (s *VolunteerService) ImportVolunteers(req *api.ImportVolunteersRequest, file *multipart.FileHeader) (*api.ImportVolunteersResponse, error) {
+	if file == nil {
+		return nil, errors.New("请上传导入文件")
+	}
+	req.DefaultPassword = strings.TrimSpace(req.DefaultPassword)
+	if req.DefaultPassword != "" {
+		if err := util.ValidatePasswordStrength(req.DefaultPassword); err != nil {
+			return nil, errors.New("默认密码不符合要求: " + err.Error())
+		}
+	}
+
+	userID, org, err := s.currentImportOrganization()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := readVolunteerImportFile(file)
+	if err != nil {
+		return nil, err
+	}
+	importRows, err := readVolunteerImportRows(file.Filename, data)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := &volunteerImportPayload{
+		FileName: truncateRunes(filepath.Base(file.Filename), 255),
+		JoinOrg:  req.JoinOrg,
+	}
+	if req.DefaultPassword != "" {
+		payload.DefaultPassword, err = util.EncryptSensitiveField(req.DefaultPassword)
+		if err != nil {
+			log.Error("志愿者导入 - 默认密码加密失败: %v", err)
+			return nil, errors.New("密码加密失败")
+		}
+	}
+	payload.FilePath, err = saveVolunteerImportFile(org.ID, file.Filename, data)
+	if err != nil {
+		log.Error("志愿者导入失败: 保存导入文件异常: %v, org_id=%d", err, org.ID)
+		return nil, errors.New("保存导入文件失败")
+	}
+
+	job, err := s.submitJob(model.JobTypeVolunteerImport, userID, org.ID, payload)
+	if err != nil {
+		removeUploadFile(payload.FilePath)
+		return nil, err
+	}
+
+	log.Info("志愿者导入任务已提交: org_id=%d, job_id=%d, 数据行数=%d", org.ID, job.ID, len(importRows))
+	return &api.ImportVolunteersResponse{
+		JobId:     job.ID,
+		TotalRows: int32(len(importRows)),
+	}, nil
+}
+
+// runVolunteerImport 执行志愿者导入任务：逐行校验入库，保存失败明细并生成导入回执作为任务结果文件
+func (s *VolunteerService) runVolunteerImport(jc *JobContext, payload *volunteerImportPayload) (any, error) {
+	job := jc.Job()
+	defer removeUploadFile(payload.FilePath)
+
+	data, err := os.ReadFile(filepath.Join(uploadDir(), filepath.FromSlash(payload.FilePath)))
+	if err != nil {
+		log.Error("志愿者导入任务失败: 读取导入文件异常: %v, job_id=%d", err, job.ID)
+		return nil, NewPermanentJobError(errors.New("导入文件不存在"))
+	}
+	importRows, err := readVolunteerImportRows(payload.FileName, data)
+	if err != nil {
+		return nil, NewPermanentJobError(err)
+	}
+
+	var joinOrg int32
+	if payload.JoinOrg {
+		joinOrg = 1
+	}
+	batch := &model.VolunteerImportBatch{
+		OrgID:      job.OrgID,
+		OperatorID: job.AccountID,
+		JobID:      job.ID,
+		FileName:   payload.FileName,
+		TotalRows:  int32(len(importRows)),
+		JoinOrg:    joinOrg,
+	}
+	if err := s.repo.CreateVolunteerImportBatch(s.repo.DB, batch); err != nil {
+		log.Error("志愿者导入任务失败: 创建导入批次异常: %v, job_id=%d", err, job.ID)
+		return nil, err
+	}
+
+	result := &volunteerImportResult{
+		BatchID:   batch.ID,
+		TotalRows: batch.TotalRows,
+	}
+	failures := make([]*model.VolunteerImportFailure, 0)
+	seenPhones := make(map[string]int32)
+	seenEmails := make(map[string]int32)
+
+	jc.SetProgress(0, len(importRows))
+	for i, row := range importRows {
+		if jc.Context().Err() != nil {
+			break
+		}
+		if err := s.importVolunteerRow(row, job.OrgID, payload, seenPhones, seenEmails); err != nil {
+			failures = append(failures, &model.VolunteerImportFailure{
+				BatchID: batch.ID,
+				RowNo:   row.rowNo,
+				Name:    truncateRunes(row.req.Name, 64),
+				Phone:   truncateRunes(util.GetMobileMask(row.req.Phone), 32),
+				Email:   truncateRunes(row.req.Email, 128),
+				Reason:  truncateRunes(err.Error(), 255),
+			})
+		} else {
+			result.SuccessCount++
+		}
+		jc.SetProgress(i+1, len(importRows))
+	}
+	result.FailedCount = int32(len(failures))
+
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		if err := s.repo.CreateVolunteerImportFailures(tx, failures); err != nil {
+			return err
+		}
+		return s.repo.UpdateVolunteerImportBatchByID(tx, batch.ID, map[string]any{
+			"success_count": result.SuccessCount,
+			"failed_count":  result.FailedCount,
+		})
+	})
+	if err != nil {
+		log.Error("志愿者导入任务失败: 保存导入结果异常: %v, batch_id=%d", err, batch.ID)
+		return nil, NewPermanentJobError(err)
+	}
+	if err := jc.Context().Err(); err != nil {
+		log.Warn("志愿者导入任务中止: job_id=%d, batch_id=%d, 已处理=%d/%d",
+			job.ID, batch.ID, result.SuccessCount+result.FailedCount, result.TotalRows)
+		return nil, err
+	}
+
+	f, err := jc.CreateResultFile(fmt.Sprintf("volunteer-import-%d-receipt.csv", batch.ID))
+	if err != nil {
+		log.Error("志愿者导入任务: 创建回执文件异常: %v, job_id=%d", err, job.ID)
+		return nil, NewPermanentJobError(errors.New("生成导入回执失败"))
+	}
+	defer f.Close()
+	if err := writeVolunteerImportReceipt(f, failures); err != nil {
+		log.Error("志愿者导入任务: 写入回执文件异常: %v, job_id=%d", err, job.ID)
+		return nil, NewPermanentJobError(errors.New("生成导入回执失败"))
+	}
+
+	log.Info("志愿者导入完成: org_id=%d, job_id=%d, batch_id=%d, 总行数=%d, 成功=%d, 失败=%d",
+		job.OrgID, job.ID, batch.ID, result.TotalRows, result.SuccessCount, result.FailedCount)
+	return result, nil
+}
+
+// importVolunteerRow 校验并导入单行志愿者，返回的错误信息即回执中的失败原因
+func (s *VolunteerService) importVolunteerRow(row *volunteerImportRow, orgID int64, payload *volunteerImportPayload,
+	seenPhones, seenEmails map[string]int32) error {
+	reg := row.req
+	if reg.UserName == "" {
+		reg.UserName = reg.Name
+	}
+	if reg.Password == "" && payload.DefaultPassword == "" {
+		return errors.New("密码不能为空，请填写密码列或设置默认密码")
+	}
+
+	// 复用志愿者注册的校验规则
+	register := &RegisterService{Service: s.Service}
+	if err := register.validateVolunteerRequest(reg); err != nil {
+		return err
+	}
+	genderCode, err := register.convertGenderToCode(reg.Gender)
+	if err != nil {
+		return err
+	}
+
+	// 文件内重复
+	if prev, ok := seenPhones[reg.Phone]; ok {
+		return fmt.Errorf("手机号与第%d行重复", prev)
+	}
+	seenPhones[reg.Phone] = row.rowNo
+	email := strings.ToLower(reg.Email)
+	if prev, ok := seenEmails[email]; ok {
+		return fmt.Errorf("邮箱与第%d行重复", prev)
+	}
+	seenEmails[email] = row.rowNo
+
+	mobilePair, err := util.ProcessSensitiveField(reg.Phone)
+	if err != nil {
+		log.Error("志愿者导入 - 手机号处理失败: %v, row=%d", err, row.rowNo)
+		return errors.New("手机号处理失败")
+	}
+	exists, err := s.repo.CheckMobileExists(s.repo.DB, mobilePair.Hash)
+	if err != nil {
+		log.Error("志愿者导入 - 检查手机号是否存在失败: %v, row=%d", err, row.rowNo)
+		return errors.New("检查手机号失败")
+	}
+	if exists {
+		return errors.New("手机号已存在")
+	}
+	exists, err = s.repo.CheckEmailExists(s.repo.DB, reg.Email)
+	if err != nil {
+		log.Error("志愿者导入 - 检查邮箱是否存在失败: %v, row=%d", err, row.rowNo)
+		return errors.New("检查邮箱失败")
+	}
+	if exists {
+		return errors.New("邮箱已存在")
+	}
+
+	// 每行单独计算 bcrypt 哈希（含默认密码），相同密码的账号哈希值互不相同
+	password := reg.Password
+	if password == "" {
+		password, err = util.DecryptSensitiveField(payload.DefaultPassword)
+		if err != nil {
+			log.Error("志愿者导入 - 默认密码解密失败: %v, row=%d", err, row.rowNo)
+			return errors.New("默认密码解析失败")
+		}
+	}
+	hashedPassword, err := util.HashPassword(password)
+	if err != nil {
+		log.Error("志愿者导入 - 密码加密失败: %v, row=%d", err, row.rowNo)
+		return errors.New("密码加密失败")
+	}
+
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		now := time.Now()
+		account := &model.SysAccount{
+			Username:     reg.UserName,
+			Mobile:       mobilePair.Encrypted,
+			MobileHash:   mobilePair.Hash,
+			Email:        reg.Email,
+			Password:     hashedPassword,
+			IdentityType: model.RegisterTypeVolunteerCode,
+			Status:       model.SysAccountNormal,
+			CreatedAt:    now,
+		}
+		if err := s.repo.CreateAccount(tx, account); err != nil {
+			return err
+		}
+
+		volunteer := &model.Volunteer{
+			AccountID:   account.ID,
+			RealName:    reg.Name,
+			Gender:      genderCode,
+			Status:      model.VolunteerActiveStatus,
+			AuditStatus: model.VolunteerAuditStatusUnverified,
+			CreatedAt:   now,
+		}
+		if err := s.repo.CreateVolunteer(tx, volunteer); err != nil {
+			return err
+		}
+
+		if !payload.JoinOrg {
+			return nil
+		}
+		return s.repo.CreateMembership(tx, &model.OrgMember{
+			OrgID:       orgID,
+			VolunteerID: volunteer.ID,
+			Role:        model.MemberRoleMember,
+			Status:      model.MemberStatusActive,
+			AppliedAt:   now,
+			JoinedAt:    &now,
+		})
+	})
+	if err != nil {
+		log.Error("志愿者导入 - 保存志愿者失败: %v, org_id=%d, row=%d", err, orgID, row.rowNo)
+		return errors.New("保存志愿者失败")
+	}
+	return nil
+}
+
+// VolunteerImportBatchList 志愿者导入批次列表（组织端）
+func (s *VolunteerService) VolunteerImportBatchList(req *api.VolunteerImportBatchListRequest) (*api.VolunteerImportBatchListResponse, error) {
+	resp := &api.VolunteerImportBatchListResponse{
+		Total: 0,
+		List:  []*api.VolunteerImportBatchItem{},
+	}
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+	if req.PageSize <= 0 {
+		req.PageSize = defaultVolunteerImportPageSize
+	}
+	if req.PageSize > maxVolunteerImportPageSize {
+		req.PageSize = maxVolunteerImportPageSize
+	}
+
+	_, org, err := s.currentImportOrganization()
+	if err != nil {
+		return nil, err
+	}
+
+	offset := int((req.Page - 1) * req.PageSize)
+	batches, total, err := s.repo.ListVolunteerImportBatches(s.repo.DB, org.ID, int(req.PageSize), offset)
+	if err != nil {
+		log.Error("志愿者导入批次查询失败: %v, org_id=%d", err, org.ID)
+		return nil, err
+	}
+
+	resp.Total = int32(total)
+	for _, batch := range batches {
+		resp.List = append(resp.List, &api.VolunteerImportBatchItem{
+			Id:           batch.ID,
+			JobId:        batch.JobID,
+			FileName:     batch.FileName,
+			TotalRows:    batch.TotalRows,
+			SuccessCount: batch.SuccessCount,
+			FailedCount:  batch.FailedCount,
+			JoinOrg:      batch.JoinOrg == 1,
+			OperatorId:   batch.OperatorID,
+			CreatedAt:    batch.CreatedAt.Format("2006-01-02 15:04:05"),
+		})
+	}
+	return resp, nil
+}
+
+// DownloadVolunteerImportReceipt 下载导入回执（组织端），CSV 带 BOM 以便 Excel 直接打开
+func (s *VolunteerService) DownloadVolunteerImportReceipt(req *api.DownloadVolunteerImportReceiptRequest) (*api.DownloadVolunteerImportReceiptResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("导入批次ID不能为空")
+	}
+
+	_, org, err := s.currentImportOrganization()
+	if err != nil {
+		return nil, err
+	}
+
+	batch, err := s.repo.GetVolunteerImportBatchByID(s.repo.DB, req.Id)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("导入批次不存在")
+		}
+		log.Error("下载导入回执失败: 查询导入批次异常: %v, batch_id=%d", err, req.Id)
+		return nil, err
+	}
+	if batch.OrgID != org.ID {
+		return nil, errors.New("无权查看该导入批次")
+	}
+
+	failures, err := s.repo.ListVolunteerImportFailures(s.repo.DB, batch.ID)
+	if err != nil {
+		log.Error("下载导入回执失败: 查询失败明细异常: %v, batch_id=%d", err, batch.ID)
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := writeVolunteerImportReceipt(&buf, failures); err != nil {
+		log.Error("下载导入回执失败: 生成CSV异常: %v, batch_id=%d", err, batch.ID)
+		return nil, err
+	}
+
+	return &api.DownloadVolunteerImportReceiptResponse{
+		Content:  buf.Bytes(),
+		FileName: fmt.Sprintf("volunteer-import-%d-receipt.csv", batch.ID),
+	}, nil
+}
+
+// currentImportOrganization 当前操作的组织（经组织权限中间件授权），返回账号ID与组织信息
+func (s *VolunteerService) currentImportOrganization() (int64, *model.Organization, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return 0, nil, err
+	}
+	orgID, ok := middleware.GetActingOrgID(s.c)
+	if !ok {
+		return 0, nil, errors.New("无权导入志愿者")
+	}
+
+	org, err := s.repo.GetOrganizationByID(s.repo.DB, orgID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return 0, nil, errors.New("组织信息不存在")
+		}
+		log.Error("查询导入组织失败: %v, org_id=%d", err, orgID)
+		return 0, nil, err
+	}
+	return userID, org, nil
+}
+
+// writeVolunteerImportReceipt 输出导入回执 CSV（带 BOM 以便 Excel 直接打开）
+func writeVolunteerImportReceipt(out io.Writer, failures []*model.VolunteerImportFailure) error {
+	if _, err := io.WriteString(out, "\xef\xbb\xbf"); err != nil {
+		return err
+	}
+	w := csv.NewWriter(out)
+	_ = w.Write([]string{"行号", "姓名", "手机号", "邮箱", "失败原因"})
+	for _, failure := range failures {
+		_ = w.Write([]string{
+			strconv.Itoa(int(failure.RowNo)),
+			failure.Name,
+			failure.Phone,
+			failure.Email,
+			failure.Reason,
+		})
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// readVolunteerImportRows 解析导入文件并按表头转换为数据行
+func readVolunteerImportRows(fileName string, data []byte) ([]*volunteerImportRow, error) {
+	rows, err := util.ReadSpreadsheet(fileName, data, maxVolunteerImportRows+1)
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) < 2 {
+		return nil, errors.New("导入文件没有数据行")
+	}
+	return parseVolunteerImportRows(rows)
+}
+
+// saveVolunteerImportFile 将导入文件暂存到上传目录，供异步任务读取，返回相对路径
+func saveVolunteerImportFile(orgID int64, fileName string, data []byte) (string, error) {
+	relPath := path.Join(volunteerImportFileDir, strconv.FormatInt(orgID, 10), uuid.NewString()+strings.ToLower(filepath.Ext(fileName)))
+	fullPath := filepath.Join(uploadDir(), filepath.FromSlash(relPath))
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return "", err
+	}
+	if err := os.WriteFile(fullPath, data, 0o644); err != nil {
+		return "", err
+	}
+	return relPath, nil
+}
+
+// removeUploadFile 删除上传目录下的暂存文件
+func removeUploadFile(relPath string) {
+	if relPath == "" {
+		return
+	}
+	if err := os.Remove(filepath.Join(uploadDir(), filepath.FromSlash(relPath))); err != nil && !os.IsNotExist(err) {
+		log.Warn("删除暂存文件失败: %v, path=%s", err, relPath)
+	}
+}
+
+// readVolunteerImportFile 读取上传的导入文件，大小受上传配置限制
+func readVolunteerImportFile(file *multipart.FileHeader) ([]byte, error) {
+	maxSizeMB := defaultVolunteerImportMaxFileSizeMB
+	if cfg := config.GetConfig(); cfg != nil && cfg.Upload != nil && cfg.Upload.MaxFileSizeMB > 0 {
+		maxSizeMB = cfg.Upload.MaxFileSizeMB
+	}
+	maxSize := int64(maxSizeMB) << 20
+	if file.Size > maxSize {
+		return nil, fmt.Errorf("导入文件不能超过%dMB", maxSizeMB)
+	}
+
+	f, err := file.Open()
+	if err != nil {
+		log.Error("读取导入文件失败: %v, file=%s", err, file.Filename)
+		return nil, errors.New("读取导入文件失败")
+	}
+	defer f.Close()
+
+	data, err := io.ReadAll(io.LimitReader(f, maxSize+1))
+	if err != nil {
+		log.Error("读取导入文件失败: %v, file=%s", err, file.Filename)
+		return nil, errors.New("读取导入文件失败")
+	}
+	if int64(len(data)) > maxSize {
+		return nil, fmt.Errorf("导入文件不能超过%dMB", maxSizeMB)
+	}
+	return data, nil
+}
+
+// parseVolunteerImportRows 按表头识别列并转换为注册请求，跳过空行
+func parseVolunteerImportRows(rows [][]string) ([]*volunteerImportRow, error) {
+	columns := make(map[string]int)
+	for i, header := range rows[0] {
+		key := strings.ToLower(strings.TrimSpace(header))
+		if column, ok := volunteerImportHeaderAliases[key]; ok {
+			if _, dup := columns[column]; !dup {
+				columns[column] = i
+			}
+		}
+	}
+	for _, required := range []struct{ column, label string }{
+		{volunteerImportColumnName, "姓名"},
+		{volunteerImportColumnPhone, "手机号"},
+		{volunteerImportColumnEmail, "邮箱"},
+		{volunteerImportColumnAge, "年龄"},
+		{volunteerImportColumnGender, "性别"},
+	} {
+		if _, ok := columns[required.column]; !ok {
+			return nil, fmt.Errorf("导入文件缺少“%s”列", required.label)
+		}
+	}
+
+	cell := func(row []string, column string) string {
+		idx, ok := columns[column]
+		if !ok || idx >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[idx])
+	}
+
+	result := make([]*volunteerImportRow, 0, len(rows)-1)
+	for i, row := range rows[1:] {
+		if isBlankImportRow(row) {
+			continue
+		}
+		result = append(result, &volunteerImportRow{
+			rowNo: int32(i + 2),
+			req: &api.VolunteerRegisterRequest{
+				Name:     cell(row, volunteerImportColumnName),
+				Phone:    cell(row, volunteerImportColumnPhone),
+				Email:    cell(row, volunteerImportColumnEmail),
+				Age:      parseImportAge(cell(row, volunteerImportColumnAge)),
+				Gender:   cell(row, volunteerImportColumnGender),
+				UserName: cell(row, volunteerImportColumnUsername),
+				Password: cell(row, volunteerImportColumnPassword),
+			},
+		})
+	}
+	if len(result) == 0 {
+		return nil, errors.New("导入文件没有数据行")
+	}
+	return result, nil
+}
+
+func isBlankImportRow(row []string) bool {
+	for _, v := range row {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// parseImportAge 解析年龄，兼容 Excel 数字单元格（如 "30.0"），无法解析时返回 0 交由校验报错
+func parseImportAge(value string) int32 {
+	if value == "" {
+		return 0
+	}
+	if age, err := strconv.Atoi(value); err == nil {
+		return int32(age)
+	}
+	if age, err := strconv.ParseFloat(value, 64); err == nil && age == float64(int32(age)) {
+		return int32(age)
+	}
+	return 0
+}
+
+// truncateRunes 按字符数截断字符串
+func truncateRunes(value string, maxRunes int) string {
+	if utf8.RuneCountInString(value) <= maxRunes {
+		return value
+	}
+	return string([]rune(value)[:maxRunes])
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+	"unicode/utf8"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// volunteerVerificationSnapshot 实名认证提交快照（audit_records.new_content），身份证号为密文
+type volunteerVerificationSnapshot struct {
+	RealName   string `json:"real_name"`
+	IDCard     string `json:"id_card"`
+	IDCardHash string `json:"id_card_hash"`
+	Birthday   string `json:"birthday"`
+	Gender     int32  `json:"gender"`
+}
+
+// SubmitVerification 提交实名认证：校验身份证号及与档案出生日期的一致性，生成待平台审核的记录
+func (s *VolunteerService) SubmitVerification(req *api.SubmitVerificationRequest) (*api.VerificationStatusResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("提交实名认证失败: 获取当前用户ID失败: %v", err)
+		return nil, err
+	}
+	volunteer, err := s.repo.FindVolunteerByAccountID(s.repo.DB, userID)
+	if err != nil || volunteer == nil {
+		if err == nil || errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("志愿者信息不存在")
+		}
+		log.Error("提交实名认证失败: 查询志愿者异常: %v, user_id=%d", err, userID)
+		return nil, err
+	}
+
+	switch volunteer.AuditStatus {
+	case model.VolunteerAuditStatusPending:
+		return nil, errors.New("实名认证审核中，请勿重复提交")
+	case model.VolunteerAuditStatusApproved:
+		return nil, errors.New("已通过实名认证")
+	}
+
+	realName := strings.TrimSpace(req.RealName)
+	if realName == "" {
+		return nil, errors.New("真实姓名不能为空")
+	}
+	if utf8.RuneCountInString(realName) > 50 {
+		return nil, errors.New("真实姓名长度不能超过50个字符")
+	}
+
+	info, err := util.ParseIDCard(req.IdCard)
+	if err != nil {
+		return nil, err
+	}
+	if volunteer.Birthday != nil && util.FormatDate(*volunteer.Birthday) != util.FormatDate(info.Birthday) {
+		return nil, errors.New("身份证号中的出生日期与个人资料不一致")
+	}
+
+	idCardPair, err := util.ProcessSensitiveField(info.Number)
+	if err != nil {
+		log.Error("提交实名认证失败: 身份证号加密异常: %v, volunteer_id=%d", err, volunteer.ID)
+		return nil, errors.New("身份证号处理失败")
+	}
+	exists, err := s.repo.ExistsVerifiedVolunteerByIDCardHash(s.repo.DB, idCardPair.Hash, volunteer.ID)
+	if err != nil {
+		log.Error("提交实名认证失败: 查询身份证号占用异常: %v, volunteer_id=%d", err, volunteer.ID)
+		return nil, err
+	}
+	if exists {
+		return nil, errors.New("该身份证号已被其他志愿者认证")
+	}
+
+	oldBirthday := ""
+	if volunteer.Birthday != nil {
+		oldBirthday = util.FormatDate(*volunteer.Birthday)
+	}
+	oldContent, err := util.MarshalSnapshot(volunteerVerificationSnapshot{
+		RealName: volunteer.RealName,
+		IDCard:   volunteer.IDCard,
+		Birthday: oldBirthday,
+		Gender:   volunteer.Gender,
+	})
+	if err != nil {
+		return nil, err
+	}
+	newContent, err := util.MarshalSnapshot(volunteerVerificationSnapshot{
+		RealName:   realName,
+		IDCard:     idCardPair.Encrypted,
+		IDCardHash: idCardPair.Hash,
+		Birthday:   util.FormatDate(info.Birthday),
+		Gender:     info.Gender,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	record := &model.AuditRecord{
+		TargetType:    model.AuditTargetVolunteer,
+		TargetID:      volunteer.ID,
+		CreatorID:     userID,
+		OldContent:    oldContent,
+		NewContent:    newContent,
+		AuditTime:     now,
+		OperationType: model.OperationTypeUpdate,
+		Status:        model.AuditStatusPending,
+	}
+	err = s.withTransaction(func(tx *gorm.DB) error {
+		if err := s.repo.CreateAuditRecord(tx, record); err != nil {
+			return err
+		}
+		return s.repo.UpdateVolunteer(tx, volunteer.ID, map[string]any{
+			"audit_status": model.VolunteerAuditStatusPending,
+		})
+	})
+	if err != nil {
+		log.Error("提交实名认证失败: 创建审核记录异常: %v, volunteer_id=%d", err, volunteer.ID)
+		return nil, err
+	}
+
+	log.Info("提交实名认证: volunteer_id=%d record_id=%d", volunteer.ID, record.ID)
+	return &api.VerificationStatusResponse{
+		AuditStatus:   model.VolunteerAuditStatusPending,
+		RealName:      util.GetNameMask(realName),
+		IdCard:        util.GetIDCardMask(info.Number),
+		AuditRecordId: record.ID,
+		SubmittedAt:   util.FormatDateTimeOrEmpty(record.CreatedAt),
+	}, nil
+}
+
+// MyVerification 查询当前志愿者的实名认证状态，身份证号与姓名均脱敏返回
+func (s *VolunteerService) MyVerification(req *api.MyVerificationRequest) (*api.VerificationStatusResponse, error) {
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		return nil, err
+	}
+	volunteer, err := s.repo.FindVolunteerByAccountID(s.repo.DB, userID)
+	if err != nil || volunteer == nil {
+		if err == nil || errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errors.New("志愿者信息不存在")
+		}
+		return nil, err
+	}
+
+	resp := &api.VerificationStatusResponse{
+		AuditStatus: volunteer.AuditStatus,
+		RealName:    util.GetNameMask(volunteer.RealName),
+		IdCard:      maskStoredIDCard(volunteer.IDCard),
+	}
+
+	record, err := s.repo.FindLatestAuditRecordByTarget(s.repo.DB, model.AuditTargetVolunteer, volunteer.ID)
+	if err != nil {
+		log.Error("查询实名认证状态失败: 查询审核记录异常: %v, volunteer_id=%d", err, volunteer.ID)
+		return nil, err
+	}
+	if record == nil {
+		return resp, nil
+	}
+	resp.AuditRecordId = record.ID
+	resp.SubmittedAt = util.FormatDateTimeOrEmpty(record.CreatedAt)
+	if record.Status == model.AuditStatusRejected {
+		resp.RejectReason = record.RejectReason
+	}
+	// 尚未审核通过时展示最近一次提交的信息
+	if volunteer.AuditStatus != model.VolunteerAuditStatusApproved {
+		var snapshot volunteerVerificationSnapshot
+		if err := json.Unmarshal([]byte(record.NewContent), &snapshot); err == nil && snapshot.IDCard != "" {
+			resp.RealName = util.GetNameMask(snapshot.RealName)
+			resp.IdCard = maskStoredIDCard(snapshot.IDCard)
+		}
+	}
+	return resp, nil
+}
+
+// ensureVolunteerVerified 活动要求实名认证时校验志愿者已通过认证
+func (s *Service) ensureVolunteerVerified(volunteerID int64) error {
+	volunteer, err := s.repo.FindVolunteerByID(s.repo.DB, volunteerID)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return errors.New("志愿者信息不存在")
+		}
+		return err
+	}
+	if volunteer.AuditStatus != model.VolunteerAuditStatusApproved {
+		return errors.New("该活动仅限实名认证通过的志愿者报名")
+	}
+	return nil
+}
+
+// maskStoredIDCard 对库中身份证号脱敏：新数据为密文需先解密，历史明文直接脱敏
+// maskVolunteerVerificationContent 将实名认证快照转换为审核详情展示内容：
+// 与收件箱一致仅保留姓名、脱敏身份证号、出生日期与性别，不返回身份证密文及哈希
+func maskVolunteerVerificationContent(content string) string {
+	if content == "" {
+		return ""
+	}
+	var snapshot volunteerVerificationSnapshot
+	if err := json.Unmarshal([]byte(content), &snapshot); err != nil {
+		log.Warn("实名认证快照解析失败: %v", err)
+		return ""
+	}
+	masked, err := util.MarshalSnapshot(volunteerVerificationSnapshot{
+		RealName: snapshot.RealName,
+		IDCard:   maskStoredIDCard(snapshot.IDCard),
+		Birthday: snapshot.Birthday,
+		Gender:   snapshot.Gender,
+	})
+	if err != nil {
+		return ""
+	}
+	return masked
+}
+
+func maskStoredIDCard(stored string) string {
+	if stored == "" {
+		return ""
+	}
+	if plain, err := util.DecryptSensitiveField(stored); err == nil {
+		return util.GetIDCardMask(plain)
+	}
+	return util.GetIDCardMask(stored)
+}
@@ -0,0 +1,268 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+	"volunteer-system/internal/api"
+	"volunteer-system/internal/middleware"
+	"volunteer-system/internal/model"
+	"volunteer-system/pkg/util"
+
+	"gorm.io/gorm"
+)
+
+// ActivityWaitlistStatus 查询我的候补排队状态
+func (s *ActivityService) ActivityWaitlistStatus(req *api.ActivityWaitlistStatusRequest) (*api.ActivityWaitlistStatusResponse, error) {
+	if req.Id <= 0 {
+		return nil, errors.New("活动ID不能为空")
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("查询候补状态失败: 获取当前用户ID异常: %v, activity_id=%d", err, req.Id)
+		return nil, err
+	}
+	volunteerID, err := s.getVolunteerIDByAccountID(userID)
+	if err != nil {
+		log.Error("查询候补状态失败: 查询志愿者身份异常: %v, user_id=%d", err, userID)
+		return nil, err
+	}
+
+	waitingCount, err := s.repo.CountWaitingWaitlist(s.repo.DB, req.Id)
+	if err != nil {
+		log.Error("查询候补状态失败: 统计排队人数异常: %v, activity_id=%d user_id=%d", err, req.Id, userID)
+		return nil, err
+	}
+
+	resp := &api.ActivityWaitlistStatusResponse{
+		WaitingCount: int32(waitingCount),
+	}
+
+	entry, err := s.repo.GetLatestWaitlistEntry(s.repo.DB, req.Id, volunteerID)
+	if err != nil {
+		log.Error("查询候补状态失败: 查询候补记录异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.Id, userID, volunteerID)
+		return nil, err
+	}
+	if entry == nil {
+		return resp, nil
+	}
+
+	resp.Status = entry.Status
+	resp.JoinedAt = util.FormatDateTimeOrEmpty(entry.CreatedAt)
+	resp.PromotedAt = util.FormatDateTimePtr(entry.PromotedAt)
+	if entry.Status == model.ActivityWaitlistStatusWaiting {
+		position, err := s.repo.CountWaitlistPosition(s.repo.DB, req.Id, entry.ID)
+		if err != nil {
+			log.Error("查询候补状态失败: 统计排位异常: %v, activity_id=%d volunteer_id=%d waitlist_id=%d", err, req.Id, volunteerID, entry.ID)
+			return nil, err
+		}
+		resp.Position = int32(position)
+	}
+
+	return resp, nil
+}
+
+// ActivityWaitlistLeave 退出候补队列
+func (s *ActivityService) ActivityWaitlistLeave(req *api.ActivityWaitlistLeaveRequest) (*api.ActivityWaitlistLeaveResponse, error) {
+	if req.ActivityId <= 0 {
+		return nil, errors.New("活动ID不能为空")
+	}
+
+	userID, err := middleware.GetUserIDInt(s.c)
+	if err != nil {
+		log.Error("退出候补失败: 获取当前用户ID异常: %v, activity_id=%d", err, req.ActivityId)
+		return nil, err
+	}
+	volunteerID, err := s.getVolunteerIDByAccountID(userID)
+	if err != nil {
+		log.Error("退出候补失败: 查询志愿者身份异常: %v, user_id=%d", err, userID)
+		return nil, err
+	}
+
+	entry, err := s.repo.GetWaitingWaitlistEntry(s.repo.DB, req.ActivityId, volunteerID)
+	if err != nil {
+		log.Error("退出候补失败: 查询候补记录异常: %v, activity_id=%d user_id=%d volunteer_id=%d", err, req.ActivityId, userID, volunteerID)
+		return nil, err
+	}
+	if entry == nil {
+		return nil, errors.New("当前不在候补队列中")
+	}
+
+	if err := s.repo.UpdateActivityWaitlistByID(s.repo.DB, entry.ID, map[string]any{
+		"status": model.ActivityWaitlistStatusLeft,
+	}); err != nil {
+		log.Error("退出候补失败: 更新候补记录异常: %v, activity_id=%d volunteer_id=%d waitlist_id=%d", err, req.ActivityId, volunteerID, entry.ID)
+		return nil, err
+	}
+
+	log.Info("退出候补成功: activity_id=%d user_id=%d volunteer_id=%d waitlist_id=%d", req.ActivityId, userID, volunteerID, entry.ID)
+	return &api.ActivityWaitlistLeaveResponse{Success: true}, nil
+}
+
+// shouldJoinWaitlist 判断新报名是否需要进入候补队列。
+// 活动或所选班次名额已满（含已递补待审核的占位），或同一班次（未设班次时为整个活动）仍有人排队时都需要排队，
+// 保证先到先得；其他班次的排队不影响有余量的班次。
+func (s *Service) shouldJoinWaitlist(tx *gorm.DB, activity *model.Activity, slot *model.ActivitySlot) (bool, error) {
+	var waitingCount int64
+	var err error
+	if slot != nil {
+		waitingCount, err = s.repo.CountWaitingWaitlistBySlot(tx, activity.ID, slot.ID)
+	} else {
+		waitingCount, err = s.repo.CountWaitingWaitlist(tx, activity.ID)
+	}
+	if err != nil {
+		return false, err
+	}
+	if waitingCount > 0 {
+		return true, nil
+	}
+
+	if slot != nil {
+		free, err := s.waitlistSlotFreeSeats(tx, slot)
+		if err != nil {
+			return false, err
+		}
+		if free == 0 {
+			return true, nil
+		}
+	}
+	if activity.MaxPeople <= 0 {
+		return false, nil
+	}
+
+	holding, err := s.repo.CountPromotedWaitlistHolding(tx, activity.ID)
+	if err != nil {
+		return false, err
+	}
+	return int64(activity.CurrentPeople)+holding >= int64(activity.MaxPeople), nil
+}
+
+// waitlistSlotFreeSeats 班次剩余可递补名额（扣除已递补待审核的占位），不限名额时返回 -1
+func (s *Service) waitlistSlotFreeSeats(tx *gorm.DB, slot *model.ActivitySlot) (int64, error) {
+	if slot.MaxPeople <= 0 {
+		return -1, nil
+	}
+	holding, err := s.repo.CountPromotedWaitlistHoldingBySlot(tx, slot.ID)
+	if err != nil {
+		return 0, err
+	}
+	return max(int64(slot.MaxPeople)-int64(slot.CurrentPeople)-holding, 0), nil
+}
+
+// promoteActivityWaitlist 按入队顺序为空出的名额递补候补志愿者，需在事务内调用。
+// 递补与普通报名一致：生成待审核的报名申请，审核通过后才真正占用 current_people。
+// 候补选择了班次时还需班次有余量，班次已满的候补继续排队，不阻塞后续其他班次的候补。
+func (s *Service) promoteActivityWaitlist(tx *gorm.DB, activityID int64) (int, error) {
+	// 锁定活动行，串行化同一活动的递补，避免并发释放名额时重复递补。
+	activity, err := s.repo.GetActivityByIDForUpdate(tx, activityID)
+	if err != nil {
+		return 0, err
+	}
+	if activity.Status != model.ActivityStatusRecruiting {
+		return 0, nil
+	}
+
+	freeSlots := -1
+	if activity.MaxPeople > 0 {
+		holding, err := s.repo.CountPromotedWaitlistHolding(tx, activityID)
+		if err != nil {
+			return 0, err
+		}
+		free := int64(activity.MaxPeople) - int64(activity.CurrentPeople) - holding
+		if free <= 0 {
+			return 0, nil
+		}
+		freeSlots = int(free)
+	}
+
+	entries, err := s.repo.ListWaitingWaitlistForUpdate(tx, activityID, 0)
+	if err != nil {
+		return 0, err
+	}
+
+	promoted := 0
+	slotFree := map[int64]int64{} // 班次ID -> 本轮剩余可递补名额，-1 表示不限
+	for _, entry := range entries {
+		if freeSlots >= 0 && promoted >= freeSlots {
+			break
+		}
+
+		// 排队期间已通过其他途径报名成功的，直接出队，不占用递补名额。
+		signup, err := s.repo.GetSignup(tx, activityID, entry.VolunteerID)
+		if err != nil {
+			return promoted, err
+		}
+		if signup != nil && (signup.Status == model.ActivitySignupStatusPending || signup.Status == model.ActivitySignupStatusSuccess) {
+			if err := s.repo.UpdateActivityWaitlistByID(tx, entry.ID, map[string]any{
+				"status": model.ActivityWaitlistStatusLeft,
+			}); err != nil {
+				return promoted, err
+			}
+			continue
+		}
+
+		if entry.SlotID > 0 {
+			free, ok := slotFree[entry.SlotID]
+			if !ok {
+				slot, err := s.repo.GetActivitySlotByID(tx, entry.SlotID)
+				if err != nil {
+					return promoted, err
+				}
+				if free, err = s.waitlistSlotFreeSeats(tx, slot); err != nil {
+					return promoted, err
+				}
+			}
+			if free == 0 {
+				slotFree[entry.SlotID] = 0
+				continue
+			}
+			if free > 0 {
+				free--
+			}
+			slotFree[entry.SlotID] = free
+		}
+
+		newContent, err := json.Marshal(&model.ActivitySignup{
+			ActivityID:  activityID,
+			VolunteerID: entry.VolunteerID,
+			SlotID:      entry.SlotID,
+			Status:      model.ActivitySignupStatusPending,
+		})
+		if err != nil {
+			return promoted, err
+		}
+
+		now := time.Now()
+		record := &model.AuditRecord{
+			TargetType:    model.AuditTargetSignup,
+			TargetID:      0,
+			OrgID:         activity.OrgID,
+			ActivityID:    activityID,
+			CreatorID:     entry.AccountID,
+			AuditorID:     0,
+			OldContent:    "{}",
+			NewContent:    string(newContent),
+			AuditResult:   0,
+			RejectReason:  "",
+			AuditTime:     now,
+			OperationType: model.OperationTypeCreate,
+			Status:        model.AuditStatusPending,
+		}
+		if err := s.repo.CreateAuditRecord(tx, record); err != nil {
+			return promoted, err
+		}
+
+		if err := s.repo.UpdateActivityWaitlistByID(tx, entry.ID, map[string]any{
+			"status":          model.ActivityWaitlistStatusPromoted,
+			"audit_record_id": record.ID,
+			"promoted_at":     now,
+		}); err != nil {
+			return promoted, err
+		}
+		promoted++
+		log.Info("候补递补成功: activity_id=%d volunteer_id=%d waitlist_id=%d record_id=%d", activityID, entry.VolunteerID, entry.ID, record.ID)
+	}
+
+	return promoted, nil
+}
@@ -81,32 +81,8 @@ func (s *WorkHourService) WorkHourLogList(req *api.WorkHourLogListRequest) (*api
 	queryMap := make(map[string]any)
 	activityFilterLocked := false
 
-	switch account.IdentityType {
-	case model.RegisterTypeVolunteerCode:
-		// 志愿者：只能看自己的流水
-		volunteer, err := s.repo.FindVolunteerByAccountID(s.repo.DB, userID)
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, errors.New("志愿者信息不存在")
-			}
-			log.Error("工时流水查询失败: 查询志愿者信息异常: %v, user_id=%d", err, userID)
-			return nil, err
-		}
-		if volunteer == nil {
-			return nil, errors.New("志愿者信息不存在")
-		}
-		queryMap["volunteer_id = ?"] = volunteer.ID
-
-	case model.RegisterTypeOrganizationCode:
-		// 组织：可查看本组织活动的流水
-		org, err := s.repo.GetOrganizationByAccountID(s.repo.DB, userID)
-		if err != nil {
-			if errors.Is(err, gorm.ErrRecordNotFound) {
-				return nil, errors.New("组织信息不存在")
-			}
-			return nil, err
-		}
-
+	if orgID, ok := middleware.GetActingOrgID(s.c); ok {
+		// 组织（含协作管理员）：可查看本组织活动的流水
 		if req.ActivityId > 0 {
 			activity, err := s.repo.GetActivityByID(s.repo.DB, req.ActivityId)
 			if err != nil {
@@ -115,17 +91,31 @@ func (s *WorkHourService) WorkHourLogList(req *api.WorkHourLogListRequest) (*api
 				}
 				return nil, err
 			}
-			if activity.OrgID != org.ID {
+			if activity.OrgID != orgID {
 				return nil, errors.New("无权查看该活动工时流水")
 			}
 			queryMap["activity_id = ?"] = req.ActivityId
 			activityFilterLocked = true
 		} else {
-			queryMap["activity_id IN (SELECT id FROM activities WHERE org_id = ?)"] = org.ID
+			queryMap["activity_id IN (SELECT id FROM activities WHERE org_id = ?)"] = orgID
 		}
-
-	default:
-		return nil, errors.New("账号身份无效")
+	} else {
+		// 志愿者：只能看自己的流水
+		if account.IdentityType != model.RegisterTypeVolunteerCode {
+			return nil, errors.New("账号身份无效")
+		}
+		volunteer, err := s.repo.FindVolunteerByAccountID(s.repo.DB, userID)
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, errors.New("志愿者信息不存在")
+			}
+			log.Error("工时流水查询失败: 查询志愿者信息异常: %v, user_id=%d", err, userID)
+			return nil, err
+		}
+		if volunteer == nil {
+			return nil, errors.New("志愿者信息不存在")
+		}
+		queryMap["volunteer_id = ?"] = volunteer.ID
 	}
 
 	if req.ActivityId > 0 && !activityFilterLocked {
@@ -323,6 +313,12 @@ func (s *WorkHourService) VoidWorkHour(req *api.VoidWorkHourRequest) (*api.VoidW
 			return err
 		}
 
+		// 已签发的证书涵盖该笔工时的，随之撤销
+		if _, err := s.repo.RevokeCertificatesByWorkHourLogID(tx, lastLog.ID, certificateRevokeReasonWorkHourVoided, time.Now()); err != nil {
+			log.Error("工时作废失败: 撤销关联证书异常: %v, signup_id=%d work_hour_log_id=%d", err, signup.ID, lastLog.ID)
+			return err
+		}
+
 		workHourLogID = logItem.ID
 		return nil
 	})
@@ -454,7 +450,11 @@ func (s *WorkHourService) RecalculateWorkHour(req *api.RecalculateWorkHourReques
 
 		targetHours := req.Hours
 		if targetHours <= 0 {
-			targetHours = util.CalcGrantedHours(activity.Duration, *signup.CheckInTime, *signup.CheckOutTime)
+			calculated, err := s.calcSignupGrantedHours(tx, activity, signup, *signup.CheckInTime, *signup.CheckOutTime)
+			if err != nil {
+				return err
+			}
+			targetHours = calculated
 		}
 		targetHours = util.RoundHours(targetHours)
 		if targetHours < 0 {
@@ -583,7 +583,7 @@ func (s *WorkHourService) ensureActivityOperableByCurrentOrgWithTx(tx *gorm.DB,
 		return nil, err
 	}
 
-	org, err := s.repo.GetOrganizationByAccountID(tx, accountID)
+	orgID, err := s.currentOrgID(tx, accountID)
 	if err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errors.New("组织信息不存在")
@@ -591,7 +591,7 @@ func (s *WorkHourService) ensureActivityOperableByCurrentOrgWithTx(tx *gorm.DB,
 		return nil, err
 	}
 
-	if activity.OrgID != org.ID {
+	if activity.OrgID != orgID {
 		return nil, errors.New("无权操作此活动")
 	}
 	return activity, nil
@@ -0,0 +1,143 @@
+package worker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"volunteer-system/config"
+	"volunteer-system/internal/service"
+	"volunteer-system/pkg/logger"
+)
+
+const (
+	defaultWorkers             = 2
+	defaultPollIntervalSeconds = 5
+	// orphanAfterIntervals 排队任务超过若干个轮询间隔仍未被领取，视为入队消息丢失
+	orphanAfterIntervals = 6
+)
+
+// Worker 异步任务执行器：多个协程从 Redis 队列领取任务执行，另有一个协程负责延迟重试与中断补偿。
+// Redis 不可用时各协程按轮询间隔直接查询数据库中到期的任务。
+type Worker struct {
+	workers      int
+	pollInterval time.Duration
+	cancel       context.CancelFunc
+	wg           sync.WaitGroup
+}
+
+// New 根据配置创建任务执行器；未启用时返回 nil。
+func New(cfg *config.Config) *Worker {
+	if cfg.Job == nil || !cfg.Job.Enabled {
+		return nil
+	}
+
+	workers := cfg.Job.Workers
+	if workers <= 0 {
+		workers = defaultWorkers
+	}
+	pollIntervalSeconds := cfg.Job.PollIntervalSeconds
+	if pollIntervalSeconds <= 0 {
+		pollIntervalSeconds = defaultPollIntervalSeconds
+	}
+
+	return &Worker{
+		workers:      workers,
+		pollInterval: time.Duration(pollIntervalSeconds) * time.Second,
+	}
+}
+
+// Start 启动任务执行协程与补偿协程
+func (w *Worker) Start() {
+	if w == nil {
+		return
+	}
+	appLog := logger.GetLogger()
+	ctx, cancel := context.WithCancel(context.Background())
+	w.cancel = cancel
+
+	if !service.NewJobWorkerService(ctx).HasJobQueue() {
+		appLog.Warn("Redis不可用，任务执行器以轮询数据库模式运行")
+	}
+
+	for i := 0; i < w.workers; i++ {
+		w.wg.Add(1)
+		go func() {
+			defer w.wg.Done()
+			w.loop(ctx)
+		}()
+	}
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		ticker := time.NewTicker(w.pollInterval)
+		defer ticker.Stop()
+		for {
+			w.recoverJobs(ctx)
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+	appLog.Info("任务执行器已启动: workers=%d, poll_interval=%s", w.workers, w.pollInterval)
+}
+
+// Stop 停止任务执行器，执行中的任务被中断后重新排队
+func (w *Worker) Stop() {
+	if w == nil || w.cancel == nil {
+		return
+	}
+	w.cancel()
+	w.wg.Wait()
+	logger.GetLogger().Info("任务执行器已停止")
+}
+
+// loop 循环领取并执行任务
+func (w *Worker) loop(ctx context.Context) {
+	appLog := logger.GetLogger()
+	for ctx.Err() == nil {
+		svc := service.NewJobWorkerService(ctx)
+		id, err := svc.NextJobID(w.pollInterval)
+		if err != nil && ctx.Err() == nil {
+			appLog.Error("获取待执行任务失败: %v", err)
+		}
+		if id == 0 {
+			// Redis 模式下 NextJobID 已阻塞等待，无需再休眠
+			if err == nil && svc.HasJobQueue() {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+			case <-time.After(w.pollInterval):
+			}
+			continue
+		}
+		w.runJob(svc, id)
+	}
+}
+
+// runJob 执行单个任务，panic 不影响后续任务
+func (w *Worker) runJob(svc *service.JobService, id int64) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.GetLogger().Error("任务执行器异常: job_id=%d panic=%v", id, r)
+		}
+	}()
+	svc.RunJob(id)
+}
+
+// recoverJobs 执行一轮补偿：延迟任务转入队列、重置中断任务、重新投递丢失的任务
+func (w *Worker) recoverJobs(ctx context.Context) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.GetLogger().Error("任务补偿异常: panic=%v", r)
+		}
+	}()
+	if ctx.Err() != nil {
+		return
+	}
+	_ = service.NewJobWorkerService(ctx).RecoverJobs(time.Now(), orphanAfterIntervals*w.pollInterval)
+}
@@ -257,3 +257,19 @@ func (m *Manager) RevokeToken(ctx context.Context, tokenID string, userID string
 
 	return nil
 }
+
+// RevokeUserTokens 撤销用户当前的 Refresh Token（账号被禁用时使用），已签发的 Access Token 在过期前仍然有效
+func (m *Manager) RevokeUserTokens(ctx context.Context, userID string) error {
+	if m.redis == nil {
+		return errors.New("redis unavailable")
+	}
+	userTokensKey := fmt.Sprintf("user:tokens:%s", userID)
+	tokenID, err := m.redis.Get(ctx, userTokensKey).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil
+		}
+		return fmt.Errorf("get user token failed: %w", err)
+	}
+	return m.RevokeToken(ctx, tokenID, userID)
+}
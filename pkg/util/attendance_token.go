@@ -0,0 +1,48 @@
+package util
+
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// AttendanceTokenClaims 签到/签退二维码令牌载荷
+type AttendanceTokenClaims struct {
+	ActivityID int64 `json:"aid"`
+	CodeType   int32 `json:"typ"`
+	Version    int64 `json:"ver"`
+	jwt.RegisteredClaims
+}
+
+// SignAttendanceToken 签发签到/签退二维码令牌（HS256）。
+func SignAttendanceToken(secret string, activityID int64, codeType int32, version int64, expireAt time.Time) (string, error) {
+	if secret == "" {
+		return "", errors.New("签名密钥未配置")
+	}
+	claims := &AttendanceTokenClaims{
+		ActivityID: activityID,
+		CodeType:   codeType,
+		Version:    version,
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			ExpiresAt: jwt.NewNumericDate(expireAt),
+		},
+	}
+	return jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+}
+
+// ParseAttendanceToken 校验签名与有效期并解析二维码令牌。
+func ParseAttendanceToken(secret, token string) (*AttendanceTokenClaims, error) {
+	if secret == "" {
+		return nil, errors.New("签名密钥未配置")
+	}
+	claims := &AttendanceTokenClaims{}
+	parsed, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		return []byte(secret), nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}), jwt.WithExpirationRequired())
+	if err != nil || !parsed.Valid {
+		return nil, errors.New("二维码无效或已过期")
+	}
+	return claims, nil
+}
@@ -0,0 +1,31 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAttendanceTokenRoundTrip(t *testing.T) {
+	token, err := SignAttendanceToken("secret", 42, 1, 3, time.Now().Add(time.Minute))
+	if err != nil {
+		t.Fatalf("SignAttendanceToken() error = %v", err)
+	}
+	claims, err := ParseAttendanceToken("secret", token)
+	if err != nil {
+		t.Fatalf("ParseAttendanceToken() error = %v", err)
+	}
+	if claims.ActivityID != 42 || claims.CodeType != 1 || claims.Version != 3 {
+		t.Fatalf("ParseAttendanceToken() claims = %+v", claims)
+	}
+
+	if _, err := ParseAttendanceToken("other", token); err == nil {
+		t.Fatal("ParseAttendanceToken() expected signature error")
+	}
+	expired, err := SignAttendanceToken("secret", 42, 1, 3, time.Now().Add(-time.Minute))
+	if err != nil {
+		t.Fatalf("SignAttendanceToken() error = %v", err)
+	}
+	if _, err := ParseAttendanceToken("secret", expired); err == nil {
+		t.Fatal("ParseAttendanceToken() expected expiry error")
+	}
+}
@@ -0,0 +1,135 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// certificateSerialAlphabet 证书编号随机段字符集（去除易混淆的 0/O/1/I）
+const certificateSerialAlphabet = "23456789ABCDEFGHJKLMNPQRSTUVWXYZ"
+
+// certificateSerialRandomLength 证书编号随机段长度
+const certificateSerialRandomLength = 10
+
+// certificateVerifyCodeSignLength 核验码中签名前缀长度
+const certificateVerifyCodeSignLength = 8
+
+// GenerateCertificateSerial 生成证书编号，格式：VC + 签发日期(yyyyMMdd) + 10位随机字符
+func GenerateCertificateSerial(issuedAt time.Time) (string, error) {
+	buf := make([]byte, certificateSerialRandomLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	for i, b := range buf {
+		buf[i] = certificateSerialAlphabet[int(b)%len(certificateSerialAlphabet)]
+	}
+	return "VC" + issuedAt.Format("20060102") + string(buf), nil
+}
+
+// CertificatePayload 证书签名原文，字段顺序固定
+func CertificatePayload(serialNo string, volunteerID, orgID, activityID int64, totalHours float64, issuedAt time.Time) string {
+	return fmt.Sprintf("%s|%d|%d|%d|%.2f|%d", serialNo, volunteerID, orgID, activityID, totalHours, issuedAt.Unix())
+}
+
+// SignCertificate 使用 HMAC-SHA256 对证书原文签名，返回十六进制签名
+func SignCertificate(secret, payload string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifyCertificateSignature 校验证书签名
+func VerifyCertificateSignature(secret, payload, signature string) bool {
+	expected := SignCertificate(secret, payload)
+	return hmac.Equal([]byte(expected), []byte(signature))
+}
+
+// CertificateVerifyCode 生成证书核验码，格式：证书编号-签名前8位（大写），用于证书二维码与人工核验
+func CertificateVerifyCode(serialNo, signature string) string {
+	if len(signature) > certificateVerifyCodeSignLength {
+		signature = signature[:certificateVerifyCodeSignLength]
+	}
+	return serialNo + "-" + strings.ToUpper(signature)
+}
+
+// ParseCertificateVerifyCode 解析核验码，返回证书编号与签名前缀（仅输入证书编号时前缀为空）。
+// 兼容直接扫码得到的核验页地址，从其 code 参数中取核验码。
+func ParseCertificateVerifyCode(code string) (string, string, error) {
+	code = strings.TrimSpace(code)
+	if strings.Contains(code, "?") {
+		u, err := url.Parse(code)
+		if err != nil {
+			return "", "", errors.New("核验码格式错误")
+		}
+		code = strings.TrimSpace(u.Query().Get("code"))
+	}
+	if code == "" {
+		return "", "", errors.New("核验码不能为空")
+	}
+
+	serialNo, signPrefix := code, ""
+	if idx := strings.LastIndex(code, "-"); idx >= 0 {
+		serialNo, signPrefix = code[:idx], code[idx+1:]
+		if len(signPrefix) != certificateVerifyCodeSignLength {
+			return "", "", errors.New("核验码格式错误")
+		}
+	}
+	if serialNo == "" {
+		return "", "", errors.New("核验码格式错误")
+	}
+	return strings.ToUpper(serialNo), strings.ToUpper(signPrefix), nil
+}
+
+// 证书模板占位符
+const (
+	CertificatePlaceholderVolunteerName = "volunteerName" // 志愿者姓名
+	CertificatePlaceholderOrgName       = "orgName"       // 签发组织名称
+	CertificatePlaceholderActivityTitle = "activityTitle" // 活动标题
+	CertificatePlaceholderHours         = "hours"         // 证书工时
+	CertificatePlaceholderServiceCount  = "serviceCount"  // 服务次数
+	CertificatePlaceholderStartDate     = "startDate"     // 服务开始日期
+	CertificatePlaceholderEndDate       = "endDate"       // 服务结束日期
+	CertificatePlaceholderIssueDate     = "issueDate"     // 签发日期
+	CertificatePlaceholderSerialNo      = "serialNo"      // 证书编号
+)
+
+var certificatePlaceholders = map[string]struct{}{
+	CertificatePlaceholderVolunteerName: {},
+	CertificatePlaceholderOrgName:       {},
+	CertificatePlaceholderActivityTitle: {},
+	CertificatePlaceholderHours:         {},
+	CertificatePlaceholderServiceCount:  {},
+	CertificatePlaceholderStartDate:     {},
+	CertificatePlaceholderEndDate:       {},
+	CertificatePlaceholderIssueDate:     {},
+	CertificatePlaceholderSerialNo:      {},
+}
+
+// certificatePlaceholderPattern 占位符格式：{{name}}，允许花括号内两侧空格
+var certificatePlaceholderPattern = regexp.MustCompile(`\{\{\s*([A-Za-z]+)\s*\}\}`)
+
+// ValidateCertificateTemplateText 校验模板文字中的占位符均为已支持的占位符
+func ValidateCertificateTemplateText(text string) error {
+	for _, match := range certificatePlaceholderPattern.FindAllStringSubmatch(text, -1) {
+		if _, ok := certificatePlaceholders[match[1]]; !ok {
+			return fmt.Errorf("不支持的占位符: %s", match[0])
+		}
+	}
+	return nil
+}
+
+// RenderCertificateTemplateText 将模板文字中的占位符替换为实际值，未提供值的占位符替换为空
+func RenderCertificateTemplateText(text string, values map[string]string) string {
+	return certificatePlaceholderPattern.ReplaceAllStringFunc(text, func(placeholder string) string {
+		name := certificatePlaceholderPattern.FindStringSubmatch(placeholder)[1]
+		return values[name]
+	})
+}
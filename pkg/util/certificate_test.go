@@ -0,0 +1,108 @@
+package util
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGenerateCertificateSerial(t *testing.T) {
+	issuedAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.Local)
+	serial, err := GenerateCertificateSerial(issuedAt)
+	if err != nil {
+		t.Fatalf("GenerateCertificateSerial() error = %v", err)
+	}
+	if !strings.HasPrefix(serial, "VC20260301") || len(serial) != 20 {
+		t.Fatalf("GenerateCertificateSerial() = %q", serial)
+	}
+	for _, r := range serial[10:] {
+		if !strings.ContainsRune(certificateSerialAlphabet, r) {
+			t.Fatalf("GenerateCertificateSerial() unexpected char %q in %q", r, serial)
+		}
+	}
+}
+
+func TestCertificateSignature(t *testing.T) {
+	issuedAt := time.Date(2026, 3, 1, 10, 0, 0, 0, time.Local)
+	payload := CertificatePayload("VC20260301ABCDEFGHJK", 7, 3, 12, 4.5, issuedAt)
+	signature := SignCertificate("secret", payload)
+	if !VerifyCertificateSignature("secret", payload, signature) {
+		t.Fatal("VerifyCertificateSignature() = false, want true")
+	}
+	if VerifyCertificateSignature("other", payload, signature) {
+		t.Fatal("VerifyCertificateSignature() with wrong secret = true")
+	}
+	tampered := CertificatePayload("VC20260301ABCDEFGHJK", 7, 3, 12, 45, issuedAt)
+	if VerifyCertificateSignature("secret", tampered, signature) {
+		t.Fatal("VerifyCertificateSignature() with tampered payload = true")
+	}
+}
+
+func TestParseCertificateVerifyCode(t *testing.T) {
+	code := CertificateVerifyCode("VC20260301ABCDEFGHJK", "0a1b2c3d4e5f")
+	if code != "VC20260301ABCDEFGHJK-0A1B2C3D" {
+		t.Fatalf("CertificateVerifyCode() = %q", code)
+	}
+
+	tests := []struct {
+		name       string
+		input      string
+		serialNo   string
+		signPrefix string
+		wantErr    bool
+	}{
+		{name: "verify code", input: code, serialNo: "VC20260301ABCDEFGHJK", signPrefix: "0A1B2C3D"},
+		{name: "serial only", input: " vc20260301abcdefghjk ", serialNo: "VC20260301ABCDEFGHJK"},
+		{name: "scanned url", input: "https://example.com/verify?code=" + code, serialNo: "VC20260301ABCDEFGHJK", signPrefix: "0A1B2C3D"},
+		{name: "url without code", input: "https://example.com/verify?x=1", wantErr: true},
+		{name: "bad sign prefix", input: "VC20260301ABCDEFGHJK-0A1B", wantErr: true},
+		{name: "empty", input: "  ", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serialNo, signPrefix, err := ParseCertificateVerifyCode(tt.input)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseCertificateVerifyCode() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if serialNo != tt.serialNo || signPrefix != tt.signPrefix {
+				t.Fatalf("ParseCertificateVerifyCode() = (%q, %q), want (%q, %q)", serialNo, signPrefix, tt.serialNo, tt.signPrefix)
+			}
+		})
+	}
+}
+
+func TestGetNameMask(t *testing.T) {
+	tests := map[string]string{
+		"":     "",
+		"李":    "李",
+		"张三":   "张*",
+		"王小明":  "王*明",
+		"欧阳娜娜": "欧**娜",
+	}
+	for name, want := range tests {
+		if got := GetNameMask(name); got != want {
+			t.Fatalf("GetNameMask(%q) = %q, want %q", name, got, want)
+		}
+	}
+}
+
+func TestCertificateTemplateText(t *testing.T) {
+	text := "{{volunteerName}} 同志：于{{ startDate }}参加“{{activityTitle}}”，服务{{hours}}小时。{{serialNo}}"
+	if err := ValidateCertificateTemplateText(text); err != nil {
+		t.Fatalf("ValidateCertificateTemplateText() error = %v", err)
+	}
+	if err := ValidateCertificateTemplateText("{{volunteerName}}{{idCard}}"); err == nil {
+		t.Fatal("ValidateCertificateTemplateText() want error for unknown placeholder")
+	}
+
+	got := RenderCertificateTemplateText(text, map[string]string{
+		CertificatePlaceholderVolunteerName: "张三",
+		CertificatePlaceholderStartDate:     "2026年03月01日",
+		CertificatePlaceholderActivityTitle: "社区清洁",
+		CertificatePlaceholderHours:         "3.5",
+	})
+	want := "张三 同志：于2026年03月01日参加“社区清洁”，服务3.5小时。"
+	if got != want {
+		t.Fatalf("RenderCertificateTemplateText() = %q, want %q", got, want)
+	}
+}
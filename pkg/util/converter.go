@@ -19,6 +19,8 @@ func ConvertSysAccountToUserInfo(account *model.SysAccount) *api.UserInfo {
 		identity = "volunteer"
 	case 2:
 		identity = "organization"
+	case 3:
+		identity = "admin"
 	default:
 		identity = "unknown"
 	}
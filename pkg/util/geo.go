@@ -0,0 +1,21 @@
+package util
+
+import "math"
+
+// earthRadiusMeters 地球平均半径（米）
+const earthRadiusMeters = 6371000.0
+
+// HaversineDistance 计算两点间的球面距离（米）。
+func HaversineDistance(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := func(deg float64) float64 { return deg * math.Pi / 180 }
+	dLat := toRad(lat2 - lat1)
+	dLng := toRad(lng2 - lng1)
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(toRad(lat1))*math.Cos(toRad(lat2))*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// IsValidCoordinate 校验经纬度取值范围。
+func IsValidCoordinate(lat, lng float64) bool {
+	return lat >= -90 && lat <= 90 && lng >= -180 && lng <= 180
+}
@@ -0,0 +1,17 @@
+package util
+
+import (
+	"math"
+	"testing"
+)
+
+func TestHaversineDistance(t *testing.T) {
+	// 天安门 -> 故宫神武门，约 1.6 公里
+	got := HaversineDistance(39.908823, 116.397470, 39.923760, 116.397030)
+	if math.Abs(got-1661) > 30 {
+		t.Fatalf("HaversineDistance() = %.0f, want about 1661", got)
+	}
+	if d := HaversineDistance(31.2304, 121.4737, 31.2304, 121.4737); d != 0 {
+		t.Fatalf("HaversineDistance() same point = %v, want 0", d)
+	}
+}
@@ -0,0 +1,76 @@
+package util
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// idCardWeights 18位身份证号前17位的加权因子（GB 11643-1999）
+var idCardWeights = [17]int{7, 9, 10, 5, 8, 4, 2, 1, 6, 3, 7, 9, 10, 5, 8, 4, 2}
+
+// idCardCheckCodes 加权和对 11 取模后对应的校验码
+const idCardCheckCodes = "10X98765432"
+
+// IDCardInfo 从身份证号解析出的信息
+type IDCardInfo struct {
+	Number   string    // 规范化后的号码（末位 x 转为大写）
+	Birthday time.Time // 出生日期
+	Gender   int32     // 性别: 1-男, 2-女
+}
+
+// NormalizeIDCard 去除首尾空白并将末位 x 转为大写
+func NormalizeIDCard(idCard string) string {
+	return strings.ToUpper(strings.TrimSpace(idCard))
+}
+
+// ParseIDCard 校验18位居民身份证号（格式、出生日期、校验码）并解析出生日期与性别
+func ParseIDCard(idCard string) (*IDCardInfo, error) {
+	number := NormalizeIDCard(idCard)
+	if len(number) != 18 {
+		return nil, errors.New("身份证号须为18位")
+	}
+
+	sum := 0
+	for i := 0; i < 17; i++ {
+		c := number[i]
+		if c < '0' || c > '9' {
+			return nil, errors.New("身份证号格式不正确")
+		}
+		sum += int(c-'0') * idCardWeights[i]
+	}
+	last := number[17]
+	if (last < '0' || last > '9') && last != 'X' {
+		return nil, errors.New("身份证号格式不正确")
+	}
+	if idCardCheckCodes[sum%11] != last {
+		return nil, errors.New("身份证号校验码不正确")
+	}
+
+	birthday, err := time.ParseInLocation("20060102", number[6:14], time.Local)
+	if err != nil {
+		return nil, errors.New("身份证号中的出生日期无效")
+	}
+	if birthday.Year() < 1900 || birthday.After(time.Now()) {
+		return nil, errors.New("身份证号中的出生日期无效")
+	}
+
+	gender := int32(2)
+	if (number[16]-'0')%2 == 1 {
+		gender = 1
+	}
+	return &IDCardInfo{
+		Number:   number,
+		Birthday: birthday,
+		Gender:   gender,
+	}, nil
+}
+
+// GetIDCardMask 身份证号脱敏，保留前3位和后4位
+// 例如: 110101199003071234 -> 110***********1234
+func GetIDCardMask(idCard string) string {
+	if len(idCard) <= 7 {
+		return idCard
+	}
+	return idCard[:3] + strings.Repeat("*", len(idCard)-7) + idCard[len(idCard)-4:]
+}
@@ -0,0 +1,51 @@
+package util
+
+import "testing"
+
+func TestParseIDCard(t *testing.T) {
+	info, err := ParseIDCard(" 11010519491231002x ")
+	if err != nil {
+		t.Fatalf("ParseIDCard() error = %v", err)
+	}
+	if info.Number != "11010519491231002X" {
+		t.Fatalf("Number = %s", info.Number)
+	}
+	if got := FormatDate(info.Birthday); got != "1949-12-31" {
+		t.Fatalf("Birthday = %s, want 1949-12-31", got)
+	}
+	if info.Gender != 2 {
+		t.Fatalf("Gender = %d, want 2", info.Gender)
+	}
+
+	info, err = ParseIDCard("110101199003071233")
+	if err != nil {
+		t.Fatalf("ParseIDCard() error = %v", err)
+	}
+	if info.Gender != 1 {
+		t.Fatalf("Gender = %d, want 1", info.Gender)
+	}
+}
+
+func TestParseIDCardInvalid(t *testing.T) {
+	cases := map[string]string{
+		"short":         "1101051949123100",
+		"letters":       "11010519491231A02X",
+		"bad checksum":  "110105194912310021",
+		"invalid date":  "110105194902300020",
+		"x not at tail": "11010519491231X020",
+	}
+	for name, idCard := range cases {
+		if _, err := ParseIDCard(idCard); err == nil {
+			t.Fatalf("%s: ParseIDCard(%s) expected error", name, idCard)
+		}
+	}
+}
+
+func TestGetIDCardMask(t *testing.T) {
+	if got := GetIDCardMask("11010519491231002X"); got != "110***********002X" {
+		t.Fatalf("GetIDCardMask() = %s", got)
+	}
+	if got := GetIDCardMask("1234"); got != "1234" {
+		t.Fatalf("GetIDCardMask() = %s", got)
+	}
+}
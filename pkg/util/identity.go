@@ -7,6 +7,8 @@ func GetIdentityTypeFromString(identity string) int32 {
 		return 1
 	case "organization":
 		return 2
+	case "admin":
+		return 3
 	default:
 		return 0 // 未知类型
 	}
@@ -25,7 +27,7 @@ func ValidateLoginType(loginType string) bool {
 // ValidateIdentity 验证身份类型是否有效
 func ValidateIdentity(identity string) bool {
 	switch identity {
-	case "volunteer", "organization":
+	case "volunteer", "organization", "admin":
 		return true
 	default:
 		return false
@@ -0,0 +1,107 @@
+package util
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// imageDownloadTimeout 远程图片下载超时时间
+const imageDownloadTimeout = 10 * time.Second
+
+// imageExtensions 支持的图片格式及对应扩展名
+var imageExtensions = map[string]string{
+	"png":  ".png",
+	"jpeg": ".jpg",
+}
+
+// DownloadImage 下载远程图片，返回图片内容与扩展名（.png/.jpg）。
+// 仅支持 http/https 且只允许连接公网地址，防止借助图片地址访问内网服务。
+func DownloadImage(ctx context.Context, rawURL string, maxBytes int64) ([]byte, string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, "", errors.New("图片地址仅支持 http/https")
+	}
+
+	dialer := &net.Dialer{
+		Timeout: imageDownloadTimeout,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if !IsPublicIP(net.ParseIP(host)) {
+				return errors.New("图片地址不允许指向内网")
+			}
+			return nil
+		},
+	}
+	client := &http.Client{
+		Timeout:   imageDownloadTimeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext},
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, "", fmt.Errorf("图片下载失败: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("图片下载失败: HTTP %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxBytes+1))
+	if err != nil {
+		return nil, "", fmt.Errorf("图片下载失败: %w", err)
+	}
+	if int64(len(data)) > maxBytes {
+		return nil, "", fmt.Errorf("图片大小不能超过%dKB", maxBytes/1024)
+	}
+	ext, err := ImageExtension(data)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, ext, nil
+}
+
+// ImageExtension 识别图片格式，仅支持 PNG/JPEG
+func ImageExtension(data []byte) (string, error) {
+	_, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return "", errors.New("图片格式仅支持 PNG 或 JPEG")
+	}
+	ext, ok := imageExtensions[format]
+	if !ok {
+		return "", errors.New("图片格式仅支持 PNG 或 JPEG")
+	}
+	return ext, nil
+}
+
+// DecodeImage 解码 PNG/JPEG 图片
+func DecodeImage(data []byte) (image.Image, error) {
+	img, _, err := image.Decode(bytes.NewReader(data))
+	return img, err
+}
+
+// IsPublicIP 判断是否为公网地址（排除回环、内网、链路本地、组播与未指定地址）
+func IsPublicIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified())
+}
@@ -0,0 +1,46 @@
+package util
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"net"
+	"testing"
+)
+
+func TestIsPublicIP(t *testing.T) {
+	tests := map[string]bool{
+		"8.8.8.8":         true,
+		"2400:3200::1":    true,
+		"127.0.0.1":       false,
+		"10.0.0.8":        false,
+		"172.16.3.4":      false,
+		"192.168.1.1":     false,
+		"169.254.169.254": false,
+		"0.0.0.0":         false,
+		"::1":             false,
+		"fd00::1":         false,
+	}
+	for ip, want := range tests {
+		if got := IsPublicIP(net.ParseIP(ip)); got != want {
+			t.Fatalf("IsPublicIP(%s) = %v, want %v", ip, got, want)
+		}
+	}
+	if IsPublicIP(nil) {
+		t.Fatal("IsPublicIP(nil) = true, want false")
+	}
+}
+
+func TestImageExtension(t *testing.T) {
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 2, 2))); err != nil {
+		t.Fatalf("png.Encode() error = %v", err)
+	}
+	ext, err := ImageExtension(buf.Bytes())
+	if err != nil || ext != ".png" {
+		t.Fatalf("ImageExtension() = (%q, %v), want .png", ext, err)
+	}
+	if _, err := ImageExtension([]byte("GIF89a")); err == nil {
+		t.Fatal("ImageExtension() want error for unsupported format")
+	}
+}
@@ -0,0 +1,318 @@
+package util
+
+import (
+	"bytes"
+	"compress/zlib"
+	"errors"
+	"fmt"
+	"image"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// A4 横版页面尺寸（单位：pt）
+const (
+	PDFPageA4LandscapeWidth  = 841.89
+	PDFPageA4LandscapeHeight = 595.28
+)
+
+// PDFDocument 极简 PDF 生成器。
+// 中文使用阅读器内置的 STSong-Light 字体（UniGB-UCS2-H 编码），无需嵌入字体文件；
+// 坐标以页面左上角为原点，单位为 pt。
+type PDFDocument struct {
+	width  float64
+	height float64
+	pages  []*bytes.Buffer
+	images []*pdfImage
+	color  [3]float64
+}
+
+type pdfImage struct {
+	width  int
+	height int
+	rgb    []byte
+	alpha  []byte
+}
+
+// NewPDFDocument 创建指定页面尺寸的文档（不含页面）
+func NewPDFDocument(width, height float64) *PDFDocument {
+	return &PDFDocument{width: width, height: height}
+}
+
+// AddPage 新增一页，后续绘制操作作用于该页
+func (d *PDFDocument) AddPage() {
+	d.pages = append(d.pages, &bytes.Buffer{})
+}
+
+// Width 页面宽度
+func (d *PDFDocument) Width() float64 {
+	return d.width
+}
+
+// Height 页面高度
+func (d *PDFDocument) Height() float64 {
+	return d.height
+}
+
+// SetColor 设置后续文字、线条的颜色
+func (d *PDFDocument) SetColor(r, g, b uint8) {
+	d.color = [3]float64{float64(r) / 255, float64(g) / 255, float64(b) / 255}
+}
+
+// TextWidth 估算文字宽度：ASCII 字符按半角、其余按全角计算
+func (d *PDFDocument) TextWidth(size float64, text string) float64 {
+	var units float64
+	for _, r := range text {
+		if r >= 0x20 && r <= 0x7e {
+			units += 0.5
+		} else {
+			units++
+		}
+	}
+	return units * size
+}
+
+// Text 在 (x, y) 处绘制单行文字，y 为文字基线
+func (d *PDFDocument) Text(x, y, size float64, text string) {
+	page := d.currentPage()
+	fmt.Fprintf(page, "BT /F1 %s Tf %s rg %s %s Td <%s> Tj ET\n",
+		pdfNumber(size), d.colorOperands(), pdfNumber(x), pdfNumber(d.height-y), encodeUCS2Hex(text))
+}
+
+// TextCenter 在页面水平居中绘制单行文字
+func (d *PDFDocument) TextCenter(y, size float64, text string) {
+	d.Text((d.width-d.TextWidth(size, text))/2, y, size, text)
+}
+
+// TextRight 以 right 为右边界绘制单行文字
+func (d *PDFDocument) TextRight(right, y, size float64, text string) {
+	d.Text(right-d.TextWidth(size, text), y, size, text)
+}
+
+// WrapText 按最大宽度将文字折行（保留原有换行符）
+func (d *PDFDocument) WrapText(size, maxWidth float64, text string) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		var line strings.Builder
+		var lineWidth float64
+		for _, r := range paragraph {
+			runeWidth := d.TextWidth(size, string(r))
+			if lineWidth+runeWidth > maxWidth && line.Len() > 0 {
+				lines = append(lines, line.String())
+				line.Reset()
+				lineWidth = 0
+			}
+			line.WriteRune(r)
+			lineWidth += runeWidth
+		}
+		lines = append(lines, line.String())
+	}
+	return lines
+}
+
+// Line 绘制线段
+func (d *PDFDocument) Line(x1, y1, x2, y2, lineWidth float64) {
+	page := d.currentPage()
+	fmt.Fprintf(page, "%s w %s RG %s %s m %s %s l S\n",
+		pdfNumber(lineWidth), d.colorOperands(),
+		pdfNumber(x1), pdfNumber(d.height-y1), pdfNumber(x2), pdfNumber(d.height-y2))
+}
+
+// Rect 绘制矩形边框，(x, y) 为左上角
+func (d *PDFDocument) Rect(x, y, w, h, lineWidth float64) {
+	page := d.currentPage()
+	fmt.Fprintf(page, "%s w %s RG %s %s %s %s re S\n",
+		pdfNumber(lineWidth), d.colorOperands(),
+		pdfNumber(x), pdfNumber(d.height-y-h), pdfNumber(w), pdfNumber(h))
+}
+
+// Image 在 (x, y) 处按 w×h 绘制图片，(x, y) 为左上角；带透明通道的图片保留透明效果
+func (d *PDFDocument) Image(img image.Image, x, y, w, h float64) {
+	bounds := img.Bounds()
+	item := &pdfImage{
+		width:  bounds.Dx(),
+		height: bounds.Dy(),
+		rgb:    make([]byte, 0, bounds.Dx()*bounds.Dy()*3),
+		alpha:  make([]byte, 0, bounds.Dx()*bounds.Dy()),
+	}
+	hasAlpha := false
+	for py := bounds.Min.Y; py < bounds.Max.Y; py++ {
+		for px := bounds.Min.X; px < bounds.Max.X; px++ {
+			r, g, b, a := img.At(px, py).RGBA()
+			// 还原预乘透明度，避免半透明边缘发黑
+			if a > 0 && a < 0xffff {
+				r, g, b = r*0xffff/a, g*0xffff/a, b*0xffff/a
+			}
+			item.rgb = append(item.rgb, byte(r>>8), byte(g>>8), byte(b>>8))
+			item.alpha = append(item.alpha, byte(a>>8))
+			if a != 0xffff {
+				hasAlpha = true
+			}
+		}
+	}
+	if !hasAlpha {
+		item.alpha = nil
+	}
+	d.images = append(d.images, item)
+
+	page := d.currentPage()
+	fmt.Fprintf(page, "q %s 0 0 %s %s %s cm /Im%d Do Q\n",
+		pdfNumber(w), pdfNumber(h), pdfNumber(x), pdfNumber(d.height-y-h), len(d.images))
+}
+
+// Bytes 输出完整的 PDF 文件内容
+func (d *PDFDocument) Bytes() ([]byte, error) {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+
+	var out bytes.Buffer
+	var offsets []int
+	// 对象编号从 1 开始，按写入顺序分配
+	beginObject := func() int {
+		offsets = append(offsets, out.Len())
+		id := len(offsets)
+		fmt.Fprintf(&out, "%d 0 obj\n", id)
+		return id
+	}
+	writeStream := func(dict string, data []byte) error {
+		compressed, err := zlibCompress(data)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(&out, "<< %s /Filter /FlateDecode /Length %d >>\nstream\n", dict, len(compressed))
+		out.Write(compressed)
+		out.WriteString("\nendstream\nendobj\n")
+		return nil
+	}
+
+	out.WriteString("%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	// 1-2: 目录与页面树（页面对象编号在图片之后，按固定规则计算）
+	imageObjects := 0
+	for _, img := range d.images {
+		imageObjects++
+		if img.alpha != nil {
+			imageObjects++
+		}
+	}
+	firstPageID := 6 + imageObjects
+	beginObject()
+	out.WriteString("<< /Type /Catalog /Pages 2 0 R >>\nendobj\n")
+	beginObject()
+	kids := make([]string, 0, len(d.pages))
+	for i := range d.pages {
+		kids = append(kids, fmt.Sprintf("%d 0 R", firstPageID+i*2))
+	}
+	fmt.Fprintf(&out, "<< /Type /Pages /Kids [%s] /Count %d >>\nendobj\n", strings.Join(kids, " "), len(d.pages))
+
+	// 3-5: 中文字体（STSong-Light，不嵌入）
+	beginObject()
+	out.WriteString("<< /Type /Font /Subtype /Type0 /BaseFont /STSong-Light /Encoding /UniGB-UCS2-H /DescendantFonts [4 0 R] >>\nendobj\n")
+	beginObject()
+	out.WriteString("<< /Type /Font /Subtype /CIDFontType0 /BaseFont /STSong-Light " +
+		"/CIDSystemInfo << /Registry (Adobe) /Ordering (GB1) /Supplement 2 >> " +
+		"/FontDescriptor 5 0 R /DW 1000 /W [1 95 500] >>\nendobj\n")
+	beginObject()
+	out.WriteString("<< /Type /FontDescriptor /FontName /STSong-Light /Flags 6 /FontBBox [-25 -254 1000 880] " +
+		"/ItalicAngle 0 /Ascent 880 /Descent -120 /CapHeight 880 /StemV 93 >>\nendobj\n")
+
+	// 图片对象（带透明通道的图片附带 SMask）
+	xObjects := make([]string, 0, len(d.images))
+	for i, img := range d.images {
+		smask := ""
+		if img.alpha != nil {
+			maskID := beginObject()
+			dict := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8", img.width, img.height)
+			if err := writeStream(dict, img.alpha); err != nil {
+				return nil, err
+			}
+			smask = fmt.Sprintf(" /SMask %d 0 R", maskID)
+		}
+		imageID := beginObject()
+		dict := fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceRGB /BitsPerComponent 8%s", img.width, img.height, smask)
+		if err := writeStream(dict, img.rgb); err != nil {
+			return nil, err
+		}
+		xObjects = append(xObjects, fmt.Sprintf("/Im%d %d 0 R", i+1, imageID))
+	}
+
+	resources := "<< /Font << /F1 3 0 R >>"
+	if len(xObjects) > 0 {
+		resources += " /XObject << " + strings.Join(xObjects, " ") + " >>"
+	}
+	resources += " >>"
+	for _, page := range d.pages {
+		pageID := beginObject()
+		fmt.Fprintf(&out, "<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %s %s] /Resources %s /Contents %d 0 R >>\nendobj\n",
+			pdfNumber(d.width), pdfNumber(d.height), resources, pageID+1)
+		beginObject()
+		if err := writeStream("", page.Bytes()); err != nil {
+			return nil, err
+		}
+	}
+
+	xrefOffset := out.Len()
+	fmt.Fprintf(&out, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&out, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&out, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xrefOffset)
+	return out.Bytes(), nil
+}
+
+// ParseHexColor 解析 #RRGGBB 格式的颜色
+func ParseHexColor(color string) (uint8, uint8, uint8, error) {
+	if len(color) != 7 || color[0] != '#' {
+		return 0, 0, 0, errors.New("颜色格式应为#RRGGBB")
+	}
+	v, err := strconv.ParseUint(color[1:], 16, 32)
+	if err != nil {
+		return 0, 0, 0, errors.New("颜色格式应为#RRGGBB")
+	}
+	return uint8(v >> 16), uint8(v >> 8), uint8(v), nil
+}
+
+func (d *PDFDocument) currentPage() *bytes.Buffer {
+	if len(d.pages) == 0 {
+		d.AddPage()
+	}
+	return d.pages[len(d.pages)-1]
+}
+
+func (d *PDFDocument) colorOperands() string {
+	return fmt.Sprintf("%s %s %s", pdfNumber(d.color[0]), pdfNumber(d.color[1]), pdfNumber(d.color[2]))
+}
+
+// encodeUCS2Hex 将文字编码为 UCS-2 大端十六进制串，超出基本平面的字符替换为问号
+func encodeUCS2Hex(text string) string {
+	var sb strings.Builder
+	for _, r := range text {
+		if r == utf8.RuneError || r > 0xffff {
+			r = '?'
+		}
+		fmt.Fprintf(&sb, "%04X", r)
+	}
+	return sb.String()
+}
+
+func pdfNumber(v float64) string {
+	s := strings.TrimRight(strings.TrimRight(fmt.Sprintf("%.2f", v), "0"), ".")
+	if s == "" || s == "-0" {
+		return "0"
+	}
+	return s
+}
+
+func zlibCompress(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	w := zlib.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,66 @@
+package util
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestPDFDocumentBytes(t *testing.T) {
+	doc := NewPDFDocument(PDFPageA4LandscapeWidth, PDFPageA4LandscapeHeight)
+	doc.AddPage()
+	doc.TextCenter(100, 24, "志愿服务证书")
+	doc.Rect(20, 20, 100, 50, 1)
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 2))
+	img.Set(0, 0, color.NRGBA{R: 255, A: 128})
+	doc.Image(img, 10, 10, 20, 20)
+
+	data, err := doc.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes() error = %v", err)
+	}
+	if !bytes.HasPrefix(data, []byte("%PDF-1.4")) || !bytes.HasSuffix(data, []byte("%%EOF\n")) {
+		t.Fatal("Bytes() missing PDF header or trailer")
+	}
+	for _, want := range []string{"/STSong-Light", "/SMask", "/Im1"} {
+		if !bytes.Contains(data, []byte(want)) {
+			t.Fatalf("Bytes() missing %s", want)
+		}
+	}
+}
+
+func TestPDFDocumentWrapText(t *testing.T) {
+	doc := NewPDFDocument(PDFPageA4LandscapeWidth, PDFPageA4LandscapeHeight)
+	if got := doc.TextWidth(10, "ab中"); got != 20 {
+		t.Fatalf("TextWidth() = %v, want 20", got)
+	}
+	lines := doc.WrapText(10, 30, "一二三四五六七\nab")
+	want := []string{"一二三", "四五六", "七", "ab"}
+	if len(lines) != len(want) {
+		t.Fatalf("WrapText() = %q, want %q", lines, want)
+	}
+	for i := range want {
+		if lines[i] != want[i] {
+			t.Fatalf("WrapText() = %q, want %q", lines, want)
+		}
+	}
+}
+
+func TestEncodeUCS2Hex(t *testing.T) {
+	if got := encodeUCS2Hex("A中"); got != "00414E2D" {
+		t.Fatalf("encodeUCS2Hex() = %q", got)
+	}
+}
+
+func TestParseHexColor(t *testing.T) {
+	r, g, b, err := ParseHexColor("#A82010")
+	if err != nil || r != 0xa8 || g != 0x20 || b != 0x10 {
+		t.Fatalf("ParseHexColor() = (%d, %d, %d, %v)", r, g, b, err)
+	}
+	for _, color := range []string{"", "A82010", "#A8201", "#GG2010", "#A820100"} {
+		if _, _, _, err := ParseHexColor(color); err == nil {
+			t.Fatalf("ParseHexColor(%q) want error", color)
+		}
+	}
+}
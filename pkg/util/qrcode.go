@@ -0,0 +1,74 @@
+package util
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+
+	qrcode "github.com/skip2/go-qrcode"
+)
+
+const (
+	// QRCodeFormatPNG PNG 图片
+	QRCodeFormatPNG = "png"
+	// QRCodeFormatSVG SVG 矢量图
+	QRCodeFormatSVG = "svg"
+)
+
+// RenderQRCode 将内容渲染为指定格式的二维码图片，返回图片内容与 Content-Type。
+// size 为图片边长（像素），SVG 以 viewBox 缩放，size 仅作为默认显示尺寸。
+func RenderQRCode(content, format string, size int) ([]byte, string, error) {
+	if content == "" {
+		return nil, "", errors.New("二维码内容不能为空")
+	}
+	if size <= 0 {
+		size = 256
+	}
+
+	switch format {
+	case "", QRCodeFormatPNG:
+		png, err := qrcode.Encode(content, qrcode.Medium, size)
+		if err != nil {
+			return nil, "", err
+		}
+		return png, "image/png", nil
+	case QRCodeFormatSVG:
+		qr, err := qrcode.New(content, qrcode.Medium)
+		if err != nil {
+			return nil, "", err
+		}
+		return renderQRCodeSVG(qr.Bitmap(), size), "image/svg+xml", nil
+	default:
+		return nil, "", errors.New("二维码格式仅支持 png 或 svg")
+	}
+}
+
+// RenderQRCodeImage 将内容渲染为二维码图像，用于嵌入 PDF 等文档。
+func RenderQRCodeImage(content string, size int) (image.Image, error) {
+	if content == "" {
+		return nil, errors.New("二维码内容不能为空")
+	}
+	qr, err := qrcode.New(content, qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+	return qr.Image(size), nil
+}
+
+// renderQRCodeSVG 将二维码点阵输出为 SVG，每个深色模块绘制为 1x1 的矩形。
+func renderQRCodeSVG(bitmap [][]bool, size int) []byte {
+	n := len(bitmap)
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, size, size, n, n)
+	fmt.Fprintf(&buf, `<rect width="%d" height="%d" fill="#ffffff"/><path fill="#000000" d="`, n, n)
+	for y, row := range bitmap {
+		for x, dark := range row {
+			if dark {
+				fmt.Fprintf(&buf, "M%d %dh1v1h-1z", x, y)
+			}
+		}
+	}
+	buf.WriteString(`"/></svg>`)
+	return buf.Bytes()
+}
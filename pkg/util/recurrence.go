@@ -0,0 +1,218 @@
+package util
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// RecurrenceFreqWeekly 按周重复
+	RecurrenceFreqWeekly = "WEEKLY"
+	// RecurrenceFreqMonthly 按月重复
+	RecurrenceFreqMonthly = "MONTHLY"
+
+	// recurrenceMaxIterations 展开时的最大周期数，防止规则异常导致死循环。
+	recurrenceMaxIterations = 1200
+)
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// RecurrenceRule 精简版 RRULE，仅支持按周/按月重复。
+type RecurrenceRule struct {
+	Freq       string
+	Interval   int
+	ByWeekday  []time.Weekday
+	ByMonthDay []int
+	Count      int
+	Until      *time.Time
+}
+
+// ParseRecurrenceRule 解析 RRULE 字符串，例如 FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,WE;UNTIL=20260630。
+func ParseRecurrenceRule(rule string) (*RecurrenceRule, error) {
+	rule = strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:")
+	if rule == "" {
+		return nil, errors.New("重复规则不能为空")
+	}
+
+	result := &RecurrenceRule{Interval: 1}
+	for _, part := range strings.Split(rule, ";") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		kv := strings.SplitN(part, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("重复规则格式错误: %s", part)
+		}
+		key := strings.ToUpper(strings.TrimSpace(kv[0]))
+		value := strings.ToUpper(strings.TrimSpace(kv[1]))
+
+		switch key {
+		case "FREQ":
+			if value != RecurrenceFreqWeekly && value != RecurrenceFreqMonthly {
+				return nil, errors.New("重复频率仅支持 WEEKLY 或 MONTHLY")
+			}
+			result.Freq = value
+		case "INTERVAL":
+			interval, err := strconv.Atoi(value)
+			if err != nil || interval <= 0 {
+				return nil, errors.New("重复间隔必须为正整数")
+			}
+			result.Interval = interval
+		case "BYDAY":
+			for _, day := range strings.Split(value, ",") {
+				weekday, ok := recurrenceWeekdays[strings.TrimSpace(day)]
+				if !ok {
+					return nil, fmt.Errorf("BYDAY 取值不合法: %s", day)
+				}
+				result.ByWeekday = append(result.ByWeekday, weekday)
+			}
+		case "BYMONTHDAY":
+			for _, day := range strings.Split(value, ",") {
+				monthDay, err := strconv.Atoi(strings.TrimSpace(day))
+				if err != nil || monthDay == 0 || monthDay < -31 || monthDay > 31 {
+					return nil, fmt.Errorf("BYMONTHDAY 取值不合法: %s", day)
+				}
+				result.ByMonthDay = append(result.ByMonthDay, monthDay)
+			}
+		case "COUNT":
+			count, err := strconv.Atoi(value)
+			if err != nil || count <= 0 {
+				return nil, errors.New("重复次数必须为正整数")
+			}
+			result.Count = count
+		case "UNTIL":
+			// 兼容 20260630 与 20260630T235959Z 两种写法，统一按日期处理。
+			if len(value) < 8 {
+				return nil, errors.New("UNTIL 格式错误")
+			}
+			until, err := time.ParseInLocation("20060102", value[:8], time.Local)
+			if err != nil {
+				return nil, errors.New("UNTIL 格式错误")
+			}
+			result.Until = &until
+		default:
+			return nil, fmt.Errorf("不支持的重复规则字段: %s", key)
+		}
+	}
+
+	if result.Freq == "" {
+		return nil, errors.New("重复规则缺少 FREQ")
+	}
+	if result.Freq == RecurrenceFreqWeekly && len(result.ByMonthDay) > 0 {
+		return nil, errors.New("按周重复不支持 BYMONTHDAY")
+	}
+	if result.Freq == RecurrenceFreqMonthly && len(result.ByWeekday) > 0 {
+		return nil, errors.New("按月重复不支持 BYDAY")
+	}
+	return result, nil
+}
+
+// ExpandRecurrence 按规则展开各场次的开始时间。
+// first 为首场开始时间（同时决定每场的时分秒）；until 为截止日期（含当天，零值表示仅依赖规则自身的 COUNT/UNTIL）；
+// exdates 为例外日期（YYYY-MM-DD）；limit 为最多展开场次。
+func ExpandRecurrence(rule *RecurrenceRule, first time.Time, until time.Time, exdates map[string]struct{}, limit int) ([]time.Time, error) {
+	if rule == nil {
+		return nil, errors.New("重复规则不能为空")
+	}
+
+	endDate := until
+	if rule.Until != nil && (endDate.IsZero() || rule.Until.Before(endDate)) {
+		endDate = *rule.Until
+	}
+	if endDate.IsZero() && rule.Count <= 0 {
+		return nil, errors.New("重复规则必须指定截止日期或重复次数")
+	}
+	var endBound time.Time
+	if !endDate.IsZero() {
+		endBound = time.Date(endDate.Year(), endDate.Month(), endDate.Day(), 23, 59, 59, 0, first.Location())
+	}
+
+	var candidates func(period int) []time.Time
+	switch rule.Freq {
+	case RecurrenceFreqWeekly:
+		weekdays := rule.ByWeekday
+		if len(weekdays) == 0 {
+			weekdays = []time.Weekday{first.Weekday()}
+		}
+		// 以首场所在周的周一为基准，周内按周一至周日排序。
+		offsets := make([]int, 0, len(weekdays))
+		for _, weekday := range weekdays {
+			offsets = append(offsets, (int(weekday)+6)%7)
+		}
+		sort.Ints(offsets)
+		weekStart := first.AddDate(0, 0, -((int(first.Weekday()) + 6) % 7))
+		candidates = func(period int) []time.Time {
+			base := weekStart.AddDate(0, 0, period*rule.Interval*7)
+			list := make([]time.Time, 0, len(offsets))
+			for _, offset := range offsets {
+				day := base.AddDate(0, 0, offset)
+				list = append(list, time.Date(day.Year(), day.Month(), day.Day(), first.Hour(), first.Minute(), first.Second(), 0, first.Location()))
+			}
+			return list
+		}
+	case RecurrenceFreqMonthly:
+		monthDays := rule.ByMonthDay
+		if len(monthDays) == 0 {
+			monthDays = []int{first.Day()}
+		}
+		candidates = func(period int) []time.Time {
+			monthStart := time.Date(first.Year(), first.Month()+time.Month(period*rule.Interval), 1, first.Hour(), first.Minute(), first.Second(), 0, first.Location())
+			daysInMonth := monthStart.AddDate(0, 1, -1).Day()
+			list := make([]time.Time, 0, len(monthDays))
+			for _, monthDay := range monthDays {
+				day := monthDay
+				if day < 0 {
+					day = daysInMonth + day + 1
+				}
+				// 当月不存在的日期（如 2 月 30 日）直接跳过。
+				if day < 1 || day > daysInMonth {
+					continue
+				}
+				list = append(list, monthStart.AddDate(0, 0, day-1))
+			}
+			sort.Slice(list, func(i, j int) bool { return list[i].Before(list[j]) })
+			return list
+		}
+	default:
+		return nil, errors.New("重复频率仅支持 WEEKLY 或 MONTHLY")
+	}
+
+	result := make([]time.Time, 0)
+	generated := 0
+	for period := 0; period < recurrenceMaxIterations; period++ {
+		for _, start := range candidates(period) {
+			if start.Before(first) {
+				continue
+			}
+			if !endBound.IsZero() && start.After(endBound) {
+				return result, nil
+			}
+			if rule.Count > 0 && generated >= rule.Count {
+				return result, nil
+			}
+			generated++
+			// COUNT 计入例外日期，与 RRULE/EXDATE 的语义保持一致。
+			if _, skip := exdates[FormatDate(start)]; skip {
+				continue
+			}
+			if limit > 0 && len(result) >= limit {
+				return nil, fmt.Errorf("重复场次超过上限 %d", limit)
+			}
+			result = append(result, start)
+		}
+	}
+	return result, nil
+}
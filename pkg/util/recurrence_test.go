@@ -0,0 +1,69 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestExpandRecurrenceWeekly(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY;INTERVAL=1;BYDAY=MO,WE;UNTIL=20260318")
+	if err != nil {
+		t.Fatalf("ParseRecurrenceRule() error = %v", err)
+	}
+
+	// 2026-03-04 为周三
+	first := time.Date(2026, 3, 4, 9, 0, 0, 0, time.Local)
+	exdates := map[string]struct{}{"2026-03-11": {}}
+	got, err := ExpandRecurrence(rule, first, time.Time{}, exdates, 100)
+	if err != nil {
+		t.Fatalf("ExpandRecurrence() error = %v", err)
+	}
+
+	want := []string{
+		"2026-03-04 09:00:00",
+		"2026-03-09 09:00:00",
+		"2026-03-16 09:00:00",
+		"2026-03-18 09:00:00",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("ExpandRecurrence() len = %d, want %d (%v)", len(got), len(want), got)
+	}
+	for i := range want {
+		if FormatDateTime(got[i]) != want[i] {
+			t.Fatalf("ExpandRecurrence()[%d] = %s, want %s", i, FormatDateTime(got[i]), want[i])
+		}
+	}
+}
+
+func TestExpandRecurrenceMonthlySkipsMissingDays(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=MONTHLY;BYMONTHDAY=31;COUNT=3")
+	if err != nil {
+		t.Fatalf("ParseRecurrenceRule() error = %v", err)
+	}
+
+	first := time.Date(2026, 1, 31, 8, 30, 0, 0, time.Local)
+	got, err := ExpandRecurrence(rule, first, time.Time{}, nil, 100)
+	if err != nil {
+		t.Fatalf("ExpandRecurrence() error = %v", err)
+	}
+
+	want := []string{"2026-01-31", "2026-03-31", "2026-05-31"}
+	if len(got) != len(want) {
+		t.Fatalf("ExpandRecurrence() len = %d, want %d (%v)", len(got), len(want), got)
+	}
+	for i := range want {
+		if FormatDate(got[i]) != want[i] {
+			t.Fatalf("ExpandRecurrence()[%d] = %s, want %s", i, FormatDate(got[i]), want[i])
+		}
+	}
+}
+
+func TestExpandRecurrenceRequiresEnd(t *testing.T) {
+	rule, err := ParseRecurrenceRule("FREQ=WEEKLY")
+	if err != nil {
+		t.Fatalf("ParseRecurrenceRule() error = %v", err)
+	}
+	if _, err := ExpandRecurrence(rule, time.Now(), time.Time{}, nil, 10); err == nil {
+		t.Fatal("ExpandRecurrence() expected error without UNTIL/COUNT")
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"io"
+	"strings"
 )
 
 // SensitiveField 敏感字段加密/解密/哈希工具
@@ -219,3 +220,17 @@ func GetMobileMask(mobile string) string {
 	}
 	return mobile[:3] + "****" + mobile[7:]
 }
+
+// GetNameMask 姓名脱敏，保留首尾字，其余替换为 *
+// 例如: 张三 -> 张*，欧阳娜娜 -> 欧**娜
+func GetNameMask(name string) string {
+	runes := []rune(strings.TrimSpace(name))
+	switch len(runes) {
+	case 0, 1:
+		return string(runes)
+	case 2:
+		return string(runes[0]) + "*"
+	default:
+		return string(runes[0]) + strings.Repeat("*", len(runes)-2) + string(runes[len(runes)-1])
+	}
+}
@@ -0,0 +1,49 @@
+package util
+
+import "strings"
+
+// NormalizeSkills 去除空白与重复项，保持原有顺序。
+func NormalizeSkills(skills []string) []string {
+	result := make([]string, 0, len(skills))
+	seen := make(map[string]struct{}, len(skills))
+	for _, skill := range skills {
+		skill = strings.TrimSpace(skill)
+		if skill == "" {
+			continue
+		}
+		if _, ok := seen[skill]; ok {
+			continue
+		}
+		seen[skill] = struct{}{}
+		result = append(result, skill)
+	}
+	return result
+}
+
+// SplitSkills 将逗号分隔的技能字段拆分为列表。
+func SplitSkills(skills string) []string {
+	if strings.TrimSpace(skills) == "" {
+		return []string{}
+	}
+	return NormalizeSkills(strings.Split(skills, ","))
+}
+
+// JoinSkills 将技能列表规范化后以逗号拼接，用于落库。
+func JoinSkills(skills []string) string {
+	return strings.Join(NormalizeSkills(skills), ",")
+}
+
+// MissingSkills 返回 required 中 owned 未具备的技能。
+func MissingSkills(required, owned []string) []string {
+	ownedSet := make(map[string]struct{}, len(owned))
+	for _, skill := range owned {
+		ownedSet[skill] = struct{}{}
+	}
+	missing := make([]string, 0)
+	for _, skill := range required {
+		if _, ok := ownedSet[skill]; !ok {
+			missing = append(missing, skill)
+		}
+	}
+	return missing
+}
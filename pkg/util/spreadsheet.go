@@ -0,0 +1,251 @@
+package util
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+// 支持导入的表格格式
+const (
+	SpreadsheetFormatCSV  = ".csv"
+	SpreadsheetFormatXLSX = ".xlsx"
+)
+
+// ReadSpreadsheet 按文件扩展名读取 CSV/XLSX 表格（XLSX 只读取第一个工作表），返回按行排列的单元格文本。
+// 超过 maxRows 行（含表头）时返回错误，maxRows <= 0 表示不限制。
+func ReadSpreadsheet(fileName string, data []byte, maxRows int) ([][]string, error) {
+	var rows [][]string
+	var err error
+	switch strings.ToLower(filepath.Ext(fileName)) {
+	case SpreadsheetFormatCSV:
+		rows, err = readCSV(data)
+	case SpreadsheetFormatXLSX:
+		rows, err = readXLSX(data)
+	default:
+		return nil, errors.New("文件格式仅支持 csv 或 xlsx")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// 去掉末尾的空行
+	for len(rows) > 0 && isBlankRow(rows[len(rows)-1]) {
+		rows = rows[:len(rows)-1]
+	}
+	if maxRows > 0 && len(rows) > maxRows {
+		return nil, fmt.Errorf("文件行数不能超过%d行", maxRows)
+	}
+	return rows, nil
+}
+
+func isBlankRow(row []string) bool {
+	for _, cell := range row {
+		if strings.TrimSpace(cell) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// readCSV 读取 CSV，兼容 UTF-8 BOM 与 Excel 默认导出的 GB18030/GBK 编码
+func readCSV(data []byte) ([][]string, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	if !utf8.Valid(data) {
+		decoded, err := simplifiedchinese.GB18030.NewDecoder().Bytes(data)
+		if err != nil {
+			return nil, errors.New("CSV 文件编码无法识别，请使用 UTF-8 编码")
+		}
+		data = decoded
+	}
+
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.LazyQuotes = true
+	rows, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("CSV 文件解析失败: %w", err)
+	}
+	return rows, nil
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxRichText struct {
+	T string `xml:"t"`
+	R []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxRichText) text() string {
+	if len(t.R) == 0 {
+		return t.T
+	}
+	var sb strings.Builder
+	sb.WriteString(t.T)
+	for _, r := range t.R {
+		sb.WriteString(r.T)
+	}
+	return sb.String()
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxRichText `xml:"si"`
+}
+
+type xlsxSheet struct {
+	Rows []struct {
+		R     int `xml:"r,attr"`
+		Cells []struct {
+			R  string       `xml:"r,attr"`
+			T  string       `xml:"t,attr"`
+			V  string       `xml:"v"`
+			Is xlsxRichText `xml:"is"`
+		} `xml:"c"`
+	} `xml:"sheetData>row"`
+}
+
+// readXLSX 读取 XLSX 第一个工作表的单元格文本（日期等数字格式按原始值返回）
+func readXLSX(data []byte) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, errors.New("XLSX 文件解析失败")
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	var workbook xlsxWorkbook
+	if err := decodeXLSXPart(files, "xl/workbook.xml", &workbook); err != nil || len(workbook.Sheets) == 0 {
+		return nil, errors.New("XLSX 文件缺少工作表")
+	}
+	var rels xlsxRelationships
+	if err := decodeXLSXPart(files, "xl/_rels/workbook.xml.rels", &rels); err != nil {
+		return nil, errors.New("XLSX 文件解析失败")
+	}
+	sheetPath := ""
+	for _, rel := range rels.Relationships {
+		if rel.ID == workbook.Sheets[0].RID {
+			sheetPath = rel.Target
+			break
+		}
+	}
+	if sheetPath == "" {
+		return nil, errors.New("XLSX 文件缺少工作表")
+	}
+	if strings.HasPrefix(sheetPath, "/") {
+		sheetPath = strings.TrimPrefix(sheetPath, "/")
+	} else {
+		sheetPath = path.Join("xl", sheetPath)
+	}
+
+	var shared xlsxSharedStrings
+	if _, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodeXLSXPart(files, "xl/sharedStrings.xml", &shared); err != nil {
+			return nil, errors.New("XLSX 文件解析失败")
+		}
+	}
+
+	var sheet xlsxSheet
+	if err := decodeXLSXPart(files, sheetPath, &sheet); err != nil {
+		return nil, errors.New("XLSX 文件解析失败")
+	}
+
+	var rows [][]string
+	for _, row := range sheet.Rows {
+		// 空行在 XML 中省略，按行号补齐；行号缺省时顺序追加
+		rowIndex := row.R - 1
+		if row.R <= 0 {
+			rowIndex = len(rows)
+		}
+		for len(rows) <= rowIndex {
+			rows = append(rows, nil)
+		}
+
+		var cells []string
+		for j, cell := range row.Cells {
+			col := j
+			if cell.R != "" {
+				if idx, ok := xlsxColumnIndex(cell.R); ok {
+					col = idx
+				}
+			}
+			for len(cells) <= col {
+				cells = append(cells, "")
+			}
+			switch cell.T {
+			case "s":
+				idx, err := strconv.Atoi(strings.TrimSpace(cell.V))
+				if err == nil && idx >= 0 && idx < len(shared.Items) {
+					cells[col] = shared.Items[idx].text()
+				}
+			case "inlineStr":
+				cells[col] = cell.Is.text()
+			case "b":
+				if cell.V == "1" {
+					cells[col] = "TRUE"
+				} else {
+					cells[col] = "FALSE"
+				}
+			default:
+				cells[col] = cell.V
+			}
+		}
+		rows[rowIndex] = cells
+	}
+	return rows, nil
+}
+
+func decodeXLSXPart(files map[string]*zip.File, name string, v any) error {
+	f, ok := files[name]
+	if !ok {
+		return fmt.Errorf("missing %s", name)
+	}
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	return xml.NewDecoder(io.LimitReader(rc, 64<<20)).Decode(v)
+}
+
+// xlsxColumnIndex 将单元格引用（如 "AB12"）的列字母转换为从 0 开始的列序号
+func xlsxColumnIndex(ref string) (int, bool) {
+	col := 0
+	n := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		col = col*26 + int(r-'A'+1)
+		n++
+	}
+	if n == 0 {
+		return 0, false
+	}
+	return col - 1, true
+}
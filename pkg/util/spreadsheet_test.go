@@ -0,0 +1,90 @@
+package util
+
+import (
+	"archive/zip"
+	"bytes"
+	"reflect"
+	"testing"
+
+	"golang.org/x/text/encoding/simplifiedchinese"
+)
+
+func TestReadSpreadsheetCSV(t *testing.T) {
+	data := []byte("\xef\xbb\xbf姓名,手机号\n张三,13800000000\n\n,\n")
+	rows, err := ReadSpreadsheet("volunteers.CSV", data, 0)
+	if err != nil {
+		t.Fatalf("ReadSpreadsheet() error = %v", err)
+	}
+	want := [][]string{{"姓名", "手机号"}, {"张三", "13800000000"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("ReadSpreadsheet() = %v, want %v", rows, want)
+	}
+}
+
+func TestReadSpreadsheetCSVGB18030(t *testing.T) {
+	data, err := simplifiedchinese.GB18030.NewEncoder().Bytes([]byte("姓名,性别\n李四,女\n"))
+	if err != nil {
+		t.Fatalf("encode error = %v", err)
+	}
+	rows, err := ReadSpreadsheet("volunteers.csv", data, 0)
+	if err != nil {
+		t.Fatalf("ReadSpreadsheet() error = %v", err)
+	}
+	if len(rows) != 2 || rows[1][0] != "李四" || rows[1][1] != "女" {
+		t.Fatalf("ReadSpreadsheet() = %v", rows)
+	}
+}
+
+func TestReadSpreadsheetXLSX(t *testing.T) {
+	files := map[string]string{
+		"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships">
+<sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+		"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">
+<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`,
+		"xl/sharedStrings.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<sst xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><si><t>姓名</t></si><si><t>年龄</t></si><si><r><t>王</t></r><r><t>五</t></r></si></sst>`,
+		"xl/worksheets/sheet1.xml": `<?xml version="1.0" encoding="UTF-8"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>
+<row r="1"><c r="A1" t="s"><v>0</v></c><c r="C1" t="s"><v>1</v></c></row>
+<row r="3"><c r="A3" t="s"><v>2</v></c><c r="B3" t="inlineStr"><is><t>x</t></is></c><c r="C3"><v>30</v></c></row>
+</sheetData></worksheet>`,
+	}
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+	for name, content := range files {
+		w, err := zw.Create(name)
+		if err != nil {
+			t.Fatalf("zip create error = %v", err)
+		}
+		if _, err := w.Write([]byte(content)); err != nil {
+			t.Fatalf("zip write error = %v", err)
+		}
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatalf("zip close error = %v", err)
+	}
+
+	rows, err := ReadSpreadsheet("volunteers.xlsx", buf.Bytes(), 0)
+	if err != nil {
+		t.Fatalf("ReadSpreadsheet() error = %v", err)
+	}
+	want := [][]string{{"姓名", "", "年龄"}, nil, {"王五", "x", "30"}}
+	if !reflect.DeepEqual(rows, want) {
+		t.Fatalf("ReadSpreadsheet() = %#v, want %#v", rows, want)
+	}
+
+	if _, err := ReadSpreadsheet("volunteers.xlsx", buf.Bytes(), 2); err == nil {
+		t.Fatal("ReadSpreadsheet() with maxRows exceeded error = nil")
+	}
+}
+
+func TestReadSpreadsheetUnsupported(t *testing.T) {
+	if _, err := ReadSpreadsheet("volunteers.xls", []byte("x"), 0); err == nil {
+		t.Fatal("ReadSpreadsheet(.xls) error = nil")
+	}
+	if _, err := ReadSpreadsheet("volunteers.xlsx", []byte("not a zip"), 0); err == nil {
+		t.Fatal("ReadSpreadsheet(invalid xlsx) error = nil")
+	}
+}
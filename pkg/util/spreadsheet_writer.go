@@ -0,0 +1,175 @@
+package util
+
+import (
+	"archive/zip"
+	"bufio"
+	"encoding/csv"
+	"encoding/xml"
+	"errors"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SpreadsheetWriter 逐行写出 CSV/XLSX 表格，已写出的行不在内存中保留，适合大数据量导出
+type SpreadsheetWriter interface {
+	// WriteRow 写入一行单元格文本
+	WriteRow(cells []string) error
+	// Close 写出剩余内容；不关闭底层 io.Writer
+	Close() error
+}
+
+// NewSpreadsheetWriter 按格式（SpreadsheetFormatCSV/SpreadsheetFormatXLSX）创建表格写入器。
+// CSV 带 UTF-8 BOM 以便 Excel 直接打开；XLSX 只包含一个工作表，单元格均为文本。
+func NewSpreadsheetWriter(out io.Writer, format string) (SpreadsheetWriter, error) {
+	switch format {
+	case SpreadsheetFormatCSV:
+		return newCSVSheetWriter(out)
+	case SpreadsheetFormatXLSX:
+		return newXLSXSheetWriter(out)
+	default:
+		return nil, errors.New("文件格式仅支持 csv 或 xlsx")
+	}
+}
+
+// csvFlushRows CSV 每写入若干行刷新一次缓冲
+const csvFlushRows = 500
+
+type csvSheetWriter struct {
+	w    *csv.Writer
+	rows int
+}
+
+func newCSVSheetWriter(out io.Writer) (*csvSheetWriter, error) {
+	if _, err := io.WriteString(out, "\xef\xbb\xbf"); err != nil {
+		return nil, err
+	}
+	return &csvSheetWriter{w: csv.NewWriter(out)}, nil
+}
+
+func (w *csvSheetWriter) WriteRow(cells []string) error {
+	record := make([]string, len(cells))
+	for i, cell := range cells {
+		record[i] = escapeCSVFormula(cell)
+	}
+	if err := w.w.Write(record); err != nil {
+		return err
+	}
+	w.rows++
+	if w.rows%csvFlushRows == 0 {
+		w.w.Flush()
+		return w.w.Error()
+	}
+	return nil
+}
+
+func (w *csvSheetWriter) Close() error {
+	w.w.Flush()
+	return w.w.Error()
+}
+
+// escapeCSVFormula 以 = + - @ 等开头的文本在 Excel 中会被当作公式执行，前面加单引号转为纯文本；数字（如负数）保持不变
+func escapeCSVFormula(cell string) string {
+	if cell == "" || !strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return cell
+	}
+	if _, err := strconv.ParseFloat(cell, 64); err == nil {
+		return cell
+	}
+	return "'" + cell
+}
+
+const (
+	xlsxContentTypes = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`
+	xlsxRootRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`
+	xlsxWorkbookXML = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`
+	xlsxWorkbookRels = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`
+	xlsxSheetHeader = `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`
+	xlsxSheetFooter = `</sheetData></worksheet>`
+)
+
+// xlsxSheetWriter 先写出工作簿的固定部分，工作表作为最后一个 zip 条目逐行写入，
+// 单元格使用内联字符串，无需在内存中维护共享字符串表
+type xlsxSheetWriter struct {
+	zw    *zip.Writer
+	sheet *bufio.Writer
+	rows  int
+}
+
+func newXLSXSheetWriter(out io.Writer) (*xlsxSheetWriter, error) {
+	zw := zip.NewWriter(out)
+	now := time.Now()
+	create := func(name string) (io.Writer, error) {
+		return zw.CreateHeader(&zip.FileHeader{Name: name, Method: zip.Deflate, Modified: now})
+	}
+	for _, part := range []struct{ name, content string }{
+		{"[Content_Types].xml", xlsxContentTypes},
+		{"_rels/.rels", xlsxRootRels},
+		{"xl/workbook.xml", xlsxWorkbookXML},
+		{"xl/_rels/workbook.xml.rels", xlsxWorkbookRels},
+	} {
+		w, err := create(part.name)
+		if err != nil {
+			return nil, err
+		}
+		if _, err := io.WriteString(w, part.content); err != nil {
+			return nil, err
+		}
+	}
+
+	w, err := create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return nil, err
+	}
+	sheet := bufio.NewWriter(w)
+	if _, err := sheet.WriteString(xlsxSheetHeader); err != nil {
+		return nil, err
+	}
+	return &xlsxSheetWriter{zw: zw, sheet: sheet}, nil
+}
+
+func (w *xlsxSheetWriter) WriteRow(cells []string) error {
+	w.rows++
+	rowNo := strconv.Itoa(w.rows)
+	w.sheet.WriteString(`<row r="` + rowNo + `">`)
+	for i, cell := range cells {
+		if cell == "" {
+			continue
+		}
+		w.sheet.WriteString(`<c r="` + xlsxColumnName(i) + rowNo + `" t="inlineStr"><is><t xml:space="preserve">`)
+		// EscapeText 会将 XML 不允许的控制字符替换为 U+FFFD
+		if err := xml.EscapeText(w.sheet, []byte(cell)); err != nil {
+			return err
+		}
+		w.sheet.WriteString(`</t></is></c>`)
+	}
+	_, err := w.sheet.WriteString(`</row>`)
+	return err
+}
+
+func (w *xlsxSheetWriter) Close() error {
+	if _, err := w.sheet.WriteString(xlsxSheetFooter); err != nil {
+		return err
+	}
+	if err := w.sheet.Flush(); err != nil {
+		return err
+	}
+	return w.zw.Close()
+}
+
+// xlsxColumnName 将从 0 开始的列序号转换为列字母（如 27 -> "AB"），与 xlsxColumnIndex 互逆
+func xlsxColumnName(index int) string {
+	var buf [8]byte
+	i := len(buf)
+	for n := index + 1; n > 0; n = (n - 1) / 26 {
+		i--
+		buf[i] = byte('A' + (n-1)%26)
+	}
+	return string(buf[i:])
+}
@@ -0,0 +1,72 @@
+package util
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func TestSpreadsheetWriterRoundTrip(t *testing.T) {
+	rows := [][]string{
+		{"姓名", "手机号", "备注"},
+		{"张三", "138****0000", "a<b & \"c\"\n换行"},
+		{"李四", "", "-1.5"},
+	}
+	for _, format := range []string{SpreadsheetFormatCSV, SpreadsheetFormatXLSX} {
+		var buf bytes.Buffer
+		w, err := NewSpreadsheetWriter(&buf, format)
+		if err != nil {
+			t.Fatalf("NewSpreadsheetWriter(%s) error = %v", format, err)
+		}
+		for _, row := range rows {
+			if err := w.WriteRow(row); err != nil {
+				t.Fatalf("WriteRow(%s) error = %v", format, err)
+			}
+		}
+		if err := w.Close(); err != nil {
+			t.Fatalf("Close(%s) error = %v", format, err)
+		}
+
+		got, err := ReadSpreadsheet("export"+format, buf.Bytes(), 0)
+		if err != nil {
+			t.Fatalf("ReadSpreadsheet(%s) error = %v", format, err)
+		}
+		if !reflect.DeepEqual(got, rows) {
+			t.Fatalf("round trip(%s) = %q, want %q", format, got, rows)
+		}
+	}
+}
+
+func TestSpreadsheetWriterCSVFormula(t *testing.T) {
+	var buf bytes.Buffer
+	w, _ := NewSpreadsheetWriter(&buf, SpreadsheetFormatCSV)
+	_ = w.WriteRow([]string{"=SUM(A1)", "@cmd", "+10", "-2", "正常"})
+	_ = w.Close()
+
+	got, err := ReadSpreadsheet("export.csv", buf.Bytes(), 0)
+	if err != nil {
+		t.Fatalf("ReadSpreadsheet() error = %v", err)
+	}
+	want := [][]string{{"'=SUM(A1)", "'@cmd", "+10", "-2", "正常"}}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("formula escape = %q, want %q", got, want)
+	}
+}
+
+func TestXLSXColumnName(t *testing.T) {
+	for _, index := range []int{0, 25, 26, 27, 701, 702} {
+		name := xlsxColumnName(index)
+		if got, ok := xlsxColumnIndex(name); !ok || got != index {
+			t.Fatalf("xlsxColumnIndex(xlsxColumnName(%d)=%q) = %d", index, name, got)
+		}
+	}
+	if xlsxColumnName(27) != "AB" {
+		t.Fatalf("xlsxColumnName(27) = %q, want AB", xlsxColumnName(27))
+	}
+}
+
+func TestNewSpreadsheetWriterUnsupported(t *testing.T) {
+	if _, err := NewSpreadsheetWriter(&bytes.Buffer{}, ".xls"); err == nil {
+		t.Fatal("NewSpreadsheetWriter(.xls) error = nil")
+	}
+}
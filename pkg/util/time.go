@@ -39,6 +39,11 @@ func ParsePastDate(dateStr string) (time.Time, error) {
 	return t, nil
 }
 
+// TruncateDate 截断到当天零点（保留原时区）
+func TruncateDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
 // FormatDate 格式化时间为字符串 YYYY-MM-DD
 func FormatDate(t time.Time) string {
 	return t.Format(DateLayout)
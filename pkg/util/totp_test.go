@@ -0,0 +1,48 @@
+package util
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+)
+
+func TestTOTPCodeRFC6238(t *testing.T) {
+	// RFC 6238 附录 B 的 SHA1 测试向量（取低 6 位）
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+	}
+	for unix, want := range cases {
+		got, err := TOTPCode(secret, time.Unix(unix, 0), 30)
+		if err != nil {
+			t.Fatalf("TOTPCode() error = %v", err)
+		}
+		if got != want {
+			t.Fatalf("TOTPCode(%d) = %s, want %s", unix, got, want)
+		}
+	}
+}
+
+func TestValidateTOTPCodeDrift(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatalf("GenerateTOTPSecret() error = %v", err)
+	}
+	now := time.Unix(1700000000, 0)
+	previous, err := TOTPCode(secret, now.Add(-30*time.Second), 30)
+	if err != nil {
+		t.Fatalf("TOTPCode() error = %v", err)
+	}
+	if !ValidateTOTPCode(secret, previous, now, 30, 1) {
+		t.Fatal("ValidateTOTPCode() expected previous period code to pass with skew=1")
+	}
+	stale, err := TOTPCode(secret, now.Add(-90*time.Second), 30)
+	if err != nil {
+		t.Fatalf("TOTPCode() error = %v", err)
+	}
+	if stale != previous && ValidateTOTPCode(secret, stale, now, 30, 1) {
+		t.Fatal("ValidateTOTPCode() expected code outside drift window to fail")
+	}
+}
@@ -32,6 +32,17 @@ func CalcGrantedHours(duration float64, checkIn, checkOut time.Time) float64 {
 	return RoundHours(hours)
 }
 
+// CalcGrantedHoursInWindow calculates granted hours with check-in/out clamped to the shift window.
+func CalcGrantedHoursInWindow(windowStart, windowEnd, checkIn, checkOut time.Time) float64 {
+	if checkIn.Before(windowStart) {
+		checkIn = windowStart
+	}
+	if checkOut.After(windowEnd) {
+		checkOut = windowEnd
+	}
+	return CalcGrantedHours(0, checkIn, checkOut)
+}
+
 // IsDuplicateEntryErr reports whether err is a MySQL duplicate-key error.
 func IsDuplicateEntryErr(err error) bool {
 	if err == nil {
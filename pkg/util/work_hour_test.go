@@ -0,0 +1,27 @@
+package util
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalcGrantedHoursInWindow(t *testing.T) {
+	windowStart := time.Date(2026, 3, 7, 9, 0, 0, 0, time.Local)
+	windowEnd := time.Date(2026, 3, 7, 12, 0, 0, 0, time.Local)
+
+	cases := []struct {
+		name     string
+		checkIn  time.Time
+		checkOut time.Time
+		want     float64
+	}{
+		{"inside", windowStart.Add(30 * time.Minute), windowEnd.Add(-30 * time.Minute), 2},
+		{"early and late", windowStart.Add(-time.Hour), windowEnd.Add(2 * time.Hour), 3},
+		{"after window", windowEnd.Add(time.Hour), windowEnd.Add(2 * time.Hour), 0},
+	}
+	for _, tc := range cases {
+		if got := CalcGrantedHoursInWindow(windowStart, windowEnd, tc.checkIn, tc.checkOut); got != tc.want {
+			t.Fatalf("%s: CalcGrantedHoursInWindow() = %v, want %v", tc.name, got, tc.want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+-- ============================================
+-- DDL Version: v1.2.0
+-- Description: activity waitlist with FIFO auto-promotion
+-- Created: 2026-02-16
+-- ============================================
+
+CREATE TABLE IF NOT EXISTS `activity_waitlists` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID（同时作为排队先后顺序）',
+    `activity_id` BIGINT NOT NULL COMMENT '活动ID（关联 activities.id）',
+    `volunteer_id` BIGINT NOT NULL COMMENT '志愿者ID（关联 volunteers.id）',
+    `account_id` BIGINT NOT NULL DEFAULT 0 COMMENT '排队人账号ID（递补时作为报名审核提交人）',
+    `status` TINYINT NOT NULL DEFAULT 1 COMMENT '候补状态：1-排队中，2-已递补，3-已退出',
+    `audit_record_id` BIGINT NOT NULL DEFAULT 0 COMMENT '递补时生成的报名审核记录ID（关联 audit_records.id）',
+    `promoted_at` DATETIME NULL COMMENT '递补时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间（入队时间）',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_waitlist_activity_status` (`activity_id`, `status`, `id`),
+    KEY `idx_waitlist_volunteer` (`volunteer_id`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='活动候补队列表';
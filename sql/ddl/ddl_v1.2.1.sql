@@ -0,0 +1,33 @@
+-- ============================================
+-- DDL Version: v1.2.1
+-- Description: recurring activity series (occurrences materialized as activities rows)
+-- Created: 2026-02-18
+-- ============================================
+
+-- 1) 活动系列表：保存重复规则与场次模板。
+CREATE TABLE IF NOT EXISTS `activity_series` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `org_id` BIGINT NOT NULL COMMENT '发布组织ID（关联 organizations.id）',
+    `title` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '系列标题（场次默认标题）',
+    `description` TEXT NOT NULL COMMENT '系列描述（场次默认描述）',
+    `cover_url` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '封面图URL',
+    `location` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '地点名称',
+    `address` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '详细地址',
+    `duration` DOUBLE NOT NULL DEFAULT 0 COMMENT '每场预估工时(小时)',
+    `max_people` INT NOT NULL DEFAULT 0 COMMENT '每场最大招募人数 (0表示不限)',
+    `rrule` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '重复规则（RRULE 子集：FREQ=WEEKLY|MONTHLY;INTERVAL;BYDAY;BYMONTHDAY;COUNT;UNTIL）',
+    `first_start_time` DATETIME NOT NULL COMMENT '首场开始时间',
+    `first_end_time` DATETIME NOT NULL COMMENT '首场结束时间',
+    `until_date` DATE NULL COMMENT '系列截止日期（含当天）',
+    `exdates` TEXT NOT NULL COMMENT '例外日期（YYYY-MM-DD，英文逗号分隔）',
+    `status` TINYINT NOT NULL DEFAULT 1 COMMENT '系列状态：1-正常，2-已取消',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_series_org` (`org_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='活动系列表';
+
+-- 2) 活动表关联系列。
+ALTER TABLE `activities`
+    ADD COLUMN `series_id` BIGINT NOT NULL DEFAULT 0 COMMENT '所属活动系列ID（0表示单次活动）' AFTER `org_id`,
+    ADD INDEX `idx_activity_series_start` (`series_id`, `start_time`);
@@ -0,0 +1,33 @@
+-- ============================================
+-- DDL Version: v1.2.2
+-- Description: activity shifts/role slots, slot-bound signups and volunteer skills
+-- Created: 2026-02-20
+-- ============================================
+
+-- 1) 活动班次/岗位表：同一活动下可拆分多个时间窗口与岗位，各自独立限额。
+CREATE TABLE IF NOT EXISTS `activity_slots` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `activity_id` BIGINT NOT NULL COMMENT '活动ID（关联 activities.id）',
+    `name` VARCHAR(100) NOT NULL DEFAULT '' COMMENT '班次/岗位名称，如：上午班-摄影',
+    `role_name` VARCHAR(100) NOT NULL DEFAULT '' COMMENT '岗位角色，如：组长、摄影、分拣',
+    `start_time` DATETIME NOT NULL COMMENT '班次开始时间',
+    `end_time` DATETIME NOT NULL COMMENT '班次结束时间',
+    `max_people` INT NOT NULL DEFAULT 0 COMMENT '班次最大人数 (0表示不限)',
+    `current_people` INT NOT NULL DEFAULT 0 COMMENT '班次当前已报名人数(冗余字段)',
+    `required_skills` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '所需技能（英文逗号分隔）',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_slot_activity` (`activity_id`, `start_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='活动班次岗位表';
+
+-- 2) 报名记录与候补记录关联班次（0 表示不区分班次）。
+ALTER TABLE `activity_signups`
+    ADD COLUMN `slot_id` BIGINT NOT NULL DEFAULT 0 COMMENT '班次岗位ID（关联 activity_slots.id，0表示不区分班次）' AFTER `volunteer_id`;
+
+ALTER TABLE `activity_waitlists`
+    ADD COLUMN `slot_id` BIGINT NOT NULL DEFAULT 0 COMMENT '意向班次岗位ID（关联 activity_slots.id）' AFTER `volunteer_id`;
+
+-- 3) 志愿者技能标签，用于匹配岗位技能要求。
+ALTER TABLE `volunteers`
+    ADD COLUMN `skills` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '技能标签（英文逗号分隔）' AFTER `introduction`;
@@ -0,0 +1,13 @@
+-- ============================================
+-- DDL Version: v1.2.3
+-- Description: rotating (TOTP) attendance code mode; codes no longer persisted in plain text
+-- Created: 2026-02-21
+-- ============================================
+
+-- 动态码模式下仅保存加密后的密钥，签到码按轮换周期由密钥实时计算；
+-- 静态码保存哈希（用于校验）与加密后的码（供组织端展示），不再写入 check_in_code/check_out_code 明文列。
+ALTER TABLE `activities`
+    ADD COLUMN `attendance_code_mode` TINYINT NOT NULL DEFAULT 1 COMMENT '签到签退码模式: 1-静态码, 2-动态轮换码' AFTER `attendance_code_updated_at`,
+    ADD COLUMN `attendance_code_period` INT NOT NULL DEFAULT 0 COMMENT '动态码轮换周期（秒），静态码为0' AFTER `attendance_code_mode`,
+    ADD COLUMN `check_in_code_secret` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '静态签到码或动态签到码密钥（加密存储）' AFTER `attendance_code_period`,
+    ADD COLUMN `check_out_code_secret` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '静态签退码或动态签退码密钥（加密存储）' AFTER `check_in_code_secret`;
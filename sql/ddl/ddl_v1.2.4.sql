@@ -0,0 +1,23 @@
+-- ============================================
+-- DDL Version: v1.2.4
+-- Description: activity geofence and captured check-in/check-out positions
+-- Created: 2026-02-22
+-- ============================================
+
+-- 1) 活动坐标与电子围栏（半径为0表示不启用围栏）。
+ALTER TABLE `activities`
+    ADD COLUMN `latitude` DECIMAL(10,7) NOT NULL DEFAULT 0 COMMENT '活动地点纬度' AFTER `address`,
+    ADD COLUMN `longitude` DECIMAL(10,7) NOT NULL DEFAULT 0 COMMENT '活动地点经度' AFTER `latitude`,
+    ADD COLUMN `geofence_radius` INT NOT NULL DEFAULT 0 COMMENT '签到围栏半径（米，0表示不启用）' AFTER `longitude`,
+    ADD COLUMN `geofence_mode` TINYINT NOT NULL DEFAULT 1 COMMENT '围栏外处理方式: 1-拒绝签到, 2-允许并标记' AFTER `geofence_radius`;
+
+-- 2) 报名记录保存签到/签退时采集的位置，供补录争议时复核。
+ALTER TABLE `activity_signups`
+    ADD COLUMN `check_in_latitude` DECIMAL(10,7) NULL COMMENT '签到位置纬度' AFTER `check_in_time`,
+    ADD COLUMN `check_in_longitude` DECIMAL(10,7) NULL COMMENT '签到位置经度' AFTER `check_in_latitude`,
+    ADD COLUMN `check_in_distance` INT NULL COMMENT '签到位置距活动地点距离（米）' AFTER `check_in_longitude`,
+    ADD COLUMN `check_in_out_of_fence` TINYINT NOT NULL DEFAULT 0 COMMENT '签到是否在围栏外: 0-否, 1-是' AFTER `check_in_distance`,
+    ADD COLUMN `check_out_latitude` DECIMAL(10,7) NULL COMMENT '签退位置纬度' AFTER `check_out_time`,
+    ADD COLUMN `check_out_longitude` DECIMAL(10,7) NULL COMMENT '签退位置经度' AFTER `check_out_latitude`,
+    ADD COLUMN `check_out_distance` INT NULL COMMENT '签退位置距活动地点距离（米）' AFTER `check_out_longitude`,
+    ADD COLUMN `check_out_out_of_fence` TINYINT NOT NULL DEFAULT 0 COMMENT '签退是否在围栏外: 0-否, 1-是' AFTER `check_out_distance`;
@@ -0,0 +1,36 @@
+-- ============================================
+-- DDL Version: v1.2.5
+-- Description: no-show detection and credit score ledger
+-- Created: 2026-02-23
+-- ============================================
+
+-- 1) 报名记录增加出勤结果，活动结束后据此判定爽约并结算信用分。
+ALTER TABLE `activity_signups`
+    ADD COLUMN `attendance_result` TINYINT NOT NULL DEFAULT 0 COMMENT '出勤结果：0-未判定，1-已出勤，2-爽约' AFTER `granted_at`,
+    ADD INDEX `idx_signup_attendance_result` (`activity_id`, `attendance_result`);
+
+-- 2) 志愿者连续出勤次数（爽约清零），用于连续出勤奖励。
+ALTER TABLE `volunteers`
+    ADD COLUMN `attendance_streak` INT NOT NULL DEFAULT 0 COMMENT '连续出勤次数（爽约清零）' AFTER `credit_score`;
+
+-- 3) 信用分流水表。
+CREATE TABLE IF NOT EXISTS `credit_score_logs` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `volunteer_id` BIGINT NOT NULL COMMENT '志愿者ID（关联 volunteers.id）',
+    `activity_id` BIGINT NOT NULL DEFAULT 0 COMMENT '活动ID（关联 activities.id）',
+    `signup_id` BIGINT NOT NULL DEFAULT 0 COMMENT '报名ID（关联 activity_signups.id）',
+    `change_type` TINYINT NOT NULL DEFAULT 1 COMMENT '变动类型：1-爽约扣分，2-连续出勤奖励，3-爽约撤销返还',
+    `score_delta` INT NOT NULL DEFAULT 0 COMMENT '信用分增量（扣分为负数）',
+    `before_score` INT NOT NULL DEFAULT 0 COMMENT '变更前信用分',
+    `after_score` INT NOT NULL DEFAULT 0 COMMENT '变更后信用分',
+    `idempotency_key` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '幂等键（防重复扣分/奖励）',
+    `ref_log_id` BIGINT NOT NULL DEFAULT 0 COMMENT '关联原流水ID（撤销场景）',
+    `reason` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '变动原因',
+    `operator_id` BIGINT NOT NULL DEFAULT 0 COMMENT '操作人账号ID（系统自动结算为0）',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_credit_idempotency_key` (`idempotency_key`),
+    KEY `idx_csl_volunteer_created` (`volunteer_id`, `created_at`),
+    KEY `idx_csl_activity` (`activity_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='志愿者信用分流水表';
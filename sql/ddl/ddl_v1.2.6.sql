@@ -0,0 +1,26 @@
+-- ============================================
+-- DDL Version: v1.2.6
+-- Description: scheduled activity lifecycle transitions
+-- Created: 2026-02-24
+-- ============================================
+
+-- 1) 活动报名截止标记，由后台调度在开始前截止时间到达后置位。
+ALTER TABLE `activities`
+    ADD COLUMN `signup_closed` TINYINT NOT NULL DEFAULT 0 COMMENT '报名是否已截止: 0-否, 1-是' AFTER `current_people`;
+
+-- 2) 调度任务按状态与时间扫描活动。
+ALTER TABLE `activities`
+    ADD INDEX `idx_activity_status_start` (`status`, `start_time`),
+    ADD INDEX `idx_activity_status_end` (`status`, `end_time`);
+
+-- 3) 自动签退结算扫描已签到未签退的报名。
+ALTER TABLE `activity_signups`
+    ADD INDEX `idx_signup_check_state` (`status`, `check_in_status`, `check_out_status`);
+
+-- 4) 历史已结束活动直接回填出勤结果（不追溯扣分），避免调度补偿结算时追溯处理旧数据。
+UPDATE `activity_signups` s
+    JOIN `activities` a ON a.`id` = s.`activity_id`
+SET s.`attendance_result` = IF(s.`check_in_status` = 1, 1, 2)
+WHERE a.`status` = 2
+  AND s.`status` = 2
+  AND s.`attendance_result` = 0;
@@ -0,0 +1,14 @@
+-- ============================================
+-- DDL Version: v1.2.7
+-- Description: per-activity auto-settlement policy for missing check-outs
+-- Created: 2026-02-25
+-- ============================================
+
+-- 1) 活动级自动结算策略：志愿者签到后未签退时，活动完结时按策略处理。
+ALTER TABLE `activities`
+    ADD COLUMN `auto_settle_policy` TINYINT NOT NULL DEFAULT 2 COMMENT '未签退自动结算策略: 1-按计划时长发放, 2-按结束时间发放, 3-不发放并标记待确认' AFTER `geofence_mode`;
+
+-- 2) 报名记录的结算复核状态，供组织确认被标记的报名。
+ALTER TABLE `activity_signups`
+    ADD COLUMN `settle_review_status` TINYINT NOT NULL DEFAULT 0 COMMENT '结算复核状态: 0-无需复核, 1-待组织确认, 2-已确认发放, 3-已确认不发放' AFTER `attendance_result`,
+    ADD INDEX `idx_signup_settle_review` (`activity_id`, `settle_review_status`);
@@ -0,0 +1,34 @@
+-- ============================================
+-- DDL Version: v1.2.8
+-- Description: volunteer service certificates
+-- Created: 2026-02-26
+-- ============================================
+
+-- 志愿服务证书签发记录（证书内容取签发时快照，下载时按快照重新渲染 PDF）。
+CREATE TABLE IF NOT EXISTS `certificates` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `serial_no` VARCHAR(32) NOT NULL COMMENT '证书编号',
+    `cert_type` TINYINT NOT NULL DEFAULT 1 COMMENT '证书类型: 1-单次活动, 2-时间段累计',
+    `volunteer_id` BIGINT NOT NULL COMMENT '志愿者ID（关联 volunteers.id）',
+    `org_id` BIGINT NOT NULL COMMENT '签发组织ID（关联 organizations.id）',
+    `activity_id` BIGINT NOT NULL DEFAULT 0 COMMENT '活动ID（累计证书为0）',
+    `volunteer_name` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '志愿者姓名（签发快照）',
+    `org_name` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '签发组织名称（签发快照）',
+    `activity_title` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '活动标题（签发快照）',
+    `period_start` DATETIME NOT NULL COMMENT '服务开始时间',
+    `period_end` DATETIME NOT NULL COMMENT '服务结束时间',
+    `total_hours` DECIMAL(10,2) NOT NULL DEFAULT 0.00 COMMENT '证书工时',
+    `service_count` INT NOT NULL DEFAULT 0 COMMENT '服务次数',
+    `work_hour_log_ids` TEXT NOT NULL COMMENT '证书涵盖的工时流水ID（英文逗号分隔）',
+    `signature` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '证书签名（HMAC-SHA256）',
+    `status` TINYINT NOT NULL DEFAULT 1 COMMENT '状态: 1-有效, 2-已撤销',
+    `issuer_id` BIGINT NOT NULL DEFAULT 0 COMMENT '签发操作人账号ID',
+    `issued_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '签发时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_certificate_serial_no` (`serial_no`),
+    KEY `idx_certificate_volunteer` (`volunteer_id`, `issued_at`),
+    KEY `idx_certificate_org` (`org_id`, `issued_at`),
+    KEY `idx_certificate_activity` (`activity_id`, `volunteer_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='志愿服务证书表';
@@ -0,0 +1,10 @@
+-- ============================================
+-- DDL Version: v1.2.9
+-- Description: certificate revocation for public verification
+-- Created: 2026-02-27
+-- ============================================
+
+-- 证书覆盖的工时流水被作废后，证书随之撤销，公开核验接口返回撤销时间与原因。
+ALTER TABLE `certificates`
+    ADD COLUMN `revoked_at` DATETIME NULL DEFAULT NULL COMMENT '撤销时间' AFTER `issued_at`,
+    ADD COLUMN `revoke_reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '撤销原因' AFTER `revoked_at`;
@@ -0,0 +1,48 @@
+-- ============================================
+-- DDL Version: v1.3.0
+-- Description: per-organization certificate templates with versioning
+-- Created: 2026-02-28
+-- ============================================
+
+-- 1) 证书模板（组织维度），每次修改生成新版本，current_version_id 指向当前版本。
+CREATE TABLE IF NOT EXISTS `certificate_templates` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `org_id` BIGINT NOT NULL COMMENT '所属组织ID（关联 organizations.id）',
+    `name` VARCHAR(64) NOT NULL COMMENT '模板名称',
+    `current_version_id` BIGINT NOT NULL DEFAULT 0 COMMENT '当前版本ID（关联 certificate_template_versions.id）',
+    `version` INT NOT NULL DEFAULT 1 COMMENT '当前版本号',
+    `is_default` TINYINT NOT NULL DEFAULT 0 COMMENT '是否组织默认模板: 0-否, 1-是',
+    `status` TINYINT NOT NULL DEFAULT 1 COMMENT '状态: 1-启用, 2-已删除',
+    `created_by` BIGINT NOT NULL DEFAULT 0 COMMENT '创建人账号ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_certificate_template_org` (`org_id`, `status`, `is_default`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='证书模板表';
+
+-- 2) 证书模板版本快照（只增不改），已签发证书固定引用签发时的版本。
+CREATE TABLE IF NOT EXISTS `certificate_template_versions` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `template_id` BIGINT NOT NULL COMMENT '模板ID（关联 certificate_templates.id）',
+    `org_id` BIGINT NOT NULL COMMENT '所属组织ID',
+    `version` INT NOT NULL COMMENT '版本号',
+    `title` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '证书标题',
+    `activity_body` TEXT NOT NULL COMMENT '单次活动证书正文（支持占位符）',
+    `cumulative_body` TEXT NOT NULL COMMENT '累计证书正文（支持占位符）',
+    `signatory` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '落款署名',
+    `show_logo` TINYINT NOT NULL DEFAULT 1 COMMENT '是否展示组织Logo: 0-否, 1-是',
+    `logo_url` VARCHAR(512) NOT NULL DEFAULT '' COMMENT '组织Logo原始地址（版本快照）',
+    `logo_path` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '组织Logo本地存储路径（相对上传目录）',
+    `seal_url` VARCHAR(512) NOT NULL DEFAULT '' COMMENT '印章图片原始地址',
+    `seal_path` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '印章图片本地存储路径（相对上传目录）',
+    `layout` TINYINT NOT NULL DEFAULT 1 COMMENT '版式: 1-A4横版, 2-A4竖版',
+    `theme_color` VARCHAR(7) NOT NULL DEFAULT '#A82020' COMMENT '主题色（#RRGGBB）',
+    `created_by` BIGINT NOT NULL DEFAULT 0 COMMENT '创建人账号ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_certificate_template_version` (`template_id`, `version`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='证书模板版本表';
+
+-- 3) 证书记录签发时使用的模板版本（0 表示系统默认样式）。
+ALTER TABLE `certificates`
+    ADD COLUMN `template_version_id` BIGINT NOT NULL DEFAULT 0 COMMENT '模板版本ID（0-系统默认样式）' AFTER `activity_id`;
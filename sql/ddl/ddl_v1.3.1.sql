@@ -0,0 +1,35 @@
+-- ============================================
+-- DDL Version: v1.3.1
+-- Description: volunteer bulk import batches and row-level failure receipts
+-- Created: 2026-03-01
+-- ============================================
+
+-- 1) 志愿者批量导入批次（组织维度），记录导入结果汇总。
+CREATE TABLE IF NOT EXISTS `volunteer_import_batches` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `org_id` BIGINT NOT NULL COMMENT '导入组织ID（关联 organizations.id）',
+    `operator_id` BIGINT NOT NULL DEFAULT 0 COMMENT '操作人账号ID',
+    `file_name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '导入文件名',
+    `total_rows` INT NOT NULL DEFAULT 0 COMMENT '数据行数（不含表头）',
+    `success_count` INT NOT NULL DEFAULT 0 COMMENT '导入成功行数',
+    `failed_count` INT NOT NULL DEFAULT 0 COMMENT '导入失败行数',
+    `join_org` TINYINT NOT NULL DEFAULT 0 COMMENT '是否同时加入本组织: 0-否, 1-是',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_volunteer_import_batch_org` (`org_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='志愿者导入批次表';
+
+-- 2) 导入失败行明细，用于生成导入回执（手机号仅保存脱敏值）。
+CREATE TABLE IF NOT EXISTS `volunteer_import_failures` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `batch_id` BIGINT NOT NULL COMMENT '导入批次ID（关联 volunteer_import_batches.id）',
+    `row_no` INT NOT NULL COMMENT '文件中的行号（表头为第1行）',
+    `name` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '姓名',
+    `phone` VARCHAR(32) NOT NULL DEFAULT '' COMMENT '手机号（脱敏）',
+    `email` VARCHAR(128) NOT NULL DEFAULT '' COMMENT '邮箱',
+    `reason` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '失败原因',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_volunteer_import_failure_batch` (`batch_id`, `row_no`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='志愿者导入失败明细表';
@@ -0,0 +1,38 @@
+-- ============================================
+-- DDL Version: v1.3.2
+-- Description: asynchronous jobs for long-running imports and exports
+-- Created: 2026-03-02
+-- ============================================
+
+-- 1) 异步任务。任务ID通过 Redis 队列分发给 worker，数据库记录为状态的唯一来源；
+--    Redis 不可用或消息丢失时 worker 按 next_run_at 轮询数据库补偿。
+CREATE TABLE IF NOT EXISTS `jobs` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `job_type` VARCHAR(64) NOT NULL COMMENT '任务类型（如 volunteer_import）',
+    `account_id` BIGINT NOT NULL DEFAULT 0 COMMENT '提交人账号ID',
+    `org_id` BIGINT NOT NULL DEFAULT 0 COMMENT '所属组织ID（非组织任务为0）',
+    `status` TINYINT NOT NULL DEFAULT 1 COMMENT '状态: 1-排队中, 2-执行中, 3-已完成, 4-失败, 5-已取消',
+    `payload` TEXT NOT NULL COMMENT '任务参数（JSON）',
+    `progress_done` INT NOT NULL DEFAULT 0 COMMENT '已处理数量',
+    `progress_total` INT NOT NULL DEFAULT 0 COMMENT '总数量（未知时为0）',
+    `attempts` INT NOT NULL DEFAULT 0 COMMENT '已执行次数',
+    `max_attempts` INT NOT NULL DEFAULT 1 COMMENT '最大执行次数（含首次）',
+    `next_run_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '下次可执行时间（重试退避）',
+    `cancel_requested` TINYINT NOT NULL DEFAULT 0 COMMENT '是否已请求取消: 0-否, 1-是',
+    `result` TEXT NULL COMMENT '执行结果摘要（JSON）',
+    `result_file` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '结果文件路径（相对上传目录）',
+    `result_file_name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '结果文件下载名',
+    `error_message` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '最近一次失败原因',
+    `heartbeat_at` DATETIME NULL COMMENT '执行心跳时间（用于识别中断的任务）',
+    `started_at` DATETIME NULL COMMENT '最近一次开始执行时间',
+    `finished_at` DATETIME NULL COMMENT '结束时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_job_status_next_run` (`status`, `next_run_at`),
+    KEY `idx_job_account` (`account_id`, `created_at`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='异步任务表';
+
+-- 2) 志愿者导入批次关联执行的异步任务。
+ALTER TABLE `volunteer_import_batches`
+    ADD COLUMN `job_id` BIGINT NOT NULL DEFAULT 0 COMMENT '异步任务ID（关联 jobs.id）' AFTER `operator_id`;
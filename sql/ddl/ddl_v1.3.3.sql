@@ -0,0 +1,12 @@
+-- ============================================
+-- DDL Version: v1.3.3
+-- Description: platform administrator identity
+-- Created: 2026-03-03
+-- ============================================
+
+-- 1) sys_accounts.identity_type 增加 3-平台管理员。
+--    平台管理员不开放注册，首个账号通过命令行创建：
+--    volunteer-system -c create-admin -username admin -email admin@example.com -phone 13800000000
+--    （密码通过 -password 参数或 ADMIN_PASSWORD 环境变量传入）
+ALTER TABLE `sys_accounts`
+    MODIFY COLUMN `identity_type` TINYINT NOT NULL COMMENT '身份类型: 1-志愿者, 2-组织管理者, 3-平台管理员';
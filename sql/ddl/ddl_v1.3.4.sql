@@ -0,0 +1,23 @@
+-- ============================================
+-- DDL Version: v1.3.4
+-- Description: multiple administrator accounts per organization
+-- Created: 2026-03-04
+-- ============================================
+
+-- 1) 组织协作管理员。组织主账号仍为 organizations.account_id，其余账号通过邀请获得组织管理权限，
+--    角色沿用成员角色编码（2-管理员, 3-负责人）并按相同的权限点授权；转让主账号时原主账号转为负责人。
+CREATE TABLE IF NOT EXISTS `org_admins` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `org_id` BIGINT NOT NULL COMMENT '组织ID（关联 organizations.id）',
+    `account_id` BIGINT NOT NULL COMMENT '被授权账号ID（关联 sys_accounts.id）',
+    `role` TINYINT NOT NULL DEFAULT 2 COMMENT '角色: 2-管理员, 3-负责人',
+    `status` TINYINT NOT NULL DEFAULT 1 COMMENT '状态: 1-待接受, 2-有效, 3-已拒绝, 4-已撤销',
+    `invited_by` BIGINT NOT NULL DEFAULT 0 COMMENT '邀请人账号ID',
+    `accepted_at` DATETIME NULL COMMENT '接受邀请时间',
+    `revoked_at` DATETIME NULL COMMENT '撤销时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    `updated_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_org_admin_org_account` (`org_id`, `account_id`),
+    KEY `idx_org_admin_account_status` (`account_id`, `status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='组织协作管理员表';
@@ -0,0 +1,16 @@
+-- ============================================
+-- DDL Version: v1.3.5
+-- Description: volunteer real-name verification
+-- Created: 2026-03-05
+-- ============================================
+
+-- 1) 志愿者实名认证。提交的身份证号加密保存在审核记录快照中，平台管理员审核通过后写入志愿者档案；
+--    id_card_hash 用于防止同一身份证号被多个志愿者认证。
+ALTER TABLE `volunteers`
+    MODIFY COLUMN `id_card` VARCHAR(100) NOT NULL DEFAULT '' COMMENT '身份证号（AES加密存储，实名认证通过后写入）',
+    ADD COLUMN `id_card_hash` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '身份证号哈希（唯一性校验）' AFTER `id_card`,
+    ADD KEY `idx_volunteer_id_card_hash` (`id_card_hash`);
+
+-- 2) 活动可要求仅限实名认证通过的志愿者报名。
+ALTER TABLE `activities`
+    ADD COLUMN `require_verified` TINYINT NOT NULL DEFAULT 0 COMMENT '是否仅限实名认证志愿者报名: 0-否, 1-是' AFTER `auto_settle_policy`;
@@ -0,0 +1,31 @@
+-- ============================================
+-- DDL Version: v1.3.6
+-- Description: organization qualification review with document uploads
+-- Created: 2026-03-06
+-- ============================================
+
+-- 1) 组织资质认证状态。组织提交资质材料后生成 audit_records（target_type=2）待平台管理员审核，
+--    审核通过后方可发布活动；驳回后可补充材料重新提交，历次提交均保留审核记录。
+ALTER TABLE `organizations`
+    ADD COLUMN `verify_status` TINYINT NOT NULL DEFAULT 0 COMMENT '资质认证状态: 0-未认证, 1-审核中, 2-已认证, 3-已驳回' AFTER `status`,
+    ADD COLUMN `verified_at` DATETIME NULL COMMENT '资质认证通过时间' AFTER `verify_status`;
+
+-- 存量组织视为已认证，不影响已有组织发布活动
+UPDATE `organizations` SET `verify_status` = 2, `verified_at` = NOW() WHERE `verify_status` = 0;
+
+-- 2) 组织资质材料。文件保存在上传目录 org_qualifications/<org_id>/ 下，
+--    audit_record_id 为 0 表示尚未提交的草稿材料，提交审核时绑定到对应审核记录。
+CREATE TABLE IF NOT EXISTS `org_qualification_documents` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `org_id` BIGINT NOT NULL COMMENT '组织ID（关联 organizations.id）',
+    `audit_record_id` BIGINT NOT NULL DEFAULT 0 COMMENT '审核记录ID（关联 audit_records.id），0-未提交',
+    `doc_type` TINYINT NOT NULL DEFAULT 1 COMMENT '材料类型: 1-登记证书/营业执照, 2-其他证明材料',
+    `file_name` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '原始文件名',
+    `file_path` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '文件相对路径（上传目录下）',
+    `file_size` BIGINT NOT NULL DEFAULT 0 COMMENT '文件大小（字节）',
+    `uploaded_by` BIGINT NOT NULL DEFAULT 0 COMMENT '上传人账号ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_org_qualification_org_record` (`org_id`, `audit_record_id`),
+    KEY `idx_org_qualification_record` (`audit_record_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='组织资质材料表';
@@ -0,0 +1,39 @@
+-- ============================================
+-- DDL Version: v1.3.7
+-- Description: audit inbox scoping columns
+-- Created: 2026-03-07
+-- ============================================
+
+-- 1) 审核记录冗余所属组织与活动，用于统一审核收件箱按组织范围及活动筛选。
+--    志愿者实名审核不属于任何组织，org_id 为 0。
+ALTER TABLE `audit_records`
+    ADD COLUMN `org_id` BIGINT NOT NULL DEFAULT 0 COMMENT '所属组织ID（关联 organizations.id），平台审核为组织资质对应组织或 0' AFTER `target_id`,
+    ADD COLUMN `activity_id` BIGINT NOT NULL DEFAULT 0 COMMENT '所属活动ID（关联 activities.id），仅活动报名审核' AFTER `org_id`,
+    ADD KEY `idx_audit_org_type_status` (`org_id`, `target_type`, `status`),
+    ADD KEY `idx_audit_activity` (`activity_id`),
+    ADD KEY `idx_audit_creator` (`creator_id`);
+
+-- 2) 回填存量数据
+-- 组织资质审核：目标即组织
+UPDATE `audit_records` SET `org_id` = `target_id` WHERE `target_type` = 2 AND `org_id` = 0;
+
+-- 加入/退出组织审核：组织ID取自成员快照
+UPDATE `audit_records`
+SET `org_id` = CAST(JSON_UNQUOTE(JSON_EXTRACT(`new_content`, '$.org_id')) AS UNSIGNED)
+WHERE `target_type` = 3 AND `org_id` = 0 AND JSON_VALID(`new_content`);
+
+-- 加入组织审核历史上未记录提交人，按快照中的志愿者回填
+UPDATE `audit_records` ar
+JOIN `volunteers` v ON v.`id` = CAST(JSON_UNQUOTE(JSON_EXTRACT(ar.`new_content`, '$.volunteer_id')) AS UNSIGNED)
+SET ar.`creator_id` = v.`account_id`
+WHERE ar.`target_type` = 3 AND ar.`creator_id` = 0 AND JSON_VALID(ar.`new_content`);
+
+-- 活动报名审核：活动ID取自报名快照，组织取自活动
+UPDATE `audit_records`
+SET `activity_id` = CAST(JSON_UNQUOTE(JSON_EXTRACT(`new_content`, '$.activity_id')) AS UNSIGNED)
+WHERE `target_type` = 4 AND `activity_id` = 0 AND JSON_VALID(`new_content`);
+
+UPDATE `audit_records` ar
+JOIN `activities` a ON a.`id` = ar.`activity_id`
+SET ar.`org_id` = a.`org_id`
+WHERE ar.`target_type` = 4 AND ar.`org_id` = 0;
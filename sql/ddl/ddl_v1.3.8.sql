@@ -0,0 +1,40 @@
+-- ============================================
+-- DDL Version: v1.3.8
+-- Description: multi-stage audit approval chains
+-- Created: 2026-03-08
+-- ============================================
+
+-- 1) 审批链配置。同一组织、审核类型下的多行按 stage_no 组成有序审批链，未配置时沿用单级审核。
+--    组织内审核（加入组织、活动报名）按组织配置，审批角色为 2-管理员、3-负责人、9-组织主账号；
+--    平台审核（志愿者实名、组织资质）统一配置在 org_id = 0 下，审批角色为 10-平台管理员。
+CREATE TABLE IF NOT EXISTS `audit_approval_stages` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `org_id` BIGINT NOT NULL DEFAULT 0 COMMENT '组织ID（关联 organizations.id），平台审核为 0',
+    `target_type` TINYINT NOT NULL COMMENT '审核类型: 1-志愿者实名, 2-组织资质, 3-加入组织申请, 4-活动报名',
+    `stage_no` INT NOT NULL COMMENT '阶段序号，从 1 开始',
+    `approver_role` TINYINT NOT NULL COMMENT '审批角色: 2-管理员, 3-负责人, 9-组织主账号, 10-平台管理员',
+    `approve_mode` TINYINT NOT NULL DEFAULT 1 COMMENT '通过方式: 1-任一审批人通过, 2-该角色全部审批人通过',
+    `updated_by` BIGINT NOT NULL DEFAULT 0 COMMENT '配置人账号ID',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_audit_stage` (`org_id`, `target_type`, `stage_no`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='审核审批链阶段配置表';
+
+-- 2) 审批阶段决定。每位审批人对同一审核记录只能作出一次决定，任一阶段驳回即整体驳回。
+CREATE TABLE IF NOT EXISTS `audit_stage_decisions` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `audit_record_id` BIGINT NOT NULL COMMENT '审核记录ID（关联 audit_records.id）',
+    `stage_no` INT NOT NULL COMMENT '阶段序号',
+    `approver_role` TINYINT NOT NULL COMMENT '审批时的角色',
+    `auditor_id` BIGINT NOT NULL COMMENT '审批人账号ID(关联sys_accounts.id)',
+    `decision` TINYINT NOT NULL COMMENT '决定: 1-通过, 2-驳回',
+    `reason` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '审批意见/驳回原因',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    UNIQUE KEY `uk_audit_stage_decision` (`audit_record_id`, `auditor_id`),
+    KEY `idx_audit_stage_decision_record` (`audit_record_id`, `stage_no`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='审核审批阶段决定表';
+
+-- 3) 审核记录当前所处阶段，最后一个阶段通过后才执行审核通过的业务处理。
+ALTER TABLE `audit_records`
+    ADD COLUMN `current_stage` INT NOT NULL DEFAULT 1 COMMENT '当前审批阶段（从 1 开始），未配置审批链时为 1' AFTER `status`;
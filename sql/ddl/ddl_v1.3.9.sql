@@ -0,0 +1,28 @@
+-- ============================================
+-- DDL Version: v1.3.9
+-- Description: audit SLA reminders and auto escalation
+-- Created: 2026-03-09
+-- ============================================
+
+-- 1) 审核记录的超时提醒与自动处理进度。处理时限按 audit_sla 配置由提交时间推算，不落库，调整配置后对存量记录同样生效。
+--    escalated_at 记录调度任务尝试超时自动处理的时间（处理失败时记录仍为待审核，不再重复尝试）。
+ALTER TABLE `audit_records`
+    ADD COLUMN `last_reminded_at` DATETIME NULL DEFAULT NULL COMMENT '最近一次超时提醒时间' AFTER `current_stage`,
+    ADD COLUMN `escalated_at` DATETIME NULL DEFAULT NULL COMMENT '超时自动处理时间' AFTER `last_reminded_at`,
+    ADD KEY `idx_audit_status_type_created` (`status`, `target_type`, `created_at`);
+
+-- 2) 审核超时提醒。每次提醒为当前负责审核的每个账号生成一条，配置审批链时仅提醒当前阶段尚未审批的审批人。
+CREATE TABLE IF NOT EXISTS `audit_reminders` (
+    `id` BIGINT NOT NULL AUTO_INCREMENT COMMENT '主键ID',
+    `audit_record_id` BIGINT NOT NULL COMMENT '审核记录ID（关联 audit_records.id）',
+    `account_id` BIGINT NOT NULL COMMENT '被提醒的审核人账号ID(关联sys_accounts.id)',
+    `target_type` TINYINT NOT NULL COMMENT '审核类型: 1-志愿者实名, 2-组织资质, 3-加入组织申请, 4-活动报名',
+    `org_id` BIGINT NOT NULL DEFAULT 0 COMMENT '所属组织ID（关联 organizations.id），平台审核为组织资质对应组织或 0',
+    `stage_no` INT NOT NULL DEFAULT 1 COMMENT '提醒时所处审批阶段',
+    `content` VARCHAR(255) NOT NULL DEFAULT '' COMMENT '提醒内容',
+    `read_at` DATETIME NULL DEFAULT NULL COMMENT '已读时间',
+    `created_at` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+    PRIMARY KEY (`id`),
+    KEY `idx_audit_reminder_account` (`account_id`, `read_at`),
+    KEY `idx_audit_reminder_record` (`audit_record_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci COMMENT='审核超时提醒表';